@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"sync"
 
 	"github.com/kkntzw/bookmark/internal/di"
 	"github.com/kkntzw/bookmark/internal/presentation/pb"
@@ -13,14 +15,38 @@ import (
 
 func main() {
 	log.Println("Start")
+	if err := di.Migrate(); err != nil {
+		log.Fatal(err)
+	}
 	lis, err := net.Listen("tcp", os.Getenv("GRPC_ADDRESS"))
 	if err != nil {
 		log.Fatal(err)
 	}
-	opts := []grpc.ServerOption{}
+	authenticator := di.InjectAuthenticator()
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor),
+	}
 	s := grpc.NewServer(opts...)
 	bs := di.InjectBookmarkServer()
 	pb.RegisterBookmarkerServer(s, bs)
+	fs := di.InjectFolderServer()
+	pb.RegisterFolderManagerServer(s, fs)
+	ws := di.InjectWebhookServer()
+	pb.RegisterWebhookManagerServer(s, ws)
+	ctx, cancel := context.WithCancel(context.Background())
+	deliverer := di.InjectWebhookDeliverer()
+	relay := di.InjectOutboxRelay()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		deliverer.Run(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		relay.Run(ctx)
+	}()
 	go func() {
 		if err := s.Serve(lis); err != nil {
 			log.Fatal(err)
@@ -30,5 +56,7 @@ func main() {
 	signal.Notify(ch, os.Interrupt)
 	<-ch
 	s.Stop()
+	cancel()
+	wg.Wait()
 	log.Println("Stop")
 }
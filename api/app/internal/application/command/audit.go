@@ -0,0 +1,39 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 監査ログ一覧取得用のコマンド。
+type ListAuditEntries struct {
+	BookmarkID string    // ブックマークのID (空文字列の場合は全てのブックマーク)
+	Actor      string    // 操作者 (空文字列の場合は全ての操作者)
+	Since      time.Time // 作成日時の下限 (ゼロ値の場合は下限なし)
+	Until      time.Time // 作成日時の上限 (ゼロ値の場合は上限なし)
+	UserID     string    // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListAuditEntries) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if cmd.BookmarkID != "" {
+		if _, err := entity.NewID(cmd.BookmarkID); err != nil {
+			args["BookmarkID"] = err
+		}
+	}
+	if !cmd.Since.IsZero() && !cmd.Until.IsZero() && !cmd.Since.Before(cmd.Until) {
+		args["Until"] = fmt.Errorf("not after since: %s", cmd.Until.Format(time.RFC3339))
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAuditEntries_Validate(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		cmd         *ListAuditEntries
+		expectedErr error
+	}{
+		"empty arguments": {
+			&ListAuditEntries{"", "", time.Time{}, time.Time{}, "alice"},
+			nil,
+		},
+		"valid arguments": {
+			&ListAuditEntries{"1", "alice", earlier, later, "alice"},
+			nil,
+		},
+		"since only": {
+			&ListAuditEntries{"", "", later, time.Time{}, "alice"},
+			nil,
+		},
+		"until only": {
+			&ListAuditEntries{"", "", time.Time{}, earlier, "alice"},
+			nil,
+		},
+		"invalid bookmark id": {
+			&ListAuditEntries{" ", "", time.Time{}, time.Time{}, "alice"},
+			&InvalidCommandError{map[string]error{"BookmarkID": helper.ToErrID(t, " ")}},
+		},
+		"until before since": {
+			&ListAuditEntries{"", "", later, earlier, "alice"},
+			&InvalidCommandError{map[string]error{"Until": errors.New("not after since: 2022-01-01T00:00:00Z")}},
+		},
+		"until equal to since": {
+			&ListAuditEntries{"", "", later, later, "alice"},
+			&InvalidCommandError{map[string]error{"Until": errors.New("not after since: 2022-01-02T00:00:00Z")}},
+		},
+		"invalid user id": {
+			&ListAuditEntries{"", "", time.Time{}, time.Time{}, ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
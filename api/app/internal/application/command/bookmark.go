@@ -1,27 +1,55 @@
 package command
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 )
 
+const (
+	DefaultPageSize = 100  // 1ページあたりの既定の件数
+	MaxPageSize     = 1000 // 1ページあたりの最大件数
+)
+
+// URIを検証する。
+//
+// URIの解析に失敗した場合はエラーを返却する。
+// URIがURIの制約を満たさない場合はエラーを返却する。
+func validateURI(v string, policy *entity.URIPolicy) error {
+	uri, err := entity.NewURI(v)
+	if err != nil {
+		return err
+	}
+	return policy.Validate(uri)
+}
+
 // ブックマーク登録用のコマンド。
 type RegisterBookmark struct {
-	Name string   // ブックマーク名
-	URI  string   // URI
-	Tags []string // タグ一覧
+	Name        string   // ブックマーク名
+	URI         string   // URI
+	Description string   // 説明
+	Tags        []string // タグ一覧
+	UserID      string   // 操作するユーザのID
 }
 
-// コマンドの妥当性を検証する。
+// URIの制約に従ってコマンドの妥当性を検証する。
 //
 // コマンドが不正な場合は InvalidCommandError を返却する。
-func (cmd *RegisterBookmark) Validate() error {
+func (cmd *RegisterBookmark) Validate(policy *entity.URIPolicy) error {
 	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
 	if _, err := entity.NewName(cmd.Name); err != nil {
 		args["Name"] = err
 	}
-	if _, err := entity.NewURI(cmd.URI); err != nil {
+	if err := validateURI(cmd.URI, policy); err != nil {
 		args["URI"] = err
 	}
+	if _, err := entity.NewDescription(cmd.Description); err != nil {
+		args["Description"] = err
+	}
 	for _, v := range cmd.Tags {
 		if _, err := entity.NewTag(v); err != nil {
 			args["Tags"] = err
@@ -34,44 +62,620 @@ func (cmd *RegisterBookmark) Validate() error {
 	return nil
 }
 
+// ブックマーク取得用のコマンド。
+type GetBookmark struct {
+	ID     string // ID
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *GetBookmark) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// ブックマーク一覧取得用のコマンド。
+type ListBookmarks struct {
+	Tags         []string // 絞り込みに用いるタグ一覧
+	MatchAllTags bool     // 全てのタグを含むブックマークに限定するか
+	NameContains string   // ブックマーク名に含まれる文字列
+	URIContains  string   // URIに含まれる文字列
+	FolderID     string   // 所属するフォルダのID (空文字列の場合は絞り込まない)
+	Status       string   // 状態 ("", "unread", "read", "archived")
+	StarredOnly  bool     // お気に入りのブックマークに限定するか
+	OrderBy      string   // 並び替えのキー ("", "ID", "Name", "URI", "CreatedAt", "UpdatedAt")
+	Descending   bool     // 降順に並び替えるか
+	PageSize     int      // 1ページあたりの最大件数 (0の場合は DefaultPageSize)
+	PageToken    string   // ページトークン (同じ並び替えのキーと順序で発行したもの)
+	UserID       string   // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListBookmarks) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	for _, v := range cmd.Tags {
+		if _, err := entity.NewTag(v); err != nil {
+			args["Tags"] = err
+			break
+		}
+	}
+	if err := validateFolderID(cmd.FolderID); err != nil {
+		args["FolderID"] = err
+	}
+	if cmd.Status != "" {
+		if _, err := entity.NewStatus(cmd.Status); err != nil {
+			args["Status"] = err
+		}
+	}
+	switch cmd.OrderBy {
+	case "", "ID", "Name", "URI", "CreatedAt", "UpdatedAt":
+	default:
+		args["OrderBy"] = fmt.Errorf("unknown sort key: %s", cmd.OrderBy)
+	}
+	if cmd.PageSize < 0 || MaxPageSize < cmd.PageSize {
+		args["PageSize"] = fmt.Errorf("out of range: %d", cmd.PageSize)
+	}
+	if cursor, err := DecodePageToken(cmd.PageToken); err != nil {
+		args["PageToken"] = err
+	} else if cursor != nil {
+		if _, err := entity.NewID(cursor.ID); err != nil {
+			args["PageToken"] = fmt.Errorf("invalid page token: %s", cmd.PageToken)
+		} else if cursor.OrderBy != cmd.OrderBy || cursor.Descending != cmd.Descending {
+			args["PageToken"] = fmt.Errorf("page token for a different order: %s", cmd.PageToken)
+		}
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
 // ブックマーク更新用のコマンド。
 type UpdateBookmark struct {
-	ID   string // ID
-	Name string // ブックマーク名
-	URI  string // URI
+	ID          string   // ID
+	Name        string   // ブックマーク名
+	URI         string   // URI
+	Description string   // 説明
+	Tags        []string // タグ一覧
+	UpdateMask  []string // 更新するフィールド一覧 ("Name", "URI", "Description", "Tags")
+	Version     uint64   // 更新前に期待する版数 (0の場合は検証しない)
+	UserID      string   // 操作するユーザのID
 }
 
-// コマンドの妥当性を検証する。
+// URIの制約に従ってコマンドの妥当性を検証する。
 //
+// 更新対象のフィールドに限り検証する。
 // コマンドが不正な場合は InvalidCommandError を返却する。
-func (cmd *UpdateBookmark) Validate() error {
+func (cmd *UpdateBookmark) Validate(policy *entity.URIPolicy) error {
 	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
 	if _, err := entity.NewID(cmd.ID); err != nil {
 		args["ID"] = err
 	}
-	if _, err := entity.NewName(cmd.Name); err != nil {
+	for _, path := range cmd.UpdateMask {
+		switch path {
+		case "Name", "URI", "Description", "Tags":
+		default:
+			args["UpdateMask"] = fmt.Errorf("unknown path: %s", path)
+		}
+	}
+	if _, err := entity.NewName(cmd.Name); err != nil && cmd.Updates("Name") {
 		args["Name"] = err
 	}
-	if _, err := entity.NewURI(cmd.URI); err != nil {
+	if err := validateURI(cmd.URI, policy); err != nil && cmd.Updates("URI") {
 		args["URI"] = err
 	}
+	if _, err := entity.NewDescription(cmd.Description); err != nil && cmd.Updates("Description") {
+		args["Description"] = err
+	}
+	for _, v := range cmd.Tags {
+		if _, err := entity.NewTag(v); err != nil && cmd.Updates("Tags") {
+			args["Tags"] = err
+			break
+		}
+	}
 	if len(args) > 0 {
 		return &InvalidCommandError{Args: args}
 	}
 	return nil
 }
 
+// フィールドが更新対象であるかを判定する。
+//
+// 更新するフィールド一覧が空の場合は Name と URI を更新対象とする。
+func (cmd *UpdateBookmark) Updates(field string) bool {
+	if len(cmd.UpdateMask) == 0 {
+		return field == "Name" || field == "URI"
+	}
+	for _, path := range cmd.UpdateMask {
+		if path == field {
+			return true
+		}
+	}
+	return false
+}
+
 // ブックマーク削除用のコマンド。
 type DeleteBookmark struct {
-	ID string // ID
+	ID      string // ID
+	Version uint64 // 削除前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
 }
 
 // コマンドの妥当性を検証する。
 //
 // コマンドが不正な場合は InvalidCommandError を返却する。
 func (cmd *DeleteBookmark) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
 	if _, err := entity.NewID(cmd.ID); err != nil {
-		return &InvalidCommandError{map[string]error{"ID": err}}
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// ゴミ箱一覧取得用のコマンド。
+type ListTrash struct {
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListTrash) Validate() error {
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		return &InvalidCommandError{map[string]error{"UserID": err}}
+	}
+	return nil
+}
+
+// ブックマーク復元用のコマンド。
+type RestoreBookmark struct {
+	ID      string // ID
+	Version uint64 // 復元前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *RestoreBookmark) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// 改訂履歴取得用のコマンド。
+type ListBookmarkRevisions struct {
+	ID     string // ブックマークのID
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListBookmarkRevisions) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// ブックマークを改訂前に戻すためのコマンド。
+type RevertBookmark struct {
+	RevisionID string // 改訂のID
+	Version    uint64 // 変更前に期待するブックマークの版数 (0の場合は検証しない)
+	UserID     string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *RevertBookmark) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.RevisionID); err != nil {
+		args["RevisionID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// 再開トークンの最大長。
+const maxResumeTokenLength = 1024
+
+// ブックマークの変更を監視するためのコマンド。
+type WatchBookmarks struct {
+	ResumeToken string // 再開トークン (空文字列の場合は以降の変更のみを監視する)
+	UserID      string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *WatchBookmarks) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if len(cmd.ResumeToken) > maxResumeTokenLength {
+		args["ResumeToken"] = fmt.Errorf("too long: %d", len(cmd.ResumeToken))
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// ゴミ箱の完全削除用のコマンド。
+type PurgeTrash struct {
+	OlderThan time.Time // この日時より前にゴミ箱に移動したブックマークを削除する
+	UserID    string    // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *PurgeTrash) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if cmd.OlderThan.IsZero() {
+		args["OlderThan"] = fmt.Errorf("zero time")
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// 既読化用のコマンド。
+type MarkRead struct {
+	ID      string // ID
+	Version uint64 // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *MarkRead) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// 未読化用のコマンド。
+type MarkUnread struct {
+	ID      string // ID
+	Version uint64 // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *MarkUnread) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// アーカイブ用のコマンド。
+type Archive struct {
+	ID      string // ID
+	Version uint64 // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *Archive) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// お気に入り登録用のコマンド。
+type Star struct {
+	ID      string // ID
+	Version uint64 // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *Star) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// お気に入り解除用のコマンド。
+type Unstar struct {
+	ID      string // ID
+	Version uint64 // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *Unstar) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// タグ追加用のコマンド。
+type AddTags struct {
+	ID      string   // ID
+	Tags    []string // 追加するタグ一覧
+	Version uint64   // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string   // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *AddTags) Validate() error {
+	return validateTagging(cmd.UserID, cmd.ID, cmd.Tags)
+}
+
+// タグ削除用のコマンド。
+type RemoveTags struct {
+	ID      string   // ID
+	Tags    []string // 削除するタグ一覧
+	Version uint64   // 変更前に期待する版数 (0の場合は検証しない)
+	UserID  string   // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *RemoveTags) Validate() error {
+	return validateTagging(cmd.UserID, cmd.ID, cmd.Tags)
+}
+
+// タグを操作するコマンドの妥当性を検証する。
+//
+// タグ一覧が空の場合は不正とする。
+func validateTagging(userID, id string, tags []string) error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(userID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(id); err != nil {
+		args["ID"] = err
+	}
+	if len(tags) == 0 {
+		args["Tags"] = fmt.Errorf("no tags")
+	}
+	for _, v := range tags {
+		if _, err := entity.NewTag(v); err != nil {
+			args["Tags"] = err
+			break
+		}
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// タグ一覧取得用のコマンド。
+type ListTags struct {
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListTags) Validate() error {
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		return &InvalidCommandError{map[string]error{"UserID": err}}
+	}
+	return nil
+}
+
+// タグ名変更用のコマンド。
+type RenameTag struct {
+	From   string // 変更前のタグ
+	To     string // 変更後のタグ
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *RenameTag) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewTag(cmd.From); err != nil {
+		args["From"] = err
+	}
+	if _, err := entity.NewTag(cmd.To); err != nil {
+		args["To"] = err
+	}
+	if len(args) == 0 && cmd.From == cmd.To {
+		args["To"] = fmt.Errorf("same as From: %s", cmd.To)
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// タグ統合用のコマンド。
+type MergeTags struct {
+	Sources []string // 統合元のタグ一覧
+	Target  string   // 統合先のタグ
+	UserID  string   // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *MergeTags) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if len(cmd.Sources) == 0 {
+		args["Sources"] = fmt.Errorf("no tags")
+	}
+	for _, v := range cmd.Sources {
+		if _, err := entity.NewTag(v); err != nil {
+			args["Sources"] = err
+			break
+		}
+	}
+	if _, err := entity.NewTag(cmd.Target); err != nil {
+		args["Target"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// 重複一覧取得用のコマンド。
+type FindDuplicates struct {
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *FindDuplicates) Validate() error {
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		return &InvalidCommandError{map[string]error{"UserID": err}}
+	}
+	return nil
+}
+
+// ブックマーク統合用のコマンド。
+type MergeBookmarks struct {
+	ID        string   // 統合先のID
+	SourceIDs []string // 統合元のID一覧
+	UserID    string   // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *MergeBookmarks) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(cmd.SourceIDs) == 0 {
+		args["SourceIDs"] = fmt.Errorf("no IDs")
+	}
+	seen := map[string]bool{cmd.ID: true}
+	for _, v := range cmd.SourceIDs {
+		if _, err := entity.NewID(v); err != nil {
+			args["SourceIDs"] = err
+			break
+		}
+		if seen[v] {
+			args["SourceIDs"] = fmt.Errorf("duplicate ID: %s", v)
+			break
+		}
+		seen[v] = true
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
 	}
 	return nil
 }
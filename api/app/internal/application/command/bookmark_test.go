@@ -1,8 +1,12 @@
 package command
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/test/helper"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,41 +18,206 @@ func TestRegisterBookmark_Validate(t *testing.T) {
 		expectedErr error
 	}{
 		"valid arguments (nil tags)": {
-			&RegisterBookmark{"Example", "https://example.com", nil},
+			&RegisterBookmark{"Example", "https://example.com", "", nil, "alice"},
 			nil,
 		},
 		"valid arguments (empty tags)": {
-			&RegisterBookmark{"Example", "https://example.com", []string{}},
+			&RegisterBookmark{"Example", "https://example.com", "", []string{}, "alice"},
 			nil,
 		},
 		"valid arguments (1 tag)": {
-			&RegisterBookmark{"Example", "https://example.com", []string{"foo"}},
+			&RegisterBookmark{"Example", "https://example.com", "", []string{"foo"}, "alice"},
 			nil,
 		},
 		"valid arguments (2 tags)": {
-			&RegisterBookmark{"Example", "https://example.com", []string{"foo", "bar"}},
+			&RegisterBookmark{"Example", "https://example.com", "", []string{"foo", "bar"}, "alice"},
 			nil,
 		},
 		"valid arguments (3 tags)": {
-			&RegisterBookmark{"Example", "https://example.com", []string{"foo", "bar", "baz"}},
+			&RegisterBookmark{"Example", "https://example.com", "", []string{"foo", "bar", "baz"}, "alice"},
+			nil,
+		},
+		"valid arguments (description)": {
+			&RegisterBookmark{"Example", "https://example.com", "Example\nDomain", nil, "alice"},
 			nil,
 		},
 		"invalid name": {
-			&RegisterBookmark{"", "https://example.com", []string{"foo", "bar", "baz"}},
+			&RegisterBookmark{"", "https://example.com", "", []string{"foo", "bar", "baz"}, "alice"},
 			&InvalidCommandError{map[string]error{"Name": helper.ToErrName(t, "")}},
 		},
 		"invalid uri": {
-			&RegisterBookmark{"Example", "", []string{"foo", "bar", "baz"}},
+			&RegisterBookmark{"Example", "", "", []string{"foo", "bar", "baz"}, "alice"},
 			&InvalidCommandError{map[string]error{"URI": helper.ToErrURI(t, "")}},
 		},
+		"relative uri": {
+			&RegisterBookmark{"Example", "example.com/foo", "", nil, "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("not absolute URI: example.com/foo")}},
+		},
+		"disallowed scheme": {
+			&RegisterBookmark{"Example", "javascript:alert(1)", "", nil, "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("scheme not allowed: javascript")}},
+		},
+		"invalid description": {
+			&RegisterBookmark{"Example", "https://example.com", "\u0000", nil, "alice"},
+			&InvalidCommandError{map[string]error{"Description": helper.ToErrDescription(t, "\u0000")}},
+		},
 		"invalid tags": {
-			&RegisterBookmark{"Example", "https://example.com", []string{"foo", "", "baz"}},
+			&RegisterBookmark{"Example", "https://example.com", "", []string{"foo", "", "baz"}, "alice"},
 			&InvalidCommandError{map[string]error{"Tags": helper.ToErrTag(t, "")}},
 		},
 		"invalid arguments": {
-			&RegisterBookmark{"", "", []string{""}},
+			&RegisterBookmark{"", "", "", []string{""}, "alice"},
 			&InvalidCommandError{map[string]error{"Name": helper.ToErrName(t, ""), "URI": helper.ToErrURI(t, ""), "Tags": helper.ToErrTag(t, "")}},
 		},
+		"invalid user id": {
+			&RegisterBookmark{"Example", "https://example.com", "", nil, ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate(entity.DefaultURIPolicy())
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRegisterBookmark_ValidateWithPolicy(t *testing.T) {
+	t.Parallel()
+	policy, err := entity.NewURIPolicy([]string{"https"}, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]struct {
+		cmd         *RegisterBookmark
+		expectedErr error
+	}{
+		"allowed scheme": {
+			&RegisterBookmark{"Example", "https://example.com", "", nil, "alice"},
+			nil,
+		},
+		"scheme not allowed by policy": {
+			&RegisterBookmark{"Example", "ftp://example.com", "", nil, "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("scheme not allowed: ftp")}},
+		},
+		"too long uri": {
+			&RegisterBookmark{"Example", "https://example.com/0123456789abc", "", nil, "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("string length exceeds 32: 33")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate(policy)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetBookmark_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *GetBookmark
+		expectedErr error
+	}{
+		"valid argument": {
+			&GetBookmark{"1", "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&GetBookmark{"", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"invalid user id": {
+			&GetBookmark{"1", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+		"invalid arguments": {
+			&GetBookmark{"", ""},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListBookmarks_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListBookmarks
+		expectedErr error
+	}{
+		"empty arguments": {
+			&ListBookmarks{UserID: "alice"},
+			nil,
+		},
+		"valid arguments": {
+			&ListBookmarks{Tags: []string{"foo", "bar"}, MatchAllTags: true, NameContains: "Example", URIContains: "example.com", FolderID: "10", Status: "read", StarredOnly: true, OrderBy: "Name", Descending: true, PageSize: 1000, PageToken: "eyJvIjoiTmFtZSIsImQiOnRydWUsInYiOiJFeGFtcGxlIiwiaSI6IjIifQ", UserID: "alice"},
+			nil,
+		},
+		"invalid tags": {
+			&ListBookmarks{Tags: []string{"foo", ""}, UserID: "alice"},
+			&InvalidCommandError{map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"invalid folder id": {
+			&ListBookmarks{FolderID: "!", UserID: "alice"},
+			&InvalidCommandError{map[string]error{"FolderID": helper.ToErrID(t, "!")}},
+		},
+		"invalid status": {
+			&ListBookmarks{Status: "deleted", UserID: "alice"},
+			&InvalidCommandError{map[string]error{"Status": errors.New("unknown status: deleted")}},
+		},
+		"order by created at": {
+			&ListBookmarks{OrderBy: "CreatedAt", UserID: "alice"},
+			nil,
+		},
+		"order by updated at": {
+			&ListBookmarks{OrderBy: "UpdatedAt", UserID: "alice"},
+			nil,
+		},
+		"invalid order": {
+			&ListBookmarks{OrderBy: "Tags", UserID: "alice"},
+			&InvalidCommandError{map[string]error{"OrderBy": errors.New("unknown sort key: Tags")}},
+		},
+		"negative page size": {
+			&ListBookmarks{PageSize: -1, UserID: "alice"},
+			&InvalidCommandError{map[string]error{"PageSize": errors.New("out of range: -1")}},
+		},
+		"too large page size": {
+			&ListBookmarks{PageSize: 1001, UserID: "alice"},
+			&InvalidCommandError{map[string]error{"PageSize": errors.New("out of range: 1001")}},
+		},
+		"invalid page token": {
+			&ListBookmarks{PageToken: "!", UserID: "alice"},
+			&InvalidCommandError{map[string]error{"PageToken": errors.New("invalid page token: !")}},
+		},
+		"page token with invalid id": {
+			&ListBookmarks{PageToken: "eyJpIjoiISJ9", UserID: "alice"},
+			&InvalidCommandError{map[string]error{"PageToken": errors.New("invalid page token: eyJpIjoiISJ9")}},
+		},
+		"page token for a different order": {
+			&ListBookmarks{OrderBy: "Name", PageToken: "eyJvIjoiTmFtZSIsImQiOnRydWUsInYiOiJFeGFtcGxlIiwiaSI6IjIifQ", UserID: "alice"},
+			&InvalidCommandError{map[string]error{"PageToken": errors.New("page token for a different order: eyJvIjoiTmFtZSIsImQiOnRydWUsInYiOiJFeGFtcGxlIiwiaSI6IjIifQ")}},
+		},
+		"invalid user id": {
+			&ListBookmarks{},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
 	}
 	for name, tc := range cases {
 		tc := tc
@@ -69,23 +238,55 @@ func TestUpdateBookmark_Validate(t *testing.T) {
 		expectedErr error
 	}{
 		"valid arguments": {
-			&UpdateBookmark{"1", "Example", "https://example.com"},
+			&UpdateBookmark{"1", "Example", "https://example.com", "", nil, nil, 0, "alice"},
+			nil,
+		},
+		"valid arguments with update mask": {
+			&UpdateBookmark{"1", "Example", "https://example.com", "Example\nDomain", []string{"foo", "bar"}, []string{"Name", "URI", "Description", "Tags"}, 0, "alice"},
 			nil,
 		},
 		"invalid id": {
-			&UpdateBookmark{"", "Example", "https://example.com"},
+			&UpdateBookmark{"", "Example", "https://example.com", "", nil, nil, 0, "alice"},
 			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
 		},
 		"invalid name": {
-			&UpdateBookmark{"1", "", "https://example.com"},
+			&UpdateBookmark{"1", "", "https://example.com", "", nil, nil, 0, "alice"},
 			&InvalidCommandError{map[string]error{"Name": helper.ToErrName(t, "")}},
 		},
 		"invalid uri": {
-			&UpdateBookmark{"1", "Example", ""},
+			&UpdateBookmark{"1", "Example", "", "", nil, nil, 0, "alice"},
 			&InvalidCommandError{map[string]error{"URI": helper.ToErrURI(t, "")}},
 		},
+		"disallowed scheme": {
+			&UpdateBookmark{"1", "Example", "javascript:alert(1)", "", nil, nil, 0, "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("scheme not allowed: javascript")}},
+		},
+		"disallowed scheme without update": {
+			&UpdateBookmark{"1", "Example", "javascript:alert(1)", "", nil, []string{"Name"}, 0, "alice"},
+			nil,
+		},
+		"invalid tags": {
+			&UpdateBookmark{"1", "", "", "", []string{"foo", ""}, []string{"Tags"}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"invalid description": {
+			&UpdateBookmark{"1", "", "", "\u0000", nil, []string{"Description"}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Description": helper.ToErrDescription(t, "\u0000")}},
+		},
+		"invalid description out of update mask": {
+			&UpdateBookmark{"1", "Example", "https://example.com", "\u0000", nil, nil, 0, "alice"},
+			nil,
+		},
+		"invalid update mask": {
+			&UpdateBookmark{"1", "Example", "", "", nil, []string{"Name", "foo"}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"UpdateMask": errors.New("unknown path: foo")}},
+		},
+		"invalid fields out of update mask": {
+			&UpdateBookmark{"1", "", "", "", []string{""}, []string{"Tags"}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
 		"invalid arguments": {
-			&UpdateBookmark{"", "", ""},
+			&UpdateBookmark{"", "", "", "", nil, nil, 0, "alice"},
 			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "Name": helper.ToErrName(t, ""), "URI": helper.ToErrURI(t, "")}},
 		},
 	}
@@ -94,13 +295,58 @@ func TestUpdateBookmark_Validate(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			// when
-			actualErr := tc.cmd.Validate()
+			actualErr := tc.cmd.Validate(entity.DefaultURIPolicy())
 			// then
 			assert.Exactly(t, tc.expectedErr, actualErr)
 		})
 	}
 }
 
+func TestUpdateBookmark_Updates(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd      *UpdateBookmark
+		field    string
+		expected bool
+	}{
+		"name without update mask": {
+			&UpdateBookmark{UpdateMask: nil, UserID: "alice"},
+			"Name",
+			true,
+		},
+		"uri without update mask": {
+			&UpdateBookmark{UpdateMask: nil, UserID: "alice"},
+			"URI",
+			true,
+		},
+		"tags without update mask": {
+			&UpdateBookmark{UpdateMask: nil, UserID: "alice"},
+			"Tags",
+			false,
+		},
+		"field in update mask": {
+			&UpdateBookmark{UpdateMask: []string{"Tags"}, UserID: "alice"},
+			"Tags",
+			true,
+		},
+		"field not in update mask": {
+			&UpdateBookmark{UpdateMask: []string{"Tags"}, UserID: "alice"},
+			"Name",
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actual := tc.cmd.Updates(tc.field)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestDeleteBookmark_Validate(t *testing.T) {
 	t.Parallel()
 	cases := map[string]struct {
@@ -108,11 +354,96 @@ func TestDeleteBookmark_Validate(t *testing.T) {
 		expectedErr error
 	}{
 		"valid argument": {
-			&DeleteBookmark{"1"},
+			&DeleteBookmark{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&DeleteBookmark{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"invalid user id": {
+			&DeleteBookmark{"1", 0, ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListTrash_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListTrash
+		expectedErr error
+	}{
+		"valid argument": {
+			&ListTrash{"alice"},
+			nil,
+		},
+		"invalid argument": {
+			&ListTrash{""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRestoreBookmark_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *RestoreBookmark
+		expectedErr error
+	}{
+		"valid argument": {
+			&RestoreBookmark{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&RestoreBookmark{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListBookmarkRevisions_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListBookmarkRevisions
+		expectedErr error
+	}{
+		"valid argument": {
+			&ListBookmarkRevisions{"1", "alice"},
 			nil,
 		},
 		"invalid argument": {
-			&DeleteBookmark{""},
+			&ListBookmarkRevisions{"", "alice"},
 			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
 		},
 	}
@@ -127,3 +458,488 @@ func TestDeleteBookmark_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRevertBookmark_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *RevertBookmark
+		expectedErr error
+	}{
+		"valid argument": {
+			&RevertBookmark{"100", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&RevertBookmark{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"RevisionID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWatchBookmarks_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *WatchBookmarks
+		expectedErr error
+	}{
+		"empty resume token": {
+			&WatchBookmarks{"", "alice"},
+			nil,
+		},
+		"resume token of max length": {
+			&WatchBookmarks{strings.Repeat("a", 1024), "alice"},
+			nil,
+		},
+		"too long resume token": {
+			&WatchBookmarks{strings.Repeat("a", 1025), "alice"},
+			&InvalidCommandError{map[string]error{"ResumeToken": errors.New("too long: 1025")}},
+		},
+		"invalid user id": {
+			&WatchBookmarks{"", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestPurgeTrash_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *PurgeTrash
+		expectedErr error
+	}{
+		"valid argument": {
+			&PurgeTrash{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "alice"},
+			nil,
+		},
+		"zero time": {
+			&PurgeTrash{time.Time{}, "alice"},
+			&InvalidCommandError{map[string]error{"OlderThan": errors.New("zero time")}},
+		},
+		"invalid user id": {
+			&PurgeTrash{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestMarkRead_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *MarkRead
+		expectedErr error
+	}{
+		"valid argument": {
+			&MarkRead{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&MarkRead{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestMarkUnread_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *MarkUnread
+		expectedErr error
+	}{
+		"valid argument": {
+			&MarkUnread{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&MarkUnread{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestArchive_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *Archive
+		expectedErr error
+	}{
+		"valid argument": {
+			&Archive{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&Archive{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestStar_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *Star
+		expectedErr error
+	}{
+		"valid argument": {
+			&Star{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&Star{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestUnstar_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *Unstar
+		expectedErr error
+	}{
+		"valid argument": {
+			&Unstar{"1", 0, "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&Unstar{"", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestAddTags_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *AddTags
+		expectedErr error
+	}{
+		"valid arguments": {
+			&AddTags{"1", []string{"foo", "bar"}, 0, "alice"},
+			nil,
+		},
+		"invalid id": {
+			&AddTags{"", []string{"foo", "bar"}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"nil tags": {
+			&AddTags{"1", nil, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Tags": errors.New("no tags")}},
+		},
+		"invalid tags": {
+			&AddTags{"1", []string{"foo", ""}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"invalid arguments": {
+			&AddTags{"", []string{}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "Tags": errors.New("no tags")}},
+		},
+		"invalid user id": {
+			&AddTags{"1", []string{"foo"}, 0, ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRemoveTags_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *RemoveTags
+		expectedErr error
+	}{
+		"valid arguments": {
+			&RemoveTags{"1", []string{"foo", "bar"}, 0, "alice"},
+			nil,
+		},
+		"invalid id": {
+			&RemoveTags{"", []string{"foo", "bar"}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"nil tags": {
+			&RemoveTags{"1", nil, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Tags": errors.New("no tags")}},
+		},
+		"invalid tags": {
+			&RemoveTags{"1", []string{"foo", ""}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"invalid arguments": {
+			&RemoveTags{"", []string{}, 0, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "Tags": errors.New("no tags")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListTags_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListTags
+		expectedErr error
+	}{
+		"valid argument": {
+			&ListTags{"alice"},
+			nil,
+		},
+		"invalid argument": {
+			&ListTags{""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRenameTag_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *RenameTag
+		expectedErr error
+	}{
+		"valid arguments": {
+			&RenameTag{"golang", "go", "alice"},
+			nil,
+		},
+		"invalid from": {
+			&RenameTag{"", "go", "alice"},
+			&InvalidCommandError{map[string]error{"From": helper.ToErrTag(t, "")}},
+		},
+		"invalid to": {
+			&RenameTag{"golang", "", "alice"},
+			&InvalidCommandError{map[string]error{"To": helper.ToErrTag(t, "")}},
+		},
+		"same tags": {
+			&RenameTag{"go", "go", "alice"},
+			&InvalidCommandError{map[string]error{"To": errors.New("same as From: go")}},
+		},
+		"invalid arguments": {
+			&RenameTag{"", "", "alice"},
+			&InvalidCommandError{map[string]error{"From": helper.ToErrTag(t, ""), "To": helper.ToErrTag(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestMergeTags_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *MergeTags
+		expectedErr error
+	}{
+		"valid arguments": {
+			&MergeTags{[]string{"golang", "go-lang"}, "go", "alice"},
+			nil,
+		},
+		"nil sources": {
+			&MergeTags{nil, "go", "alice"},
+			&InvalidCommandError{map[string]error{"Sources": errors.New("no tags")}},
+		},
+		"invalid sources": {
+			&MergeTags{[]string{"golang", ""}, "go", "alice"},
+			&InvalidCommandError{map[string]error{"Sources": helper.ToErrTag(t, "")}},
+		},
+		"invalid target": {
+			&MergeTags{[]string{"golang", "go-lang"}, "", "alice"},
+			&InvalidCommandError{map[string]error{"Target": helper.ToErrTag(t, "")}},
+		},
+		"invalid user id": {
+			&MergeTags{[]string{"golang"}, "go", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFindDuplicates_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *FindDuplicates
+		expectedErr error
+	}{
+		"valid argument": {
+			&FindDuplicates{"alice"},
+			nil,
+		},
+		"invalid argument": {
+			&FindDuplicates{""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestMergeBookmarks_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *MergeBookmarks
+		expectedErr error
+	}{
+		"valid arguments": {
+			&MergeBookmarks{"1", []string{"2", "3"}, "alice"},
+			nil,
+		},
+		"invalid id": {
+			&MergeBookmarks{"", []string{"2", "3"}, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"nil source ids": {
+			&MergeBookmarks{"1", nil, "alice"},
+			&InvalidCommandError{map[string]error{"SourceIDs": errors.New("no IDs")}},
+		},
+		"invalid source ids": {
+			&MergeBookmarks{"1", []string{"2", ""}, "alice"},
+			&InvalidCommandError{map[string]error{"SourceIDs": helper.ToErrID(t, "")}},
+		},
+		"duplicate source ids": {
+			&MergeBookmarks{"1", []string{"2", "3", "2"}, "alice"},
+			&InvalidCommandError{map[string]error{"SourceIDs": errors.New("duplicate ID: 2")}},
+		},
+		"source ids containing id": {
+			&MergeBookmarks{"1", []string{"2", "1"}, "alice"},
+			&InvalidCommandError{map[string]error{"SourceIDs": errors.New("duplicate ID: 1")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
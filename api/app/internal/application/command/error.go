@@ -31,3 +31,64 @@ func (e *InvalidCommandError) Error() string {
 	}
 	return text
 }
+
+// 存在しないリソースを表すエラー。
+type NotFoundError struct {
+	Resource string // リソース名
+}
+
+// エラー状態を表す。
+//
+// "<Resource> does not exist" を出力する。
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s does not exist", e.Resource)
+}
+
+// 既に存在するリソースを表すエラー。
+type AlreadyExistsError struct {
+	Resource string // リソース名
+}
+
+// エラー状態を表す。
+//
+// "<Resource> already exists" を出力する。
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s already exists", e.Resource)
+}
+
+// リソースの状態と競合する操作を表すエラー。
+type ConflictError struct {
+	Resource string // リソース名
+}
+
+// エラー状態を表す。
+//
+// "<Resource> conflicts with the current state" を出力する。
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s conflicts with the current state", e.Resource)
+}
+
+// 前提条件を満たさない操作を表すエラー。
+type FailedPreconditionError struct {
+	Resource string // リソース名
+	Reason   string // 前提条件を満たさない理由
+}
+
+// エラー状態を表す。
+//
+// "<Resource> precondition failed: <Reason>" を出力する。
+func (e *FailedPreconditionError) Error() string {
+	return fmt.Sprintf("%s precondition failed: %s", e.Resource, e.Reason)
+}
+
+// 権限のない操作を表すエラー。
+type PermissionDeniedError struct {
+	Resource string // リソース名
+}
+
+// エラー状態を表す。
+//
+// "<Resource> permission denied" を出力する。
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("%s permission denied", e.Resource)
+}
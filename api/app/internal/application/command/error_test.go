@@ -43,3 +43,58 @@ func TestInvalidCommandError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestNotFoundError_Error(t *testing.T) {
+	t.Parallel()
+	// given
+	err := &NotFoundError{"bookmark"}
+	// when
+	actualErrString := err.Error()
+	// then
+	expectedErrString := "bookmark does not exist"
+	assert.Exactly(t, expectedErrString, actualErrString)
+}
+
+func TestAlreadyExistsError_Error(t *testing.T) {
+	t.Parallel()
+	// given
+	err := &AlreadyExistsError{"bookmark"}
+	// when
+	actualErrString := err.Error()
+	// then
+	expectedErrString := "bookmark already exists"
+	assert.Exactly(t, expectedErrString, actualErrString)
+}
+
+func TestConflictError_Error(t *testing.T) {
+	t.Parallel()
+	// given
+	err := &ConflictError{"bookmark"}
+	// when
+	actualErrString := err.Error()
+	// then
+	expectedErrString := "bookmark conflicts with the current state"
+	assert.Exactly(t, expectedErrString, actualErrString)
+}
+
+func TestFailedPreconditionError_Error(t *testing.T) {
+	t.Parallel()
+	// given
+	err := &FailedPreconditionError{"folder", "folder is not empty"}
+	// when
+	actualErrString := err.Error()
+	// then
+	expectedErrString := "folder precondition failed: folder is not empty"
+	assert.Exactly(t, expectedErrString, actualErrString)
+}
+
+func TestPermissionDeniedError_Error(t *testing.T) {
+	t.Parallel()
+	// given
+	err := &PermissionDeniedError{"bookmark"}
+	// when
+	actualErrString := err.Error()
+	// then
+	expectedErrString := "bookmark permission denied"
+	assert.Exactly(t, expectedErrString, actualErrString)
+}
@@ -0,0 +1,214 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 親フォルダのIDを検証する。
+//
+// 空文字列の場合は最上位を表すため妥当とする。
+func validateFolderID(v string) error {
+	if v == "" {
+		return nil
+	}
+	_, err := entity.NewID(v)
+	return err
+}
+
+// 並び順を検証する。
+func validatePosition(v int) error {
+	if v < 0 {
+		return fmt.Errorf("negative position: %d", v)
+	}
+	return nil
+}
+
+// フォルダ作成用のコマンド。
+type CreateFolder struct {
+	Name     string // フォルダ名
+	ParentID string // 親フォルダのID (空文字列の場合は最上位)
+	Position int    // 並び順
+	UserID   string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *CreateFolder) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewName(cmd.Name); err != nil {
+		args["Name"] = err
+	}
+	if err := validateFolderID(cmd.ParentID); err != nil {
+		args["ParentID"] = err
+	}
+	if err := validatePosition(cmd.Position); err != nil {
+		args["Position"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// フォルダ取得用のコマンド。
+type GetFolder struct {
+	ID     string // ID
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *GetFolder) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// フォルダ一覧取得用のコマンド。
+type ListFolders struct {
+	ParentID string // 親フォルダのID (空文字列の場合は最上位)
+	UserID   string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListFolders) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if err := validateFolderID(cmd.ParentID); err != nil {
+		args["ParentID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// フォルダ更新用のコマンド。
+type UpdateFolder struct {
+	ID     string // ID
+	Name   string // フォルダ名
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *UpdateFolder) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if _, err := entity.NewName(cmd.Name); err != nil {
+		args["Name"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// フォルダ削除用のコマンド。
+type DeleteFolder struct {
+	ID     string // ID
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *DeleteFolder) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// フォルダ移動用のコマンド。
+type MoveFolder struct {
+	ID       string // ID
+	ParentID string // 移動先の親フォルダのID (空文字列の場合は最上位)
+	Position int    // 移動先での並び順
+	UserID   string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *MoveFolder) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if err := validateFolderID(cmd.ParentID); err != nil {
+		args["ParentID"] = err
+	}
+	if err := validatePosition(cmd.Position); err != nil {
+		args["Position"] = err
+	}
+	if len(args) == 0 && cmd.ID == cmd.ParentID {
+		args["ParentID"] = fmt.Errorf("same as ID: %s", cmd.ParentID)
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// ブックマーク移動用のコマンド。
+type MoveBookmark struct {
+	ID       string // ブックマークのID
+	FolderID string // 移動先のフォルダのID (空文字列の場合は最上位)
+	UserID   string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *MoveBookmark) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if err := validateFolderID(cmd.FolderID); err != nil {
+		args["FolderID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFolder_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *CreateFolder
+		expectedErr error
+	}{
+		"valid arguments": {
+			&CreateFolder{"Reading List", "1", 0, "alice"},
+			nil,
+		},
+		"top level": {
+			&CreateFolder{"Reading List", "", 0, "alice"},
+			nil,
+		},
+		"invalid name": {
+			&CreateFolder{"", "1", 0, "alice"},
+			&InvalidCommandError{map[string]error{"Name": helper.ToErrName(t, "")}},
+		},
+		"invalid parent id": {
+			&CreateFolder{"Reading List", "!", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ParentID": helper.ToErrID(t, "!")}},
+		},
+		"negative position": {
+			&CreateFolder{"Reading List", "1", -1, "alice"},
+			&InvalidCommandError{map[string]error{"Position": errors.New("negative position: -1")}},
+		},
+		"invalid user id": {
+			&CreateFolder{"Reading List", "1", 0, ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetFolder_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *GetFolder
+		expectedErr error
+	}{
+		"valid argument": {
+			&GetFolder{"1", "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&GetFolder{"", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"invalid user id": {
+			&GetFolder{"1", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListFolders_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListFolders
+		expectedErr error
+	}{
+		"parent id": {
+			&ListFolders{"1", "alice"},
+			nil,
+		},
+		"top level": {
+			&ListFolders{"", "alice"},
+			nil,
+		},
+		"invalid parent id": {
+			&ListFolders{"!", "alice"},
+			&InvalidCommandError{map[string]error{"ParentID": helper.ToErrID(t, "!")}},
+		},
+		"invalid user id": {
+			&ListFolders{"", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestUpdateFolder_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *UpdateFolder
+		expectedErr error
+	}{
+		"valid arguments": {
+			&UpdateFolder{"1", "Reading List", "alice"},
+			nil,
+		},
+		"invalid arguments": {
+			&UpdateFolder{"", "", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "Name": helper.ToErrName(t, "")}},
+		},
+		"invalid user id": {
+			&UpdateFolder{"1", "Reading List", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDeleteFolder_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *DeleteFolder
+		expectedErr error
+	}{
+		"valid argument": {
+			&DeleteFolder{"1", "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&DeleteFolder{"", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"invalid user id": {
+			&DeleteFolder{"1", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestMoveFolder_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *MoveFolder
+		expectedErr error
+	}{
+		"valid arguments": {
+			&MoveFolder{"1", "2", 3, "alice"},
+			nil,
+		},
+		"top level": {
+			&MoveFolder{"1", "", 0, "alice"},
+			nil,
+		},
+		"same ids": {
+			&MoveFolder{"1", "1", 0, "alice"},
+			&InvalidCommandError{map[string]error{"ParentID": errors.New("same as ID: 1")}},
+		},
+		"invalid arguments": {
+			&MoveFolder{"", "!", -1, "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "ParentID": helper.ToErrID(t, "!"), "Position": errors.New("negative position: -1")}},
+		},
+		"invalid user id": {
+			&MoveFolder{"1", "2", 3, ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestMoveBookmark_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *MoveBookmark
+		expectedErr error
+	}{
+		"valid arguments": {
+			&MoveBookmark{"1", "10", "alice"},
+			nil,
+		},
+		"top level": {
+			&MoveBookmark{"1", "", "alice"},
+			nil,
+		},
+		"invalid arguments": {
+			&MoveBookmark{"", "!", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "FolderID": helper.ToErrID(t, "!")}},
+		},
+		"invalid user id": {
+			&MoveBookmark{"1", "", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
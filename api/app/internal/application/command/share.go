@@ -0,0 +1,92 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマーク共有用のコマンド。
+//
+// ブックマークを共有する場合は ID を、コレクションを共有する場合は Tag を指定する。
+type ShareBookmark struct {
+	ID      string // 共有するブックマークのID (コレクションを共有する場合は空文字列)
+	Tag     string // 共有するコレクションのタグ (ブックマークを共有する場合は空文字列)
+	Grantee string // 共有先のユーザID
+	Role    string // 権限 ("viewer", "editor")
+	UserID  string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ShareBookmark) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	switch {
+	case cmd.ID == "" && cmd.Tag == "":
+		args["ID"] = fmt.Errorf("either ID or Tag is required")
+	case cmd.ID != "" && cmd.Tag != "":
+		args["Tag"] = fmt.Errorf("specified together with ID: %s", cmd.ID)
+	case cmd.ID != "":
+		if _, err := entity.NewID(cmd.ID); err != nil {
+			args["ID"] = err
+		}
+	default:
+		if _, err := entity.NewTag(cmd.Tag); err != nil {
+			args["Tag"] = err
+		}
+	}
+	if _, err := entity.NewUserID(cmd.Grantee); err != nil {
+		args["Grantee"] = err
+	} else if cmd.Grantee == cmd.UserID {
+		args["Grantee"] = fmt.Errorf("same as UserID: %s", cmd.Grantee)
+	}
+	if _, err := entity.NewShareRole(cmd.Role); err != nil {
+		args["Role"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// ブックマーク共有解除用のコマンド。
+type RevokeShare struct {
+	ID     string // 共有のID
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *RevokeShare) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// 共有されたブックマーク一覧取得用のコマンド。
+type ListSharedWithMe struct {
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListSharedWithMe) Validate() error {
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		return &InvalidCommandError{map[string]error{"UserID": err}}
+	}
+	return nil
+}
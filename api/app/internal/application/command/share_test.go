@@ -0,0 +1,122 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShareBookmark_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ShareBookmark
+		expectedErr error
+	}{
+		"bookmark": {
+			&ShareBookmark{"1", "", "bob", "viewer", "alice"},
+			nil,
+		},
+		"collection": {
+			&ShareBookmark{"", "golang", "bob", "editor", "alice"},
+			nil,
+		},
+		"neither id nor tag": {
+			&ShareBookmark{"", "", "bob", "viewer", "alice"},
+			&InvalidCommandError{map[string]error{"ID": errors.New("either ID or Tag is required")}},
+		},
+		"both id and tag": {
+			&ShareBookmark{"1", "golang", "bob", "viewer", "alice"},
+			&InvalidCommandError{map[string]error{"Tag": errors.New("specified together with ID: 1")}},
+		},
+		"invalid id": {
+			&ShareBookmark{"!", "", "bob", "viewer", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "!")}},
+		},
+		"invalid tag": {
+			&ShareBookmark{"", " ", "bob", "viewer", "alice"},
+			&InvalidCommandError{map[string]error{"Tag": helper.ToErrTag(t, " ")}},
+		},
+		"invalid grantee": {
+			&ShareBookmark{"1", "", "", "viewer", "alice"},
+			&InvalidCommandError{map[string]error{"Grantee": helper.ToErrUserID(t, "")}},
+		},
+		"grantee same as user id": {
+			&ShareBookmark{"1", "", "alice", "viewer", "alice"},
+			&InvalidCommandError{map[string]error{"Grantee": errors.New("same as UserID: alice")}},
+		},
+		"invalid role": {
+			&ShareBookmark{"1", "", "bob", "owner", "alice"},
+			&InvalidCommandError{map[string]error{"Role": errors.New("unknown role: owner")}},
+		},
+		"invalid user id": {
+			&ShareBookmark{"1", "", "bob", "viewer", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRevokeShare_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *RevokeShare
+		expectedErr error
+	}{
+		"valid arguments": {
+			&RevokeShare{"1", "alice"},
+			nil,
+		},
+		"invalid arguments": {
+			&RevokeShare{"", ""},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, ""), "UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListSharedWithMe_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListSharedWithMe
+		expectedErr error
+	}{
+		"valid argument": {
+			&ListSharedWithMe{"alice"},
+			nil,
+		},
+		"invalid argument": {
+			&ListSharedWithMe{""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
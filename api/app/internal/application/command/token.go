@@ -0,0 +1,65 @@
+package command
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ページトークンが示す再開位置。
+//
+// 前のページの最後のブックマークの並び替えのキーの値とIDを保持する。
+type PageCursor struct {
+	OrderBy    string    // 並び替えのキー
+	Descending bool      // 降順に並び替えるか
+	Value      string    // ブックマーク名、URI、IDで並び替える場合のキーの値
+	Time       time.Time // 作成日時、更新日時で並び替える場合のキーの値
+	ID         string    // ブックマークのID
+}
+
+// ページトークンの符号化に用いる形式。
+type pageToken struct {
+	OrderBy    string     `json:"o,omitempty"`
+	Descending bool       `json:"d,omitempty"`
+	Value      string     `json:"v,omitempty"`
+	Time       *time.Time `json:"t,omitempty"`
+	ID         string     `json:"i"`
+}
+
+// ページトークンを生成する。
+//
+// 再開位置をJSONに変換してBase64URL形式で符号化する。
+func EncodePageToken(cursor PageCursor) string {
+	token := pageToken{OrderBy: cursor.OrderBy, Descending: cursor.Descending, Value: cursor.Value, ID: cursor.ID}
+	if !cursor.Time.IsZero() {
+		t := cursor.Time.UTC()
+		token.Time = &t
+	}
+	b, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ページトークンを解析する。
+//
+// 空文字列を指定した場合はnilを返却する。
+// 復号に失敗した場合はエラーを返却する。
+// IDを含まない場合はエラーを返却する。
+func DecodePageToken(token string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %s", token)
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded.ID == "" {
+		return nil, fmt.Errorf("invalid page token: %s", token)
+	}
+	cursor := &PageCursor{OrderBy: decoded.OrderBy, Descending: decoded.Descending, Value: decoded.Value, ID: decoded.ID}
+	if decoded.Time != nil {
+		cursor.Time = *decoded.Time
+	}
+	return cursor, nil
+}
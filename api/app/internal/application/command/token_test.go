@@ -0,0 +1,87 @@
+package command
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePageToken(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cursor        PageCursor
+		expectedToken string
+	}{
+		"order by name": {
+			PageCursor{OrderBy: "Name", Descending: true, Value: "Example", ID: "2"},
+			"eyJvIjoiTmFtZSIsImQiOnRydWUsInYiOiJFeGFtcGxlIiwiaSI6IjIifQ",
+		},
+		"order by created at": {
+			PageCursor{OrderBy: "CreatedAt", Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), ID: "3"},
+			"eyJvIjoiQ3JlYXRlZEF0IiwidCI6IjIwMjItMDEtMDFUMDA6MDA6MDBaIiwiaSI6IjMifQ",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualToken := EncodePageToken(tc.cursor)
+			// then
+			assert.Exactly(t, tc.expectedToken, actualToken)
+		})
+	}
+}
+
+func TestDecodePageToken(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		token          string
+		expectedCursor *PageCursor
+		expectedErr    error
+	}{
+		"empty token": {
+			"",
+			nil,
+			nil,
+		},
+		"token ordered by name": {
+			"eyJvIjoiTmFtZSIsImQiOnRydWUsInYiOiJFeGFtcGxlIiwiaSI6IjIifQ",
+			&PageCursor{OrderBy: "Name", Descending: true, Value: "Example", ID: "2"},
+			nil,
+		},
+		"token ordered by created at": {
+			"eyJvIjoiQ3JlYXRlZEF0IiwidCI6IjIwMjItMDEtMDFUMDA6MDA6MDBaIiwiaSI6IjMifQ",
+			&PageCursor{OrderBy: "CreatedAt", Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), ID: "3"},
+			nil,
+		},
+		"non-base64 token": {
+			"!",
+			nil,
+			errors.New("invalid page token: !"),
+		},
+		"non-json token": {
+			"Zm9v",
+			nil,
+			errors.New("invalid page token: Zm9v"),
+		},
+		"token without id": {
+			"eyJvIjoiTmFtZSJ9",
+			nil,
+			errors.New("invalid page token: eyJvIjoiTmFtZSJ9"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualCursor, actualErr := DecodePageToken(tc.token)
+			// then
+			assert.Exactly(t, tc.expectedCursor, actualCursor)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
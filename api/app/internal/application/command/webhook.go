@@ -0,0 +1,115 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// Webhookの配信先のURIを検証する。
+//
+// 外部に公開されていないホストは配信先として認めない。
+func validateWebhookURI(v string) error {
+	uri, err := entity.NewURI(v)
+	if err != nil {
+		return err
+	}
+	if err := entity.ValidateWebhookURI(*uri); err != nil {
+		return err
+	}
+	return entity.ValidateWebhookDestination(*uri)
+}
+
+// Webhook購読用のコマンド。
+type CreateWebhook struct {
+	URI    string   // 配信先のURI
+	Events []string // 購読するイベント名一覧 (空の場合は全てのイベント)
+	Secret string   // 署名に用いる共有シークレット
+	UserID string   // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *CreateWebhook) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if err := validateWebhookURI(cmd.URI); err != nil {
+		args["URI"] = err
+	}
+	if err := entity.ValidateWebhookEvents(cmd.Events); err != nil {
+		args["Events"] = err
+	}
+	if cmd.Secret == "" {
+		args["Secret"] = fmt.Errorf("secret is empty")
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// Webhook購読一覧取得用のコマンド。
+type ListWebhooks struct {
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListWebhooks) Validate() error {
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		return &InvalidCommandError{map[string]error{"UserID": err}}
+	}
+	return nil
+}
+
+// Webhook購読解除用のコマンド。
+type DeleteWebhook struct {
+	ID     string // ID
+	UserID string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *DeleteWebhook) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if _, err := entity.NewID(cmd.ID); err != nil {
+		args["ID"] = err
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
+
+// デッドレター一覧取得用のコマンド。
+type ListDeadLetters struct {
+	WebhookID string // WebhookのID (空文字列の場合は全てのWebhook)
+	UserID    string // 操作するユーザのID
+}
+
+// コマンドの妥当性を検証する。
+//
+// コマンドが不正な場合は InvalidCommandError を返却する。
+func (cmd *ListDeadLetters) Validate() error {
+	args := map[string]error{}
+	if _, err := entity.NewUserID(cmd.UserID); err != nil {
+		args["UserID"] = err
+	}
+	if cmd.WebhookID != "" {
+		if _, err := entity.NewID(cmd.WebhookID); err != nil {
+			args["WebhookID"] = err
+		}
+	}
+	if len(args) > 0 {
+		return &InvalidCommandError{Args: args}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *CreateWebhook
+		expectedErr error
+	}{
+		"valid arguments": {
+			&CreateWebhook{"https://example.com/hooks", []string{entity.EventBookmarkRegistered}, "secret", "alice"},
+			nil,
+		},
+		"all events": {
+			&CreateWebhook{"http://example.com:8080/hooks", []string{}, "secret", "alice"},
+			nil,
+		},
+		"public address": {
+			&CreateWebhook{"https://93.184.216.34/hooks", []string{}, "secret", "alice"},
+			nil,
+		},
+		"localhost": {
+			&CreateWebhook{"http://localhost:8080/hooks", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("forbidden host: localhost")}},
+		},
+		"loopback address": {
+			&CreateWebhook{"http://127.0.0.1:8080/hooks", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("forbidden host: 127.0.0.1")}},
+		},
+		"link-local address": {
+			&CreateWebhook{"http://169.254.169.254/latest/meta-data", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("forbidden host: 169.254.169.254")}},
+		},
+		"private address": {
+			&CreateWebhook{"http://10.0.0.1/hooks", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("forbidden host: 10.0.0.1")}},
+		},
+		"private ipv6 address": {
+			&CreateWebhook{"http://[fd00::1]/hooks", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("forbidden host: fd00::1")}},
+		},
+		"invalid uri": {
+			&CreateWebhook{"", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": helper.ToErrURI(t, "")}},
+		},
+		"unsupported scheme": {
+			&CreateWebhook{"ftp://example.com", []string{}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"URI": errors.New("unsupported scheme: ftp")}},
+		},
+		"unknown event": {
+			&CreateWebhook{"https://example.com/hooks", []string{"BookmarkPinned"}, "secret", "alice"},
+			&InvalidCommandError{map[string]error{"Events": errors.New("unknown event: BookmarkPinned")}},
+		},
+		"empty secret": {
+			&CreateWebhook{"https://example.com/hooks", []string{}, "", "alice"},
+			&InvalidCommandError{map[string]error{"Secret": errors.New("secret is empty")}},
+		},
+		"invalid user id": {
+			&CreateWebhook{"https://example.com/hooks", []string{}, "secret", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListWebhooks_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListWebhooks
+		expectedErr error
+	}{
+		"valid argument": {
+			&ListWebhooks{"alice"},
+			nil,
+		},
+		"invalid argument": {
+			&ListWebhooks{""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDeleteWebhook_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *DeleteWebhook
+		expectedErr error
+	}{
+		"valid argument": {
+			&DeleteWebhook{"1", "alice"},
+			nil,
+		},
+		"invalid argument": {
+			&DeleteWebhook{"", "alice"},
+			&InvalidCommandError{map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"invalid user id": {
+			&DeleteWebhook{"1", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestListDeadLetters_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		cmd         *ListDeadLetters
+		expectedErr error
+	}{
+		"webhook id": {
+			&ListDeadLetters{"1", "alice"},
+			nil,
+		},
+		"all webhooks": {
+			&ListDeadLetters{"", "alice"},
+			nil,
+		},
+		"invalid webhook id": {
+			&ListDeadLetters{"!", "alice"},
+			&InvalidCommandError{map[string]error{"WebhookID": helper.ToErrID(t, "!")}},
+		},
+		"invalid user id": {
+			&ListDeadLetters{"1", ""},
+			&InvalidCommandError{map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := tc.cmd.Validate()
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
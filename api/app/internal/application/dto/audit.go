@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 監査ログの記録を表すDTO。
+type AuditEntry struct {
+	ID         string    // ID
+	Actor      string    // 操作者 (不明な場合は空文字列)
+	Operation  string    // 操作 ("register", "update", "delete")
+	BookmarkID string    // 操作したブックマークのID
+	Before     *Snapshot // 操作前の内容 (登録の場合はnil)
+	After      *Snapshot // 操作後の内容 (削除の場合はnil)
+	CreatedAt  time.Time // 作成日時
+}
+
+// 監査ログの記録を表すエンティティからDTOを生成する。
+func NewAuditEntry(entity entity.AuditEntry) AuditEntry {
+	id := entity.ID()
+	bookmarkID := entity.BookmarkID()
+	var before, after *Snapshot
+	if snapshot := entity.Before(); snapshot != nil {
+		value := NewSnapshot(*snapshot)
+		before = &value
+	}
+	if snapshot := entity.After(); snapshot != nil {
+		value := NewSnapshot(*snapshot)
+		after = &value
+	}
+	return AuditEntry{id.Value(), entity.Actor(), entity.Operation().Value(), bookmarkID.Value(), before, after, entity.CreatedAt()}
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	a := helper.ToSnapshot(t, "Example", "https://example.com", "foo")
+	b := helper.ToSnapshot(t, "Example Domain", "https://example.org")
+	cases := map[string]struct {
+		entity        entity.AuditEntry
+		expectedEntry AuditEntry
+	}{
+		"register": {
+			*helper.ToTimestampedAuditEntry(t, createdAt, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a),
+			AuditEntry{"100", "alice", "register", "1", nil, &Snapshot{"Example", "https://example.com", "", []string{"foo"}}, createdAt},
+		},
+		"update": {
+			*helper.ToTimestampedAuditEntry(t, createdAt, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, a, b),
+			AuditEntry{
+				"100", "alice", "update", "1",
+				&Snapshot{"Example", "https://example.com", "", []string{"foo"}},
+				&Snapshot{"Example Domain", "https://example.org", "", []string{}},
+				createdAt,
+			},
+		},
+		"delete": {
+			*helper.ToTimestampedAuditEntry(t, createdAt, "100", "", entity.AuditOperationDelete, "1", helper.UserID, b, nil),
+			AuditEntry{"100", "", "delete", "1", &Snapshot{"Example Domain", "https://example.org", "", []string{}}, nil, createdAt},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEntry := NewAuditEntry(tc.entity)
+			// then
+			assert.Exactly(t, tc.expectedEntry, actualEntry)
+		})
+	}
+}
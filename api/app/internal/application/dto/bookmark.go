@@ -1,15 +1,25 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 )
 
 // ブックマークを表すDTO。
 type Bookmark struct {
-	ID   string   // ID
-	Name string   // ブックマーク名
-	URI  string   // URI
-	Tags []string // タグ一覧
+	ID          string    // ID
+	Name        string    // ブックマーク名
+	URI         string    // URI
+	Description string    // 説明
+	FolderID    string    // 所属するフォルダのID (最上位の場合は空文字列)
+	Status      string    // 状態 ("unread", "read", "archived")
+	Starred     bool      // お気に入りに登録されているか
+	Tags        []string  // タグ一覧
+	Version     uint64    // 版数
+	CreatedAt   time.Time // 作成日時
+	UpdatedAt   time.Time // 更新日時
+	DeletedAt   time.Time // ゴミ箱に移動した日時 (ゴミ箱にない場合はゼロ値)
 }
 
 // ブックマークを表すエンティティからDTOを生成する。
@@ -17,9 +27,20 @@ func NewBookmark(entity entity.Bookmark) Bookmark {
 	id := entity.ID()
 	name := entity.Name()
 	uri := entity.URI()
+	description := entity.Description()
+	folderID := ""
+	if folder := entity.Folder(); folder != nil {
+		folderID = folder.Value()
+	}
 	tags := make([]string, len(entity.Tags()))
 	for i, tag := range entity.Tags() {
 		tags[i] = tag.Value()
 	}
-	return Bookmark{id.Value(), name.Value(), uri.String(), tags}
+	return Bookmark{id.Value(), name.Value(), uri.String(), description.Value(), folderID, entity.Status().Value(), entity.Starred(), tags, entity.Version(), entity.CreatedAt(), entity.UpdatedAt(), entity.DeletedAt()}
+}
+
+// ブックマーク一覧の1ページを表すDTO。
+type BookmarkPage struct {
+	Bookmarks     []Bookmark // ブックマーク一覧
+	NextPageToken string     // 次のページを取得するためのトークン (次のページが存在しない場合は空文字列)
 }
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/test/helper"
@@ -16,11 +17,31 @@ func TestNewBookmark(t *testing.T) {
 	}{
 		"valid entity (empty tags)": {
 			*helper.ToBookmark(t, "1", "Example", "https://example.com"),
-			Bookmark{"1", "Example", "https://example.com", []string{}},
+			Bookmark{"1", "Example", "https://example.com", "", "", "unread", false, []string{}, 0, time.Time{}, time.Time{}, time.Time{}},
 		},
 		"valid entity (3 tags)": {
 			*helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
-			Bookmark{"1", "Example", "https://example.com", []string{"foo", "bar", "baz"}},
+			Bookmark{"1", "Example", "https://example.com", "", "", "unread", false, []string{"foo", "bar", "baz"}, 0, time.Time{}, time.Time{}, time.Time{}},
+		},
+		"valid entity (described)": {
+			*helper.ToDescribedBookmark(t, "Example\nDomain", "1", "Example", "https://example.com"),
+			Bookmark{"1", "Example", "https://example.com", "Example\nDomain", "", "unread", false, []string{}, 0, time.Time{}, time.Time{}, time.Time{}},
+		},
+		"valid entity (filed)": {
+			*helper.ToFiledBookmark(t, "10", "1", "Example", "https://example.com"),
+			Bookmark{"1", "Example", "https://example.com", "", "10", "unread", false, []string{}, 0, time.Time{}, time.Time{}, time.Time{}},
+		},
+		"valid entity (archived and starred)": {
+			*helper.ToMarkedBookmark(t, entity.StatusArchived, true, "1", "Example", "https://example.com"),
+			Bookmark{"1", "Example", "https://example.com", "", "", "archived", true, []string{}, 0, time.Time{}, time.Time{}, time.Time{}},
+		},
+		"valid entity (persisted)": {
+			*helper.ToTimestampedBookmark(t, 3, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), "1", "Example", "https://example.com", "foo"),
+			Bookmark{"1", "Example", "https://example.com", "", "", "unread", false, []string{"foo"}, 3, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), time.Time{}},
+		},
+		"valid entity (trashed)": {
+			*helper.ToTrashedBookmark(t, 4, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), "1", "Example", "https://example.com"),
+			Bookmark{"1", "Example", "https://example.com", "", "", "unread", false, []string{}, 4, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)},
 		},
 	}
 	for name, tc := range cases {
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// ブックマークの変更を表すDTO。
+type BookmarkChange struct {
+	Type        string    // 変更の種類 ("created", "updated", "deleted")
+	ID          string    // 変更されたブックマークのID
+	Bookmark    *Bookmark // 変更後のブックマーク (完全に削除された場合はnil)
+	ResumeToken string    // 再開トークン
+}
+
+// ブックマークの変更からDTOを生成する。
+func NewBookmarkChange(change repository.BookmarkChange) BookmarkChange {
+	var bookmark *Bookmark
+	if change.Bookmark != nil {
+		b := NewBookmark(*change.Bookmark)
+		bookmark = &b
+	}
+	return BookmarkChange{string(change.Type), change.ID.Value(), bookmark, change.ResumeToken}
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBookmarkChange(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		change         repository.BookmarkChange
+		expectedChange BookmarkChange
+	}{
+		"change with bookmark": {
+			repository.BookmarkChange{
+				Type:        repository.ChangeUpdated,
+				ID:          *helper.ToID(t, "1"),
+				Bookmark:    helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"),
+				ResumeToken: "2",
+			},
+			BookmarkChange{
+				"updated",
+				"1",
+				&Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}},
+				"2",
+			},
+		},
+		"change without bookmark": {
+			repository.BookmarkChange{
+				Type:        repository.ChangeDeleted,
+				ID:          *helper.ToID(t, "1"),
+				Bookmark:    nil,
+				ResumeToken: "3",
+			},
+			BookmarkChange{"deleted", "1", nil, "3"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualChange := NewBookmarkChange(tc.change)
+			// then
+			assert.Exactly(t, tc.expectedChange, actualChange)
+		})
+	}
+}
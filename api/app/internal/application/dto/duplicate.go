@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// 正規形のURIと一致するブックマーク一覧の組を表すDTO。
+type Duplicate struct {
+	CanonicalURI string     // 正規形のURI
+	Bookmarks    []Bookmark // ブックマーク一覧
+}
+
+// 正規形のURIと一致するブックマーク一覧の組からDTOを生成する。
+func NewDuplicate(duplicate repository.Duplicate) Duplicate {
+	bookmarks := make([]Bookmark, len(duplicate.Bookmarks))
+	for i, bookmark := range duplicate.Bookmarks {
+		bookmarks[i] = NewBookmark(bookmark)
+	}
+	return Duplicate{duplicate.CanonicalURI, bookmarks}
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDuplicate(t *testing.T) {
+	t.Parallel()
+	// given
+	duplicate := repository.Duplicate{
+		CanonicalURI: "https://example.com/",
+		Bookmarks: []entity.Bookmark{
+			*helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"),
+			*helper.ToBookmark(t, "2", "Example B", "https://example.com/#bar"),
+		},
+	}
+	// when
+	actualDuplicate := NewDuplicate(duplicate)
+	// then
+	expectedDuplicate := Duplicate{
+		"https://example.com/",
+		[]Bookmark{
+			{"1", "Example A", "https://example.com", "", "", "unread", false, []string{"foo"}, 0, time.Time{}, time.Time{}, time.Time{}},
+			{"2", "Example B", "https://example.com/#bar", "", "", "unread", false, []string{}, 0, time.Time{}, time.Time{}, time.Time{}},
+		},
+	}
+	assert.Exactly(t, expectedDuplicate, actualDuplicate)
+}
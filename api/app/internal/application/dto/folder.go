@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// フォルダを表すDTO。
+type Folder struct {
+	ID       string // ID
+	Name     string // フォルダ名
+	ParentID string // 親フォルダのID (最上位の場合は空文字列)
+	Position int    // 並び順
+}
+
+// フォルダを表すエンティティからDTOを生成する。
+func NewFolder(entity entity.Folder) Folder {
+	id := entity.ID()
+	name := entity.Name()
+	parentID := ""
+	if parent := entity.Parent(); parent != nil {
+		parentID = parent.Value()
+	}
+	return Folder{id.Value(), name.Value(), parentID, entity.Position()}
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFolder(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		entity         entity.Folder
+		expectedFolder Folder
+	}{
+		"top-level entity": {
+			*helper.ToFolder(t, "1", "Reading List", "", 0),
+			Folder{"1", "Reading List", "", 0},
+		},
+		"nested entity": {
+			*helper.ToFolder(t, "2", "Go", "1", 3),
+			Folder{"2", "Go", "1", 3},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualFolder := NewFolder(tc.entity)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマークの内容を表すDTO。
+type Snapshot struct {
+	Name        string   // ブックマーク名
+	URI         string   // URI
+	Description string   // 説明
+	Tags        []string // タグ一覧
+}
+
+// ブックマークの内容を表す値オブジェクトからDTOを生成する。
+func NewSnapshot(value entity.Snapshot) Snapshot {
+	name := value.Name()
+	uri := value.URI()
+	description := value.Description()
+	tags := make([]string, len(value.Tags()))
+	for i, tag := range value.Tags() {
+		tags[i] = tag.Value()
+	}
+	return Snapshot{name.Value(), uri.String(), description.Value(), tags}
+}
+
+// ブックマークの改訂を表すDTO。
+type Revision struct {
+	ID         string    // ID
+	BookmarkID string    // 変更したブックマークのID
+	Version    uint64    // 変更後のブックマークの版数
+	Actor      string    // 変更者 (不明な場合は空文字列)
+	Before     Snapshot  // 変更前の内容
+	After      Snapshot  // 変更後の内容
+	CreatedAt  time.Time // 作成日時
+}
+
+// ブックマークの改訂を表すエンティティからDTOを生成する。
+func NewRevision(entity entity.Revision) Revision {
+	id := entity.ID()
+	bookmarkID := entity.BookmarkID()
+	return Revision{id.Value(), bookmarkID.Value(), entity.Version(), entity.Actor(), NewSnapshot(entity.Before()), NewSnapshot(entity.After()), entity.CreatedAt()}
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		value            entity.Snapshot
+		expectedSnapshot Snapshot
+	}{
+		"valid value (empty tags)": {
+			*helper.ToSnapshot(t, "Example", "https://example.com"),
+			Snapshot{"Example", "https://example.com", "", []string{}},
+		},
+		"valid value (2 tags)": {
+			*helper.ToSnapshot(t, "Example", "https://example.com", "foo", "bar"),
+			Snapshot{"Example", "https://example.com", "", []string{"foo", "bar"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualSnapshot := NewSnapshot(tc.value)
+			// then
+			assert.Exactly(t, tc.expectedSnapshot, actualSnapshot)
+		})
+	}
+}
+
+func TestNewRevision(t *testing.T) {
+	t.Parallel()
+	// given
+	entity := helper.ToTimestampedRevision(
+		t, time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), "100", "1", 2, "alice",
+		helper.ToSnapshot(t, "Example", "https://example.com", "foo"),
+		helper.ToSnapshot(t, "Example Domain", "https://example.org"),
+	)
+	// when
+	actualRevision := NewRevision(*entity)
+	// then
+	expectedRevision := Revision{
+		"100",
+		"1",
+		2,
+		"alice",
+		Snapshot{"Example", "https://example.com", "", []string{"foo"}},
+		Snapshot{"Example Domain", "https://example.org", "", []string{}},
+		time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	assert.Exactly(t, expectedRevision, actualRevision)
+}
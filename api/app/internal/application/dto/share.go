@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマークの共有を表すDTO。
+type Share struct {
+	ID         string // ID
+	Owner      string // 共有元のユーザID
+	Grantee    string // 共有先のユーザID
+	BookmarkID string // 共有するブックマークのID (コレクションを共有する場合は空文字列)
+	Tag        string // 共有するコレクションのタグ (ブックマークを共有する場合は空文字列)
+	Role       string // 権限 ("viewer", "editor")
+}
+
+// ブックマークの共有を表すエンティティからDTOを生成する。
+func NewShare(entity entity.Share) Share {
+	id := entity.ID()
+	owner := entity.Owner()
+	grantee := entity.Grantee()
+	bookmarkID := ""
+	if v := entity.BookmarkID(); v != nil {
+		bookmarkID = v.Value()
+	}
+	tag := ""
+	if v := entity.Tag(); v != nil {
+		tag = v.Value()
+	}
+	return Share{id.Value(), owner.Value(), grantee.Value(), bookmarkID, tag, entity.Role().Value()}
+}
+
+// 共有されたブックマークを表すDTO。
+type SharedBookmark struct {
+	Bookmark Bookmark // ブックマーク
+	Owner    string   // 所有者のユーザID
+	Role     string   // 付与された権限 ("viewer", "editor")
+}
+
+// ブックマークを表すエンティティと付与された権限からDTOを生成する。
+func NewSharedBookmark(entity entity.Bookmark, role entity.ShareRole) SharedBookmark {
+	owner := entity.UserID()
+	return SharedBookmark{NewBookmark(entity), owner.Value(), role.Value()}
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShare(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		entity        entity.Share
+		expectedShare Share
+	}{
+		"bookmark": {
+			*helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			Share{"1", "alice", "bob", "10", "", "viewer"},
+		},
+		"collection": {
+			*helper.ToCollectionShare(t, "1", "alice", "bob", "golang", entity.ShareRoleEditor),
+			Share{"1", "alice", "bob", "", "golang", "editor"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualShare := NewShare(tc.entity)
+			// then
+			assert.Exactly(t, tc.expectedShare, actualShare)
+		})
+	}
+}
+
+func TestNewSharedBookmark(t *testing.T) {
+	t.Parallel()
+	// given
+	bookmark := *helper.ToOwnedBookmark(t, "alice", "1", "Example", "https://example.com", "golang")
+	// when
+	actualSharedBookmark := NewSharedBookmark(bookmark, entity.ShareRoleEditor)
+	// then
+	expectedSharedBookmark := SharedBookmark{NewBookmark(bookmark), "alice", "editor"}
+	assert.Exactly(t, expectedSharedBookmark, actualSharedBookmark)
+}
@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// タグとブックマーク数の組を表すDTO。
+type TagCount struct {
+	Name  string // タグ名
+	Count int    // タグが付与されたブックマーク数
+}
+
+// タグとブックマーク数の組からDTOを生成する。
+func NewTagCount(tagCount repository.TagCount) TagCount {
+	return TagCount{tagCount.Tag.Value(), tagCount.Count}
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTagCount(t *testing.T) {
+	t.Parallel()
+	// given
+	tagCount := repository.TagCount{Tag: helper.ToTags(t, "foo")[0], Count: 3}
+	// when
+	actualTagCount := NewTagCount(tagCount)
+	// then
+	expectedTagCount := TagCount{"foo", 3}
+	assert.Exactly(t, expectedTagCount, actualTagCount)
+}
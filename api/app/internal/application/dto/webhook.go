@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// Webhookの購読を表すDTO。
+//
+// 共有シークレットは含まない。
+type Webhook struct {
+	ID     string   // ID
+	URI    string   // 配信先のURI
+	Events []string // 購読するイベント名一覧 (空の場合は全てのイベント)
+}
+
+// Webhookの購読を表すエンティティからDTOを生成する。
+func NewWebhook(entity entity.Webhook) Webhook {
+	id := entity.ID()
+	uri := entity.URI()
+	return Webhook{id.Value(), uri.String(), entity.Events()}
+}
+
+// 配信に失敗したWebhookの通知を表すDTO。
+type DeadLetter struct {
+	ID         string    // ID (配信ID)
+	WebhookID  string    // 配信先のWebhookのID
+	EventName  string    // イベント名
+	BookmarkID string    // イベントが起きたブックマークのID
+	Payload    string    // 送信したペイロード
+	Attempts   int       // 試行回数
+	LastError  string    // 最後の試行で発生したエラー
+	CreatedAt  time.Time // 作成日時
+}
+
+// 配信に失敗したWebhookの通知を表すエンティティからDTOを生成する。
+func NewDeadLetter(entity entity.DeadLetter) DeadLetter {
+	id := entity.ID()
+	webhookID := entity.WebhookID()
+	bookmarkID := entity.BookmarkID()
+	return DeadLetter{id.Value(), webhookID.Value(), entity.EventName(), bookmarkID.Value(), string(entity.Payload()), entity.Attempts(), entity.LastError(), entity.CreatedAt()}
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhook(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		entity          entity.Webhook
+		expectedWebhook Webhook
+	}{
+		"all events": {
+			*helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			Webhook{"1", "https://example.com/hooks", []string{}},
+		},
+		"filtered events": {
+			*helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered, entity.EventBookmarkDeleted),
+			Webhook{"1", "https://example.com/hooks", []string{entity.EventBookmarkRegistered, entity.EventBookmarkDeleted}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualWebhook := NewWebhook(tc.entity)
+			// then
+			assert.Exactly(t, tc.expectedWebhook, actualWebhook)
+		})
+	}
+}
+
+func TestNewDeadLetter(t *testing.T) {
+	t.Parallel()
+	// given
+	createdAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	entity := helper.ToTimestampedDeadLetter(t, createdAt, "100", "10", entity.EventBookmarkDeleted, "1", `{"event":"BookmarkDeleted"}`, 5, "unexpected status: 500")
+	// when
+	actualDeadLetter := NewDeadLetter(*entity)
+	// then
+	expectedDeadLetter := DeadLetter{"100", "10", "BookmarkDeleted", "1", `{"event":"BookmarkDeleted"}`, 5, "unexpected status: 500", createdAt}
+	assert.Exactly(t, expectedDeadLetter, actualDeadLetter)
+}
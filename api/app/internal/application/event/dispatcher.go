@@ -0,0 +1,58 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ドメインイベントを処理するハンドラ。
+type Handler func(entity.Event)
+
+// ドメインイベントの発行を担うディスパッチャのインターフェース。
+type Dispatcher interface {
+	// ハンドラを登録する。
+	Subscribe(Handler)
+
+	// ドメインイベントを発行する。
+	Publish([]entity.Event)
+}
+
+// プロセス内でドメインイベントを発行するディスパッチャの具象型。
+type dispatcher struct {
+	mu       sync.RWMutex // ハンドラ一覧の排他制御
+	handlers []Handler    // ハンドラ一覧
+}
+
+// プロセス内でドメインイベントを発行するディスパッチャを生成する。
+func NewDispatcher() Dispatcher {
+	return &dispatcher{handlers: []Handler{}}
+}
+
+// ハンドラを登録する。
+//
+// 登録したハンドラは全てのドメインイベントを受け取る。
+// nilを指定した場合は登録しない。
+func (d *dispatcher) Subscribe(handler Handler) {
+	if handler == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers = append(d.handlers, handler)
+}
+
+// ドメインイベントを発行する。
+//
+// ドメインイベントを記録した順に、登録した順のハンドラへ同期的に渡す。
+// ハンドラは発行元の処理を妨げないよう、時間のかかる処理を非同期に行う必要がある。
+func (d *dispatcher) Publish(events []entity.Event) {
+	d.mu.RLock()
+	handlers := append([]Handler{}, d.handlers...)
+	d.mu.RUnlock()
+	for _, event := range events {
+		for _, handler := range handlers {
+			handler(event)
+		}
+	}
+}
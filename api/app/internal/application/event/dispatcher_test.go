@@ -0,0 +1,93 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDispatcher(t *testing.T) {
+	t.Parallel()
+	// when
+	object := NewDispatcher()
+	// then
+	interfaceObject := (*Dispatcher)(nil)
+	assert.Implements(t, interfaceObject, object)
+}
+
+func TestDispatcher_Publish(t *testing.T) {
+	t.Parallel()
+	bookmark, _ := entity.RegisterBookmark(helper.ToID(t, "1"), helper.ToUserID(t, helper.UserID), helper.ToName(t, "Example"), helper.ToURI(t, "https://example.com"), helper.ToDescription(t, ""), helper.ToTags(t))
+	bookmark.Rename(helper.ToName(t, "EXAMPLE"))
+	bookmark.Delete()
+	events := bookmark.PullEvents()
+	cases := map[string]struct {
+		subscribers    int
+		events         []entity.Event
+		expectedNames  []string
+		expectedCounts []int
+	}{
+		"no subscriber": {
+			0,
+			events,
+			[]string{},
+			[]int{},
+		},
+		"one subscriber": {
+			1,
+			events,
+			[]string{"BookmarkRegistered", "BookmarkRenamed", "BookmarkDeleted"},
+			[]int{3},
+		},
+		"two subscribers": {
+			2,
+			events,
+			[]string{"BookmarkRegistered", "BookmarkRegistered", "BookmarkRenamed", "BookmarkRenamed", "BookmarkDeleted", "BookmarkDeleted"},
+			[]int{3, 3},
+		},
+		"no event": {
+			2,
+			[]entity.Event{},
+			[]string{},
+			[]int{0, 0},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			dispatcher := NewDispatcher()
+			actualNames := []string{}
+			actualCounts := make([]int, tc.subscribers)
+			for i := 0; i < tc.subscribers; i++ {
+				i := i
+				dispatcher.Subscribe(func(event entity.Event) {
+					actualNames = append(actualNames, event.EventName())
+					actualCounts[i]++
+				})
+			}
+			// when
+			dispatcher.Publish(tc.events)
+			// then
+			assert.Exactly(t, tc.expectedNames, actualNames)
+			assert.Exactly(t, tc.expectedCounts, actualCounts)
+		})
+	}
+}
+
+func TestDispatcher_Subscribe(t *testing.T) {
+	t.Parallel()
+	t.Run("nil handler", func(t *testing.T) {
+		t.Parallel()
+		// given
+		dispatcher := NewDispatcher()
+		bookmark, _ := entity.RegisterBookmark(helper.ToID(t, "1"), helper.ToUserID(t, helper.UserID), helper.ToName(t, "Example"), helper.ToURI(t, "https://example.com"), helper.ToDescription(t, ""), helper.ToTags(t))
+		// when
+		dispatcher.Subscribe(nil)
+		// then
+		assert.NotPanics(t, func() { dispatcher.Publish(bookmark.PullEvents()) })
+	})
+}
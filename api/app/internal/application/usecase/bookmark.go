@@ -1,10 +1,14 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/kkntzw/bookmark/internal/application/command"
 	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/application/event"
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/repository"
 	"github.com/kkntzw/bookmark/internal/domain/service"
@@ -13,45 +17,224 @@ import (
 // ブックマークに関するユースケースのインターフェース。
 type Bookmark interface {
 	// ブックマークを登録する。
-	Register(*command.RegisterBookmark) error
+	Register(*command.RegisterBookmark) (*dto.Bookmark, error)
+
+	// ブックマークを取得する。
+	Get(*command.GetBookmark) (*dto.Bookmark, error)
 
 	// ブックマークを一覧取得する。
-	List() ([]dto.Bookmark, error)
+	List(*command.ListBookmarks) (*dto.BookmarkPage, error)
+
+	// ブックマークの変更を監視する。
+	Watch(context.Context, *command.WatchBookmarks, func(dto.BookmarkChange) error) error
 
 	// ブックマークを更新する。
-	Update(*command.UpdateBookmark) error
+	Update(*command.UpdateBookmark) (*dto.Bookmark, error)
 
-	// ブックマークを削除する。
+	// ブックマークをゴミ箱に移動する。
 	Delete(*command.DeleteBookmark) error
+
+	// ゴミ箱にあるブックマークを一覧取得する。
+	ListTrash(*command.ListTrash) ([]dto.Bookmark, error)
+
+	// ブックマークをゴミ箱から復元する。
+	Restore(*command.RestoreBookmark) (*dto.Bookmark, error)
+
+	// ゴミ箱にあるブックマークを完全に削除する。
+	PurgeTrash(*command.PurgeTrash) (int, error)
+
+	// ブックマークを既読にする。
+	MarkRead(*command.MarkRead) (*dto.Bookmark, error)
+
+	// ブックマークを未読に戻す。
+	MarkUnread(*command.MarkUnread) (*dto.Bookmark, error)
+
+	// ブックマークをアーカイブする。
+	Archive(*command.Archive) (*dto.Bookmark, error)
+
+	// ブックマークをお気に入りに登録する。
+	Star(*command.Star) (*dto.Bookmark, error)
+
+	// ブックマークをお気に入りから外す。
+	Unstar(*command.Unstar) (*dto.Bookmark, error)
+
+	// ブックマークの改訂履歴を一覧取得する。
+	ListRevisions(*command.ListBookmarkRevisions) ([]dto.Revision, error)
+
+	// ブックマークを改訂前の内容に戻す。
+	Revert(*command.RevertBookmark) (*dto.Bookmark, error)
+
+	// ブックマークにタグを追加する。
+	AddTags(*command.AddTags) (*dto.Bookmark, error)
+
+	// ブックマークからタグを削除する。
+	RemoveTags(*command.RemoveTags) (*dto.Bookmark, error)
+
+	// タグを一覧取得する。
+	ListTags(*command.ListTags) ([]dto.TagCount, error)
+
+	// タグ名を変更する。
+	RenameTag(*command.RenameTag) (int, error)
+
+	// タグを統合する。
+	MergeTags(*command.MergeTags) (int, error)
+
+	// 重複するブックマークを一覧取得する。
+	FindDuplicates(*command.FindDuplicates) ([]dto.Duplicate, error)
+
+	// ブックマークを統合する。
+	MergeBookmarks(*command.MergeBookmarks) (*dto.Bookmark, error)
+
+	// 監査ログを一覧取得する。
+	ListAuditEntries(*command.ListAuditEntries) ([]dto.AuditEntry, error)
+
+	// ブックマークまたはコレクションを共有する。
+	Share(*command.ShareBookmark) (*dto.Share, error)
+
+	// 共有を解除する。
+	RevokeShare(*command.RevokeShare) error
+
+	// 共有されたブックマークを一覧取得する。
+	ListSharedWithMe(*command.ListSharedWithMe) ([]dto.SharedBookmark, error)
 }
 
 // ブックマークに関するユースケースの具象型。
+//
+// ブックマークはコマンドで指定したユーザが所有するものに限り操作する。
+// ただし取得、更新、削除、状態やタグの変更、改訂履歴の一覧取得と改訂前の内容に戻す操作は、共有されたブックマークも付与された権限の範囲で操作できる。
+// 所有も共有もされていないブックマークは存在しないものとして扱う。
+// ブックマークの保存に成功した場合は、集約に記録されたドメインイベントをディスパッチャに発行する。
+// ブックマークの改訂と監査ログはリポジトリが保存と同時に記録する。
+// 変更者はコマンドで指定した変更者とし、指定できないコマンドでは操作するユーザとする。
 type bookmarkUsecase struct {
-	repository repository.Bookmark // リポジトリ
-	service    service.Bookmark    // ドメインサービス
+	repository         repository.Bookmark        // リポジトリ
+	revisionRepository repository.Revision        // 改訂履歴のリポジトリ
+	auditRepository    repository.Audit           // 監査ログのリポジトリ
+	shareRepository    repository.Share           // 共有のリポジトリ
+	watcher            repository.BookmarkWatcher // 変更の監視
+	service            service.Bookmark           // ドメインサービス
+	dispatcher         event.Dispatcher           // ドメインイベントのディスパッチャ
+	uriPolicy          *entity.URIPolicy          // URIの制約
 }
 
 // ブックマークに関するユースケースを生成する。
-func NewBookmarkUsecase(repository repository.Bookmark, service service.Bookmark) Bookmark {
+func NewBookmarkUsecase(repository repository.Bookmark, revisionRepository repository.Revision, auditRepository repository.Audit, shareRepository repository.Share, watcher repository.BookmarkWatcher, service service.Bookmark, dispatcher event.Dispatcher, uriPolicy *entity.URIPolicy) Bookmark {
 	return &bookmarkUsecase{
-		repository: repository,
-		service:    service,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		auditRepository:    auditRepository,
+		shareRepository:    shareRepository,
+		watcher:            watcher,
+		service:            service,
+		dispatcher:         dispatcher,
+		uriPolicy:          uriPolicy,
 	}
 }
 
+// 所有者が同じで正規形が一致するURIの別のブックマークが存在しないことを確認する。
+//
+// ブックマークの存在確認に失敗した場合はエラーを返却する。
+// 同じURIのブックマークが存在する場合は AlreadyExistsError を返却する。
+func (u *bookmarkUsecase) ensureUnique(bookmark *entity.Bookmark) error {
+	exists, err := u.service.Exists(bookmark)
+	if err != nil {
+		return fmt.Errorf("failed at service.Exists: %w", err)
+	}
+	if exists {
+		return &command.AlreadyExistsError{Resource: "bookmark"}
+	}
+	return nil
+}
+
+// ブックマーク一覧の発行前のドメインイベントをまとめて発行する。
+func (u *bookmarkUsecase) publishAll(bookmarks []entity.Bookmark) {
+	events := []entity.Event{}
+	for i := range bookmarks {
+		events = append(events, bookmarks[i].PullEvents()...)
+	}
+	u.dispatcher.Publish(events)
+}
+
+// 操作するユーザに共有されたブックマークを検索する。
+//
+// IDが一致するブックマークと、そのブックマークに付与された権限のうち最も強い権限を返却する。
+// 該当するブックマークが存在しない場合はnilを返却する。
+//
+// 共有の検索に失敗した場合はエラーを返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) findShared(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, entity.ShareRole, error) {
+	shares, err := u.shareRepository.FindByGrantee(userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed at shareRepository.FindByGrantee: %w", err)
+	}
+	found := map[entity.UserID]*entity.Bookmark{}
+	var shared *entity.Bookmark
+	var role entity.ShareRole
+	for _, share := range shares {
+		owner := share.Owner()
+		bookmark, ok := found[owner]
+		if !ok {
+			bookmark, err = u.repository.FindByID(&owner, id)
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed at repository.FindByID: %w", err)
+			}
+			found[owner] = bookmark
+		}
+		if bookmark == nil || !share.Covers(*bookmark) {
+			continue
+		}
+		if shared == nil || !role.Allows(share.Role()) {
+			shared, role = bookmark, share.Role()
+		}
+	}
+	return shared, role, nil
+}
+
+// 操作するユーザが必要な権限を持つブックマークを検索する。
+//
+// 所有するブックマークを優先し、存在しない場合は共有されたブックマークを検索する。
+//
+// ブックマークの検索に失敗した場合はエラーを返却する。
+// 共有の検索に失敗した場合はエラーを返却する。
+// 所有も共有もされていない場合は NotFoundError を返却する。
+// 共有された権限が必要な権限に満たない場合は PermissionDeniedError を返却する。
+func (u *bookmarkUsecase) findAccessible(userID *entity.UserID, id *entity.ID, required entity.ShareRole) (*entity.Bookmark, error) {
+	bookmark, err := u.repository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if bookmark != nil {
+		return bookmark, nil
+	}
+	shared, role, err := u.findShared(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if shared == nil {
+		return nil, &command.NotFoundError{Resource: "bookmark"}
+	}
+	if !role.Allows(required) {
+		return nil, &command.PermissionDeniedError{Resource: "bookmark"}
+	}
+	return shared, nil
+}
+
 // ブックマークを登録する。
 //
+// コマンドで指定したユーザを所有者として登録する。
+// 登録に成功した場合は登録したブックマークを返却する。
+//
 // nilを指定した場合はエラーを返却する。
-// 不正なコマンドを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
 // ブックマークの存在確認に失敗した場合はエラーを返却する。
-// ブックマークが存在する場合はエラーを返却する。
+// ブックマークが存在する場合は AlreadyExistsError を返却する。
 // ブックマークの保存に失敗した場合はエラーを返却する。
-func (u *bookmarkUsecase) Register(cmd *command.RegisterBookmark) error {
+func (u *bookmarkUsecase) Register(cmd *command.RegisterBookmark) (*dto.Bookmark, error) {
 	if cmd == nil {
-		return fmt.Errorf("argument \"cmd\" is nil")
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
 	}
-	if err := cmd.Validate(); err != nil {
-		return err
+	if err := cmd.Validate(u.uriPolicy); err != nil {
+		return nil, err
 	}
 	id := u.repository.NextID()
 	name, _ := entity.NewName(cmd.Name)
@@ -61,91 +244,1058 @@ func (u *bookmarkUsecase) Register(cmd *command.RegisterBookmark) error {
 		tag, _ := entity.NewTag(v)
 		tags[i] = *tag
 	}
-	bookmark, _ := entity.NewBookmark(id, name, uri, tags)
-	exists, err := u.service.Exists(bookmark)
-	if err != nil {
-		return fmt.Errorf("failed at service.Exists: %w", err)
+	description, _ := entity.NewDescription(cmd.Description)
+	userID, _ := entity.NewUserID(cmd.UserID)
+	bookmark, _ := entity.RegisterBookmark(id, userID, name, uri, description, tags)
+	if err := u.ensureUnique(bookmark); err != nil {
+		return nil, err
 	}
-	if exists {
-		return fmt.Errorf("bookmark already exists")
+	if err := u.repository.Save(bookmark, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, &command.AlreadyExistsError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
 	}
-	if err := u.repository.Save(bookmark); err != nil {
-		return fmt.Errorf("failed at repository.Save: %w", err)
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
+
+// ブックマークを取得する。
+//
+// 閲覧者以上の権限で共有されたブックマークも取得できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+func (u *bookmarkUsecase) Get(cmd *command.GetBookmark) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
 	}
-	return nil
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	bookmark, err := u.findAccessible(userID, id, entity.ShareRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
 }
 
 // ブックマークを一覧取得する。
 //
+// 検索条件に該当するブックマークを1ページ分返却する。
+// 次のページが存在する場合はページトークンを設定する。
+// ページトークンには最後のブックマークの並び替えのキーの値とIDを符号化し、その直後から次のページを取得する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
 // ブックマークの検索に失敗した場合はエラーを返却する。
-func (u *bookmarkUsecase) List() ([]dto.Bookmark, error) {
-	entities, err := u.repository.FindAll()
+func (u *bookmarkUsecase) List(cmd *command.ListBookmarks) (*dto.BookmarkPage, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	tags := make([]entity.Tag, len(cmd.Tags))
+	for i, v := range cmd.Tags {
+		tag, _ := entity.NewTag(v)
+		tags[i] = *tag
+	}
+	sortKeys := map[string]repository.BookmarkSortKey{
+		"":          repository.SortByID,
+		"ID":        repository.SortByID,
+		"Name":      repository.SortByName,
+		"URI":       repository.SortByURI,
+		"CreatedAt": repository.SortByCreatedAt,
+		"UpdatedAt": repository.SortByUpdatedAt,
+	}
+	pageSize := cmd.PageSize
+	if pageSize == 0 {
+		pageSize = command.DefaultPageSize
+	}
+	var after *repository.BookmarkCursor
+	if cursor, _ := command.DecodePageToken(cmd.PageToken); cursor != nil {
+		id, _ := entity.NewID(cursor.ID)
+		after = &repository.BookmarkCursor{Value: cursor.Value, Time: cursor.Time, ID: *id}
+	}
+	var folder *entity.ID
+	if cmd.FolderID != "" {
+		folder, _ = entity.NewID(cmd.FolderID)
+	}
+	var status *entity.Status
+	if cmd.Status != "" {
+		status, _ = entity.NewStatus(cmd.Status)
+	}
+	spec := &repository.BookmarkSpec{
+		Tags:         tags,
+		MatchAllTags: cmd.MatchAllTags,
+		NameContains: cmd.NameContains,
+		URIContains:  cmd.URIContains,
+		Folder:       folder,
+		Status:       status,
+		StarredOnly:  cmd.StarredOnly,
+		SortKey:      sortKeys[cmd.OrderBy],
+		Descending:   cmd.Descending,
+		After:        after,
+		Limit:        pageSize + 1,
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	entities, err := u.repository.FindBySpec(userID, spec)
 	if err != nil {
-		return nil, fmt.Errorf("failed at repository.FindAll: %w", err)
+		return nil, fmt.Errorf("failed at repository.FindBySpec: %w", err)
+	}
+	nextPageToken := ""
+	if len(entities) > pageSize {
+		entities = entities[:pageSize]
+		last := repository.NewBookmarkCursor(&entities[pageSize-1], spec.SortKey)
+		nextPageToken = command.EncodePageToken(command.PageCursor{
+			OrderBy:    cmd.OrderBy,
+			Descending: cmd.Descending,
+			Value:      last.Value,
+			Time:       last.Time,
+			ID:         last.ID.Value(),
+		})
 	}
 	bookmarks := make([]dto.Bookmark, len(entities))
 	for i, entity := range entities {
 		bookmarks[i] = dto.NewBookmark(entity)
 	}
-	return bookmarks, nil
+	return &dto.BookmarkPage{Bookmarks: bookmarks, NextPageToken: nextPageToken}, nil
+}
+
+// ブックマークの変更を監視する。
+//
+// 変更が発生した順にハンドラを呼び出し、コンテキストが終了するまで監視を続ける。
+// コマンドで指定したユーザが所有するブックマークの変更に限りハンドラを呼び出す。
+// 完全な削除を含め、他のユーザが所有するブックマークの変更は通知しない。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 再開トークンが不正な場合は InvalidCommandError を返却する。
+// コンテキストが終了した場合はコンテキストのエラーを返却する。
+// ハンドラがエラーを返却した場合はそのエラーを返却する。
+// 変更の監視に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Watch(ctx context.Context, cmd *command.WatchBookmarks, handler func(dto.BookmarkChange) error) error {
+	if cmd == nil {
+		return fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if handler == nil {
+		return fmt.Errorf("argument \"handler\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	var handlerErr error
+	err := u.watcher.Watch(ctx, userID, cmd.ResumeToken, func(change repository.BookmarkChange) error {
+		handlerErr = handler(dto.NewBookmarkChange(change))
+		return handlerErr
+	})
+	switch {
+	case err == nil:
+		return nil
+	case handlerErr != nil:
+		return handlerErr
+	case errors.Is(err, repository.ErrInvalidResumeToken):
+		return &command.InvalidCommandError{Args: map[string]error{"ResumeToken": err}}
+	case ctx.Err() != nil:
+		return ctx.Err()
+	}
+	return fmt.Errorf("failed at watcher.Watch: %w", err)
 }
 
 // ブックマークを更新する。
 //
+// 更新対象のフィールドに限り更新する。
+// 編集者の権限で共有されたブックマークも更新できる。
+// 更新に成功した場合は更新したブックマークを返却する。
+//
 // nilを指定した場合はエラーを返却する。
-// 不正なコマンドを指定した場合はエラーを返却する。
-// ブックマークの検索に失敗した場合はエラーを返却する。
-// ブックマークが存在しない場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
 // ブックマークの保存に失敗した場合はエラーを返却する。
-func (u *bookmarkUsecase) Update(cmd *command.UpdateBookmark) error {
+func (u *bookmarkUsecase) Update(cmd *command.UpdateBookmark) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(u.uriPolicy); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	bookmark, err := u.findAccessible(userID, id, entity.ShareRoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Version != 0 && cmd.Version != bookmark.Version() {
+		return nil, &command.ConflictError{Resource: "bookmark"}
+	}
+	if cmd.Updates("Name") {
+		name, _ := entity.NewName(cmd.Name)
+		bookmark.Rename(name)
+	}
+	if cmd.Updates("URI") {
+		uri, _ := entity.NewURI(cmd.URI)
+		bookmark.RewriteURI(uri)
+	}
+	if cmd.Updates("Description") {
+		description, _ := entity.NewDescription(cmd.Description)
+		bookmark.Describe(description)
+	}
+	if cmd.Updates("Tags") {
+		tags := make([]entity.Tag, len(cmd.Tags))
+		for i, v := range cmd.Tags {
+			tag, _ := entity.NewTag(v)
+			tags[i] = *tag
+		}
+		bookmark.ReplaceTags(tags)
+	}
+	if cmd.Updates("URI") {
+		if err := u.ensureUnique(bookmark); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.repository.Save(bookmark, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, &command.AlreadyExistsError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
+
+// ブックマークをゴミ箱に移動する。
+//
+// ゴミ箱に移動したブックマークは復元できる。
+// 編集者の権限で共有されたブックマークも移動できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// ブックマークの移動に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Delete(cmd *command.DeleteBookmark) error {
 	if cmd == nil {
 		return fmt.Errorf("argument \"cmd\" is nil")
 	}
 	if err := cmd.Validate(); err != nil {
 		return err
 	}
+	userID, _ := entity.NewUserID(cmd.UserID)
 	id, _ := entity.NewID(cmd.ID)
-	bookmark, err := u.repository.FindByID(id)
+	bookmark, err := u.findAccessible(userID, id, entity.ShareRoleEditor)
 	if err != nil {
-		return fmt.Errorf("failed at repository.FindByID: %w", err)
+		return err
 	}
-	if bookmark == nil {
-		return fmt.Errorf("bookmark does not exist")
+	if cmd.Version != 0 && cmd.Version != bookmark.Version() {
+		return &command.ConflictError{Resource: "bookmark"}
 	}
-	name, _ := entity.NewName(cmd.Name)
-	bookmark.Rename(name)
-	uri, _ := entity.NewURI(cmd.URI)
-	bookmark.RewriteURI(uri)
-	if err := u.repository.Save(bookmark); err != nil {
-		return fmt.Errorf("failed at repository.Save: %w", err)
+	bookmark.Delete()
+	if err := u.repository.Trash(bookmark, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return &command.ConflictError{Resource: "bookmark"}
+		}
+		return fmt.Errorf("failed at repository.Trash: %w", err)
 	}
+	u.dispatcher.Publish(bookmark.PullEvents())
 	return nil
 }
 
-// ブックマークを削除する。
+// ゴミ箱にあるブックマークを一覧取得する。
+//
+// ゴミ箱に移動した日時の新しい順に返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) ListTrash(cmd *command.ListTrash) ([]dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	entities, err := u.repository.FindTrash(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindTrash: %w", err)
+	}
+	bookmarks := make([]dto.Bookmark, len(entities))
+	for i, entity := range entities {
+		bookmarks[i] = dto.NewBookmark(entity)
+	}
+	return bookmarks, nil
+}
+
+// ブックマークをゴミ箱から復元する。
+//
+// 復元に成功した場合は復元したブックマークを返却する。
 //
 // nilを指定した場合はエラーを返却する。
-// 不正なコマンドを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
 // ブックマークの検索に失敗した場合はエラーを返却する。
-// ブックマークが存在しない場合はエラーを返却する。
+// ゴミ箱にブックマークが存在しない場合は NotFoundError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// ブックマークの存在確認に失敗した場合はエラーを返却する。
+// 同じURIのブックマークが存在する場合は AlreadyExistsError を返却する。
+// ブックマークの復元に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Restore(cmd *command.RestoreBookmark) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	bookmark, err := u.repository.FindTrashByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindTrashByID: %w", err)
+	}
+	if bookmark == nil {
+		return nil, &command.NotFoundError{Resource: "bookmark"}
+	}
+	if cmd.Version != 0 && cmd.Version != bookmark.Version() {
+		return nil, &command.ConflictError{Resource: "bookmark"}
+	}
+	if err := u.ensureUnique(bookmark); err != nil {
+		return nil, err
+	}
+	bookmark.Restore()
+	if err := u.repository.Restore(bookmark, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, &command.AlreadyExistsError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Restore: %w", err)
+	}
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
+
+// ゴミ箱にあるブックマークを完全に削除する。
+//
+// 指定日時より前にゴミ箱に移動したブックマークを対象とする。
+// 削除したブックマーク数を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
 // ブックマークの削除に失敗した場合はエラーを返却する。
-func (u *bookmarkUsecase) Delete(cmd *command.DeleteBookmark) error {
+func (u *bookmarkUsecase) PurgeTrash(cmd *command.PurgeTrash) (int, error) {
+	if cmd == nil {
+		return 0, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	bookmarks, err := u.repository.PurgeTrash(userID, cmd.OlderThan, cmd.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed at repository.PurgeTrash: %w", err)
+	}
+	u.publishAll(bookmarks)
+	return len(bookmarks), nil
+}
+
+// ブックマークを既読にする。
+//
+// 既読にしたブックマークを返却する。
+// 編集者の権限で共有されたブックマークも既読にできる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) MarkRead(cmd *command.MarkRead) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.mark(cmd.UserID, cmd.ID, cmd.Version, (*entity.Bookmark).MarkRead)
+}
+
+// ブックマークを未読に戻す。
+//
+// 未読に戻したブックマークを返却する。
+// 編集者の権限で共有されたブックマークも未読に戻せる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) MarkUnread(cmd *command.MarkUnread) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.mark(cmd.UserID, cmd.ID, cmd.Version, (*entity.Bookmark).MarkUnread)
+}
+
+// ブックマークをアーカイブする。
+//
+// アーカイブしたブックマークを返却する。
+// 編集者の権限で共有されたブックマークもアーカイブできる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Archive(cmd *command.Archive) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.mark(cmd.UserID, cmd.ID, cmd.Version, (*entity.Bookmark).Archive)
+}
+
+// ブックマークをお気に入りに登録する。
+//
+// お気に入りに登録したブックマークを返却する。
+// 編集者の権限で共有されたブックマークもお気に入りに登録できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Star(cmd *command.Star) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.mark(cmd.UserID, cmd.ID, cmd.Version, (*entity.Bookmark).Star)
+}
+
+// ブックマークをお気に入りから外す。
+//
+// お気に入りから外したブックマークを返却する。
+// 編集者の権限で共有されたブックマークもお気に入りから外せる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Unstar(cmd *command.Unstar) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.mark(cmd.UserID, cmd.ID, cmd.Version, (*entity.Bookmark).Unstar)
+}
+
+// 検証済みのユーザIDとID、期待する版数を用いてブックマークの状態を変更する。
+//
+// 期待する版数が0の場合は版数を検証しない。
+func (u *bookmarkUsecase) mark(uv, iv string, version uint64, apply func(*entity.Bookmark)) (*dto.Bookmark, error) {
+	userID, _ := entity.NewUserID(uv)
+	id, _ := entity.NewID(iv)
+	bookmark, err := u.findAccessible(userID, id, entity.ShareRoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && version != bookmark.Version() {
+		return nil, &command.ConflictError{Resource: "bookmark"}
+	}
+	apply(bookmark)
+	if err := u.repository.Save(bookmark, uv); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
+
+// ブックマークの改訂履歴を一覧取得する。
+//
+// 作成日時の新しい順に返却する。
+// 閲覧者以上の権限で共有されたブックマークの改訂履歴も取得できる。
+// ゴミ箱にあるブックマークの改訂履歴も取得できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 改訂の検索に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) ListRevisions(cmd *command.ListBookmarkRevisions) ([]dto.Revision, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	if _, err := u.findAccessible(userID, id, entity.ShareRoleViewer); err != nil {
+		var notFound *command.NotFoundError
+		if !errors.As(err, &notFound) {
+			return nil, err
+		}
+		trashed, err := u.repository.FindTrashByID(userID, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed at repository.FindTrashByID: %w", err)
+		}
+		if trashed == nil {
+			return nil, &command.NotFoundError{Resource: "bookmark"}
+		}
+	}
+	entities, err := u.revisionRepository.FindByBookmarkID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at revisionRepository.FindByBookmarkID: %w", err)
+	}
+	revisions := make([]dto.Revision, len(entities))
+	for i, entity := range entities {
+		revisions[i] = dto.NewRevision(entity)
+	}
+	return revisions, nil
+}
+
+// ブックマークを改訂前の内容に戻す。
+//
+// ブックマーク名、URI、説明、タグ一覧、所属するフォルダ、状態、お気に入りへの登録を改訂の変更前の内容に置き換え、新たな改訂として記録する。
+// 編集者の権限で共有されたブックマークも戻すことができる。
+// 戻すことに成功した場合は更新したブックマークを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 改訂の検索に失敗した場合はエラーを返却する。
+// 改訂が存在しない場合は NotFoundError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合、または所有も共有もされていない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Revert(cmd *command.RevertBookmark) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	revisionID, _ := entity.NewID(cmd.RevisionID)
+	revision, err := u.revisionRepository.FindByID(revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at revisionRepository.FindByID: %w", err)
+	}
+	if revision == nil {
+		return nil, &command.NotFoundError{Resource: "revision"}
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id := revision.BookmarkID()
+	bookmark, err := u.findAccessible(userID, &id, entity.ShareRoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Version != 0 && cmd.Version != bookmark.Version() {
+		return nil, &command.ConflictError{Resource: "bookmark"}
+	}
+	before := bookmark.Snapshot()
+	snapshot := revision.Before()
+	bookmark.Revert(&snapshot)
+	if uri, reverted := before.URI(), bookmark.URI(); uri.Canonical() != reverted.Canonical() {
+		if err := u.ensureUnique(bookmark); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.repository.Save(bookmark, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, &command.AlreadyExistsError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
+
+// ブックマークにタグを追加する。
+//
+// 追加に成功した場合は更新したブックマークを返却する。
+// 編集者の権限で共有されたブックマークもタグを追加できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) AddTags(cmd *command.AddTags) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.retag(cmd.UserID, cmd.ID, cmd.Version, cmd.Tags, (*entity.Bookmark).AddTags)
+}
+
+// ブックマークからタグを削除する。
+//
+// 削除に成功した場合は更新したブックマークを返却する。
+// 編集者の権限で共有されたブックマークもタグを削除できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークまたは共有の検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 閲覧者の権限で共有されたブックマークの場合は PermissionDeniedError を返却する。
+// 版数が期待する版数と異なる場合は ConflictError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) RemoveTags(cmd *command.RemoveTags) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	return u.retag(cmd.UserID, cmd.ID, cmd.Version, cmd.Tags, (*entity.Bookmark).RemoveTags)
+}
+
+// 検証済みのユーザIDとID、期待する版数、タグ一覧を用いてブックマークのタグを変更する。
+//
+// 期待する版数が0の場合は版数を検証しない。
+func (u *bookmarkUsecase) retag(uv, iv string, version uint64, tvs []string, apply func(*entity.Bookmark, []entity.Tag) error) (*dto.Bookmark, error) {
+	userID, _ := entity.NewUserID(uv)
+	id, _ := entity.NewID(iv)
+	bookmark, err := u.findAccessible(userID, id, entity.ShareRoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && version != bookmark.Version() {
+		return nil, &command.ConflictError{Resource: "bookmark"}
+	}
+	tags := make([]entity.Tag, len(tvs))
+	for i, v := range tvs {
+		tag, _ := entity.NewTag(v)
+		tags[i] = *tag
+	}
+	apply(bookmark, tags)
+	if err := u.repository.Save(bookmark, uv); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
+
+// タグを一覧取得する。
+//
+// タグごとに付与されたブックマーク数を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// タグの集計に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) ListTags(cmd *command.ListTags) ([]dto.TagCount, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	entities, err := u.repository.CountTags(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.CountTags: %w", err)
+	}
+	tagCounts := make([]dto.TagCount, len(entities))
+	for i, entity := range entities {
+		tagCounts[i] = dto.NewTagCount(entity)
+	}
+	return tagCounts, nil
+}
+
+// タグ名を変更する。
+//
+// 変更前のタグが付与された、ユーザが所有する全てのブックマークを対象とする。
+// 変更したブックマーク数を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 検索後に他の書き込みがあった場合は ConflictError を返却する。
+// タグの統合に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) RenameTag(cmd *command.RenameTag) (int, error) {
+	if cmd == nil {
+		return 0, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+	from, _ := entity.NewTag(cmd.From)
+	to, _ := entity.NewTag(cmd.To)
+	userID, _ := entity.NewUserID(cmd.UserID)
+	bookmarks, err := u.repository.MergeTags(userID, []entity.Tag{*from}, to, cmd.UserID)
+	if err != nil {
+		return 0, toMergeTagsError(err)
+	}
+	u.publishAll(bookmarks)
+	return len(bookmarks), nil
+}
+
+// タグを統合する。
+//
+// 統合元のタグが付与された、ユーザが所有する全てのブックマークを対象とする。
+// 統合したブックマーク数を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 検索後に他の書き込みがあった場合は ConflictError を返却する。
+// タグの統合に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) MergeTags(cmd *command.MergeTags) (int, error) {
+	if cmd == nil {
+		return 0, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+	sources := make([]entity.Tag, len(cmd.Sources))
+	for i, v := range cmd.Sources {
+		tag, _ := entity.NewTag(v)
+		sources[i] = *tag
+	}
+	target, _ := entity.NewTag(cmd.Target)
+	userID, _ := entity.NewUserID(cmd.UserID)
+	bookmarks, err := u.repository.MergeTags(userID, sources, target, cmd.UserID)
+	if err != nil {
+		return 0, toMergeTagsError(err)
+	}
+	u.publishAll(bookmarks)
+	return len(bookmarks), nil
+}
+
+// タグの統合で発生したエラーを変換する。
+//
+// 検索後に他の書き込みがあった場合は ConflictError に変換する。
+func toMergeTagsError(err error) error {
+	if errors.Is(err, repository.ErrConflict) {
+		return &command.ConflictError{Resource: "bookmark"}
+	}
+	return fmt.Errorf("failed at repository.MergeTags: %w", err)
+}
+
+// 重複するブックマークを一覧取得する。
+//
+// ユーザが所有するブックマークのうち、正規形が一致するURIのブックマークを重複とみなす。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 重複の集計に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) FindDuplicates(cmd *command.FindDuplicates) ([]dto.Duplicate, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	entities, err := u.repository.FindDuplicates(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindDuplicates: %w", err)
+	}
+	duplicates := make([]dto.Duplicate, len(entities))
+	for i, entity := range entities {
+		duplicates[i] = dto.NewDuplicate(entity)
+	}
+	return duplicates, nil
+}
+
+// ブックマークを統合する。
+//
+// 統合元のブックマークのタグを統合先のブックマークに追加し、統合元のブックマークを削除する。
+// 統合に成功した場合は統合先のブックマークを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+// いずれかのブックマークが存在しない場合は NotFoundError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの統合に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) MergeBookmarks(cmd *command.MergeBookmarks) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	target, err := u.repository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if target == nil {
+		return nil, &command.NotFoundError{Resource: "bookmark"}
+	}
+	sources := make([]entity.Bookmark, len(cmd.SourceIDs))
+	for i, v := range cmd.SourceIDs {
+		id, _ := entity.NewID(v)
+		source, err := u.repository.FindByID(userID, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+		}
+		if source == nil {
+			return nil, &command.NotFoundError{Resource: "bookmark"}
+		}
+		target.AddTags(source.Tags())
+		source.Purge()
+		sources[i] = *source
+	}
+	if err := u.repository.MergeBookmarks(target, sources, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.MergeBookmarks: %w", err)
+	}
+	events := target.PullEvents()
+	for i := range sources {
+		events = append(events, sources[i].PullEvents()...)
+	}
+	u.dispatcher.Publish(events)
+	result := dto.NewBookmark(*target)
+	return &result, nil
+}
+
+// 監査ログを一覧取得する。
+//
+// 作成日時の新しい順に返却する。
+// ユーザが所有するブックマークの記録に限り返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 監査ログの検索に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) ListAuditEntries(cmd *command.ListAuditEntries) ([]dto.AuditEntry, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	var bookmarkID *entity.ID
+	if cmd.BookmarkID != "" {
+		bookmarkID, _ = entity.NewID(cmd.BookmarkID)
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	spec := &repository.AuditSpec{
+		BookmarkID: bookmarkID,
+		UserID:     userID,
+		Actor:      cmd.Actor,
+		Since:      cmd.Since,
+		Until:      cmd.Until,
+	}
+	entities, err := u.auditRepository.FindBySpec(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed at auditRepository.FindBySpec: %w", err)
+	}
+	entries := make([]dto.AuditEntry, len(entities))
+	for i, entity := range entities {
+		entries[i] = dto.NewAuditEntry(entity)
+	}
+	return entries, nil
+}
+
+// ブックマークまたはコレクションを共有する。
+//
+// ブックマークを共有する場合は操作するユーザが所有するものに限る。
+// 同じ共有先に同じ対象を共有済みの場合は権限を変更する。
+// 共有に成功した場合は保存した共有を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// 共有の検索に失敗した場合はエラーを返却する。
+// 共有の保存に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) Share(cmd *command.ShareBookmark) (*dto.Share, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	grantee, _ := entity.NewUserID(cmd.Grantee)
+	role, _ := entity.NewShareRole(cmd.Role)
+	var bookmarkID *entity.ID
+	var tag *entity.Tag
+	if cmd.ID != "" {
+		bookmarkID, _ = entity.NewID(cmd.ID)
+		bookmark, err := u.repository.FindByID(userID, bookmarkID)
+		if err != nil {
+			return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+		}
+		if bookmark == nil {
+			return nil, &command.NotFoundError{Resource: "bookmark"}
+		}
+	} else {
+		tag, _ = entity.NewTag(cmd.Tag)
+	}
+	share, _ := entity.NewShare(u.shareRepository.NextID(), userID, grantee, bookmarkID, tag, *role)
+	shares, err := u.shareRepository.FindByOwner(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at shareRepository.FindByOwner: %w", err)
+	}
+	for i := range shares {
+		if shares[i].SameTarget(*share) {
+			share = &shares[i]
+			share.Grant(*role)
+			break
+		}
+	}
+	if err := u.shareRepository.Save(share); err != nil {
+		return nil, fmt.Errorf("failed at shareRepository.Save: %w", err)
+	}
+	result := dto.NewShare(*share)
+	return &result, nil
+}
+
+// 共有を解除する。
+//
+// 共有元または共有先のユーザに限り解除できる。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 共有の検索に失敗した場合はエラーを返却する。
+// 共有が存在しない場合、または操作するユーザが共有元でも共有先でもない場合は NotFoundError を返却する。
+// 共有の削除に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) RevokeShare(cmd *command.RevokeShare) error {
 	if cmd == nil {
 		return fmt.Errorf("argument \"cmd\" is nil")
 	}
 	if err := cmd.Validate(); err != nil {
 		return err
 	}
+	userID, _ := entity.NewUserID(cmd.UserID)
 	id, _ := entity.NewID(cmd.ID)
-	bookmark, err := u.repository.FindByID(id)
+	share, err := u.shareRepository.FindByID(id)
 	if err != nil {
-		return fmt.Errorf("failed at repository.FindByID: %w", err)
+		return fmt.Errorf("failed at shareRepository.FindByID: %w", err)
 	}
-	if bookmark == nil {
-		return fmt.Errorf("bookmark does not exist")
+	if share == nil || (share.Owner() != *userID && share.Grantee() != *userID) {
+		return &command.NotFoundError{Resource: "share"}
 	}
-	if err := u.repository.Delete(bookmark); err != nil {
-		return fmt.Errorf("failed at repository.Delete: %w", err)
+	if err := u.shareRepository.Delete(share); err != nil {
+		return fmt.Errorf("failed at shareRepository.Delete: %w", err)
 	}
 	return nil
 }
+
+// 共有されたブックマークを一覧取得する。
+//
+// ゴミ箱にあるブックマークは含まない。
+// 複数の共有で同じブックマークが共有された場合は最も強い権限を返却する。
+// IDの昇順に返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 共有の検索に失敗した場合はエラーを返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+func (u *bookmarkUsecase) ListSharedWithMe(cmd *command.ListSharedWithMe) ([]dto.SharedBookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	shares, err := u.shareRepository.FindByGrantee(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at shareRepository.FindByGrantee: %w", err)
+	}
+	bookmarks := map[string]entity.Bookmark{}
+	roles := map[string]entity.ShareRole{}
+	for _, share := range shares {
+		owner := share.Owner()
+		var entities []entity.Bookmark
+		if bookmarkID := share.BookmarkID(); bookmarkID != nil {
+			bookmark, err := u.repository.FindByID(&owner, bookmarkID)
+			if err != nil {
+				return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+			}
+			if bookmark != nil {
+				entities = append(entities, *bookmark)
+			}
+		} else {
+			spec := &repository.BookmarkSpec{Tags: []entity.Tag{*share.Tag()}}
+			entities, err = u.repository.FindBySpec(&owner, spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed at repository.FindBySpec: %w", err)
+			}
+		}
+		for _, bookmark := range entities {
+			id := bookmark.ID()
+			if role, ok := roles[id.Value()]; ok && role.Allows(share.Role()) {
+				continue
+			}
+			bookmarks[id.Value()] = bookmark
+			roles[id.Value()] = share.Role()
+		}
+	}
+	ids := make([]string, 0, len(bookmarks))
+	for id := range bookmarks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	shared := make([]dto.SharedBookmark, len(ids))
+	for i, id := range ids {
+		shared[i] = dto.NewSharedBookmark(bookmarks[id], roles[id])
+	}
+	return shared, nil
+}
@@ -1,14 +1,19 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/kkntzw/bookmark/internal/application/command"
 	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/application/event"
 	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
 	"github.com/kkntzw/bookmark/test/helper"
 	mock_repository "github.com/kkntzw/bookmark/test/mock/domain/repository"
 	mock_service "github.com/kkntzw/bookmark/test/mock/domain/service"
@@ -23,9 +28,13 @@ func TestNewBookmarkUsecase(t *testing.T) {
 		t.Parallel()
 		// given
 		repository := mock_repository.NewMockBookmark(ctrl)
+		revisionRepository := mock_repository.NewMockRevision(ctrl)
+		auditRepository := mock_repository.NewMockAudit(ctrl)
+		shareRepository := mock_repository.NewMockShare(ctrl)
+		watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
 		service := mock_service.NewMockBookmark(ctrl)
 		// when
-		object := NewBookmarkUsecase(repository, service)
+		object := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
 		// then
 		assert.NotNil(t, object)
 		interfaceObject := (*Bookmark)(nil)
@@ -35,18 +44,42 @@ func TestNewBookmarkUsecase(t *testing.T) {
 		t.Parallel()
 		// given
 		repository := mock_repository.NewMockBookmark(ctrl)
+		revisionRepository := mock_repository.NewMockRevision(ctrl)
+		auditRepository := mock_repository.NewMockAudit(ctrl)
+		shareRepository := mock_repository.NewMockShare(ctrl)
+		watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
 		service := mock_service.NewMockBookmark(ctrl)
-		abstractUsecase := NewBookmarkUsecase(repository, service)
+		dispatcher := event.NewDispatcher()
+		uriPolicy := entity.DefaultURIPolicy()
+		abstractUsecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, dispatcher, uriPolicy)
 		// when
 		concreteUsecase, ok := abstractUsecase.(*bookmarkUsecase)
 		actualRepository := concreteUsecase.repository
+		actualRevisionRepository := concreteUsecase.revisionRepository
+		actualAuditRepository := concreteUsecase.auditRepository
+		actualShareRepository := concreteUsecase.shareRepository
+		actualWatcher := concreteUsecase.watcher
 		actualService := concreteUsecase.service
+		actualDispatcher := concreteUsecase.dispatcher
+		actualURIPolicy := concreteUsecase.uriPolicy
 		// then
 		assert.True(t, ok)
 		expectedRepository := repository
 		assert.Exactly(t, expectedRepository, actualRepository)
+		expectedRevisionRepository := revisionRepository
+		assert.Exactly(t, expectedRevisionRepository, actualRevisionRepository)
+		expectedAuditRepository := auditRepository
+		assert.Exactly(t, expectedAuditRepository, actualAuditRepository)
+		expectedShareRepository := shareRepository
+		assert.Exactly(t, expectedShareRepository, actualShareRepository)
+		expectedWatcher := watcher
+		assert.Exactly(t, expectedWatcher, actualWatcher)
 		expectedService := service
 		assert.Exactly(t, expectedService, actualService)
+		expectedDispatcher := dispatcher
+		assert.Exactly(t, expectedDispatcher, actualDispatcher)
+		expectedURIPolicy := uriPolicy
+		assert.Exactly(t, expectedURIPolicy, actualURIPolicy)
 	})
 }
 
@@ -55,67 +88,204 @@ func TestBookmark_Register(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	cases := map[string]struct {
-		prepare     func(*mock_repository.MockBookmark, *mock_service.MockBookmark)
-		cmd         *command.RegisterBookmark
-		expectedErr error
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockAudit, *mock_service.MockBookmark)
+		cmd              *command.RegisterBookmark
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
 	}{
 		"non-nil command": {
-			func(repository *mock_repository.MockBookmark, service *mock_service.MockBookmark) {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				repository.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkRegistered"), "alice").Return(nil)
+				service.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkRegistered")).Return(false, nil)
+			},
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo", "bar"}},
+			nil,
+		},
+		"command with description": {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
 				repository.EXPECT().NextID().Return(helper.ToID(t, "1"))
-				repository.EXPECT().Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar")).Return(nil)
-				service.EXPECT().Exists(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar")).Return(false, nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToDescribedBookmark(t, "Example\nDomain", "1", "Example", "https://example.com"), "BookmarkRegistered"), helper.UserID).Return(nil)
+				service.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToDescribedBookmark(t, "Example\nDomain", "1", "Example", "https://example.com"), "BookmarkRegistered")).Return(false, nil)
 			},
-			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}},
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Description: "Example\nDomain", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Description: "Example\nDomain", Status: "unread", Tags: []string{}},
 			nil,
 		},
 		"nil command": {
-			func(repository *mock_repository.MockBookmark, service *mock_service.MockBookmark) {},
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+			},
+			nil,
 			nil,
 			errors.New("argument \"cmd\" is nil"),
 		},
 		"invalid command": {
-			func(repository *mock_repository.MockBookmark, service *mock_service.MockBookmark) {},
-			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{""}},
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+			},
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{""}, UserID: helper.UserID},
+			nil,
 			&command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}},
 		},
 		"duplicate bookmark": {
-			func(repository *mock_repository.MockBookmark, service *mock_service.MockBookmark) {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
 				repository.EXPECT().NextID().Return(helper.ToID(t, "1"))
-				service.EXPECT().Exists(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar")).Return(true, nil)
+				service.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkRegistered")).Return(true, nil)
 			},
-			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}},
-			errors.New("bookmark already exists"),
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}, UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
 		},
 		"failed at service.Exists": {
-			func(repository *mock_repository.MockBookmark, service *mock_service.MockBookmark) {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
 				repository.EXPECT().NextID().Return(helper.ToID(t, "1"))
-				service.EXPECT().Exists(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar")).Return(false, errors.New("some error"))
+				service.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkRegistered")).Return(false, errors.New("some error"))
 			},
-			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}},
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}, UserID: helper.UserID},
+			nil,
 			fmt.Errorf("failed at service.Exists: %w", errors.New("some error")),
 		},
 		"failed at repository.Save": {
-			func(repository *mock_repository.MockBookmark, service *mock_service.MockBookmark) {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
 				repository.EXPECT().NextID().Return(helper.ToID(t, "1"))
-				repository.EXPECT().Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar")).Return(errors.New("some error"))
-				service.EXPECT().Exists(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar")).Return(false, nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkRegistered"), helper.UserID).Return(errors.New("some error"))
+				service.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkRegistered")).Return(false, nil)
 			},
-			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}},
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}, UserID: helper.UserID},
+			nil,
 			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
 		},
+		"duplicate at repository.Save": {
+			func(r *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(gomock.Any(), helper.UserID).Return(repository.ErrDuplicate)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+			},
+			&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}, UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
 	}
 	for name, tc := range cases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
 			service := mock_service.NewMockBookmark(ctrl)
-			tc.prepare(repository, service)
+			tc.prepare(repository, auditRepository, service)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Register(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Get(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.GetBookmark
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.GetBookmark{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo", "bar"}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.GetBookmark{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.GetBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), nil)
+			},
+			&command.GetBookmark{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}},
+			nil,
+		},
+		"bookmark out of shared collection": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToCollectionShare(t, "10", "bob", helper.UserID, "bar", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), nil)
+			},
+			&command.GetBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.GetBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at shareRepository.FindByGrantee": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.GetBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at shareRepository.FindByGrantee: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
 			// given
-			usecase := NewBookmarkUsecase(repository, service)
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
 			// when
-			actualErr := usecase.Register(tc.cmd)
+			actualBookmark, actualErr := usecase.Get(tc.cmd)
 			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
 			assert.Exactly(t, tc.expectedErr, actualErr)
 		})
 	}
@@ -123,16 +293,18 @@ func TestBookmark_Register(t *testing.T) {
 
 func TestBookmark_List(t *testing.T) {
 	t.Parallel()
+	updatedAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	cases := map[string]struct {
-		prepare           func(*mock_repository.MockBookmark)
-		expectedBookmarks []dto.Bookmark
-		expectedErr       error
+		prepare      func(*mock_repository.MockBookmark)
+		cmd          *command.ListBookmarks
+		expectedPage *dto.BookmarkPage
+		expectedErr  error
 	}{
-		"3 bookmarks": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindAll().Return(
+		"last page": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{Tags: []entity.Tag{}, Limit: 101}).Return(
 					[]entity.Bookmark{
 						*helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"),
 						*helper.ToBookmark(t, "2", "Example B", "https://bar.example.com", "2-A"),
@@ -141,26 +313,133 @@ func TestBookmark_List(t *testing.T) {
 					nil,
 				)
 			},
-			[]dto.Bookmark{
-				{ID: "1", Name: "Example A", URI: "https://foo.example.com", Tags: []string{}},
-				{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{"2-A"}},
-				{ID: "3", Name: "Example C", URI: "https://baz.example.com", Tags: []string{"3-A", "3-B"}},
+			&command.ListBookmarks{UserID: helper.UserID},
+			&dto.BookmarkPage{
+				Bookmarks: []dto.Bookmark{
+					{ID: "1", Name: "Example A", URI: "https://foo.example.com", Status: "unread", Tags: []string{}},
+					{ID: "2", Name: "Example B", URI: "https://bar.example.com", Status: "unread", Tags: []string{"2-A"}},
+					{ID: "3", Name: "Example C", URI: "https://baz.example.com", Status: "unread", Tags: []string{"3-A", "3-B"}},
+				},
+				NextPageToken: "",
+			},
+			nil,
+		},
+		"intermediate page": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{
+					Tags:         helper.ToTags(t, "foo", "bar"),
+					MatchAllTags: true,
+					NameContains: "Example",
+					URIContains:  "example.com",
+					SortKey:      repository.SortByName,
+					Descending:   true,
+					After:        &repository.BookmarkCursor{Value: "Example D", ID: *helper.ToID(t, "4")},
+					Limit:        3,
+				}).Return(
+					[]entity.Bookmark{
+						*helper.ToBookmark(t, "3", "Example C", "https://baz.example.com", "foo", "bar"),
+						*helper.ToBookmark(t, "2", "Example B", "https://bar.example.com", "foo", "bar"),
+						*helper.ToBookmark(t, "1", "Example A", "https://foo.example.com", "foo", "bar"),
+					},
+					nil,
+				)
+			},
+			&command.ListBookmarks{
+				Tags:         []string{"foo", "bar"},
+				MatchAllTags: true,
+				NameContains: "Example",
+				URIContains:  "example.com",
+				OrderBy:      "Name",
+				Descending:   true,
+				PageSize:     2,
+				PageToken:    command.EncodePageToken(command.PageCursor{OrderBy: "Name", Descending: true, Value: "Example D", ID: "4"}),
+				UserID:       helper.UserID,
+			},
+			&dto.BookmarkPage{
+				Bookmarks: []dto.Bookmark{
+					{ID: "3", Name: "Example C", URI: "https://baz.example.com", Status: "unread", Tags: []string{"foo", "bar"}},
+					{ID: "2", Name: "Example B", URI: "https://bar.example.com", Status: "unread", Tags: []string{"foo", "bar"}},
+				},
+				NextPageToken: command.EncodePageToken(command.PageCursor{OrderBy: "Name", Descending: true, Value: "Example B", ID: "2"}),
+			},
+			nil,
+		},
+		"in folder": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{Tags: []entity.Tag{}, Folder: helper.ToID(t, "10"), Limit: 101}).Return(
+					[]entity.Bookmark{
+						*helper.ToFiledBookmark(t, "10", "1", "Example A", "https://foo.example.com"),
+					},
+					nil,
+				)
+			},
+			&command.ListBookmarks{FolderID: "10", UserID: helper.UserID},
+			&dto.BookmarkPage{
+				Bookmarks: []dto.Bookmark{
+					{ID: "1", Name: "Example A", URI: "https://foo.example.com", FolderID: "10", Status: "unread", Tags: []string{}},
+				},
+				NextPageToken: "",
+			},
+			nil,
+		},
+		"archived and starred": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{Tags: []entity.Tag{}, Status: helper.ToStatus(t, "archived"), StarredOnly: true, Limit: 101}).Return(
+					[]entity.Bookmark{
+						*helper.ToMarkedBookmark(t, entity.StatusArchived, true, "1", "Example A", "https://foo.example.com"),
+					},
+					nil,
+				)
+			},
+			&command.ListBookmarks{Status: "archived", StarredOnly: true, UserID: helper.UserID},
+			&dto.BookmarkPage{
+				Bookmarks: []dto.Bookmark{
+					{ID: "1", Name: "Example A", URI: "https://foo.example.com", Status: "archived", Starred: true, Tags: []string{}},
+				},
+				NextPageToken: "",
+			},
+			nil,
+		},
+		"order by updated at": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{
+					Tags:    []entity.Tag{},
+					SortKey: repository.SortByUpdatedAt,
+					After:   &repository.BookmarkCursor{Time: updatedAt, ID: *helper.ToID(t, "1")},
+					Limit:   101,
+				}).Return([]entity.Bookmark{}, nil)
 			},
+			&command.ListBookmarks{OrderBy: "UpdatedAt", PageToken: command.EncodePageToken(command.PageCursor{OrderBy: "UpdatedAt", Time: updatedAt, ID: "1"}), UserID: helper.UserID},
+			&dto.BookmarkPage{Bookmarks: []dto.Bookmark{}, NextPageToken: ""},
 			nil,
 		},
 		"no bookmarks": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindAll().Return([]entity.Bookmark{}, nil)
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{Tags: []entity.Tag{}, Limit: 101}).Return([]entity.Bookmark{}, nil)
 			},
-			[]dto.Bookmark{},
+			&command.ListBookmarks{UserID: helper.UserID},
+			&dto.BookmarkPage{Bookmarks: []dto.Bookmark{}, NextPageToken: ""},
 			nil,
 		},
-		"failed at repository.FindAll": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindAll().Return(nil, errors.New("some error"))
+		"nil command": {
+			func(r *mock_repository.MockBookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark) {},
+			&command.ListBookmarks{Tags: []string{""}, UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"failed at repository.FindBySpec": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindBySpec(helper.ToUserID(t, helper.UserID), &repository.BookmarkSpec{Tags: []entity.Tag{}, Limit: 101}).Return(nil, errors.New("some error"))
 			},
+			&command.ListBookmarks{UserID: helper.UserID},
 			nil,
-			fmt.Errorf("failed at repository.FindAll: %w", errors.New("some error")),
+			fmt.Errorf("failed at repository.FindBySpec: %w", errors.New("some error")),
 		},
 	}
 	for name, tc := range cases {
@@ -168,14 +447,133 @@ func TestBookmark_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
 			service := mock_service.NewMockBookmark(ctrl)
 			tc.prepare(repository)
 			// given
-			usecase := NewBookmarkUsecase(repository, service)
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
 			// when
-			actualBookmarks, actualErr := usecase.List()
+			actualPage, actualErr := usecase.List(tc.cmd)
 			// then
-			assert.Exactly(t, tc.expectedBookmarks, actualBookmarks)
+			assert.Exactly(t, tc.expectedPage, actualPage)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Watch(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	changes := []repository.BookmarkChange{
+		{Type: repository.ChangeCreated, ID: *helper.ToID(t, "1"), UserID: *helper.ToUserID(t, helper.UserID), Bookmark: helper.ToBookmark(t, "1", "Example", "https://example.com"), ResumeToken: "1"},
+		{Type: repository.ChangeDeleted, ID: *helper.ToID(t, "1"), UserID: *helper.ToUserID(t, helper.UserID), Bookmark: nil, ResumeToken: "2"},
+	}
+	deliver := func(ctx context.Context, userID *entity.UserID, resumeToken string, handler func(repository.BookmarkChange) error) error {
+		for _, change := range changes {
+			if err := handler(change); err != nil {
+				return err
+			}
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	cases := map[string]struct {
+		prepare         func(*mock_repository.MockBookmarkWatcher)
+		ctx             context.Context
+		cmd             *command.WatchBookmarks
+		handlerErr      error
+		expectedChanges []dto.BookmarkChange
+		expectedErr     error
+	}{
+		"non-nil command": {
+			func(watcher *mock_repository.MockBookmarkWatcher) {
+				watcher.EXPECT().Watch(canceled, helper.ToUserID(t, helper.UserID), "1", gomock.Any()).DoAndReturn(deliver)
+			},
+			canceled,
+			&command.WatchBookmarks{ResumeToken: "1", UserID: helper.UserID},
+			nil,
+			[]dto.BookmarkChange{
+				{Type: "created", ID: "1", Bookmark: &dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{}}, ResumeToken: "1"},
+				{Type: "deleted", ID: "1", Bookmark: nil, ResumeToken: "2"},
+			},
+			context.Canceled,
+		},
+		"nil command": {
+			func(watcher *mock_repository.MockBookmarkWatcher) {},
+			canceled,
+			nil,
+			nil,
+			[]dto.BookmarkChange{},
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(watcher *mock_repository.MockBookmarkWatcher) {},
+			canceled,
+			&command.WatchBookmarks{ResumeToken: strings.Repeat("a", 1025), UserID: helper.UserID},
+			nil,
+			[]dto.BookmarkChange{},
+			&command.InvalidCommandError{Args: map[string]error{"ResumeToken": errors.New("too long: 1025")}},
+		},
+		"invalid resume token": {
+			func(watcher *mock_repository.MockBookmarkWatcher) {
+				watcher.EXPECT().Watch(canceled, helper.ToUserID(t, helper.UserID), "x", gomock.Any()).Return(repository.ErrInvalidResumeToken)
+			},
+			canceled,
+			&command.WatchBookmarks{ResumeToken: "x", UserID: helper.UserID},
+			nil,
+			[]dto.BookmarkChange{},
+			&command.InvalidCommandError{Args: map[string]error{"ResumeToken": repository.ErrInvalidResumeToken}},
+		},
+		"failed at handler": {
+			func(watcher *mock_repository.MockBookmarkWatcher) {
+				watcher.EXPECT().Watch(canceled, helper.ToUserID(t, helper.UserID), "", gomock.Any()).DoAndReturn(deliver)
+			},
+			canceled,
+			&command.WatchBookmarks{UserID: helper.UserID},
+			errors.New("some error"),
+			[]dto.BookmarkChange{
+				{Type: "created", ID: "1", Bookmark: &dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{}}, ResumeToken: "1"},
+			},
+			errors.New("some error"),
+		},
+		"failed at watcher.Watch": {
+			func(watcher *mock_repository.MockBookmarkWatcher) {
+				watcher.EXPECT().Watch(context.TODO(), helper.ToUserID(t, helper.UserID), "", gomock.Any()).Return(errors.New("some error"))
+			},
+			context.TODO(),
+			&command.WatchBookmarks{UserID: helper.UserID},
+			nil,
+			[]dto.BookmarkChange{},
+			fmt.Errorf("failed at watcher.Watch: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(watcher)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			actualChanges := []dto.BookmarkChange{}
+			// when
+			actualErr := usecase.Watch(tc.ctx, tc.cmd, func(change dto.BookmarkChange) error {
+				actualChanges = append(actualChanges, change)
+				return tc.handlerErr
+			})
+			// then
+			assert.Exactly(t, tc.expectedChanges, actualChanges)
 			assert.Exactly(t, tc.expectedErr, actualErr)
 		})
 	}
@@ -186,63 +584,230 @@ func TestBookmark_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	cases := map[string]struct {
-		prepare     func(*mock_repository.MockBookmark)
-		cmd         *command.UpdateBookmark
-		expectedErr error
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockRevision, *mock_repository.MockAudit, *mock_repository.MockShare)
+		cmd              *command.UpdateBookmark
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
 	}{
 		"non-nil command": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
-				repository.EXPECT().Save(helper.ToBookmark(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz")).Return(nil)
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"), "BookmarkRenamed", "BookmarkURIRewritten"), "alice").Return(nil)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Status: "unread", Tags: []string{"foo", "bar", "baz"}},
+			nil,
+		},
+		"command with update mask of name": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "EXAMPLE", "http://example.com", "foo", "bar", "baz"), "BookmarkRenamed"), helper.UserID).Return(nil)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", UpdateMask: []string{"Name"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "EXAMPLE", URI: "http://example.com", Status: "unread", Tags: []string{"foo", "bar", "baz"}},
+			nil,
+		},
+		"command with update mask of description": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToDescribedBookmark(t, "Example\nDomain", "1", "Example", "http://example.com", "foo"), "BookmarkDescribed"), helper.UserID).Return(nil)
+			},
+			&command.UpdateBookmark{ID: "1", Description: "Example\nDomain", UpdateMask: []string{"Description"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "http://example.com", Description: "Example\nDomain", Status: "unread", Tags: []string{"foo"}},
+			nil,
+		},
+		"command with update mask of tags": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "http://example.com", "qux", "foo"), "BookmarkTagged", "BookmarkUntagged"), helper.UserID).Return(nil)
+			},
+			&command.UpdateBookmark{ID: "1", Tags: []string{"qux", "foo", "qux"}, UpdateMask: []string{"Tags"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "http://example.com", Status: "unread", Tags: []string{"qux", "foo"}},
+			nil,
+		},
+		"command without changes": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo"), nil)
+				repository.EXPECT().Save(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo"), helper.UserID).Return(nil)
 			},
-			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com"},
+			&command.UpdateBookmark{ID: "1", Name: "Example", UpdateMask: []string{"Name"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "http://example.com", Status: "unread", Tags: []string{"foo"}},
 			nil,
 		},
 		"nil command": {
-			func(repository *mock_repository.MockBookmark) {},
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+			},
+			nil,
 			nil,
 			errors.New("argument \"cmd\" is nil"),
 		},
 		"invalid command": {
-			func(repository *mock_repository.MockBookmark) {},
-			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: ""},
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "", UserID: helper.UserID},
+			nil,
 			&command.InvalidCommandError{Args: map[string]error{"URI": helper.ToErrURI(t, "")}},
 		},
+		"command with unknown update mask": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", UpdateMask: []string{"foo"}, UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"UpdateMask": errors.New("unknown path: foo")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+					*helper.ToCollectionShare(t, "11", "bob", helper.UserID, "foo", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedBookmark(t, "bob", "1", "EXAMPLE", "https://example.com", "foo"), "BookmarkRenamed"), "alice").Return(nil)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", UserID: helper.UserID, UpdateMask: []string{"Name"}},
+			&dto.Bookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), nil)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", UserID: helper.UserID, UpdateMask: []string{"Name"}},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
 		"non-existent bookmark": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(nil, nil)
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
 			},
-			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com"},
-			errors.New("bookmark does not exist"),
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
 		},
 		"failed at repository.FindByID": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
 			},
-			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com"},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", UserID: helper.UserID},
+			nil,
 			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
 		},
 		"failed at repository.Save": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
-				repository.EXPECT().Save(helper.ToBookmark(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz")).Return(errors.New("some error"))
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"), "BookmarkRenamed", "BookmarkURIRewritten"), helper.UserID).Return(errors.New("some error"))
 			},
-			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com"},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", UserID: helper.UserID},
+			nil,
 			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
 		},
+		"duplicate at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+				r.EXPECT().Save(gomock.Any(), helper.UserID).Return(repository.ErrDuplicate)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
+		"command with different version": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 1, "1", "Example", "http://example.com", "foo", "bar", "baz"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 1, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"), "BookmarkRenamed", "BookmarkURIRewritten"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
 	}
 	for name, tc := range cases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
 			service := mock_service.NewMockBookmark(ctrl)
-			tc.prepare(repository)
+			service.EXPECT().Exists(gomock.Any()).Return(false, nil).AnyTimes()
+			tc.prepare(repository, revisionRepository, auditRepository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Update(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_UpdateURI(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare     func(*mock_repository.MockBookmark, *mock_service.MockBookmark)
+		cmd         *command.UpdateBookmark
+		expectedErr error
+	}{
+		"duplicate uri": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				s.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.org"), "BookmarkURIRewritten")).Return(true, nil)
+			},
+			&command.UpdateBookmark{ID: "1", URI: "https://example.org", UpdateMask: []string{"URI"}, UserID: helper.UserID},
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
+		"failed at service.Exists": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				s.EXPECT().Exists(gomock.Any()).Return(false, errors.New("some error"))
+			},
+			&command.UpdateBookmark{ID: "1", URI: "https://example.org", UpdateMask: []string{"URI"}, UserID: helper.UserID},
+			fmt.Errorf("failed at service.Exists: %w", errors.New("some error")),
+		},
+		"update without uri": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(gomock.Any(), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.org", UpdateMask: []string{"Name"}, UserID: helper.UserID},
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, service)
 			// given
-			usecase := NewBookmarkUsecase(repository, service)
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
 			// when
-			actualErr := usecase.Update(tc.cmd)
+			actualBookmark, actualErr := usecase.Update(tc.cmd)
 			// then
+			assert.Nil(t, actualBookmark)
 			assert.Exactly(t, tc.expectedErr, actualErr)
 		})
 	}
@@ -253,49 +818,90 @@ func TestBookmark_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	cases := map[string]struct {
-		prepare     func(*mock_repository.MockBookmark)
+		prepare     func(*mock_repository.MockBookmark, *mock_repository.MockAudit, *mock_repository.MockShare)
 		cmd         *command.DeleteBookmark
 		expectedErr error
 	}{
 		"non-nil command": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), nil)
-				repository.EXPECT().Delete(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz")).Return(nil)
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), nil)
+				repository.EXPECT().Trash(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkDeleted"), "alice").Return(nil)
 			},
-			&command.DeleteBookmark{ID: "1"},
+			&command.DeleteBookmark{ID: "1", UserID: helper.UserID},
 			nil,
 		},
 		"nil command": {
-			func(repository *mock_repository.MockBookmark) {},
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+			},
 			nil,
 			errors.New("argument \"cmd\" is nil"),
 		},
 		"invalid command": {
-			func(repository *mock_repository.MockBookmark) {},
-			&command.DeleteBookmark{ID: ""},
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+			},
+			&command.DeleteBookmark{ID: "", UserID: helper.UserID},
 			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
 		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToCollectionShare(t, "10", "bob", helper.UserID, "foo", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), nil)
+				repository.EXPECT().Trash(helper.ToBookmarkMatcher(t, helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), "BookmarkDeleted"), "alice").Return(nil)
+			},
+			&command.DeleteBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToCollectionShare(t, "10", "bob", helper.UserID, "foo", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), nil)
+			},
+			&command.DeleteBookmark{ID: "1", UserID: helper.UserID},
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
 		"non-existent bookmark": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(nil, nil)
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
 			},
-			&command.DeleteBookmark{ID: "1"},
-			errors.New("bookmark does not exist"),
+			&command.DeleteBookmark{ID: "1", UserID: helper.UserID},
+			&command.NotFoundError{Resource: "bookmark"},
 		},
 		"failed at repository.FindByID": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
 			},
-			&command.DeleteBookmark{ID: "1"},
+			&command.DeleteBookmark{ID: "1", UserID: helper.UserID},
 			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
 		},
-		"failed at repository.Delete": {
-			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), nil)
-				repository.EXPECT().Delete(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz")).Return(errors.New("some error"))
+		"failed at repository.Trash": {
+			func(repository *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), nil)
+				repository.EXPECT().Trash(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkDeleted"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.DeleteBookmark{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.Trash: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(r *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar", "baz"), nil)
+			},
+			&command.DeleteBookmark{ID: "1", Version: 1, UserID: helper.UserID},
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"conflict at repository.Trash": {
+			func(r *mock_repository.MockBookmark, auditRepository *mock_repository.MockAudit, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"), nil)
+				r.EXPECT().Trash(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkDeleted"), helper.UserID).Return(repository.ErrConflict)
 			},
-			&command.DeleteBookmark{ID: "1"},
-			fmt.Errorf("failed at repository.Delete: %w", errors.New("some error")),
+			&command.DeleteBookmark{ID: "1", Version: 1, UserID: helper.UserID},
+			&command.ConflictError{Resource: "bookmark"},
 		},
 	}
 	for name, tc := range cases {
@@ -303,10 +909,14 @@ func TestBookmark_Delete(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
 			service := mock_service.NewMockBookmark(ctrl)
-			tc.prepare(repository)
+			tc.prepare(repository, auditRepository, shareRepository)
 			// given
-			usecase := NewBookmarkUsecase(repository, service)
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
 			// when
 			actualErr := usecase.Delete(tc.cmd)
 			// then
@@ -314,3 +924,2537 @@ func TestBookmark_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestBookmark_ListTrash(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deletedAt := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		prepare           func(*mock_repository.MockBookmark)
+		cmd               *command.ListTrash
+		expectedBookmarks []dto.Bookmark
+		expectedErr       error
+	}{
+		"trashed bookmarks": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindTrash(helper.ToUserID(t, helper.UserID)).Return(
+					[]entity.Bookmark{
+						*helper.ToTrashedBookmark(t, 2, time.Time{}, time.Time{}, deletedAt, "2", "Example B", "https://bar.example.com"),
+						*helper.ToTrashedBookmark(t, 2, time.Time{}, time.Time{}, deletedAt, "1", "Example A", "https://foo.example.com", "foo"),
+					},
+					nil,
+				)
+			},
+			&command.ListTrash{UserID: helper.UserID},
+			[]dto.Bookmark{
+				{ID: "2", Name: "Example B", URI: "https://bar.example.com", Status: "unread", Tags: []string{}, Version: 2, DeletedAt: deletedAt},
+				{ID: "1", Name: "Example A", URI: "https://foo.example.com", Status: "unread", Tags: []string{"foo"}, Version: 2, DeletedAt: deletedAt},
+			},
+			nil,
+		},
+		"no trashed bookmarks": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindTrash(helper.ToUserID(t, helper.UserID)).Return([]entity.Bookmark{}, nil)
+			},
+			&command.ListTrash{UserID: helper.UserID},
+			[]dto.Bookmark{},
+			nil,
+		},
+		"failed at repository.FindTrash": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindTrash(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.ListTrash{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindTrash: %w", errors.New("some error")),
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark) {},
+			&command.ListTrash{},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmarks, actualErr := usecase.ListTrash(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmarks, actualBookmarks)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Restore(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deletedAt := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	trashed := func() *entity.Bookmark {
+		return helper.ToTrashedBookmark(t, 2, time.Time{}, time.Time{}, deletedAt, "1", "Example", "https://example.com", "foo")
+	}
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_service.MockBookmark)
+		cmd              *command.RestoreBookmark
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+				s.EXPECT().Exists(trashed()).Return(false, nil)
+				r.EXPECT().
+					Restore(helper.ToBookmarkMatcher(t, trashed(), "BookmarkRestored"), helper.UserID).
+					DoAndReturn(func(bookmark *entity.Bookmark, actor string) error {
+						bookmark.SetVersion(3)
+						bookmark.SetDeletedAt(time.Time{})
+						return nil
+					})
+			},
+			&command.RestoreBookmark{ID: "1", Version: 2, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}, Version: 3},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {},
+			&command.RestoreBookmark{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"non-existent bookmark": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindTrashByID": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindTrashByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+			},
+			&command.RestoreBookmark{ID: "1", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"duplicate bookmark": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+				s.EXPECT().Exists(trashed()).Return(true, nil)
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
+		"failed at service.Exists": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+				s.EXPECT().Exists(trashed()).Return(false, errors.New("some error"))
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at service.Exists: %w", errors.New("some error")),
+		},
+		"conflict at repository.Restore": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+				s.EXPECT().Exists(trashed()).Return(false, nil)
+				r.EXPECT().Restore(helper.ToBookmarkMatcher(t, trashed(), "BookmarkRestored"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"duplicate at repository.Restore": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+				s.EXPECT().Exists(trashed()).Return(false, nil)
+				r.EXPECT().Restore(helper.ToBookmarkMatcher(t, trashed(), "BookmarkRestored"), helper.UserID).Return(repository.ErrDuplicate)
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
+		"failed at repository.Restore": {
+			func(r *mock_repository.MockBookmark, s *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(trashed(), nil)
+				s.EXPECT().Exists(trashed()).Return(false, nil)
+				r.EXPECT().Restore(helper.ToBookmarkMatcher(t, trashed(), "BookmarkRestored"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.RestoreBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Restore: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, service)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Restore(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_PurgeTrash(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	olderThan := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		prepare       func(*mock_repository.MockBookmark)
+		cmd           *command.PurgeTrash
+		expectedCount int
+		expectedErr   error
+	}{
+		"non-nil command": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().PurgeTrash(helper.ToUserID(t, helper.UserID), olderThan, helper.UserID).Return([]entity.Bookmark{
+					*helper.ToBookmark(t, "1", "Example A", "https://example.com/a", "go"),
+					*helper.ToBookmark(t, "2", "Example B", "https://example.com/b", "go"),
+					*helper.ToBookmark(t, "3", "Example C", "https://example.com/c", "go"),
+				}, nil)
+			},
+			&command.PurgeTrash{OlderThan: olderThan, UserID: helper.UserID},
+			3,
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark) {},
+			nil,
+			0,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark) {},
+			&command.PurgeTrash{UserID: helper.UserID},
+			0,
+			&command.InvalidCommandError{Args: map[string]error{"OlderThan": errors.New("zero time")}},
+		},
+		"failed at repository.PurgeTrash": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().PurgeTrash(helper.ToUserID(t, helper.UserID), olderThan, helper.UserID).Return(nil, errors.New("some error"))
+			},
+			&command.PurgeTrash{OlderThan: olderThan, UserID: helper.UserID},
+			0,
+			fmt.Errorf("failed at repository.PurgeTrash: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualCount, actualErr := usecase.PurgeTrash(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedCount, actualCount)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MarkRead(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.MarkRead
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "read", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.MarkRead{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusRead, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "read", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.MarkRead{ID: "1", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.MarkRead{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.MarkRead(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MarkUnread(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.MarkUnread
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.MarkUnread{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.MarkUnread{ID: "1", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.MarkUnread{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.MarkUnread(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Archive(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.Archive
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "archived", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.Archive{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusRead, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusArchived, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "archived", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusRead, false, "1", "Example", "https://example.com"), nil)
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.Archive{ID: "1", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusArchived, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.Archive{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Archive(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Star(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.Star
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, true, "1", "Example", "https://example.com"), "BookmarkStarred"), helper.UserID).Return(nil)
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: true, Tags: []string{}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.Star{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, true, "1", "Example", "https://example.com"), "BookmarkStarred"), helper.UserID).Return(nil)
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: true, Tags: []string{}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, false, "1", "Example", "https://example.com"), nil)
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.Star{ID: "1", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, true, "1", "Example", "https://example.com"), "BookmarkStarred"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, true, "1", "Example", "https://example.com"), "BookmarkStarred"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.Star{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Star(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Unstar(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.Unstar
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusUnread, true, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkUnstarred"), helper.UserID).Return(nil)
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.Unstar{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, true, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkUnstarred"), helper.UserID).Return(nil)
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: false, Tags: []string{}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedMarkedBookmark(t, "bob", entity.StatusUnread, true, "1", "Example", "https://example.com"), nil)
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.Unstar{ID: "1", Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusUnread, true, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkUnstarred"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToMarkedBookmark(t, entity.StatusUnread, true, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusUnread, false, "1", "Example", "https://example.com"), "BookmarkUnstarred"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.Unstar{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Unstar(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListRevisions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		prepare           func(*mock_repository.MockBookmark, *mock_repository.MockRevision, *mock_repository.MockShare)
+		cmd               *command.ListBookmarkRevisions
+		expectedRevisions []dto.Revision
+		expectedErr       error
+	}{
+		"bookmark with revisions": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example Domain", "https://example.com", "foo"), nil)
+				revisionRepository.EXPECT().FindByBookmarkID(helper.ToID(t, "1")).Return(
+					[]entity.Revision{
+						*helper.ToTimestampedRevision(
+							t, now, "100", "1", 2, "alice",
+							helper.ToSnapshot(t, "Example", "https://example.com", "foo"),
+							helper.ToSnapshot(t, "Example Domain", "https://example.com", "foo"),
+						),
+					},
+					nil,
+				)
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			[]dto.Revision{
+				{
+					ID:         "100",
+					BookmarkID: "1",
+					Version:    2,
+					Actor:      "alice",
+					Before:     dto.Snapshot{Name: "Example", URI: "https://example.com", Tags: []string{"foo"}},
+					After:      dto.Snapshot{Name: "Example Domain", URI: "https://example.com", Tags: []string{"foo"}},
+					CreatedAt:  now,
+				},
+			},
+			nil,
+		},
+		"bookmark without revisions": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example Domain", "https://example.com", "foo"), nil)
+				revisionRepository.EXPECT().FindByBookmarkID(helper.ToID(t, "1")).Return([]entity.Revision{}, nil)
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			[]dto.Revision{},
+			nil,
+		},
+		"trashed bookmark": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				revisionRepository.EXPECT().FindByBookmarkID(helper.ToID(t, "1")).Return([]entity.Revision{}, nil)
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			[]dto.Revision{},
+			nil,
+		},
+		"bookmark shared with editor role": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com"), nil)
+				revisionRepository.EXPECT().FindByBookmarkID(helper.ToID(t, "1")).Return([]entity.Revision{}, nil)
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			[]dto.Revision{},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com"), nil)
+				revisionRepository.EXPECT().FindByBookmarkID(helper.ToID(t, "1")).Return([]entity.Revision{}, nil)
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			[]dto.Revision{},
+			nil,
+		},
+		"failed at shareRepository.FindByGrantee": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at shareRepository.FindByGrantee: %w", errors.New("some error")),
+		},
+		"non-existing bookmark": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+			},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+			},
+			&command.ListBookmarkRevisions{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at repository.FindTrashByID": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindTrashByID: %w", errors.New("some error")),
+		},
+		"failed at revisionRepository.FindByBookmarkID": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example Domain", "https://example.com", "foo"), nil)
+				revisionRepository.EXPECT().FindByBookmarkID(helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.ListBookmarkRevisions{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at revisionRepository.FindByBookmarkID: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, revisionRepository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualRevisions, actualErr := usecase.ListRevisions(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedRevisions, actualRevisions)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Revert(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	revision := func() *entity.Revision {
+		return helper.ToRevision(
+			t, "100", "1", 2, "alice",
+			helper.ToSnapshot(t, "Example", "https://example.com", "foo"),
+			helper.ToSnapshot(t, "Example Domain", "https://example.org", "foo", "bar"),
+		)
+	}
+	moved := func(folder string, bookmark *entity.Bookmark) *entity.Bookmark {
+		bookmark.MoveTo(helper.ToID(t, folder))
+		bookmark.PullEvents()
+		return bookmark
+	}
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockRevision, *mock_repository.MockShare, *mock_service.MockBookmark)
+		cmd              *command.RevertBookmark
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar", "baz"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().
+					Save(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkUntagged"), "alice").
+					DoAndReturn(func(bookmark *entity.Bookmark, actor string) error {
+						bookmark.SetVersion(4)
+						return nil
+					})
+			},
+			&command.RevertBookmark{RevisionID: "100", Version: 3, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}, Version: 4},
+			nil,
+		},
+		"revision of a move": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(helper.ToRevision(
+					t, "100", "1", 2, "alice",
+					helper.ToStatefulSnapshot(t, helper.ToID(t, "10"), entity.StatusUnread, false, false, "Example", "https://example.com", "foo"),
+					helper.ToStatefulSnapshot(t, helper.ToID(t, "20"), entity.StatusUnread, false, false, "Example", "https://example.com", "foo"),
+				), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(moved("20", helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo")), nil)
+				r.EXPECT().
+					Save(helper.ToBookmarkMatcher(t, moved("10", helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo")), "BookmarkMoved"), helper.UserID).
+					DoAndReturn(func(bookmark *entity.Bookmark, actor string) error {
+						bookmark.SetVersion(4)
+						return nil
+					})
+			},
+			&command.RevertBookmark{RevisionID: "100", Version: 3, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", FolderID: "10", Status: "unread", Tags: []string{"foo"}, Version: 4},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+			},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+			},
+			&command.RevertBookmark{RevisionID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"RevisionID": helper.ToErrID(t, "")}},
+		},
+		"non-existent revision": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(nil, nil)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "revision"},
+		},
+		"failed at revisionRepository.FindByID": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(nil, errors.New("some error"))
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at revisionRepository.FindByID: %w", errors.New("some error")),
+		},
+		"bookmark shared with editor role": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkUntagged"), helper.UserID).Return(nil)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+			},
+			&command.RevertBookmark{RevisionID: "100", Version: 2, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkUntagged"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkUntagged"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"duplicate uri": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+				service.EXPECT().Exists(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkUntagged")).Return(true, nil)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
+		"failed at service.Exists": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, errors.New("some error"))
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at service.Exists: %w", errors.New("some error")),
+		},
+		"duplicate at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.org", "foo", "bar"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Save(gomock.Any(), helper.UserID).Return(repository.ErrDuplicate)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.AlreadyExistsError{Resource: "bookmark"},
+		},
+		"revision without uri change": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare, service *mock_service.MockBookmark) {
+				revisionRepository.EXPECT().FindByID(helper.ToID(t, "100")).Return(revision(), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 3, "1", "Example Domain", "https://example.com/", "foo", "bar"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 3, "1", "Example", "https://example.com", "foo"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkUntagged"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.RevertBookmark{RevisionID: "100", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, revisionRepository, shareRepository, service)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.Revert(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_AddTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockRevision, *mock_repository.MockShare)
+		cmd              *command.AddTags
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkTagged"), "alice").Return(nil)
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz", "baz"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo", "bar", "baz"}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+			},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+			},
+			&command.AddTags{ID: "1", Tags: []string{""}, UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo", "bar"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkTagged"), helper.UserID).Return(nil)
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo", "bar", "baz"}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz"}, UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz", "baz"}, UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz", "baz"}, UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz", "baz"}, Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkTagged"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz", "baz"}, UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "BookmarkTagged"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.AddTags{ID: "1", Tags: []string{"bar", "baz", "baz"}, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, revisionRepository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.AddTags(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RemoveTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockRevision, *mock_repository.MockShare)
+		cmd              *command.RemoveTags
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "bar"), "BookmarkUntagged"), "alice").Return(nil)
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo", "qux"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"bar"}},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+			},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{""}, UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}},
+		},
+		"bookmark shared with editor role": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleEditor),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo", "bar"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "bar"), "BookmarkUntagged"), helper.UserID).Return(nil)
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"bar"}},
+			nil,
+		},
+		"bookmark shared with viewer role": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo"}, UserID: helper.UserID},
+			nil,
+			&command.PermissionDeniedError{Resource: "bookmark"},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo", "qux"}, UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo", "qux"}, UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"command with different version": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 2, "1", "Example", "https://example.com", "foo", "bar"), nil)
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo", "qux"}, Version: 1, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(repository *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), nil)
+				repository.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "bar"), "BookmarkUntagged"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.RemoveTags{ID: "1", Tags: []string{"foo", "qux"}, UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, revisionRepository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.RemoveTags(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare           func(*mock_repository.MockBookmark)
+		cmd               *command.ListTags
+		expectedTagCounts []dto.TagCount
+		expectedErr       error
+	}{
+		"2 tags": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().CountTags(helper.ToUserID(t, helper.UserID)).Return(
+					[]repository.TagCount{
+						{Tag: helper.ToTags(t, "bar")[0], Count: 2},
+						{Tag: helper.ToTags(t, "foo")[0], Count: 3},
+					},
+					nil,
+				)
+			},
+			&command.ListTags{UserID: helper.UserID},
+			[]dto.TagCount{
+				{Name: "bar", Count: 2},
+				{Name: "foo", Count: 3},
+			},
+			nil,
+		},
+		"no tags": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().CountTags(helper.ToUserID(t, helper.UserID)).Return([]repository.TagCount{}, nil)
+			},
+			&command.ListTags{UserID: helper.UserID},
+			[]dto.TagCount{},
+			nil,
+		},
+		"failed at repository.CountTags": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().CountTags(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.ListTags{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.CountTags: %w", errors.New("some error")),
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark) {},
+			&command.ListTags{},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualTagCounts, actualErr := usecase.ListTags(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedTagCounts, actualTagCounts)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RenameTag(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare       func(*mock_repository.MockBookmark)
+		cmd           *command.RenameTag
+		expectedCount int
+		expectedErr   error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark) {
+				repository.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0], helper.UserID).Return([]entity.Bookmark{
+					*helper.ToBookmark(t, "1", "Example A", "https://example.com/a", "go"),
+					*helper.ToBookmark(t, "2", "Example B", "https://example.com/b", "go"),
+					*helper.ToBookmark(t, "3", "Example C", "https://example.com/c", "go"),
+				}, nil)
+			},
+			&command.RenameTag{From: "golang", To: "go", UserID: helper.UserID},
+			3,
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark) {},
+			nil,
+			0,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark) {},
+			&command.RenameTag{From: "golang", To: "", UserID: helper.UserID},
+			0,
+			&command.InvalidCommandError{Args: map[string]error{"To": helper.ToErrTag(t, "")}},
+		},
+		"failed at repository.MergeTags": {
+			func(repository *mock_repository.MockBookmark) {
+				repository.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0], helper.UserID).Return(nil, errors.New("some error"))
+			},
+			&command.RenameTag{From: "golang", To: "go", UserID: helper.UserID},
+			0,
+			fmt.Errorf("failed at repository.MergeTags: %w", errors.New("some error")),
+		},
+		"conflict at repository.MergeTags": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0], helper.UserID).Return(nil, repository.ErrConflict)
+			},
+			&command.RenameTag{From: "golang", To: "go", UserID: helper.UserID},
+			0,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualCount, actualErr := usecase.RenameTag(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedCount, actualCount)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MergeTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare       func(*mock_repository.MockBookmark)
+		cmd           *command.MergeTags
+		expectedCount int
+		expectedErr   error
+	}{
+		"non-nil command": {
+			func(repository *mock_repository.MockBookmark) {
+				repository.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang", "go-lang"), &helper.ToTags(t, "go")[0], helper.UserID).Return([]entity.Bookmark{
+					*helper.ToBookmark(t, "1", "Example A", "https://example.com/a", "go"),
+					*helper.ToBookmark(t, "2", "Example B", "https://example.com/b", "go"),
+					*helper.ToBookmark(t, "3", "Example C", "https://example.com/c", "go"),
+				}, nil)
+			},
+			&command.MergeTags{Sources: []string{"golang", "go-lang"}, Target: "go", UserID: helper.UserID},
+			3,
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark) {},
+			nil,
+			0,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark) {},
+			&command.MergeTags{Sources: []string{}, Target: "go", UserID: helper.UserID},
+			0,
+			&command.InvalidCommandError{Args: map[string]error{"Sources": errors.New("no tags")}},
+		},
+		"failed at repository.MergeTags": {
+			func(repository *mock_repository.MockBookmark) {
+				repository.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang", "go-lang"), &helper.ToTags(t, "go")[0], helper.UserID).Return(nil, errors.New("some error"))
+			},
+			&command.MergeTags{Sources: []string{"golang", "go-lang"}, Target: "go", UserID: helper.UserID},
+			0,
+			fmt.Errorf("failed at repository.MergeTags: %w", errors.New("some error")),
+		},
+		"conflict at repository.MergeTags": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang", "go-lang"), &helper.ToTags(t, "go")[0], helper.UserID).Return(nil, repository.ErrConflict)
+			},
+			&command.MergeTags{Sources: []string{"golang", "go-lang"}, Target: "go", UserID: helper.UserID},
+			0,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualCount, actualErr := usecase.MergeTags(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedCount, actualCount)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_FindDuplicates(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare            func(*mock_repository.MockBookmark)
+		cmd                *command.FindDuplicates
+		expectedDuplicates []dto.Duplicate
+		expectedErr        error
+	}{
+		"duplicated bookmarks": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindDuplicates(helper.ToUserID(t, helper.UserID)).Return([]repository.Duplicate{
+					{
+						CanonicalURI: "https://example.com/",
+						Bookmarks: []entity.Bookmark{
+							*helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"),
+							*helper.ToBookmark(t, "2", "Example B", "https://example.com/#bar"),
+						},
+					},
+				}, nil)
+			},
+			&command.FindDuplicates{UserID: helper.UserID},
+			[]dto.Duplicate{
+				{
+					CanonicalURI: "https://example.com/",
+					Bookmarks: []dto.Bookmark{
+						{ID: "1", Name: "Example A", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}},
+						{ID: "2", Name: "Example B", URI: "https://example.com/#bar", Status: "unread", Tags: []string{}},
+					},
+				},
+			},
+			nil,
+		},
+		"no duplicated bookmarks": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindDuplicates(helper.ToUserID(t, helper.UserID)).Return([]repository.Duplicate{}, nil)
+			},
+			&command.FindDuplicates{UserID: helper.UserID},
+			[]dto.Duplicate{},
+			nil,
+		},
+		"failed at repository.FindDuplicates": {
+			func(r *mock_repository.MockBookmark) {
+				r.EXPECT().FindDuplicates(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.FindDuplicates{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindDuplicates: %w", errors.New("some error")),
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark) {},
+			&command.FindDuplicates{},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualDuplicates, actualErr := usecase.FindDuplicates(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedDuplicates, actualDuplicates)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MergeBookmarks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockRevision)
+		cmd              *command.MergeBookmarks
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"non-nil command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToVersionedBookmark(t, 1, "1", "Example A", "https://example.com", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToVersionedBookmark(t, 1, "2", "Example B", "https://example.com/", "bar", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "3")).Return(helper.ToVersionedBookmark(t, 2, "3", "Example C", "https://example.com/#baz", "baz"), nil)
+				r.EXPECT().
+					MergeBookmarks(
+						helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 1, "1", "Example A", "https://example.com", "foo", "bar", "baz"), "BookmarkTagged", "BookmarkTagged"),
+						helper.ToBookmarksMatcher(
+							t,
+							helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 1, "2", "Example B", "https://example.com/", "bar", "foo"), "BookmarkPurged"),
+							helper.ToBookmarkMatcher(t, helper.ToVersionedBookmark(t, 2, "3", "Example C", "https://example.com/#baz", "baz"), "BookmarkPurged"),
+						),
+						"alice",
+					).
+					DoAndReturn(func(target *entity.Bookmark, sources []entity.Bookmark, actor string) error {
+						target.SetVersion(2)
+						return nil
+					})
+			},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2", "3"}, UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example A", URI: "https://example.com", Status: "unread", Tags: []string{"foo", "bar", "baz"}, Version: 2},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{}, UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"SourceIDs": errors.New("no IDs")}},
+		},
+		"non-existent target": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"non-existent source": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, nil)
+			},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, errors.New("some error"))
+			},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"conflict at repository.MergeBookmarks": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToBookmark(t, "2", "Example B", "https://example.com/", "bar"), nil)
+				r.EXPECT().MergeBookmarks(gomock.Any(), gomock.Any(), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.MergeBookmarks": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToBookmark(t, "2", "Example B", "https://example.com/", "bar"), nil)
+				r.EXPECT().MergeBookmarks(gomock.Any(), gomock.Any(), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.MergeBookmarks: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, revisionRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmark, actualErr := usecase.MergeBookmarks(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_PublishEvents(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare        func(*mock_repository.MockBookmark, *mock_repository.MockRevision, *mock_repository.MockAudit, *mock_service.MockBookmark)
+		execute        func(Bookmark) error
+		expectedEvents []string
+	}{
+		"register": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), "BookmarkRegistered"), helper.UserID).Return(nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo"}, UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkRegistered:1"},
+		},
+		"update": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "EXAMPLE", "http://example.com", "foo", "bar"), "BookmarkRenamed", "BookmarkURIRewritten", "BookmarkTagged"), helper.UserID).Return(nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "http://example.com", Tags: []string{"foo", "bar"}, UpdateMask: []string{"Name", "URI", "Tags"}, UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkRenamed:1", "BookmarkURIRewritten:1", "BookmarkTagged:1"},
+		},
+		"add tags": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar"), "BookmarkTagged"), helper.UserID).Return(nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.AddTags(&command.AddTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkTagged:1"},
+		},
+		"delete": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Trash(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com"), "BookmarkDeleted"), helper.UserID).Return(nil)
+			},
+			func(u Bookmark) error {
+				return u.Delete(&command.DeleteBookmark{ID: "1", UserID: helper.UserID})
+			},
+			[]string{"BookmarkDeleted:1"},
+		},
+		"merge bookmarks": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToBookmark(t, "2", "Example B", "https://example.com/", "bar"), nil)
+				r.EXPECT().
+					MergeBookmarks(
+						helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo", "bar"), "BookmarkTagged"),
+						helper.ToBookmarksMatcher(t, helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "2", "Example B", "https://example.com/", "bar"), "BookmarkPurged")),
+						helper.UserID,
+					).
+					Return(nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.MergeBookmarks(&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkTagged:1", "BookmarkPurged:2"},
+		},
+		"status change": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToMarkedBookmark(t, entity.StatusRead, false, "1", "Example", "https://example.com"), "BookmarkStatusChanged"), helper.UserID).Return(nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.MarkRead(&command.MarkRead{ID: "1", UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkStatusChanged:1"},
+		},
+		"restore": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindTrashByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				service.EXPECT().Exists(gomock.Any()).Return(false, nil)
+				r.EXPECT().Restore(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com"), "BookmarkRestored"), helper.UserID).Return(nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.Restore(&command.RestoreBookmark{ID: "1", UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkRestored:1"},
+		},
+		"purge trash": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				purged := helper.ToBookmark(t, "1", "Example", "https://example.com")
+				purged.Purge()
+				r.EXPECT().PurgeTrash(helper.ToUserID(t, helper.UserID), gomock.Any(), helper.UserID).Return([]entity.Bookmark{*purged}, nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.PurgeTrash(&command.PurgeTrash{OlderThan: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkPurged:1"},
+		},
+		"merge tags": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				merged := helper.ToBookmark(t, "1", "Example", "https://example.com", "golang")
+				merged.MergeTags(helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0])
+				r.EXPECT().MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0], helper.UserID).Return([]entity.Bookmark{*merged}, nil)
+			},
+			func(u Bookmark) error {
+				_, err := u.RenameTag(&command.RenameTag{From: "golang", To: "go", UserID: helper.UserID})
+				return err
+			},
+			[]string{"BookmarkTagged:1", "BookmarkUntagged:1"},
+		},
+		"failed at repository.Save": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), nil)
+				r.EXPECT().Save(gomock.Any(), helper.UserID).Return(errors.New("some error"))
+			},
+			func(u Bookmark) error {
+				_, err := u.AddTags(&command.AddTags{ID: "1", Tags: []string{"bar"}, UserID: helper.UserID})
+				if err == nil {
+					return errors.New("expected error")
+				}
+				return nil
+			},
+			[]string{},
+		},
+		"failed at repository.Trash": {
+			func(r *mock_repository.MockBookmark, revisionRepository *mock_repository.MockRevision, auditRepository *mock_repository.MockAudit, service *mock_service.MockBookmark) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				r.EXPECT().Trash(gomock.Any(), helper.UserID).Return(repository.ErrConflict)
+			},
+			func(u Bookmark) error {
+				if err := u.Delete(&command.DeleteBookmark{ID: "1", UserID: helper.UserID}); err == nil {
+					return errors.New("expected error")
+				}
+				return nil
+			},
+			[]string{},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, revisionRepository, auditRepository, service)
+			// given
+			dispatcher := event.NewDispatcher()
+			actualEvents := []string{}
+			dispatcher.Subscribe(func(e entity.Event) {
+				id := e.BookmarkID()
+				actualEvents = append(actualEvents, fmt.Sprintf("%s:%s", e.EventName(), id.Value()))
+			})
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, dispatcher, entity.DefaultURIPolicy())
+			// when
+			err := tc.execute(usecase)
+			// then
+			assert.NoError(t, err)
+			assert.Exactly(t, tc.expectedEvents, actualEvents)
+		})
+	}
+}
+
+func TestBookmark_ListAuditEntries(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	earlier := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		prepare         func(*mock_repository.MockAudit)
+		cmd             *command.ListAuditEntries
+		expectedEntries []dto.AuditEntry
+		expectedErr     error
+	}{
+		"command with all conditions": {
+			func(auditRepository *mock_repository.MockAudit) {
+				auditRepository.EXPECT().
+					FindBySpec(&repository.AuditSpec{BookmarkID: helper.ToID(t, "1"), UserID: helper.ToUserID(t, helper.UserID), Actor: "alice", Since: earlier, Until: now}).
+					Return(
+						[]entity.AuditEntry{
+							*helper.ToTimestampedAuditEntry(
+								t, earlier, "100", "alice", entity.AuditOperationDelete, "1", helper.UserID,
+								helper.ToSnapshot(t, "Example", "https://example.com", "foo"), nil,
+							),
+						},
+						nil,
+					)
+			},
+			&command.ListAuditEntries{BookmarkID: "1", Actor: "alice", Since: earlier, Until: now, UserID: helper.UserID},
+			[]dto.AuditEntry{
+				{
+					ID:         "100",
+					Actor:      "alice",
+					Operation:  "delete",
+					BookmarkID: "1",
+					Before:     &dto.Snapshot{Name: "Example", URI: "https://example.com", Tags: []string{"foo"}},
+					CreatedAt:  earlier,
+				},
+			},
+			nil,
+		},
+		"command without conditions": {
+			func(auditRepository *mock_repository.MockAudit) {
+				auditRepository.EXPECT().FindBySpec(&repository.AuditSpec{UserID: helper.ToUserID(t, helper.UserID)}).Return([]entity.AuditEntry{}, nil)
+			},
+			&command.ListAuditEntries{UserID: helper.UserID},
+			[]dto.AuditEntry{},
+			nil,
+		},
+		"nil command": {
+			func(auditRepository *mock_repository.MockAudit) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(auditRepository *mock_repository.MockAudit) {},
+			&command.ListAuditEntries{BookmarkID: " ", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"BookmarkID": helper.ToErrID(t, " ")}},
+		},
+		"failed at auditRepository.FindBySpec": {
+			func(auditRepository *mock_repository.MockAudit) {
+				auditRepository.EXPECT().FindBySpec(&repository.AuditSpec{UserID: helper.ToUserID(t, helper.UserID)}).Return(nil, errors.New("some error"))
+			},
+			&command.ListAuditEntries{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at auditRepository.FindBySpec: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(auditRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualEntries, actualErr := usecase.ListAuditEntries(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedEntries, actualEntries)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Share(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare       func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd           *command.ShareBookmark
+		expectedShare *dto.Share
+		expectedErr   error
+	}{
+		"bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				shareRepository.EXPECT().NextID().Return(helper.ToID(t, "10"))
+				shareRepository.EXPECT().FindByOwner(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+				shareRepository.EXPECT().Save(helper.ToBookmarkShare(t, "10", helper.UserID, "bob", "1", entity.ShareRoleViewer)).Return(nil)
+			},
+			&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "viewer", UserID: helper.UserID},
+			&dto.Share{ID: "10", Owner: helper.UserID, Grantee: "bob", BookmarkID: "1", Role: "viewer"},
+			nil,
+		},
+		"collection": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().NextID().Return(helper.ToID(t, "10"))
+				shareRepository.EXPECT().FindByOwner(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "9", helper.UserID, "bob", "1", entity.ShareRoleViewer),
+				}, nil)
+				shareRepository.EXPECT().Save(helper.ToCollectionShare(t, "10", helper.UserID, "bob", "foo", entity.ShareRoleEditor)).Return(nil)
+			},
+			&command.ShareBookmark{Tag: "foo", Grantee: "bob", Role: "editor", UserID: helper.UserID},
+			&dto.Share{ID: "10", Owner: helper.UserID, Grantee: "bob", Tag: "foo", Role: "editor"},
+			nil,
+		},
+		"already shared target": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().NextID().Return(helper.ToID(t, "10"))
+				shareRepository.EXPECT().FindByOwner(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToCollectionShare(t, "9", helper.UserID, "bob", "foo", entity.ShareRoleViewer),
+				}, nil)
+				shareRepository.EXPECT().Save(helper.ToCollectionShare(t, "9", helper.UserID, "bob", "foo", entity.ShareRoleEditor)).Return(nil)
+			},
+			&command.ShareBookmark{Tag: "foo", Grantee: "bob", Role: "editor", UserID: helper.UserID},
+			&dto.Share{ID: "9", Owner: helper.UserID, Grantee: "bob", Tag: "foo", Role: "editor"},
+			nil,
+		},
+		"nil command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "owner", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Role": errors.New("unknown role: owner")}},
+		},
+		"non-existent bookmark": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "viewer", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "viewer", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at shareRepository.FindByOwner": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().NextID().Return(helper.ToID(t, "10"))
+				shareRepository.EXPECT().FindByOwner(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.ShareBookmark{Tag: "foo", Grantee: "bob", Role: "viewer", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at shareRepository.FindByOwner: %w", errors.New("some error")),
+		},
+		"failed at shareRepository.Save": {
+			func(repository *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().NextID().Return(helper.ToID(t, "10"))
+				shareRepository.EXPECT().FindByOwner(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+				shareRepository.EXPECT().Save(helper.ToCollectionShare(t, "10", helper.UserID, "bob", "foo", entity.ShareRoleViewer)).Return(errors.New("some error"))
+			},
+			&command.ShareBookmark{Tag: "foo", Grantee: "bob", Role: "viewer", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at shareRepository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualShare, actualErr := usecase.Share(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedShare, actualShare)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RevokeShare(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare     func(*mock_repository.MockShare)
+		cmd         *command.RevokeShare
+		expectedErr error
+	}{
+		"revoked by owner": {
+			func(shareRepository *mock_repository.MockShare) {
+				share := helper.ToBookmarkShare(t, "10", helper.UserID, "bob", "1", entity.ShareRoleViewer)
+				shareRepository.EXPECT().FindByID(helper.ToID(t, "10")).Return(share, nil)
+				shareRepository.EXPECT().Delete(share).Return(nil)
+			},
+			&command.RevokeShare{ID: "10", UserID: helper.UserID},
+			nil,
+		},
+		"revoked by grantee": {
+			func(shareRepository *mock_repository.MockShare) {
+				share := helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer)
+				shareRepository.EXPECT().FindByID(helper.ToID(t, "10")).Return(share, nil)
+				shareRepository.EXPECT().Delete(share).Return(nil)
+			},
+			&command.RevokeShare{ID: "10", UserID: helper.UserID},
+			nil,
+		},
+		"share of other users": {
+			func(shareRepository *mock_repository.MockShare) {
+				share := helper.ToBookmarkShare(t, "10", "bob", "carol", "1", entity.ShareRoleViewer)
+				shareRepository.EXPECT().FindByID(helper.ToID(t, "10")).Return(share, nil)
+			},
+			&command.RevokeShare{ID: "10", UserID: helper.UserID},
+			&command.NotFoundError{Resource: "share"},
+		},
+		"nil command": {
+			func(shareRepository *mock_repository.MockShare) {},
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(shareRepository *mock_repository.MockShare) {},
+			&command.RevokeShare{ID: " ", UserID: helper.UserID},
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, " ")}},
+		},
+		"non-existent share": {
+			func(shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByID(helper.ToID(t, "10")).Return(nil, nil)
+			},
+			&command.RevokeShare{ID: "10", UserID: helper.UserID},
+			&command.NotFoundError{Resource: "share"},
+		},
+		"failed at shareRepository.FindByID": {
+			func(shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByID(helper.ToID(t, "10")).Return(nil, errors.New("some error"))
+			},
+			&command.RevokeShare{ID: "10", UserID: helper.UserID},
+			fmt.Errorf("failed at shareRepository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at shareRepository.Delete": {
+			func(shareRepository *mock_repository.MockShare) {
+				share := helper.ToBookmarkShare(t, "10", helper.UserID, "bob", "1", entity.ShareRoleViewer)
+				shareRepository.EXPECT().FindByID(helper.ToID(t, "10")).Return(share, nil)
+				shareRepository.EXPECT().Delete(share).Return(errors.New("some error"))
+			},
+			&command.RevokeShare{ID: "10", UserID: helper.UserID},
+			fmt.Errorf("failed at shareRepository.Delete: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualErr := usecase.RevokeShare(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListSharedWithMe(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockBookmark, *mock_repository.MockShare)
+		cmd              *command.ListSharedWithMe
+		expectedBookmark []dto.SharedBookmark
+		expectedErr      error
+	}{
+		"shared bookmarks and collections": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "2", entity.ShareRoleViewer),
+					*helper.ToCollectionShare(t, "11", "bob", helper.UserID, "foo", entity.ShareRoleEditor),
+					*helper.ToBookmarkShare(t, "12", "carol", helper.UserID, "3", entity.ShareRoleViewer),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "2")).Return(helper.ToOwnedBookmark(t, "bob", "2", "Example B", "https://b.example.com", "foo"), nil)
+				r.EXPECT().FindBySpec(helper.ToUserID(t, "bob"), &repository.BookmarkSpec{Tags: helper.ToTags(t, "foo")}).Return([]entity.Bookmark{
+					*helper.ToOwnedBookmark(t, "bob", "1", "Example A", "https://a.example.com", "foo"),
+					*helper.ToOwnedBookmark(t, "bob", "2", "Example B", "https://b.example.com", "foo"),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "carol"), helper.ToID(t, "3")).Return(nil, nil)
+			},
+			&command.ListSharedWithMe{UserID: helper.UserID},
+			[]dto.SharedBookmark{
+				{
+					Bookmark: dto.Bookmark{ID: "1", Name: "Example A", URI: "https://a.example.com", Status: "unread", Tags: []string{"foo"}},
+					Owner:    "bob",
+					Role:     "editor",
+				},
+				{
+					Bookmark: dto.Bookmark{ID: "2", Name: "Example B", URI: "https://b.example.com", Status: "unread", Tags: []string{"foo"}},
+					Owner:    "bob",
+					Role:     "editor",
+				},
+			},
+			nil,
+		},
+		"no shares": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{}, nil)
+			},
+			&command.ListSharedWithMe{UserID: helper.UserID},
+			[]dto.SharedBookmark{},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {},
+			&command.ListSharedWithMe{UserID: ""},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+		"failed at shareRepository.FindByGrantee": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.ListSharedWithMe{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at shareRepository.FindByGrantee: %w", errors.New("some error")),
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToBookmarkShare(t, "10", "bob", helper.UserID, "1", entity.ShareRoleViewer),
+				}, nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, "bob"), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.ListSharedWithMe{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at repository.FindBySpec": {
+			func(r *mock_repository.MockBookmark, shareRepository *mock_repository.MockShare) {
+				shareRepository.EXPECT().FindByGrantee(helper.ToUserID(t, helper.UserID)).Return([]entity.Share{
+					*helper.ToCollectionShare(t, "10", "bob", helper.UserID, "foo", entity.ShareRoleViewer),
+				}, nil)
+				r.EXPECT().FindBySpec(helper.ToUserID(t, "bob"), &repository.BookmarkSpec{Tags: helper.ToTags(t, "foo")}).Return(nil, errors.New("some error"))
+			},
+			&command.ListSharedWithMe{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindBySpec: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockBookmark(ctrl)
+			revisionRepository := mock_repository.NewMockRevision(ctrl)
+			auditRepository := mock_repository.NewMockAudit(ctrl)
+			shareRepository := mock_repository.NewMockShare(ctrl)
+			watcher := mock_repository.NewMockBookmarkWatcher(ctrl)
+			service := mock_service.NewMockBookmark(ctrl)
+			tc.prepare(repository, shareRepository)
+			// given
+			usecase := NewBookmarkUsecase(repository, revisionRepository, auditRepository, shareRepository, watcher, service, event.NewDispatcher(), entity.DefaultURIPolicy())
+			// when
+			actualBookmarks, actualErr := usecase.ListSharedWithMe(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmarks)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
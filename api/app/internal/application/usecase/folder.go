@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/application/event"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/internal/domain/service"
+)
+
+// フォルダに関するユースケースのインターフェース。
+type Folder interface {
+	// フォルダを作成する。
+	Create(*command.CreateFolder) (*dto.Folder, error)
+
+	// フォルダを取得する。
+	Get(*command.GetFolder) (*dto.Folder, error)
+
+	// フォルダを一覧取得する。
+	List(*command.ListFolders) ([]dto.Folder, error)
+
+	// フォルダを更新する。
+	Update(*command.UpdateFolder) (*dto.Folder, error)
+
+	// フォルダを削除する。
+	Delete(*command.DeleteFolder) error
+
+	// フォルダを移動する。
+	Move(*command.MoveFolder) (*dto.Folder, error)
+
+	// ブックマークをフォルダに移動する。
+	MoveBookmark(*command.MoveBookmark) (*dto.Bookmark, error)
+}
+
+// フォルダに関するユースケースの具象型。
+type folderUsecase struct {
+	folderRepository   repository.Folder   // フォルダのリポジトリ
+	bookmarkRepository repository.Bookmark // ブックマークのリポジトリ
+	service            service.Folder      // ドメインサービス
+	dispatcher         event.Dispatcher    // ドメインイベントのディスパッチャ
+}
+
+// フォルダに関するユースケースを生成する。
+func NewFolderUsecase(folderRepository repository.Folder, bookmarkRepository repository.Bookmark, service service.Folder, dispatcher event.Dispatcher) Folder {
+	return &folderUsecase{
+		folderRepository:   folderRepository,
+		bookmarkRepository: bookmarkRepository,
+		service:            service,
+		dispatcher:         dispatcher,
+	}
+}
+
+// 親フォルダのIDからユーザが所有するフォルダの存在を確認する。
+//
+// 空文字列を指定した場合は最上位を表すnilを返却する。
+//
+// フォルダの検索に失敗した場合はエラーを返却する。
+// フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+func (u *folderUsecase) findParent(userID *entity.UserID, v string) (*entity.ID, error) {
+	if v == "" {
+		return nil, nil
+	}
+	id, _ := entity.NewID(v)
+	folder, err := u.folderRepository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if folder == nil {
+		return nil, &command.NotFoundError{Resource: "folder"}
+	}
+	return id, nil
+}
+
+// フォルダを作成する。
+//
+// 作成に成功した場合は作成したフォルダを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// 親フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+// フォルダの保存に失敗した場合はエラーを返却する。
+func (u *folderUsecase) Create(cmd *command.CreateFolder) (*dto.Folder, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	parent, err := u.findParent(userID, cmd.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	id := u.folderRepository.NextID()
+	name, _ := entity.NewName(cmd.Name)
+	folder, _ := entity.NewFolder(id, userID, name, parent, cmd.Position)
+	if err := u.folderRepository.Save(folder); err != nil {
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	result := dto.NewFolder(*folder)
+	return &result, nil
+}
+
+// フォルダを取得する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+func (u *folderUsecase) Get(cmd *command.GetFolder) (*dto.Folder, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	folder, err := u.folderRepository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if folder == nil {
+		return nil, &command.NotFoundError{Resource: "folder"}
+	}
+	result := dto.NewFolder(*folder)
+	return &result, nil
+}
+
+// フォルダを一覧取得する。
+//
+// 親フォルダ直下のフォルダを並び順に返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// 親フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+func (u *folderUsecase) List(cmd *command.ListFolders) ([]dto.Folder, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	parent, err := u.findParent(userID, cmd.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := u.folderRepository.FindByParent(userID, parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByParent: %w", err)
+	}
+	folders := make([]dto.Folder, len(entities))
+	for i, entity := range entities {
+		folders[i] = dto.NewFolder(entity)
+	}
+	return folders, nil
+}
+
+// フォルダを更新する。
+//
+// 更新に成功した場合は更新したフォルダを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+// フォルダの保存に失敗した場合はエラーを返却する。
+func (u *folderUsecase) Update(cmd *command.UpdateFolder) (*dto.Folder, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	folder, err := u.folderRepository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if folder == nil {
+		return nil, &command.NotFoundError{Resource: "folder"}
+	}
+	name, _ := entity.NewName(cmd.Name)
+	folder.Rename(name)
+	if err := u.folderRepository.Save(folder); err != nil {
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	result := dto.NewFolder(*folder)
+	return &result, nil
+}
+
+// フォルダを削除する。
+//
+// 子フォルダまたはブックマークを含むフォルダは削除できない。
+// ゴミ箱にあるブックマークも復元すると元のフォルダに戻るため、フォルダに含まれるものとみなす。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+// フォルダが空でない場合は FailedPreconditionError を返却する。
+// フォルダの削除に失敗した場合はエラーを返却する。
+func (u *folderUsecase) Delete(cmd *command.DeleteFolder) error {
+	if cmd == nil {
+		return fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	folder, err := u.folderRepository.FindByID(userID, id)
+	if err != nil {
+		return fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if folder == nil {
+		return &command.NotFoundError{Resource: "folder"}
+	}
+	children, err := u.folderRepository.FindByParent(userID, id)
+	if err != nil {
+		return fmt.Errorf("failed at repository.FindByParent: %w", err)
+	}
+	if len(children) > 0 {
+		return &command.FailedPreconditionError{Resource: "folder", Reason: "folder has subfolders"}
+	}
+	exists, err := u.bookmarkRepository.ExistsInFolder(userID, id)
+	if err != nil {
+		return fmt.Errorf("failed at repository.ExistsInFolder: %w", err)
+	}
+	if exists {
+		return &command.FailedPreconditionError{Resource: "folder", Reason: "folder has bookmarks"}
+	}
+	if err := u.folderRepository.Delete(folder); err != nil {
+		return fmt.Errorf("failed at repository.Delete: %w", err)
+	}
+	return nil
+}
+
+// フォルダを移動する。
+//
+// 移動に成功した場合は移動したフォルダを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// フォルダまたは移動先の親フォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+// 循環の確認に失敗した場合はエラーを返却する。
+// 移動により循環が生じる場合は FailedPreconditionError を返却する。
+// フォルダの保存に失敗した場合はエラーを返却する。
+func (u *folderUsecase) Move(cmd *command.MoveFolder) (*dto.Folder, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	folder, err := u.folderRepository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if folder == nil {
+		return nil, &command.NotFoundError{Resource: "folder"}
+	}
+	parent, err := u.findParent(userID, cmd.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	cycles, err := u.service.CreatesCycle(folder, parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed at service.CreatesCycle: %w", err)
+	}
+	if cycles {
+		return nil, &command.FailedPreconditionError{Resource: "folder", Reason: "move creates a cycle"}
+	}
+	folder.Move(parent, cmd.Position)
+	if err := u.folderRepository.Save(folder); err != nil {
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	result := dto.NewFolder(*folder)
+	return &result, nil
+}
+
+// ブックマークをフォルダに移動する。
+//
+// 移動に成功した場合は移動したブックマークを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// ブックマークの検索に失敗した場合はエラーを返却する。
+// ブックマークが存在しない場合は NotFoundError を返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+// 移動先のフォルダが存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+// 保存されている版数が異なる場合は ConflictError を返却する。
+// ブックマークの保存に失敗した場合はエラーを返却する。
+func (u *folderUsecase) MoveBookmark(cmd *command.MoveBookmark) (*dto.Bookmark, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	bookmark, err := u.bookmarkRepository.FindByID(userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if bookmark == nil {
+		return nil, &command.NotFoundError{Resource: "bookmark"}
+	}
+	folder, err := u.findParent(userID, cmd.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	bookmark.MoveTo(folder)
+	if err := u.bookmarkRepository.Save(bookmark, cmd.UserID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, &command.ConflictError{Resource: "bookmark"}
+		}
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	u.dispatcher.Publish(bookmark.PullEvents())
+	result := dto.NewBookmark(*bookmark)
+	return &result, nil
+}
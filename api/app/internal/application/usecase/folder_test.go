@@ -0,0 +1,704 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/application/event"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	mock_repository "github.com/kkntzw/bookmark/test/mock/domain/repository"
+	mock_service "github.com/kkntzw/bookmark/test/mock/domain/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFolderUsecase(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Run("implementing usecase.Folder", func(t *testing.T) {
+		t.Parallel()
+		// given
+		folderRepository := mock_repository.NewMockFolder(ctrl)
+		bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+		service := mock_service.NewMockFolder(ctrl)
+		// when
+		object := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*Folder)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		folderRepository := mock_repository.NewMockFolder(ctrl)
+		bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+		service := mock_service.NewMockFolder(ctrl)
+		dispatcher := event.NewDispatcher()
+		abstractUsecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, dispatcher)
+		// when
+		concreteUsecase, ok := abstractUsecase.(*folderUsecase)
+		actualFolderRepository := concreteUsecase.folderRepository
+		actualBookmarkRepository := concreteUsecase.bookmarkRepository
+		actualService := concreteUsecase.service
+		actualDispatcher := concreteUsecase.dispatcher
+		// then
+		assert.True(t, ok)
+		expectedFolderRepository := folderRepository
+		assert.Exactly(t, expectedFolderRepository, actualFolderRepository)
+		expectedBookmarkRepository := bookmarkRepository
+		assert.Exactly(t, expectedBookmarkRepository, actualBookmarkRepository)
+		expectedService := service
+		assert.Exactly(t, expectedService, actualService)
+		expectedDispatcher := dispatcher
+		assert.Exactly(t, expectedDispatcher, actualDispatcher)
+	})
+}
+
+func TestFolder_Create(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare        func(*mock_repository.MockFolder)
+		cmd            *command.CreateFolder
+		expectedFolder *dto.Folder
+		expectedErr    error
+	}{
+		"top-level folder": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(helper.ToFolder(t, "1", "Reading List", "", 0)).Return(nil)
+			},
+			&command.CreateFolder{Name: "Reading List", UserID: helper.UserID},
+			&dto.Folder{ID: "1", Name: "Reading List"},
+			nil,
+		},
+		"nested folder": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Work", "", 0), nil)
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(helper.ToFolder(t, "1", "Reading List", "2", 3)).Return(nil)
+			},
+			&command.CreateFolder{Name: "Reading List", ParentID: "2", Position: 3, UserID: helper.UserID},
+			&dto.Folder{ID: "1", Name: "Reading List", ParentID: "2", Position: 3},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockFolder) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockFolder) {},
+			&command.CreateFolder{Name: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Name": helper.ToErrName(t, "")}},
+		},
+		"non-existent parent": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, nil)
+			},
+			&command.CreateFolder{Name: "Reading List", ParentID: "2", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, errors.New("some error"))
+			},
+			&command.CreateFolder{Name: "Reading List", ParentID: "2", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at repository.Save": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(helper.ToFolder(t, "1", "Reading List", "", 0)).Return(errors.New("some error"))
+			},
+			&command.CreateFolder{Name: "Reading List", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualFolder, actualErr := usecase.Create(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_Get(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare        func(*mock_repository.MockFolder)
+		cmd            *command.GetFolder
+		expectedFolder *dto.Folder
+		expectedErr    error
+	}{
+		"non-nil command": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "2", 3), nil)
+			},
+			&command.GetFolder{ID: "1", UserID: helper.UserID},
+			&dto.Folder{ID: "1", Name: "Reading List", ParentID: "2", Position: 3},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockFolder) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockFolder) {},
+			&command.GetFolder{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"non-existent folder": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.GetFolder{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.GetFolder{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualFolder, actualErr := usecase.Get(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_List(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare         func(*mock_repository.MockFolder)
+		cmd             *command.ListFolders
+		expectedFolders []dto.Folder
+		expectedErr     error
+	}{
+		"top level": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), nil).Return(
+					[]entity.Folder{
+						*helper.ToFolder(t, "1", "Work", "", 0),
+						*helper.ToFolder(t, "2", "Private", "", 1),
+					},
+					nil,
+				)
+			},
+			&command.ListFolders{UserID: helper.UserID},
+			[]dto.Folder{
+				{ID: "1", Name: "Work", Position: 0},
+				{ID: "2", Name: "Private", Position: 1},
+			},
+			nil,
+		},
+		"children": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Work", "", 0), nil)
+				r.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return([]entity.Folder{*helper.ToFolder(t, "3", "Go", "1", 0)}, nil)
+			},
+			&command.ListFolders{ParentID: "1", UserID: helper.UserID},
+			[]dto.Folder{
+				{ID: "3", Name: "Go", ParentID: "1", Position: 0},
+			},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockFolder) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockFolder) {},
+			&command.ListFolders{ParentID: "!", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ParentID": helper.ToErrID(t, "!")}},
+		},
+		"non-existent parent": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.ListFolders{ParentID: "1", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByParent": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), nil).Return(nil, errors.New("some error"))
+			},
+			&command.ListFolders{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByParent: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualFolders, actualErr := usecase.List(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedFolders, actualFolders)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_Update(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare        func(*mock_repository.MockFolder)
+		cmd            *command.UpdateFolder
+		expectedFolder *dto.Folder
+		expectedErr    error
+	}{
+		"non-nil command": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "2", 3), nil)
+				r.EXPECT().Save(helper.ToFolder(t, "1", "To Read", "2", 3)).Return(nil)
+			},
+			&command.UpdateFolder{ID: "1", Name: "To Read", UserID: helper.UserID},
+			&dto.Folder{ID: "1", Name: "To Read", ParentID: "2", Position: 3},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockFolder) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockFolder) {},
+			&command.UpdateFolder{ID: "1", Name: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Name": helper.ToErrName(t, "")}},
+		},
+		"non-existent folder": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.UpdateFolder{ID: "1", Name: "To Read", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.UpdateFolder{ID: "1", Name: "To Read", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at repository.Save": {
+			func(r *mock_repository.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				r.EXPECT().Save(helper.ToFolder(t, "1", "To Read", "", 0)).Return(errors.New("some error"))
+			},
+			&command.UpdateFolder{ID: "1", Name: "To Read", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualFolder, actualErr := usecase.Update(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_Delete(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare     func(*mock_repository.MockFolder, *mock_repository.MockBookmark)
+		cmd         *command.DeleteFolder
+		expectedErr error
+	}{
+		"empty folder": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				f.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return([]entity.Folder{}, nil)
+				b.EXPECT().ExistsInFolder(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(false, nil)
+				f.EXPECT().Delete(helper.ToFolder(t, "1", "Reading List", "", 0)).Return(nil)
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			nil,
+		},
+		"folder with subfolders": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				f.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return([]entity.Folder{*helper.ToFolder(t, "2", "Go", "1", 0)}, nil)
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			&command.FailedPreconditionError{Resource: "folder", Reason: "folder has subfolders"},
+		},
+		"folder with bookmarks": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				f.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return([]entity.Folder{}, nil)
+				b.EXPECT().ExistsInFolder(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(true, nil)
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			&command.FailedPreconditionError{Resource: "folder", Reason: "folder has bookmarks"},
+		},
+		"nil command": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {},
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {},
+			&command.DeleteFolder{ID: "", UserID: helper.UserID},
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"non-existent folder": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByID": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at repository.FindByParent": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				f.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.FindByParent: %w", errors.New("some error")),
+		},
+		"failed at repository.ExistsInFolder": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				f.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return([]entity.Folder{}, nil)
+				b.EXPECT().ExistsInFolder(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(false, errors.New("some error"))
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.ExistsInFolder: %w", errors.New("some error")),
+		},
+		"failed at repository.Delete": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				f.EXPECT().FindByParent(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return([]entity.Folder{}, nil)
+				b.EXPECT().ExistsInFolder(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(false, nil)
+				f.EXPECT().Delete(helper.ToFolder(t, "1", "Reading List", "", 0)).Return(errors.New("some error"))
+			},
+			&command.DeleteFolder{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.Delete: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository, bookmarkRepository)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualErr := usecase.Delete(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_Move(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare        func(*mock_repository.MockFolder, *mock_service.MockFolder)
+		cmd            *command.MoveFolder
+		expectedFolder *dto.Folder
+		expectedErr    error
+	}{
+		"move under other folder": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Work", "", 1), nil)
+				s.EXPECT().CreatesCycle(helper.ToFolder(t, "1", "Reading List", "", 0), helper.ToID(t, "2")).Return(false, nil)
+				r.EXPECT().Save(helper.ToFolder(t, "1", "Reading List", "2", 3)).Return(nil)
+			},
+			&command.MoveFolder{ID: "1", ParentID: "2", Position: 3, UserID: helper.UserID},
+			&dto.Folder{ID: "1", Name: "Reading List", ParentID: "2", Position: 3},
+			nil,
+		},
+		"move to top level": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "2", 3), nil)
+				s.EXPECT().CreatesCycle(helper.ToFolder(t, "1", "Reading List", "2", 3), nil).Return(false, nil)
+				r.EXPECT().Save(helper.ToFolder(t, "1", "Reading List", "", 0)).Return(nil)
+			},
+			&command.MoveFolder{ID: "1", UserID: helper.UserID},
+			&dto.Folder{ID: "1", Name: "Reading List"},
+			nil,
+		},
+		"move under descendant": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Go", "1", 0), nil)
+				s.EXPECT().CreatesCycle(helper.ToFolder(t, "1", "Reading List", "", 0), helper.ToID(t, "2")).Return(true, nil)
+			},
+			&command.MoveFolder{ID: "1", ParentID: "2", UserID: helper.UserID},
+			nil,
+			&command.FailedPreconditionError{Resource: "folder", Reason: "move creates a cycle"},
+		},
+		"nil command": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {},
+			&command.MoveFolder{ID: "1", ParentID: "1", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ParentID": errors.New("same as ID: 1")}},
+		},
+		"non-existent folder": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.MoveFolder{ID: "1", ParentID: "2", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"non-existent parent": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, nil)
+			},
+			&command.MoveFolder{ID: "1", ParentID: "2", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.MoveFolder{ID: "1", ParentID: "2", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at service.CreatesCycle": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "", 0), nil)
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Work", "", 1), nil)
+				s.EXPECT().CreatesCycle(helper.ToFolder(t, "1", "Reading List", "", 0), helper.ToID(t, "2")).Return(false, errors.New("some error"))
+			},
+			&command.MoveFolder{ID: "1", ParentID: "2", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at service.CreatesCycle: %w", errors.New("some error")),
+		},
+		"failed at repository.Save": {
+			func(r *mock_repository.MockFolder, s *mock_service.MockFolder) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFolder(t, "1", "Reading List", "2", 3), nil)
+				s.EXPECT().CreatesCycle(helper.ToFolder(t, "1", "Reading List", "2", 3), nil).Return(false, nil)
+				r.EXPECT().Save(helper.ToFolder(t, "1", "Reading List", "", 0)).Return(errors.New("some error"))
+			},
+			&command.MoveFolder{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository, service)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualFolder, actualErr := usecase.Move(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_MoveBookmark(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockFolder, *mock_repository.MockBookmark)
+		cmd              *command.MoveBookmark
+		expectedBookmark *dto.Bookmark
+		expectedErr      error
+	}{
+		"move into folder": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), nil)
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10")).Return(helper.ToFolder(t, "10", "Reading List", "", 0), nil)
+				b.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToFiledBookmark(t, "10", "1", "Example", "https://example.com", "foo"), "BookmarkMoved"), helper.UserID).Return(nil)
+			},
+			&command.MoveBookmark{ID: "1", FolderID: "10", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", FolderID: "10", Status: "unread", Tags: []string{"foo"}},
+			nil,
+		},
+		"move to top level": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToFiledBookmark(t, "10", "1", "Example", "https://example.com", "foo"), nil)
+				b.EXPECT().Save(helper.ToBookmarkMatcher(t, helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), "BookmarkMoved"), helper.UserID).Return(nil)
+			},
+			&command.MoveBookmark{ID: "1", UserID: helper.UserID},
+			&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Tags: []string{"foo"}},
+			nil,
+		},
+		"nil command": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {},
+			&command.MoveBookmark{ID: "", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"non-existent bookmark": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.MoveBookmark{ID: "1", FolderID: "10", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "bookmark"},
+		},
+		"non-existent folder": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				f.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10")).Return(nil, nil)
+			},
+			&command.MoveBookmark{ID: "1", FolderID: "10", UserID: helper.UserID},
+			nil,
+			&command.NotFoundError{Resource: "folder"},
+		},
+		"failed at repository.FindByID": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.MoveBookmark{ID: "1", FolderID: "10", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"conflict at repository.Save": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				b.EXPECT().Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), helper.UserID).Return(repository.ErrConflict)
+			},
+			&command.MoveBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			&command.ConflictError{Resource: "bookmark"},
+		},
+		"failed at repository.Save": {
+			func(f *mock_repository.MockFolder, b *mock_repository.MockBookmark) {
+				b.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				b.EXPECT().Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), helper.UserID).Return(errors.New("some error"))
+			},
+			&command.MoveBookmark{ID: "1", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			folderRepository := mock_repository.NewMockFolder(ctrl)
+			bookmarkRepository := mock_repository.NewMockBookmark(ctrl)
+			service := mock_service.NewMockFolder(ctrl)
+			tc.prepare(folderRepository, bookmarkRepository)
+			// given
+			usecase := NewFolderUsecase(folderRepository, bookmarkRepository, service, event.NewDispatcher())
+			// when
+			actualBookmark, actualErr := usecase.MoveBookmark(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
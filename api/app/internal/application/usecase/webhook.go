@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// Webhookに関するユースケースのインターフェース。
+type Webhook interface {
+	// Webhookを購読する。
+	Create(*command.CreateWebhook) (*dto.Webhook, error)
+
+	// Webhookの購読を一覧取得する。
+	List(*command.ListWebhooks) ([]dto.Webhook, error)
+
+	// Webhookの購読を解除する。
+	Delete(*command.DeleteWebhook) error
+
+	// 配信に失敗した通知を一覧取得する。
+	ListDeadLetters(*command.ListDeadLetters) ([]dto.DeadLetter, error)
+}
+
+// Webhookに関するユースケースの具象型。
+type webhookUsecase struct {
+	webhookRepository    repository.Webhook    // Webhookの購読のリポジトリ
+	deadLetterRepository repository.DeadLetter // デッドレターのリポジトリ
+}
+
+// Webhookに関するユースケースを生成する。
+func NewWebhookUsecase(webhookRepository repository.Webhook, deadLetterRepository repository.DeadLetter) Webhook {
+	return &webhookUsecase{
+		webhookRepository:    webhookRepository,
+		deadLetterRepository: deadLetterRepository,
+	}
+}
+
+// Webhookを購読する。
+//
+// 購読に成功した場合は作成した購読を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 購読の保存に失敗した場合はエラーを返却する。
+func (u *webhookUsecase) Create(cmd *command.CreateWebhook) (*dto.Webhook, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	id := u.webhookRepository.NextID()
+	userID, _ := entity.NewUserID(cmd.UserID)
+	uri, _ := entity.NewURI(cmd.URI)
+	events := cmd.Events
+	if events == nil {
+		events = []string{}
+	}
+	webhook, _ := entity.NewWebhook(id, userID, uri, events, cmd.Secret)
+	if err := u.webhookRepository.Save(webhook); err != nil {
+		return nil, fmt.Errorf("failed at repository.Save: %w", err)
+	}
+	result := dto.NewWebhook(*webhook)
+	return &result, nil
+}
+
+// Webhookの購読を一覧取得する。
+//
+// 操作するユーザが所有する購読をIDの昇順に返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 購読の検索に失敗した場合はエラーを返却する。
+func (u *webhookUsecase) List(cmd *command.ListWebhooks) ([]dto.Webhook, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	entities, err := u.webhookRepository.FindAll(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindAll: %w", err)
+	}
+	webhooks := make([]dto.Webhook, len(entities))
+	for i, entity := range entities {
+		webhooks[i] = dto.NewWebhook(entity)
+	}
+	return webhooks, nil
+}
+
+// Webhookの購読を解除する。
+//
+// 記録済みのデッドレターは削除しない。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// 購読の検索に失敗した場合はエラーを返却する。
+// 購読が存在しない場合、あるいは他のユーザが所有する場合は NotFoundError を返却する。
+// 購読の削除に失敗した場合はエラーを返却する。
+func (u *webhookUsecase) Delete(cmd *command.DeleteWebhook) error {
+	if cmd == nil {
+		return fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	id, _ := entity.NewID(cmd.ID)
+	webhook, err := u.webhookRepository.FindByID(userID, id)
+	if err != nil {
+		return fmt.Errorf("failed at repository.FindByID: %w", err)
+	}
+	if webhook == nil {
+		return &command.NotFoundError{Resource: "webhook"}
+	}
+	if err := u.webhookRepository.Delete(webhook); err != nil {
+		return fmt.Errorf("failed at repository.Delete: %w", err)
+	}
+	return nil
+}
+
+// 配信に失敗した通知を一覧取得する。
+//
+// 操作するユーザが所有するWebhookのデッドレターに限定する。
+// WebhookのIDを指定しない場合はユーザの全てのWebhookを対象とする。
+// 購読を解除したWebhookのデッドレターも対象とする。
+// 作成日時の新しい順に返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// 不正なコマンドを指定した場合は InvalidCommandError を返却する。
+// デッドレターの検索に失敗した場合はエラーを返却する。
+func (u *webhookUsecase) ListDeadLetters(cmd *command.ListDeadLetters) ([]dto.DeadLetter, error) {
+	if cmd == nil {
+		return nil, fmt.Errorf("argument \"cmd\" is nil")
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	userID, _ := entity.NewUserID(cmd.UserID)
+	var webhookID *entity.ID
+	if cmd.WebhookID != "" {
+		webhookID, _ = entity.NewID(cmd.WebhookID)
+	}
+	entities, err := u.deadLetterRepository.FindByWebhookID(userID, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed at repository.FindByWebhookID: %w", err)
+	}
+	deadLetters := make([]dto.DeadLetter, len(entities))
+	for i, entity := range entities {
+		deadLetters[i] = dto.NewDeadLetter(entity)
+	}
+	return deadLetters, nil
+}
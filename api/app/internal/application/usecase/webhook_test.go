@@ -0,0 +1,319 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	mock_repository "github.com/kkntzw/bookmark/test/mock/domain/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookUsecase(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Run("implementing usecase.Webhook", func(t *testing.T) {
+		t.Parallel()
+		// given
+		webhookRepository := mock_repository.NewMockWebhook(ctrl)
+		deadLetterRepository := mock_repository.NewMockDeadLetter(ctrl)
+		// when
+		object := NewWebhookUsecase(webhookRepository, deadLetterRepository)
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*Webhook)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		webhookRepository := mock_repository.NewMockWebhook(ctrl)
+		deadLetterRepository := mock_repository.NewMockDeadLetter(ctrl)
+		abstractUsecase := NewWebhookUsecase(webhookRepository, deadLetterRepository)
+		// when
+		concreteUsecase, ok := abstractUsecase.(*webhookUsecase)
+		actualWebhookRepository := concreteUsecase.webhookRepository
+		actualDeadLetterRepository := concreteUsecase.deadLetterRepository
+		// then
+		assert.True(t, ok)
+		expectedWebhookRepository := webhookRepository
+		assert.Exactly(t, expectedWebhookRepository, actualWebhookRepository)
+		expectedDeadLetterRepository := deadLetterRepository
+		assert.Exactly(t, expectedDeadLetterRepository, actualDeadLetterRepository)
+	})
+}
+
+func TestWebhook_Create(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare         func(*mock_repository.MockWebhook)
+		cmd             *command.CreateWebhook
+		expectedWebhook *dto.Webhook
+		expectedErr     error
+	}{
+		"filtered events": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkDeleted)).Return(nil)
+			},
+			&command.CreateWebhook{URI: "https://example.com/hooks", Events: []string{entity.EventBookmarkDeleted}, Secret: "secret", UserID: helper.UserID},
+			&dto.Webhook{ID: "1", URI: "https://example.com/hooks", Events: []string{entity.EventBookmarkDeleted}},
+			nil,
+		},
+		"all events": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret")).Return(nil)
+			},
+			&command.CreateWebhook{URI: "https://example.com/hooks", Secret: "secret", UserID: helper.UserID},
+			&dto.Webhook{ID: "1", URI: "https://example.com/hooks", Events: []string{}},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockWebhook) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockWebhook) {},
+			&command.CreateWebhook{URI: "https://example.com/hooks", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"Secret": errors.New("secret is empty")}},
+		},
+		"failed at repository.Save": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().NextID().Return(helper.ToID(t, "1"))
+				r.EXPECT().Save(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret")).Return(errors.New("some error"))
+			},
+			&command.CreateWebhook{URI: "https://example.com/hooks", Secret: "secret", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.Save: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			webhookRepository := mock_repository.NewMockWebhook(ctrl)
+			deadLetterRepository := mock_repository.NewMockDeadLetter(ctrl)
+			tc.prepare(webhookRepository)
+			// given
+			usecase := NewWebhookUsecase(webhookRepository, deadLetterRepository)
+			// when
+			actualWebhook, actualErr := usecase.Create(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedWebhook, actualWebhook)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_List(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_repository.MockWebhook)
+		cmd              *command.ListWebhooks
+		expectedWebhooks []dto.Webhook
+		expectedErr      error
+	}{
+		"stored webhooks": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().FindAll(helper.ToUserID(t, helper.UserID)).Return([]entity.Webhook{
+					*helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+					*helper.ToWebhook(t, "2", "https://example.org/hooks", "secret", entity.EventBookmarkRenamed),
+				}, nil)
+			},
+			&command.ListWebhooks{UserID: helper.UserID},
+			[]dto.Webhook{
+				{ID: "1", URI: "https://example.com/hooks", Events: []string{}},
+				{ID: "2", URI: "https://example.org/hooks", Events: []string{entity.EventBookmarkRenamed}},
+			},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockWebhook) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockWebhook) {},
+			&command.ListWebhooks{UserID: ""},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"UserID": helper.ToErrUserID(t, "")}},
+		},
+		"failed at repository.FindAll": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().FindAll(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("some error"))
+			},
+			&command.ListWebhooks{UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindAll: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			webhookRepository := mock_repository.NewMockWebhook(ctrl)
+			deadLetterRepository := mock_repository.NewMockDeadLetter(ctrl)
+			tc.prepare(webhookRepository)
+			// given
+			usecase := NewWebhookUsecase(webhookRepository, deadLetterRepository)
+			// when
+			actualWebhooks, actualErr := usecase.List(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedWebhooks, actualWebhooks)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_Delete(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare     func(*mock_repository.MockWebhook)
+		cmd         *command.DeleteWebhook
+		expectedErr error
+	}{
+		"stored webhook": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"), nil)
+				r.EXPECT().Delete(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret")).Return(nil)
+			},
+			&command.DeleteWebhook{ID: "1", UserID: helper.UserID},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockWebhook) {},
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockWebhook) {},
+			&command.DeleteWebhook{ID: "", UserID: helper.UserID},
+			&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}},
+		},
+		"non-existent webhook": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, nil)
+			},
+			&command.DeleteWebhook{ID: "1", UserID: helper.UserID},
+			&command.NotFoundError{Resource: "webhook"},
+		},
+		"failed at repository.FindByID": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+			},
+			&command.DeleteWebhook{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+		"failed at repository.Delete": {
+			func(r *mock_repository.MockWebhook) {
+				r.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1")).Return(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"), nil)
+				r.EXPECT().Delete(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret")).Return(errors.New("some error"))
+			},
+			&command.DeleteWebhook{ID: "1", UserID: helper.UserID},
+			fmt.Errorf("failed at repository.Delete: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			webhookRepository := mock_repository.NewMockWebhook(ctrl)
+			deadLetterRepository := mock_repository.NewMockDeadLetter(ctrl)
+			tc.prepare(webhookRepository)
+			// given
+			usecase := NewWebhookUsecase(webhookRepository, deadLetterRepository)
+			// when
+			actualErr := usecase.Delete(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_ListDeadLetters(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	createdAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		prepare             func(*mock_repository.MockDeadLetter)
+		cmd                 *command.ListDeadLetters
+		expectedDeadLetters []dto.DeadLetter
+		expectedErr         error
+	}{
+		"webhook id": {
+			func(r *mock_repository.MockDeadLetter) {
+				r.EXPECT().FindByWebhookID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10")).Return([]entity.DeadLetter{
+					*helper.ToTimestampedDeadLetter(t, createdAt, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "unexpected status: 500"),
+				}, nil)
+			},
+			&command.ListDeadLetters{WebhookID: "10", UserID: helper.UserID},
+			[]dto.DeadLetter{
+				{ID: "100", WebhookID: "10", EventName: entity.EventBookmarkDeleted, BookmarkID: "1", Payload: `{}`, Attempts: 5, LastError: "unexpected status: 500", CreatedAt: createdAt},
+			},
+			nil,
+		},
+		"all webhooks": {
+			func(r *mock_repository.MockDeadLetter) {
+				r.EXPECT().FindByWebhookID(helper.ToUserID(t, helper.UserID), nil).Return([]entity.DeadLetter{}, nil)
+			},
+			&command.ListDeadLetters{UserID: helper.UserID},
+			[]dto.DeadLetter{},
+			nil,
+		},
+		"nil command": {
+			func(r *mock_repository.MockDeadLetter) {},
+			nil,
+			nil,
+			errors.New("argument \"cmd\" is nil"),
+		},
+		"invalid command": {
+			func(r *mock_repository.MockDeadLetter) {},
+			&command.ListDeadLetters{WebhookID: "!", UserID: helper.UserID},
+			nil,
+			&command.InvalidCommandError{Args: map[string]error{"WebhookID": helper.ToErrID(t, "!")}},
+		},
+		"failed at repository.FindByWebhookID": {
+			func(r *mock_repository.MockDeadLetter) {
+				r.EXPECT().FindByWebhookID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10")).Return(nil, errors.New("some error"))
+			},
+			&command.ListDeadLetters{WebhookID: "10", UserID: helper.UserID},
+			nil,
+			fmt.Errorf("failed at repository.FindByWebhookID: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			webhookRepository := mock_repository.NewMockWebhook(ctrl)
+			deadLetterRepository := mock_repository.NewMockDeadLetter(ctrl)
+			tc.prepare(deadLetterRepository)
+			// given
+			usecase := NewWebhookUsecase(webhookRepository, deadLetterRepository)
+			// when
+			actualDeadLetters, actualErr := usecase.ListDeadLetters(tc.cmd)
+			// then
+			assert.Exactly(t, tc.expectedDeadLetters, actualDeadLetters)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
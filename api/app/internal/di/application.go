@@ -1,14 +1,31 @@
 package di
 
 import (
+	"github.com/kkntzw/bookmark/internal/application/event"
 	"github.com/kkntzw/bookmark/internal/application/usecase"
 )
 
+// ドメインイベントのディスパッチャ。
+//
+// 他のファイルの init で購読を登録できるよう、init より前に評価される変数の初期化で生成する。
+var dispatcher = event.NewDispatcher()
+
+// ドメインイベントのディスパッチャを注入する。
+func InjectEventDispatcher() event.Dispatcher {
+	return dispatcher
+}
+
 // ブックマークに関するユースケースを注入する。
 func InjectBookmarkUsecase() usecase.Bookmark {
 	return usecase.NewBookmarkUsecase(
 		InjectMongoDBBookmarkRepository(),
+		InjectMongoDBRevisionRepository(),
+		InjectMongoDBAuditRepository(),
+		InjectMongoDBShareRepository(),
+		InjectMongoDBBookmarkWatcher(),
 		InjectBookmarkService(),
+		InjectEventDispatcher(),
+		InjectURIPolicy(),
 	)
 }
 
@@ -16,6 +33,48 @@ func InjectBookmarkUsecase() usecase.Bookmark {
 func InjectTestBookmarkUsecase() usecase.Bookmark {
 	return usecase.NewBookmarkUsecase(
 		InjectInMemoryBookmarkRepository(),
+		InjectInMemoryRevisionRepository(),
+		InjectInMemoryAuditRepository(),
+		InjectInMemoryShareRepository(),
+		InjectInMemoryBookmarkWatcher(),
 		InjectTestBookmarkService(),
+		InjectEventDispatcher(),
+		InjectURIPolicy(),
+	)
+}
+
+// フォルダに関するユースケースを注入する。
+func InjectFolderUsecase() usecase.Folder {
+	return usecase.NewFolderUsecase(
+		InjectMongoDBFolderRepository(),
+		InjectMongoDBBookmarkRepository(),
+		InjectFolderService(),
+		InjectEventDispatcher(),
+	)
+}
+
+// フォルダに関するテスト用ユースケースを注入する。
+func InjectTestFolderUsecase() usecase.Folder {
+	return usecase.NewFolderUsecase(
+		InjectInMemoryFolderRepository(),
+		InjectInMemoryBookmarkRepository(),
+		InjectTestFolderService(),
+		InjectEventDispatcher(),
+	)
+}
+
+// Webhookに関するユースケースを注入する。
+func InjectWebhookUsecase() usecase.Webhook {
+	return usecase.NewWebhookUsecase(
+		InjectMongoDBWebhookRepository(),
+		InjectMongoDBDeadLetterRepository(),
+	)
+}
+
+// Webhookに関するテスト用ユースケースを注入する。
+func InjectTestWebhookUsecase() usecase.Webhook {
+	return usecase.NewWebhookUsecase(
+		InjectInMemoryWebhookRepository(),
+		InjectInMemoryDeadLetterRepository(),
 	)
 }
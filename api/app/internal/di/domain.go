@@ -1,9 +1,27 @@
 package di
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kkntzw/bookmark/internal/config"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/service"
+	"go.uber.org/zap"
+)
+
+var (
+	uriPolicy *entity.URIPolicy // URIの制約
 )
 
+// 時計を注入する。
+func InjectClock() clock.Clock {
+	return clock.NewSystemClock()
+}
+
 // ブックマークに関するドメインサービスを注入する。
 func InjectBookmarkService() service.Bookmark {
 	return service.NewBookmarkService(
@@ -17,3 +35,59 @@ func InjectTestBookmarkService() service.Bookmark {
 		InjectInMemoryBookmarkRepository(),
 	)
 }
+
+// フォルダに関するドメインサービスを注入する。
+func InjectFolderService() service.Folder {
+	return service.NewFolderService(
+		InjectMongoDBFolderRepository(),
+	)
+}
+
+// フォルダに関するテスト用ドメインサービスを注入する。
+func InjectTestFolderService() service.Folder {
+	return service.NewFolderService(
+		InjectInMemoryFolderRepository(),
+	)
+}
+
+// URIの制約を注入する。
+func InjectURIPolicy() *entity.URIPolicy {
+	return uriPolicy
+}
+
+// 環境変数からURIの制約を生成する。
+//
+// 環境変数 URI_SCHEMES に許可するスキームをカンマ区切りで、URI_MAX_LENGTH に最大文字列長を指定する。
+// 指定しない場合は既定値を用いる。
+//
+// 最大文字列長が整数でない場合はエラーを返却する。
+// URIの制約の生成に失敗した場合はエラーを返却する。
+func parseURIPolicy(schemes, maxLength string) (*entity.URIPolicy, error) {
+	allowed := entity.DefaultURISchemes
+	if schemes != "" {
+		allowed = []string{}
+		for _, scheme := range strings.Split(schemes, ",") {
+			if scheme = strings.TrimSpace(scheme); scheme != "" {
+				allowed = append(allowed, scheme)
+			}
+		}
+	}
+	length := entity.MaxURILength
+	if maxLength != "" {
+		v, err := strconv.Atoi(maxLength)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max length: %s", maxLength)
+		}
+		length = v
+	}
+	return entity.NewURIPolicy(allowed, length)
+}
+
+// シングルトンでインスタンスを扱うために初期化する。
+func init() {
+	policy, err := parseURIPolicy(os.Getenv("URI_SCHEMES"), os.Getenv("URI_MAX_LENGTH"))
+	if err != nil {
+		config.Logger.Fatal("Failed to configure the URI policy", zap.Error(err))
+	}
+	uriPolicy = policy
+}
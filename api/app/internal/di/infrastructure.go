@@ -1,16 +1,45 @@
 package di
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/kkntzw/bookmark/internal/config"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/repository"
 	"github.com/kkntzw/bookmark/internal/infrastructure/inmemory"
 	"github.com/kkntzw/bookmark/internal/infrastructure/mongodb"
+	"github.com/kkntzw/bookmark/internal/infrastructure/webhook"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
 )
 
 var (
-	inMemoryBookmarkRepository repository.Bookmark // ブックマークを扱うインメモリ型リポジトリ
-	mongoDbBookmarkRepository  repository.Bookmark // ブックマークを扱うMongoDBリポジトリ
+	inMemoryBookmarkRepository   repository.Bookmark           // ブックマークを扱うインメモリ型リポジトリ
+	mongoDbBookmarkRepository    repository.Bookmark           // ブックマークを扱うMongoDBリポジトリ
+	inMemoryFolderRepository     repository.Folder             // フォルダを扱うインメモリ型リポジトリ
+	mongoDbFolderRepository      repository.Folder             // フォルダを扱うMongoDBリポジトリ
+	inMemoryRevisionRepository   repository.Revision           // 改訂履歴を扱うインメモリ型リポジトリ
+	mongoDbRevisionRepository    repository.Revision           // 改訂履歴を扱うMongoDBリポジトリ
+	inMemoryAuditRepository      repository.Audit              // 監査ログを扱うインメモリ型リポジトリ
+	mongoDbAuditRepository       repository.Audit              // 監査ログを扱うMongoDBリポジトリ
+	inMemoryShareRepository      repository.Share              // ブックマークの共有を扱うインメモリ型リポジトリ
+	mongoDbShareRepository       repository.Share              // ブックマークの共有を扱うMongoDBリポジトリ
+	inMemoryBookmarkBroadcaster  *inmemory.BookmarkBroadcaster // ブックマークの変更を配信するインメモリ型ブロードキャスタ
+	mongoDbBookmarkWatcher       repository.BookmarkWatcher    // ブックマークの変更を監視するMongoDBウォッチャ
+	inMemoryWebhookRepository    repository.Webhook            // Webhookの購読を扱うインメモリ型リポジトリ
+	mongoDbWebhookRepository     repository.Webhook            // Webhookの購読を扱うMongoDBリポジトリ
+	inMemoryDeliveryRepository   repository.Delivery           // 配信待ちの通知を扱うインメモリ型リポジトリ
+	mongoDbDeliveryRepository    repository.Delivery           // 配信待ちの通知を扱うMongoDBリポジトリ
+	inMemoryDeadLetterRepository repository.DeadLetter         // デッドレターを扱うインメモリ型リポジトリ
+	mongoDbDeadLetterRepository  repository.DeadLetter         // デッドレターを扱うMongoDBリポジトリ
+	webhookDeliverer             *webhook.Deliverer            // Webhookの通知を配信するワーカー
+	outboxRelay                  *mongodb.OutboxRelay          // 送信箱のドメインイベントを配信する中継器
+	mongoDbBookmarkCollection    *mongo.Collection             // ブックマークのMongoDBコレクション
+	mongoDbOutboxCollection      *mongo.Collection             // 送信箱のMongoDBコレクション
+	mongoDbLegacyCollections     []*mongo.Collection           // 所有者を持たないドキュメントが残り得るMongoDBコレクション一覧
 )
 
 // ブックマークの永続化を担うインメモリ型リポジトリを注入する。
@@ -23,11 +52,185 @@ func InjectMongoDBBookmarkRepository() repository.Bookmark {
 	return mongoDbBookmarkRepository
 }
 
+// フォルダの永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryFolderRepository() repository.Folder {
+	return inMemoryFolderRepository
+}
+
+// フォルダの永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBFolderRepository() repository.Folder {
+	return mongoDbFolderRepository
+}
+
+// ブックマークの改訂履歴の永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryRevisionRepository() repository.Revision {
+	return inMemoryRevisionRepository
+}
+
+// ブックマークの改訂履歴の永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBRevisionRepository() repository.Revision {
+	return mongoDbRevisionRepository
+}
+
+// 監査ログの永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryAuditRepository() repository.Audit {
+	return inMemoryAuditRepository
+}
+
+// 監査ログの永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBAuditRepository() repository.Audit {
+	return mongoDbAuditRepository
+}
+
+// ブックマークの共有の永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryShareRepository() repository.Share {
+	return inMemoryShareRepository
+}
+
+// ブックマークの共有の永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBShareRepository() repository.Share {
+	return mongoDbShareRepository
+}
+
+// ブックマークの変更の監視を担うインメモリ型ブロードキャスタを注入する。
+func InjectInMemoryBookmarkWatcher() repository.BookmarkWatcher {
+	return inMemoryBookmarkBroadcaster
+}
+
+// ブックマークの変更の監視を担うMongoDBウォッチャを注入する。
+func InjectMongoDBBookmarkWatcher() repository.BookmarkWatcher {
+	return mongoDbBookmarkWatcher
+}
+
+// Webhookの購読の永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryWebhookRepository() repository.Webhook {
+	return inMemoryWebhookRepository
+}
+
+// Webhookの購読の永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBWebhookRepository() repository.Webhook {
+	return mongoDbWebhookRepository
+}
+
+// 配信待ちの通知の永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryDeliveryRepository() repository.Delivery {
+	return inMemoryDeliveryRepository
+}
+
+// 配信待ちの通知の永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBDeliveryRepository() repository.Delivery {
+	return mongoDbDeliveryRepository
+}
+
+// デッドレターの永続化を担うインメモリ型リポジトリを注入する。
+func InjectInMemoryDeadLetterRepository() repository.DeadLetter {
+	return inMemoryDeadLetterRepository
+}
+
+// デッドレターの永続化を担うMongoDBリポジトリを注入する。
+func InjectMongoDBDeadLetterRepository() repository.DeadLetter {
+	return mongoDbDeadLetterRepository
+}
+
+// Webhookの通知を配信するワーカーを注入する。
+func InjectWebhookDeliverer() *webhook.Deliverer {
+	return webhookDeliverer
+}
+
+// 送信箱に記録したドメインイベントを配信する中継器を注入する。
+func InjectOutboxRelay() *mongodb.OutboxRelay {
+	return outboxRelay
+}
+
+// MongoDBのコレクションを現在のスキーマに移行する。
+//
+// 起動時にサーバを開始する前に呼び出す。
+// 所有者を持たない既存のドキュメントは MONGO_LEGACY_OWNER のユーザに引き継ぐ。
+// 既に重複したブックマークがある場合は FindDuplicates と MergeBookmarks で解消できるよう一意インデックスを作成せずに移行を続ける。
+//
+// MONGO_LEGACY_OWNER が不正な場合はエラーを返却する。
+// 所有者の引き継ぎに失敗した場合はエラーを返却する。
+// 引き継ぎ先を設定せずに所有者を持たないドキュメントが残っている場合はエラーを返却する。
+// 重複以外の理由でブックマークの移行に失敗した場合はエラーを返却する。
+// 送信箱の移行に失敗した場合はエラーを返却する。
+func Migrate() error {
+	if v := os.Getenv("MONGO_LEGACY_OWNER"); v != "" {
+		legacyOwner, err := entity.NewUserID(v)
+		if err != nil {
+			return fmt.Errorf("invalid MONGO_LEGACY_OWNER: %w", err)
+		}
+		for _, c := range mongoDbLegacyCollections {
+			count, err := mongodb.MigrateOwner(c, legacyOwner)
+			if err != nil {
+				return fmt.Errorf("failed at mongodb.MigrateOwner (%s): %w", c.Name(), err)
+			}
+			if count > 0 {
+				config.Logger.Info("Backfilled the owners", zap.String("collection", c.Name()), zap.Int("count", count))
+			}
+		}
+	} else {
+		for _, c := range mongoDbLegacyCollections {
+			count, err := mongodb.CountWithoutOwner(c)
+			if err != nil {
+				return fmt.Errorf("failed at mongodb.CountWithoutOwner (%s): %w", c.Name(), err)
+			}
+			if count > 0 {
+				return fmt.Errorf("found %d documents without owners in %s; set MONGO_LEGACY_OWNER to the user who takes them over, then restart", count, c.Name())
+			}
+		}
+	}
+	if count, err := mongodb.MigrateBookmarks(mongoDbBookmarkCollection); errors.Is(err, mongodb.ErrDuplicateBookmarks) {
+		config.Logger.Warn("Started without the unique index on the canonical URIs; resolve the duplicates with FindDuplicates and MergeBookmarks, then restart", zap.Error(err))
+	} else if err != nil {
+		return fmt.Errorf("failed at mongodb.MigrateBookmarks: %w", err)
+	} else if count > 0 {
+		config.Logger.Info("Migrated the bookmarks", zap.Int("count", count))
+	}
+	// 配信済みのドメインイベントは7日間保持してから削除する。
+	if err := mongodb.MigrateOutbox(mongoDbOutboxCollection, 7*24*time.Hour); err != nil {
+		return fmt.Errorf("failed at mongodb.MigrateOutbox: %w", err)
+	}
+	return nil
+}
+
 // シングルトンでインスタンスを扱うために初期化する。
 func init() {
-	inMemoryBookmarkRepository = inmemory.NewBookmarkRepository()
+	inMemoryBookmarkBroadcaster = inmemory.NewBookmarkBroadcaster(1000)
+	inMemoryRevisionRepository = inmemory.NewRevisionRepository(InjectClock())
+	inMemoryAuditRepository = inmemory.NewAuditRepository(InjectClock())
+	inMemoryBookmarkRepository = inmemory.NewBookmarkRepository(InjectClock(), inMemoryBookmarkBroadcaster, inMemoryRevisionRepository, inMemoryAuditRepository)
+	inMemoryFolderRepository = inmemory.NewFolderRepository()
+	inMemoryShareRepository = inmemory.NewShareRepository()
+	inMemoryWebhookRepository = inmemory.NewWebhookRepository()
+	inMemoryDeliveryRepository = inmemory.NewDeliveryRepository()
+	inMemoryDeadLetterRepository = inmemory.NewDeadLetterRepository(InjectClock())
 
 	db := mongodb.NewMongoDatabase(os.Getenv("MONGO_URI"), os.Getenv("MONGO_DATABASE"))
 	collection := db.Collection(os.Getenv("MONGO_COLLECTION"))
-	mongoDbBookmarkRepository = mongodb.NewBookmarkRepository(collection)
+	outboxCollection := db.Collection(os.Getenv("MONGO_OUTBOX_COLLECTION"))
+	outboxPoisonCollection := db.Collection(os.Getenv("MONGO_OUTBOX_POISON_COLLECTION"))
+	revisionCollection := db.Collection(os.Getenv("MONGO_REVISION_COLLECTION"))
+	auditCollection := db.Collection(os.Getenv("MONGO_AUDIT_COLLECTION"))
+	folderCollection := db.Collection(os.Getenv("MONGO_FOLDER_COLLECTION"))
+	shareCollection := db.Collection(os.Getenv("MONGO_SHARE_COLLECTION"))
+	webhookCollection := db.Collection(os.Getenv("MONGO_WEBHOOK_COLLECTION"))
+	deliveryCollection := db.Collection(os.Getenv("MONGO_DELIVERY_COLLECTION"))
+	deadLetterCollection := db.Collection(os.Getenv("MONGO_DEAD_LETTER_COLLECTION"))
+	mongoDbBookmarkCollection = collection
+	mongoDbOutboxCollection = outboxCollection
+	mongoDbLegacyCollections = []*mongo.Collection{collection, outboxCollection, auditCollection, folderCollection, webhookCollection, deadLetterCollection}
+	mongoDbRevisionRepository = mongodb.NewRevisionRepository(revisionCollection, InjectClock())
+	mongoDbAuditRepository = mongodb.NewAuditRepository(auditCollection, InjectClock())
+	mongoDbBookmarkRepository = mongodb.NewBookmarkRepository(collection, outboxCollection, revisionCollection, auditCollection, InjectClock())
+	mongoDbBookmarkWatcher = mongodb.NewBookmarkWatcher(collection)
+	mongoDbFolderRepository = mongodb.NewFolderRepository(folderCollection)
+	mongoDbShareRepository = mongodb.NewShareRepository(shareCollection)
+	mongoDbWebhookRepository = mongodb.NewWebhookRepository(webhookCollection)
+	mongoDbDeliveryRepository = mongodb.NewDeliveryRepository(deliveryCollection)
+	mongoDbDeadLetterRepository = mongodb.NewDeadLetterRepository(deadLetterCollection, InjectClock())
+
+	client := webhook.NewClient(10 * time.Second)
+	webhookDeliverer = webhook.NewDeliverer(mongoDbWebhookRepository, mongoDbDeliveryRepository, mongoDbDeadLetterRepository, client, InjectClock(), config.Logger, 8, 5, time.Second, time.Minute, time.Second, 30*time.Second)
+	outboxRelay = mongodb.NewOutboxRelay(outboxCollection, outboxPoisonCollection, webhookDeliverer.Deliver, InjectClock(), config.Logger, time.Second, 100)
+	InjectEventDispatcher().Subscribe(outboxRelay.Notify)
 }
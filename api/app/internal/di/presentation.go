@@ -1,6 +1,9 @@
 package di
 
 import (
+	"os"
+	"strings"
+
 	"github.com/kkntzw/bookmark/internal/presentation/pb"
 	"github.com/kkntzw/bookmark/internal/presentation/server"
 )
@@ -18,3 +21,53 @@ func InjectTestBookmarkServer() pb.BookmarkerServer {
 		InjectTestBookmarkUsecase(),
 	)
 }
+
+// フォルダに関するgRPCサーバを注入する。
+func InjectFolderServer() pb.FolderManagerServer {
+	return server.NewFolderServer(
+		InjectFolderUsecase(),
+	)
+}
+
+// フォルダに関するテスト用gRPCサーバを注入する。
+func InjectTestFolderServer() pb.FolderManagerServer {
+	return server.NewFolderServer(
+		InjectTestFolderUsecase(),
+	)
+}
+
+// Webhookに関するgRPCサーバを注入する。
+func InjectWebhookServer() pb.WebhookManagerServer {
+	return server.NewWebhookServer(
+		InjectWebhookUsecase(),
+	)
+}
+
+// Webhookに関するテスト用gRPCサーバを注入する。
+func InjectTestWebhookServer() pb.WebhookManagerServer {
+	return server.NewWebhookServer(
+		InjectTestWebhookUsecase(),
+	)
+}
+
+// gRPCの呼び出し元を認証する認証器を注入する。
+//
+// 環境変数 AUTH_TOKENS に "トークン=ユーザID" をカンマ区切りで指定する。
+func InjectAuthenticator() *server.Authenticator {
+	return server.NewAuthenticator(parseAuthTokens(os.Getenv("AUTH_TOKENS")))
+}
+
+// "トークン=ユーザID" のカンマ区切りの文字列からトークンとユーザIDの対応を生成する。
+//
+// 形式が不正な要素は無視する。
+func parseAuthTokens(v string) map[string]string {
+	users := map[string]string{}
+	for _, pair := range strings.Split(v, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			continue
+		}
+		users[kv[0]] = kv[1]
+	}
+	return users
+}
@@ -0,0 +1,24 @@
+package clock
+
+import "time"
+
+// 現在時刻を提供するインターフェース。
+type Clock interface {
+	// 現在時刻を取得する。
+	Now() time.Time
+}
+
+// システム時計を表す具象型。
+type systemClock struct{}
+
+// システム時計を生成する。
+func NewSystemClock() Clock {
+	return &systemClock{}
+}
+
+// 現在時刻を取得する。
+//
+// 永続化先の精度に合わせてミリ秒未満を切り捨てたUTCの時刻を返却する。
+func (c *systemClock) Now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
@@ -0,0 +1,23 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock_Now(t *testing.T) {
+	t.Parallel()
+	// given
+	clock := NewSystemClock()
+	before := time.Now().UTC().Truncate(time.Millisecond)
+	// when
+	actualNow := clock.Now()
+	// then
+	after := time.Now().UTC()
+	assert.Exactly(t, time.UTC, actualNow.Location())
+	assert.Zero(t, actualNow.Nanosecond()%int(time.Millisecond))
+	assert.False(t, actualNow.Before(before))
+	assert.False(t, actualNow.After(after))
+}
@@ -0,0 +1,180 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// 監査ログに記録する操作を表す値オブジェクト。
+type AuditOperation int
+
+const (
+	AuditOperationRegister AuditOperation = iota // 登録
+	AuditOperationUpdate                         // 更新
+	AuditOperationDelete                         // 削除 (ゴミ箱への移動)
+	AuditOperationRestore                        // ゴミ箱からの復元
+	AuditOperationPurge                          // 完全な削除
+)
+
+// 操作の文字列表現。
+var auditOperationValues = map[AuditOperation]string{
+	AuditOperationRegister: "register",
+	AuditOperationUpdate:   "update",
+	AuditOperationDelete:   "delete",
+	AuditOperationRestore:  "restore",
+	AuditOperationPurge:    "purge",
+}
+
+// 監査ログに記録する操作を表す値オブジェクトを生成する。
+//
+// 文字列表現 ("register", "update", "delete", "restore", "purge") から生成する。
+//
+// 未知の操作を指定した場合はエラーを返却する。
+func NewAuditOperation(v string) (*AuditOperation, error) {
+	for operation, value := range auditOperationValues {
+		if value == v {
+			return &operation, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation: %s", v)
+}
+
+// 値を取得する。
+func (operation AuditOperation) Value() string {
+	return auditOperationValues[operation]
+}
+
+// 監査ログの記録を表すエンティティ。
+//
+// 誰がいつどのブックマークに何をしたかを記録する。
+type AuditEntry struct {
+	id         ID             // ID
+	actor      string         // 操作者 (不明な場合は空文字列)
+	operation  AuditOperation // 操作
+	bookmarkID ID             // 操作したブックマークのID
+	userID     UserID         // 操作したブックマークの所有者のユーザID
+	before     *Snapshot      // 操作前の内容 (登録、復元の場合はnil)
+	after      *Snapshot      // 操作後の内容 (削除、完全な削除の場合はnil)
+	createdAt  time.Time      // 作成日時
+}
+
+// 監査ログの記録を表すエンティティを生成する。
+//
+// 登録と復元の場合は操作後の内容、更新の場合は操作前後の内容、削除と完全な削除の場合は操作前の内容を指定する。
+//
+// ID、ブックマークのIDまたは所有者のユーザIDにnilを指定した場合はエラーを返却する。
+// 操作に必要な内容にnilを指定した場合はエラーを返却する。
+// 操作に不要な内容を指定した場合はエラーを返却する。
+func NewAuditEntry(id *ID, actor string, operation AuditOperation, bookmarkID *ID, userID *UserID, before *Snapshot, after *Snapshot) (*AuditEntry, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	switch operation {
+	case AuditOperationRegister, AuditOperationRestore:
+		if before != nil {
+			return nil, fmt.Errorf("unexpected before for operation: %s", operation.Value())
+		}
+		if after == nil {
+			return nil, fmt.Errorf("argument \"after\" is nil")
+		}
+	case AuditOperationUpdate:
+		if before == nil {
+			return nil, fmt.Errorf("argument \"before\" is nil")
+		}
+		if after == nil {
+			return nil, fmt.Errorf("argument \"after\" is nil")
+		}
+	case AuditOperationDelete, AuditOperationPurge:
+		if before == nil {
+			return nil, fmt.Errorf("argument \"before\" is nil")
+		}
+		if after != nil {
+			return nil, fmt.Errorf("unexpected after for operation: %s", operation.Value())
+		}
+	default:
+		return nil, fmt.Errorf("unknown operation: %d", operation)
+	}
+	entry := &AuditEntry{*id, actor, operation, *bookmarkID, *userID, nil, nil, time.Time{}}
+	if before != nil {
+		entry.before = before.DeepCopy()
+	}
+	if after != nil {
+		entry.after = after.DeepCopy()
+	}
+	return entry, nil
+}
+
+// フィールド id を取得する。
+func (e *AuditEntry) ID() ID {
+	return e.id
+}
+
+// フィールド actor を取得する。
+func (e *AuditEntry) Actor() string {
+	return e.actor
+}
+
+// フィールド operation を取得する。
+func (e *AuditEntry) Operation() AuditOperation {
+	return e.operation
+}
+
+// フィールド bookmarkID を取得する。
+func (e *AuditEntry) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e *AuditEntry) UserID() UserID {
+	return e.userID
+}
+
+// フィールド before を取得する。
+//
+// 登録、復元の場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (e *AuditEntry) Before() *Snapshot {
+	if e.before == nil {
+		return nil
+	}
+	return e.before.DeepCopy()
+}
+
+// フィールド after を取得する。
+//
+// 削除、完全な削除の場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (e *AuditEntry) After() *Snapshot {
+	if e.after == nil {
+		return nil
+	}
+	return e.after.DeepCopy()
+}
+
+// フィールド createdAt を取得する。
+//
+// 永続化されていない場合はゼロ値を返却する。
+func (e *AuditEntry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// フィールド createdAt を設定する。
+//
+// リポジトリが永続化した日時を反映するために用いる。
+func (e *AuditEntry) SetCreatedAt(createdAt time.Time) {
+	e.createdAt = createdAt
+}
+
+// インスタンスをディープコピーする。
+func (e AuditEntry) DeepCopy() *AuditEntry {
+	copy := &e
+	copy.before = e.Before()
+	copy.after = e.After()
+	return copy
+}
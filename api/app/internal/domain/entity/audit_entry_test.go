@@ -0,0 +1,240 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditOperation(t *testing.T) {
+	t.Parallel()
+	register, update, delete := AuditOperationRegister, AuditOperationUpdate, AuditOperationDelete
+	restore, purge := AuditOperationRestore, AuditOperationPurge
+	cases := map[string]struct {
+		v                 string
+		expectedOperation *AuditOperation
+		expectedErr       error
+	}{
+		"register": {
+			"register",
+			&register,
+			nil,
+		},
+		"update": {
+			"update",
+			&update,
+			nil,
+		},
+		"delete": {
+			"delete",
+			&delete,
+			nil,
+		},
+		"restore": {
+			"restore",
+			&restore,
+			nil,
+		},
+		"purge": {
+			"purge",
+			&purge,
+			nil,
+		},
+		"empty string": {
+			"",
+			nil,
+			errors.New("unknown operation: "),
+		},
+		"unknown operation": {
+			"revert",
+			nil,
+			errors.New("unknown operation: revert"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualOperation, actualErr := NewAuditOperation(tc.v)
+			// then
+			assert.Exactly(t, tc.expectedOperation, actualOperation)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestAuditOperation_Value(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		operation     AuditOperation
+		expectedValue string
+	}{
+		"register": {AuditOperationRegister, "register"},
+		"update":   {AuditOperationUpdate, "update"},
+		"delete":   {AuditOperationDelete, "delete"},
+		"restore":  {AuditOperationRestore, "restore"},
+		"purge":    {AuditOperationPurge, "purge"},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualValue := tc.operation.Value()
+			// then
+			assert.Exactly(t, tc.expectedValue, actualValue)
+		})
+	}
+}
+
+func TestNewAuditEntry(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "100")
+	bookmarkID := toId(t, "1")
+	userID := &UserID{"carol"}
+	before := toSnapshot(t, "Example", "https://example.com", "foo")
+	after := toSnapshot(t, "Example Domain", "https://example.com", "foo")
+	cases := map[string]struct {
+		id            *ID
+		operation     AuditOperation
+		bookmarkID    *ID
+		userID        *UserID
+		before        *Snapshot
+		after         *Snapshot
+		expectedEntry *AuditEntry
+		expectedErr   error
+	}{
+		"register": {
+			id, AuditOperationRegister, bookmarkID, userID, nil, after,
+			&AuditEntry{*id, "alice", AuditOperationRegister, *bookmarkID, *userID, nil, after, time.Time{}},
+			nil,
+		},
+		"update": {
+			id, AuditOperationUpdate, bookmarkID, userID, before, after,
+			&AuditEntry{*id, "alice", AuditOperationUpdate, *bookmarkID, *userID, before, after, time.Time{}},
+			nil,
+		},
+		"delete": {
+			id, AuditOperationDelete, bookmarkID, userID, before, nil,
+			&AuditEntry{*id, "alice", AuditOperationDelete, *bookmarkID, *userID, before, nil, time.Time{}},
+			nil,
+		},
+		"restore": {
+			id, AuditOperationRestore, bookmarkID, userID, nil, after,
+			&AuditEntry{*id, "alice", AuditOperationRestore, *bookmarkID, *userID, nil, after, time.Time{}},
+			nil,
+		},
+		"purge": {
+			id, AuditOperationPurge, bookmarkID, userID, before, nil,
+			&AuditEntry{*id, "alice", AuditOperationPurge, *bookmarkID, *userID, before, nil, time.Time{}},
+			nil,
+		},
+		"nil id": {
+			nil, AuditOperationUpdate, bookmarkID, userID, before, after,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil bookmark id": {
+			id, AuditOperationUpdate, nil, userID, before, after,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil user id": {
+			id, AuditOperationUpdate, bookmarkID, nil, before, after,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"register with before": {
+			id, AuditOperationRegister, bookmarkID, userID, before, after,
+			nil,
+			errors.New("unexpected before for operation: register"),
+		},
+		"register without after": {
+			id, AuditOperationRegister, bookmarkID, userID, nil, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+		"update without before": {
+			id, AuditOperationUpdate, bookmarkID, userID, nil, after,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"update without after": {
+			id, AuditOperationUpdate, bookmarkID, userID, before, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+		"delete without before": {
+			id, AuditOperationDelete, bookmarkID, userID, nil, nil,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"delete with after": {
+			id, AuditOperationDelete, bookmarkID, userID, before, after,
+			nil,
+			errors.New("unexpected after for operation: delete"),
+		},
+		"restore with before": {
+			id, AuditOperationRestore, bookmarkID, userID, before, after,
+			nil,
+			errors.New("unexpected before for operation: restore"),
+		},
+		"purge with after": {
+			id, AuditOperationPurge, bookmarkID, userID, before, after,
+			nil,
+			errors.New("unexpected after for operation: purge"),
+		},
+		"unknown operation": {
+			id, AuditOperation(100), bookmarkID, userID, before, after,
+			nil,
+			errors.New("unknown operation: 100"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEntry, actualErr := NewAuditEntry(tc.id, "alice", tc.operation, tc.bookmarkID, tc.userID, tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedEntry, actualEntry)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestAuditEntry_Accessors(t *testing.T) {
+	t.Parallel()
+	// given
+	before := toSnapshot(t, "Example", "https://example.com", "foo")
+	after := toSnapshot(t, "Example Domain", "https://example.org", "bar")
+	entry, _ := NewAuditEntry(toId(t, "100"), "alice", AuditOperationUpdate, toId(t, "1"), &UserID{"carol"}, before, after)
+	// when
+	entry.SetCreatedAt(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	// then
+	assert.Exactly(t, *toId(t, "100"), entry.ID())
+	assert.Exactly(t, "alice", entry.Actor())
+	assert.Exactly(t, AuditOperationUpdate, entry.Operation())
+	assert.Exactly(t, *toId(t, "1"), entry.BookmarkID())
+	assert.Exactly(t, UserID{"carol"}, entry.UserID())
+	assert.Exactly(t, before, entry.Before())
+	assert.Exactly(t, after, entry.After())
+	assert.Exactly(t, time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), entry.CreatedAt())
+}
+
+func TestAuditEntry_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewAuditEntry(toId(t, "100"), "alice", AuditOperationDelete, toId(t, "1"), &UserID{"carol"}, toSnapshot(t, "Example", "https://example.com", "foo"), nil)
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, original.before, copy.before)
+	assert.NotSame(t, &original.before.tags[0], &copy.before.tags[0])
+	assert.Nil(t, copy.after)
+}
@@ -1,13 +1,26 @@
 package entity
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ブックマークを表すエンティティ。
 type Bookmark struct {
-	id   ID    // ID
-	name Name  // ブックマーク名
-	uri  URI   // URI
-	tags []Tag // タグ一覧
+	id          ID          // ID
+	userID      UserID      // 所有者のユーザID (所有者が未設定の場合はゼロ値)
+	name        Name        // ブックマーク名
+	uri         URI         // URI
+	tags        []Tag       // タグ一覧
+	description Description // 説明
+	folder      *ID         // 所属するフォルダのID (最上位の場合はnil)
+	status      Status      // 状態
+	starred     bool        // お気に入りに登録されているか
+	version     uint64      // 版数
+	createdAt   time.Time   // 作成日時
+	updatedAt   time.Time   // 更新日時
+	deletedAt   time.Time   // ゴミ箱に移動した日時 (ゴミ箱にない場合はゼロ値)
+	events      []Event     // 発行前のドメインイベント一覧
 }
 
 // ブックマークを表すエンティティを生成する。
@@ -28,7 +41,31 @@ func NewBookmark(id *ID, name *Name, uri *URI, tags []Tag) (*Bookmark, error) {
 	if tags == nil {
 		return nil, fmt.Errorf("argument \"tags\" is nil")
 	}
-	return &Bookmark{*id, *name, *uri, append([]Tag{}, tags...)}, nil
+	return &Bookmark{*id, UserID{}, *name, *uri, append([]Tag{}, tags...), Description{}, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, nil}, nil
+}
+
+// ブックマークを新たに登録する。
+//
+// NewBookmark と異なり、登録されたことを表すドメインイベントを記録する。
+// 永続化されたブックマークの復元には NewBookmark を用いる。
+//
+// 所有者と説明を設定してから記録するため、ドメインイベントは所有者のユーザIDと説明を持つ。
+//
+// nilを指定した場合はエラーを返却する。
+func RegisterBookmark(id *ID, userID *UserID, name *Name, uri *URI, description *Description, tags []Tag) (*Bookmark, error) {
+	bookmark, err := NewBookmark(id, name, uri, tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := bookmark.AssignTo(userID); err != nil {
+		return nil, err
+	}
+	if description == nil {
+		return nil, fmt.Errorf("argument \"description\" is nil")
+	}
+	bookmark.description = *description
+	bookmark.record(BookmarkRegistered{bookmark.id, bookmark.userID, bookmark.name, bookmark.uri, bookmark.description, bookmark.Tags()})
+	return bookmark, nil
 }
 
 // フィールド id を取得する。
@@ -36,6 +73,24 @@ func (b *Bookmark) ID() ID {
 	return b.id
 }
 
+// フィールド userID を取得する。
+//
+// 所有者が未設定の場合はゼロ値を返却する。
+func (b *Bookmark) UserID() UserID {
+	return b.userID
+}
+
+// 所有者を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+func (b *Bookmark) AssignTo(userID *UserID) error {
+	if userID == nil {
+		return fmt.Errorf("argument \"userID\" is nil")
+	}
+	b.userID = *userID
+	return nil
+}
+
 // フィールド name を取得する。
 func (b *Bookmark) Name() Name {
 	return b.name
@@ -53,6 +108,85 @@ func (b *Bookmark) Tags() []Tag {
 	return append([]Tag{}, b.tags...)
 }
 
+// フィールド description を取得する。
+func (b *Bookmark) Description() Description {
+	return b.description
+}
+
+// フィールド folder を取得する。
+//
+// 最上位に所属する場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (b *Bookmark) Folder() *ID {
+	return copyID(b.folder)
+}
+
+// フィールド status を取得する。
+func (b *Bookmark) Status() Status {
+	return b.status
+}
+
+// フィールド starred を取得する。
+func (b *Bookmark) Starred() bool {
+	return b.starred
+}
+
+// フィールド version を取得する。
+//
+// 永続化されていない場合は0を返却する。
+func (b *Bookmark) Version() uint64 {
+	return b.version
+}
+
+// 版数を設定する。
+//
+// リポジトリが永続化した版数を反映するために用いる。
+func (b *Bookmark) SetVersion(version uint64) {
+	b.version = version
+}
+
+// フィールド createdAt を取得する。
+//
+// 永続化されていない場合はゼロ値を返却する。
+func (b *Bookmark) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// フィールド updatedAt を取得する。
+//
+// 永続化されていない場合はゼロ値を返却する。
+func (b *Bookmark) UpdatedAt() time.Time {
+	return b.updatedAt
+}
+
+// 作成日時と更新日時を設定する。
+//
+// リポジトリが永続化した日時を反映するために用いる。
+func (b *Bookmark) SetTimestamps(createdAt, updatedAt time.Time) {
+	b.createdAt = createdAt
+	b.updatedAt = updatedAt
+}
+
+// フィールド deletedAt を取得する。
+//
+// ゴミ箱にない場合はゼロ値を返却する。
+func (b *Bookmark) DeletedAt() time.Time {
+	return b.deletedAt
+}
+
+// ゴミ箱にあるか判定する。
+func (b *Bookmark) IsTrashed() bool {
+	return !b.deletedAt.IsZero()
+}
+
+// ゴミ箱に移動した日時を設定する。
+//
+// ゼロ値を指定した場合はゴミ箱にないものとする。
+// リポジトリが永続化した日時を反映するために用いる。
+func (b *Bookmark) SetDeletedAt(deletedAt time.Time) {
+	b.deletedAt = deletedAt
+}
+
 // ブックマーク名を変更する。
 //
 // nilを指定した場合はエラーを返却する。
@@ -60,10 +194,18 @@ func (b *Bookmark) Rename(name *Name) error {
 	if name == nil {
 		return fmt.Errorf("argument \"name\" is nil")
 	}
-	b.name = *name
+	b.rename(*name)
 	return nil
 }
 
+// ブックマーク名を変更し、変更された場合はドメインイベントを記録する。
+func (b *Bookmark) rename(name Name) {
+	if b.name != name {
+		b.record(BookmarkRenamed{b.id, b.userID, b.name, name})
+	}
+	b.name = name
+}
+
 // URIを書き換える。
 //
 // nilを指定した場合はエラーを返却する。
@@ -71,13 +213,308 @@ func (b *Bookmark) RewriteURI(uri *URI) error {
 	if uri == nil {
 		return fmt.Errorf("argument \"uri\" is nil")
 	}
-	b.uri = *uri
+	b.rewriteURI(*uri)
+	return nil
+}
+
+// URIを書き換え、書き換えられた場合はドメインイベントを記録する。
+func (b *Bookmark) rewriteURI(uri URI) {
+	if b.uri.String() != uri.String() {
+		b.record(BookmarkURIRewritten{b.id, b.userID, b.uri, uri})
+	}
+	b.uri = uri
+}
+
+// 説明を変更する。
+//
+// nilを指定した場合はエラーを返却する。
+func (b *Bookmark) Describe(description *Description) error {
+	if description == nil {
+		return fmt.Errorf("argument \"description\" is nil")
+	}
+	b.describe(*description)
+	return nil
+}
+
+// 説明を変更し、変更された場合はドメインイベントを記録する。
+func (b *Bookmark) describe(description Description) {
+	if b.description != description {
+		b.record(BookmarkDescribed{b.id, b.userID, b.description, description})
+	}
+	b.description = description
+}
+
+// フォルダに移動する。
+//
+// nilを指定した場合は最上位に移動する。
+// 所属するフォルダが変更された場合はドメインイベントを記録する。
+func (b *Bookmark) MoveTo(folder *ID) {
+	if !equalID(b.folder, folder) {
+		b.record(BookmarkMoved{b.id, b.userID, copyID(b.folder), copyID(folder)})
+	}
+	b.folder = copyID(folder)
+}
+
+// 既読にする。
+//
+// アーカイブ済みの場合はアーカイブを解除して既読にする。
+func (b *Bookmark) MarkRead() {
+	b.changeStatus(StatusRead)
+}
+
+// 未読に戻す。
+//
+// 既読とアーカイブ済みのいずれの状態からも未読に戻せる。
+func (b *Bookmark) MarkUnread() {
+	b.changeStatus(StatusUnread)
+}
+
+// アーカイブする。
+//
+// 未読と既読のいずれの状態からもアーカイブできる。
+func (b *Bookmark) Archive() {
+	b.changeStatus(StatusArchived)
+}
+
+// 状態を変更し、変更された場合はドメインイベントを記録する。
+func (b *Bookmark) changeStatus(status Status) {
+	if b.status != status {
+		b.record(BookmarkStatusChanged{b.id, b.userID, b.status, status})
+	}
+	b.status = status
+}
+
+// お気に入りに登録する。
+//
+// 登録されていなかった場合はドメインイベントを記録する。
+func (b *Bookmark) Star() {
+	if !b.starred {
+		b.record(BookmarkStarred{b.id, b.userID})
+	}
+	b.starred = true
+}
+
+// お気に入りから外す。
+//
+// 登録されていた場合はドメインイベントを記録する。
+func (b *Bookmark) Unstar() {
+	if b.starred {
+		b.record(BookmarkUnstarred{b.id, b.userID})
+	}
+	b.starred = false
+}
+
+// タグを追加する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 既に付与されているタグおよび重複するタグは追加しない。
+func (b *Bookmark) AddTags(tags []Tag) error {
+	if tags == nil {
+		return fmt.Errorf("argument \"tags\" is nil")
+	}
+	b.retag(uniqueTags(append(append([]Tag{}, b.tags...), tags...)))
+	return nil
+}
+
+// タグを削除する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 付与されていないタグは無視する。
+func (b *Bookmark) RemoveTags(tags []Tag) error {
+	if tags == nil {
+		return fmt.Errorf("argument \"tags\" is nil")
+	}
+	removed := map[Tag]bool{}
+	for _, tag := range tags {
+		removed[tag] = true
+	}
+	remaining := []Tag{}
+	for _, tag := range b.tags {
+		if !removed[tag] {
+			remaining = append(remaining, tag)
+		}
+	}
+	b.retag(remaining)
 	return nil
 }
 
+// タグを置き換える。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 重複するタグは1つにまとめる。
+func (b *Bookmark) ReplaceTags(tags []Tag) error {
+	if tags == nil {
+		return fmt.Errorf("argument \"tags\" is nil")
+	}
+	b.retag(uniqueTags(tags))
+	return nil
+}
+
+// タグを統合する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 統合元のタグを統合先のタグに置き換え、重複するタグは最初に出現した位置に1つにまとめる。
+func (b *Bookmark) MergeTags(sources []Tag, target *Tag) error {
+	if sources == nil {
+		return fmt.Errorf("argument \"sources\" is nil")
+	}
+	if target == nil {
+		return fmt.Errorf("argument \"target\" is nil")
+	}
+	merged := map[Tag]bool{}
+	for _, source := range sources {
+		merged[source] = true
+	}
+	tags := make([]Tag, len(b.tags))
+	for i, tag := range b.tags {
+		if merged[tag] {
+			tag = *target
+		}
+		tags[i] = tag
+	}
+	b.retag(uniqueTags(tags))
+	return nil
+}
+
+// 内容を取得する。
+//
+// ブックマーク名、URI、説明、タグ一覧と、所属するフォルダ、状態、お気に入りへの登録、ゴミ箱への移動の有無を複製したインスタンスを返却する。
+func (b *Bookmark) Snapshot() Snapshot {
+	return Snapshot{b.name, b.uri, b.description, append([]Tag{}, b.tags...), copyID(b.folder), b.status, b.starred, b.IsTrashed()}
+}
+
+// 内容を元に戻す。
+//
+// ブックマーク名、URI、説明、タグ一覧、所属するフォルダ、状態、お気に入りへの登録を置き換える。
+// ゴミ箱への移動の有無はゴミ箱の操作で変更するため元に戻さない。
+//
+// nilを指定した場合はエラーを返却する。
+func (b *Bookmark) Revert(snapshot *Snapshot) error {
+	if snapshot == nil {
+		return fmt.Errorf("argument \"snapshot\" is nil")
+	}
+	b.rename(snapshot.name)
+	b.rewriteURI(snapshot.uri)
+	b.describe(snapshot.description)
+	b.retag(append([]Tag{}, snapshot.tags...))
+	b.MoveTo(snapshot.folder)
+	b.changeStatus(snapshot.status)
+	if snapshot.starred {
+		b.Star()
+	} else {
+		b.Unstar()
+	}
+	return nil
+}
+
+// ゴミ箱への移動を記録する。
+//
+// ゴミ箱に移動した日時はリポジトリが設定する。
+func (b *Bookmark) Delete() {
+	b.record(BookmarkDeleted{b.id, b.userID})
+}
+
+// ゴミ箱からの復元を記録する。
+//
+// ゴミ箱に移動した日時はリポジトリが消去する。
+func (b *Bookmark) Restore() {
+	b.record(BookmarkRestored{b.id, b.userID})
+}
+
+// 完全な削除を記録する。
+//
+// ゴミ箱を経由せずに削除する場合に用いる。
+func (b *Bookmark) Purge() {
+	b.record(BookmarkPurged{b.id, b.userID})
+}
+
+// タグ一覧を置き換え、付与または外されたタグがある場合はドメインイベントを記録する。
+//
+// 付与されたタグ、外されたタグの順に記録する。
+func (b *Bookmark) retag(tags []Tag) {
+	added, removed := diffTags(b.tags, tags)
+	if len(added) > 0 {
+		b.record(BookmarkTagged{b.id, b.userID, added})
+	}
+	if len(removed) > 0 {
+		b.record(BookmarkUntagged{b.id, b.userID, removed})
+	}
+	b.tags = tags
+}
+
+// 変更前後のタグ一覧から、付与されたタグと外されたタグを算出する。
+//
+// それぞれ変更後、変更前のタグ一覧に出現する順に返却する。
+func diffTags(before, after []Tag) ([]Tag, []Tag) {
+	inBefore := map[Tag]bool{}
+	for _, tag := range before {
+		inBefore[tag] = true
+	}
+	inAfter := map[Tag]bool{}
+	added := []Tag{}
+	for _, tag := range after {
+		inAfter[tag] = true
+		if !inBefore[tag] {
+			added = append(added, tag)
+		}
+	}
+	removed := []Tag{}
+	for _, tag := range before {
+		if !inAfter[tag] {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
+
+// ドメインイベントを記録する。
+func (b *Bookmark) record(event Event) {
+	b.events = append(b.events, event)
+}
+
+// 発行前のドメインイベント一覧を取得する。
+//
+// 記録した順に返却する。
+// 複製したスライスを返却する。
+func (b *Bookmark) Events() []Event {
+	return append([]Event{}, b.events...)
+}
+
+// 発行前のドメインイベント一覧を取り出す。
+//
+// 記録した順に返却し、記録したドメインイベントを消去する。
+func (b *Bookmark) PullEvents() []Event {
+	events := append([]Event{}, b.events...)
+	b.events = nil
+	return events
+}
+
+// 重複するタグを取り除いたスライスを生成する。
+//
+// 最初に出現した順序を維持する。
+func uniqueTags(tags []Tag) []Tag {
+	seen := map[Tag]bool{}
+	unique := []Tag{}
+	for _, tag := range tags {
+		if !seen[tag] {
+			seen[tag] = true
+			unique = append(unique, tag)
+		}
+	}
+	return unique
+}
+
 // インスタンスをディープコピーする。
 func (b Bookmark) DeepCopy() *Bookmark {
 	copy := &b
 	copy.tags = append([]Tag{}, b.tags...)
+	copy.folder = b.Folder()
+	if b.events != nil {
+		copy.events = append([]Event{}, b.events...)
+	}
 	return copy
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -67,22 +68,22 @@ func TestNewBookmark(t *testing.T) {
 	}{
 		"non-nil arguments (empty tags)": {
 			id, name, uri, emptyTags,
-			&Bookmark{*id, *name, *uri, emptyTags},
+			&Bookmark{*id, UserID{}, *name, *uri, emptyTags, Description{}, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, nil},
 			nil,
 		},
 		"non-nil arguments (1 tag)": {
 			id, name, uri, oneTag,
-			&Bookmark{*id, *name, *uri, oneTag},
+			&Bookmark{*id, UserID{}, *name, *uri, oneTag, Description{}, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, nil},
 			nil,
 		},
 		"non-nil arguments (2 tags)": {
 			id, name, uri, twoTags,
-			&Bookmark{*id, *name, *uri, twoTags},
+			&Bookmark{*id, UserID{}, *name, *uri, twoTags, Description{}, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, nil},
 			nil,
 		},
 		"non-nil arguments (3 tags)": {
 			id, name, uri, threeTags,
-			&Bookmark{*id, *name, *uri, threeTags},
+			&Bookmark{*id, UserID{}, *name, *uri, threeTags, Description{}, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, nil},
 			nil,
 		},
 		"nil id": {
@@ -147,6 +148,74 @@ func TestBookmark_ID(t *testing.T) {
 	assert.Exactly(t, expectedId, actualId)
 }
 
+func TestBookmark_UserID(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	t.Run("unassigned", func(t *testing.T) {
+		t.Parallel()
+		// given
+		bookmark, _ := NewBookmark(id, name, uri, tags)
+		// when
+		actualUserID := bookmark.UserID()
+		// then
+		expectedUserID := UserID{}
+		assert.Exactly(t, expectedUserID, actualUserID)
+	})
+	t.Run("assigned", func(t *testing.T) {
+		t.Parallel()
+		// given
+		bookmark, _ := NewBookmark(id, name, uri, tags)
+		bookmark.AssignTo(&UserID{"alice"})
+		// when
+		actualUserID := bookmark.UserID()
+		// then
+		expectedUserID := UserID{"alice"}
+		assert.Exactly(t, expectedUserID, actualUserID)
+	})
+}
+
+func TestBookmark_AssignTo(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		userID         *UserID
+		expectedUserID UserID
+		expectedErr    error
+	}{
+		"non-nil user ID": {
+			&UserID{"alice"},
+			UserID{"alice"},
+			nil,
+		},
+		"nil user ID": {
+			nil,
+			UserID{"bob"},
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			bookmark.AssignTo(&UserID{"bob"})
+			// when
+			actualErr := bookmark.AssignTo(tc.userID)
+			actualUserID := bookmark.userID
+			// then
+			assert.Exactly(t, tc.expectedUserID, actualUserID)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
 func TestBookmark_Name(t *testing.T) {
 	t.Parallel()
 	id := toId(t, "1")
@@ -208,6 +277,216 @@ func TestBookmark_Tags(t *testing.T) {
 	})
 }
 
+func TestBookmark_Description(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualDescription := bookmark.Description()
+	// then
+	expectedDescription := Description{}
+	assert.Exactly(t, expectedDescription, actualDescription)
+}
+
+func TestBookmark_Folder(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	t.Run("top level", func(t *testing.T) {
+		t.Parallel()
+		// given
+		bookmark, _ := NewBookmark(id, name, uri, tags)
+		// when
+		actualFolder := bookmark.Folder()
+		// then
+		assert.Nil(t, actualFolder)
+	})
+	t.Run("in folder", func(t *testing.T) {
+		t.Parallel()
+		// given
+		bookmark, _ := NewBookmark(id, name, uri, tags)
+		bookmark.MoveTo(toId(t, "10"))
+		// when
+		actualFolder := bookmark.Folder()
+		// then
+		expectedFolder := toId(t, "10")
+		assert.Exactly(t, expectedFolder, actualFolder)
+		assert.NotSame(t, bookmark.folder, actualFolder)
+	})
+}
+
+func TestBookmark_Status(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualStatus := bookmark.Status()
+	// then
+	assert.Exactly(t, StatusUnread, actualStatus)
+}
+
+func TestBookmark_Starred(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualStarred := bookmark.Starred()
+	// then
+	assert.False(t, actualStarred)
+}
+
+func TestBookmark_Version(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualVersion := bookmark.Version()
+	// then
+	expectedVersion := uint64(0)
+	assert.Exactly(t, expectedVersion, actualVersion)
+}
+
+func TestBookmark_SetVersion(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	bookmark.SetVersion(3)
+	// then
+	expectedVersion := uint64(3)
+	assert.Exactly(t, expectedVersion, bookmark.version)
+}
+
+func TestBookmark_CreatedAt(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualCreatedAt := bookmark.CreatedAt()
+	// then
+	assert.True(t, actualCreatedAt.IsZero())
+}
+
+func TestBookmark_UpdatedAt(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualUpdatedAt := bookmark.UpdatedAt()
+	// then
+	assert.True(t, actualUpdatedAt.IsZero())
+}
+
+func TestBookmark_SetTimestamps(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	bookmark.SetTimestamps(createdAt, updatedAt)
+	// then
+	assert.Exactly(t, createdAt, bookmark.createdAt)
+	assert.Exactly(t, updatedAt, bookmark.updatedAt)
+}
+
+func TestBookmark_DeletedAt(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	actualDeletedAt := bookmark.DeletedAt()
+	// then
+	assert.True(t, actualDeletedAt.IsZero())
+}
+
+func TestBookmark_IsTrashed(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		deletedAt       time.Time
+		expectedTrashed bool
+	}{
+		"trashed bookmark": {
+			time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+			true,
+		},
+		"active bookmark": {
+			time.Time{},
+			false,
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			bookmark.SetDeletedAt(tc.deletedAt)
+			// when
+			actualTrashed := bookmark.IsTrashed()
+			// then
+			assert.Exactly(t, tc.expectedTrashed, actualTrashed)
+		})
+	}
+}
+
+func TestBookmark_SetDeletedAt(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	deletedAt := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	// given
+	bookmark, _ := NewBookmark(id, name, uri, tags)
+	// when
+	bookmark.SetDeletedAt(deletedAt)
+	// then
+	assert.Exactly(t, deletedAt, bookmark.deletedAt)
+}
+
 func TestBookmark_Rename(t *testing.T) {
 	t.Parallel()
 	id := toId(t, "1")
@@ -286,34 +565,917 @@ func TestBookmark_RewriteURI(t *testing.T) {
 	}
 }
 
-func TestBookmark_DeepCopy(t *testing.T) {
+func TestBookmark_Describe(t *testing.T) {
 	t.Parallel()
 	id := toId(t, "1")
 	name := toName(t, "Example")
 	uri := toUri(t, "https://example.com")
 	tags := toTags(t, "foo", "bar", "baz")
-	t.Run("bookmark", func(t *testing.T) {
-		t.Parallel()
-		// given
-		original, _ := NewBookmark(id, name, uri, tags)
-		// when
-		copy := original.DeepCopy()
-		// then
-		assert.Exactly(t, original, copy)
-		assert.NotSame(t, original, copy)
-	})
-	t.Run("tags pointer", func(t *testing.T) {
-		t.Parallel()
-		// given
-		original, _ := NewBookmark(id, name, uri, tags)
-		copy := original.DeepCopy()
-		x := copy.tags
-		y := original.tags
-		// when
-		same := reflect.ValueOf(x).Pointer() == reflect.ValueOf(y).Pointer()
-		equiv := reflect.DeepEqual(x, y)
-		// then
-		assert.False(t, same)
-		assert.True(t, equiv)
-	})
+	newDescription, _ := NewDescription("Example\nDomain")
+	cases := map[string]struct {
+		description         *Description
+		expectedDescription Description
+		expectedErr         error
+	}{
+		"non-nil description": {
+			newDescription,
+			*newDescription,
+			nil,
+		},
+		"nil description": {
+			nil,
+			Description{},
+			errors.New("argument \"description\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			// when
+			actualErr := bookmark.Describe(tc.description)
+			actualDescription := bookmark.description
+			// then
+			assert.Exactly(t, tc.expectedDescription, actualDescription)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MoveTo(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		current        *ID
+		folder         *ID
+		expectedFolder *ID
+	}{
+		"folder": {
+			nil,
+			toId(t, "10"),
+			toId(t, "10"),
+		},
+		"another folder": {
+			toId(t, "10"),
+			toId(t, "20"),
+			toId(t, "20"),
+		},
+		"top level": {
+			toId(t, "10"),
+			nil,
+			nil,
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			bookmark.MoveTo(tc.current)
+			// when
+			bookmark.MoveTo(tc.folder)
+			actualFolder := bookmark.folder
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			if tc.folder != nil {
+				assert.NotSame(t, tc.folder, actualFolder)
+			}
+		})
+	}
+}
+
+func TestBookmark_MarkRead(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		prepare func(*Bookmark)
+	}{
+		"unread bookmark": {
+			func(b *Bookmark) {},
+		},
+		"read bookmark": {
+			func(b *Bookmark) { b.MarkRead() },
+		},
+		"archived bookmark": {
+			func(b *Bookmark) { b.Archive() },
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			tc.prepare(bookmark)
+			// when
+			bookmark.MarkRead()
+			// then
+			assert.Exactly(t, StatusRead, bookmark.status)
+		})
+	}
+}
+
+func TestBookmark_MarkUnread(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		prepare func(*Bookmark)
+	}{
+		"unread bookmark": {
+			func(b *Bookmark) {},
+		},
+		"read bookmark": {
+			func(b *Bookmark) { b.MarkRead() },
+		},
+		"archived bookmark": {
+			func(b *Bookmark) { b.Archive() },
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			tc.prepare(bookmark)
+			// when
+			bookmark.MarkUnread()
+			// then
+			assert.Exactly(t, StatusUnread, bookmark.status)
+		})
+	}
+}
+
+func TestBookmark_Archive(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		prepare func(*Bookmark)
+	}{
+		"unread bookmark": {
+			func(b *Bookmark) {},
+		},
+		"read bookmark": {
+			func(b *Bookmark) { b.MarkRead() },
+		},
+		"archived bookmark": {
+			func(b *Bookmark) { b.Archive() },
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			tc.prepare(bookmark)
+			// when
+			bookmark.Archive()
+			// then
+			assert.Exactly(t, StatusArchived, bookmark.status)
+		})
+	}
+}
+
+func TestBookmark_Star(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		current bool
+	}{
+		"unstarred bookmark": {
+			false,
+		},
+		"starred bookmark": {
+			true,
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			bookmark.starred = tc.current
+			// when
+			bookmark.Star()
+			// then
+			assert.True(t, bookmark.starred)
+		})
+	}
+}
+
+func TestBookmark_Unstar(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		current bool
+	}{
+		"unstarred bookmark": {
+			false,
+		},
+		"starred bookmark": {
+			true,
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			bookmark.starred = tc.current
+			// when
+			bookmark.Unstar()
+			// then
+			assert.False(t, bookmark.starred)
+		})
+	}
+}
+
+func TestBookmark_AddTags(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	oldTags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		tags         []Tag
+		expectedTags []Tag
+		expectedErr  error
+	}{
+		"new tags": {
+			toTags(t, "baz", "qux"),
+			toTags(t, "foo", "bar", "baz", "qux"),
+			nil,
+		},
+		"existing and duplicate tags": {
+			toTags(t, "bar", "baz", "baz"),
+			toTags(t, "foo", "bar", "baz"),
+			nil,
+		},
+		"empty tags": {
+			toTags(t),
+			toTags(t, "foo", "bar"),
+			nil,
+		},
+		"nil tags": {
+			nil,
+			toTags(t, "foo", "bar"),
+			errors.New("argument \"tags\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, oldTags)
+			// when
+			actualErr := bookmark.AddTags(tc.tags)
+			actualTags := bookmark.tags
+			// then
+			assert.Exactly(t, tc.expectedTags, actualTags)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RemoveTags(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	oldTags := toTags(t, "foo", "bar", "baz")
+	cases := map[string]struct {
+		tags         []Tag
+		expectedTags []Tag
+		expectedErr  error
+	}{
+		"existing tags": {
+			toTags(t, "foo", "baz"),
+			toTags(t, "bar"),
+			nil,
+		},
+		"non-existing tags": {
+			toTags(t, "qux"),
+			toTags(t, "foo", "bar", "baz"),
+			nil,
+		},
+		"all tags": {
+			toTags(t, "foo", "bar", "baz"),
+			toTags(t),
+			nil,
+		},
+		"nil tags": {
+			nil,
+			toTags(t, "foo", "bar", "baz"),
+			errors.New("argument \"tags\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, oldTags)
+			// when
+			actualErr := bookmark.RemoveTags(tc.tags)
+			actualTags := bookmark.tags
+			// then
+			assert.Exactly(t, tc.expectedTags, actualTags)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ReplaceTags(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	oldTags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		tags         []Tag
+		expectedTags []Tag
+		expectedErr  error
+	}{
+		"new tags": {
+			toTags(t, "bar", "baz"),
+			toTags(t, "bar", "baz"),
+			nil,
+		},
+		"duplicate tags": {
+			toTags(t, "baz", "qux", "baz"),
+			toTags(t, "baz", "qux"),
+			nil,
+		},
+		"empty tags": {
+			toTags(t),
+			toTags(t),
+			nil,
+		},
+		"nil tags": {
+			nil,
+			toTags(t, "foo", "bar"),
+			errors.New("argument \"tags\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, oldTags)
+			// when
+			actualErr := bookmark.ReplaceTags(tc.tags)
+			actualTags := bookmark.tags
+			// then
+			assert.Exactly(t, tc.expectedTags, actualTags)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+	t.Run("tags pointer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		bookmark, _ := NewBookmark(id, name, uri, oldTags)
+		tags := toTags(t, "baz", "qux")
+		bookmark.ReplaceTags(tags)
+		x := bookmark.tags
+		y := tags
+		// when
+		same := reflect.ValueOf(x).Pointer() == reflect.ValueOf(y).Pointer()
+		equiv := reflect.DeepEqual(x, y)
+		// then
+		assert.False(t, same)
+		assert.True(t, equiv)
+	})
+}
+
+func TestBookmark_MergeTags(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	oldTags := toTags(t, "golang", "foo", "go-lang", "go")
+	target := toTags(t, "go")[0]
+	cases := map[string]struct {
+		sources      []Tag
+		target       *Tag
+		expectedTags []Tag
+		expectedErr  error
+	}{
+		"2 sources": {
+			toTags(t, "golang", "go-lang"),
+			&target,
+			toTags(t, "go", "foo"),
+			nil,
+		},
+		"missing sources": {
+			toTags(t, "bar"),
+			&target,
+			toTags(t, "golang", "foo", "go-lang", "go"),
+			nil,
+		},
+		"nil sources": {
+			nil,
+			&target,
+			toTags(t, "golang", "foo", "go-lang", "go"),
+			errors.New("argument \"sources\" is nil"),
+		},
+		"nil target": {
+			toTags(t, "golang"),
+			nil,
+			toTags(t, "golang", "foo", "go-lang", "go"),
+			errors.New("argument \"target\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, oldTags)
+			// when
+			actualErr := bookmark.MergeTags(tc.sources, tc.target)
+			actualTags := bookmark.tags
+			// then
+			assert.Exactly(t, tc.expectedTags, actualTags)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Snapshot(t *testing.T) {
+	t.Parallel()
+	// given
+	bookmark, _ := NewBookmark(toId(t, "1"), toName(t, "Example"), toUri(t, "https://example.com"), toTags(t, "foo", "bar"))
+	bookmark.Describe(toDescription(t, "Example Domain"))
+	// when
+	snapshot := bookmark.Snapshot()
+	// then
+	assert.Exactly(t, Snapshot{*toName(t, "Example"), *toUri(t, "https://example.com"), *toDescription(t, "Example Domain"), toTags(t, "foo", "bar"), nil, StatusUnread, false, false}, snapshot)
+}
+
+func TestBookmark_Snapshot_State(t *testing.T) {
+	t.Parallel()
+	// given
+	bookmark, _ := NewBookmark(toId(t, "1"), toName(t, "Example"), toUri(t, "https://example.com"), toTags(t, "foo", "bar"))
+	bookmark.MoveTo(toId(t, "2"))
+	bookmark.Archive()
+	bookmark.Star()
+	bookmark.SetDeletedAt(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	// when
+	snapshot := bookmark.Snapshot()
+	// then
+	assert.Exactly(t, toId(t, "2"), snapshot.Folder())
+	assert.Exactly(t, StatusArchived, snapshot.Status())
+	assert.True(t, snapshot.Starred())
+	assert.True(t, snapshot.IsTrashed())
+}
+
+func TestBookmark_Revert(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		snapshot         *Snapshot
+		expectedSnapshot Snapshot
+		expectedErr      error
+	}{
+		"non-nil snapshot": {
+			toSnapshot(t, "Example Domain", "https://example.org", "baz"),
+			*toSnapshot(t, "Example Domain", "https://example.org", "baz"),
+			nil,
+		},
+		"nil snapshot": {
+			nil,
+			*toSnapshot(t, "Example", "https://example.com", "foo", "bar"),
+			errors.New("argument \"snapshot\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, name, uri, tags)
+			// when
+			actualErr := bookmark.Revert(tc.snapshot)
+			actualSnapshot := bookmark.Snapshot()
+			// then
+			assert.Exactly(t, tc.expectedSnapshot, actualSnapshot)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Revert_State(t *testing.T) {
+	t.Parallel()
+	// given
+	bookmark, _ := NewBookmark(toId(t, "1"), toName(t, "Example"), toUri(t, "https://example.com"), toTags(t, "foo"))
+	bookmark.Archive()
+	bookmark.Star()
+	snapshot := toSnapshot(t, "Example", "https://example.com", "foo")
+	snapshot.SetState(toId(t, "2"), StatusRead, false, true)
+	// when
+	bookmark.Revert(snapshot)
+	// then
+	assert.Exactly(t, toId(t, "2"), bookmark.Folder())
+	assert.Exactly(t, StatusRead, bookmark.Status())
+	assert.False(t, bookmark.Starred())
+	assert.False(t, bookmark.IsTrashed())
+}
+
+func TestBookmark_Revert_Move(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		folder         *ID
+		snapshotFolder *ID
+		expectedFolder *ID
+		expectedEvents []Event
+	}{
+		"moved to another folder": {
+			toId(t, "20"), toId(t, "10"),
+			toId(t, "10"),
+			[]Event{BookmarkMoved{*toId(t, "1"), *toUserId(t, "alice"), toId(t, "20"), toId(t, "10")}},
+		},
+		"moved from top level": {
+			toId(t, "20"), nil,
+			nil,
+			[]Event{BookmarkMoved{*toId(t, "1"), *toUserId(t, "alice"), toId(t, "20"), nil}},
+		},
+		"not moved": {
+			toId(t, "10"), toId(t, "10"),
+			toId(t, "10"),
+			[]Event{},
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := RegisterBookmark(toId(t, "1"), toUserId(t, "alice"), toName(t, "Example"), toUri(t, "https://example.com"), &Description{}, toTags(t, "foo"))
+			bookmark.MoveTo(tc.folder)
+			bookmark.PullEvents()
+			snapshot := bookmark.Snapshot()
+			snapshot.SetState(tc.snapshotFolder, StatusUnread, false, false)
+			// when
+			bookmark.Revert(&snapshot)
+			// then
+			assert.Exactly(t, tc.expectedFolder, bookmark.Folder())
+			assert.Exactly(t, tc.expectedEvents, bookmark.PullEvents())
+		})
+	}
+}
+
+func TestBookmark_DeepCopy(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo", "bar", "baz")
+	t.Run("bookmark", func(t *testing.T) {
+		t.Parallel()
+		// given
+		original, _ := NewBookmark(id, name, uri, tags)
+		// when
+		copy := original.DeepCopy()
+		// then
+		assert.Exactly(t, original, copy)
+		assert.NotSame(t, original, copy)
+	})
+	t.Run("tags pointer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		original, _ := NewBookmark(id, name, uri, tags)
+		copy := original.DeepCopy()
+		x := copy.tags
+		y := original.tags
+		// when
+		same := reflect.ValueOf(x).Pointer() == reflect.ValueOf(y).Pointer()
+		equiv := reflect.DeepEqual(x, y)
+		// then
+		assert.False(t, same)
+		assert.True(t, equiv)
+	})
+	t.Run("folder pointer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		original, _ := NewBookmark(id, name, uri, tags)
+		original.MoveTo(toId(t, "10"))
+		// when
+		copy := original.DeepCopy()
+		// then
+		assert.Exactly(t, original.folder, copy.folder)
+		assert.NotSame(t, original.folder, copy.folder)
+	})
+	t.Run("events pointer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		original, _ := RegisterBookmark(id, toUserId(t, "alice"), name, uri, &Description{}, tags)
+		copy := original.DeepCopy()
+		x := copy.events
+		y := original.events
+		// when
+		same := reflect.ValueOf(x).Pointer() == reflect.ValueOf(y).Pointer()
+		equiv := reflect.DeepEqual(x, y)
+		// then
+		assert.False(t, same)
+		assert.True(t, equiv)
+	})
+}
+
+func TestRegisterBookmark(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	description := toDescription(t, "Example\nDomain")
+	tags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		id               *ID
+		userID           *UserID
+		name             *Name
+		uri              *URI
+		description      *Description
+		tags             []Tag
+		expectedBookmark *Bookmark
+		expectedErr      error
+	}{
+		"non-nil arguments": {
+			id,
+			userID,
+			name,
+			uri,
+			description,
+			tags,
+			&Bookmark{*id, *userID, *name, *uri, tags, *description, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, []Event{BookmarkRegistered{*id, *userID, *name, *uri, *description, tags}}},
+			nil,
+		},
+		"empty description": {
+			id,
+			userID,
+			name,
+			uri,
+			&Description{},
+			tags,
+			&Bookmark{*id, *userID, *name, *uri, tags, Description{}, nil, StatusUnread, false, 0, time.Time{}, time.Time{}, time.Time{}, []Event{BookmarkRegistered{*id, *userID, *name, *uri, Description{}, tags}}},
+			nil,
+		},
+		"nil id": {
+			nil,
+			userID,
+			name,
+			uri,
+			description,
+			tags,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil userID": {
+			id,
+			nil,
+			name,
+			uri,
+			description,
+			tags,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil description": {
+			id,
+			userID,
+			name,
+			uri,
+			nil,
+			tags,
+			nil,
+			errors.New("argument \"description\" is nil"),
+		},
+		"nil tags": {
+			id,
+			userID,
+			name,
+			uri,
+			description,
+			nil,
+			nil,
+			errors.New("argument \"tags\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualBookmark, actualErr := RegisterBookmark(tc.id, tc.userID, tc.name, tc.uri, tc.description, tc.tags)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Events(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	oldName := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo")
+	cases := map[string]struct {
+		mutate         func(*Bookmark)
+		expectedEvents []Event
+	}{
+		"no mutation": {
+			func(b *Bookmark) {},
+			[]Event{},
+		},
+		"rename": {
+			func(b *Bookmark) { b.Rename(toName(t, "EXAMPLE")) },
+			[]Event{BookmarkRenamed{*id, *userID, *oldName, *toName(t, "EXAMPLE")}},
+		},
+		"rename to same name": {
+			func(b *Bookmark) { b.Rename(toName(t, "Example")) },
+			[]Event{},
+		},
+		"rewrite uri": {
+			func(b *Bookmark) { b.RewriteURI(toUri(t, "http://example.com")) },
+			[]Event{BookmarkURIRewritten{*id, *userID, *uri, *toUri(t, "http://example.com")}},
+		},
+		"rewrite uri to same uri": {
+			func(b *Bookmark) { b.RewriteURI(toUri(t, "https://example.com")) },
+			[]Event{},
+		},
+		"add tags": {
+			func(b *Bookmark) { b.AddTags(toTags(t, "foo", "bar")) },
+			[]Event{BookmarkTagged{*id, *userID, toTags(t, "bar")}},
+		},
+		"add existing tags": {
+			func(b *Bookmark) { b.AddTags(toTags(t, "foo")) },
+			[]Event{},
+		},
+		"remove tags": {
+			func(b *Bookmark) { b.RemoveTags(toTags(t, "foo")) },
+			[]Event{BookmarkUntagged{*id, *userID, toTags(t, "foo")}},
+		},
+		"remove missing tags": {
+			func(b *Bookmark) { b.RemoveTags(toTags(t, "bar")) },
+			[]Event{},
+		},
+		"merge tags": {
+			func(b *Bookmark) { b.MergeTags(toTags(t, "foo"), &toTags(t, "bar")[0]) },
+			[]Event{BookmarkTagged{*id, *userID, toTags(t, "bar")}, BookmarkUntagged{*id, *userID, toTags(t, "foo")}},
+		},
+		"replace tags": {
+			func(b *Bookmark) { b.ReplaceTags(toTags(t, "bar", "baz")) },
+			[]Event{BookmarkTagged{*id, *userID, toTags(t, "bar", "baz")}, BookmarkUntagged{*id, *userID, toTags(t, "foo")}},
+		},
+		"describe": {
+			func(b *Bookmark) { b.Describe(toDescription(t, "Example Domain")) },
+			[]Event{BookmarkDescribed{*id, *userID, Description{}, *toDescription(t, "Example Domain")}},
+		},
+		"describe with same description": {
+			func(b *Bookmark) { b.Describe(toDescription(t, "")) },
+			[]Event{},
+		},
+		"move to folder": {
+			func(b *Bookmark) { b.MoveTo(toId(t, "10")) },
+			[]Event{BookmarkMoved{*id, *userID, nil, toId(t, "10")}},
+		},
+		"move to same folder": {
+			func(b *Bookmark) { b.MoveTo(nil) },
+			[]Event{},
+		},
+		"mark read": {
+			func(b *Bookmark) { b.MarkRead() },
+			[]Event{BookmarkStatusChanged{*id, *userID, StatusUnread, StatusRead}},
+		},
+		"mark unread": {
+			func(b *Bookmark) {
+				b.Archive()
+				b.MarkUnread()
+			},
+			[]Event{
+				BookmarkStatusChanged{*id, *userID, StatusUnread, StatusArchived},
+				BookmarkStatusChanged{*id, *userID, StatusArchived, StatusUnread},
+			},
+		},
+		"mark unread without change": {
+			func(b *Bookmark) { b.MarkUnread() },
+			[]Event{},
+		},
+		"archive": {
+			func(b *Bookmark) { b.Archive() },
+			[]Event{BookmarkStatusChanged{*id, *userID, StatusUnread, StatusArchived}},
+		},
+		"archive twice": {
+			func(b *Bookmark) {
+				b.Archive()
+				b.Archive()
+			},
+			[]Event{BookmarkStatusChanged{*id, *userID, StatusUnread, StatusArchived}},
+		},
+		"star": {
+			func(b *Bookmark) { b.Star() },
+			[]Event{BookmarkStarred{*id, *userID}},
+		},
+		"unstar": {
+			func(b *Bookmark) {
+				b.Star()
+				b.Unstar()
+			},
+			[]Event{BookmarkStarred{*id, *userID}, BookmarkUnstarred{*id, *userID}},
+		},
+		"unstar without star": {
+			func(b *Bookmark) { b.Unstar() },
+			[]Event{},
+		},
+		"revert": {
+			func(b *Bookmark) { b.Revert(toSnapshot(t, "EXAMPLE", "http://example.com", "foo", "bar")) },
+			[]Event{
+				BookmarkRenamed{*id, *userID, *oldName, *toName(t, "EXAMPLE")},
+				BookmarkURIRewritten{*id, *userID, *uri, *toUri(t, "http://example.com")},
+				BookmarkTagged{*id, *userID, toTags(t, "bar")},
+			},
+		},
+		"delete": {
+			func(b *Bookmark) { b.Delete() },
+			[]Event{BookmarkDeleted{*id, *userID}},
+		},
+		"restore": {
+			func(b *Bookmark) { b.Restore() },
+			[]Event{BookmarkRestored{*id, *userID}},
+		},
+		"purge": {
+			func(b *Bookmark) { b.Purge() },
+			[]Event{BookmarkPurged{*id, *userID}},
+		},
+		"multiple mutations": {
+			func(b *Bookmark) {
+				b.Rename(toName(t, "EXAMPLE"))
+				b.Delete()
+			},
+			[]Event{BookmarkRenamed{*id, *userID, *oldName, *toName(t, "EXAMPLE")}, BookmarkDeleted{*id, *userID}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			bookmark, _ := NewBookmark(id, oldName, uri, tags)
+			bookmark.AssignTo(userID)
+			tc.mutate(bookmark)
+			// when
+			actualEvents := bookmark.Events()
+			// then
+			assert.Exactly(t, tc.expectedEvents, actualEvents)
+		})
+	}
+}
+
+func TestBookmark_PullEvents(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	tags := toTags(t, "foo")
+	userID := toUserId(t, "alice")
+	// given
+	bookmark, _ := RegisterBookmark(id, userID, name, uri, &Description{}, tags)
+	bookmark.Delete()
+	// when
+	actualEvents := bookmark.PullEvents()
+	remainingEvents := bookmark.Events()
+	// then
+	assert.Exactly(t, []Event{BookmarkRegistered{*id, *userID, *name, *uri, Description{}, tags}, BookmarkDeleted{*id, *userID}}, actualEvents)
+	assert.Exactly(t, []Event{}, remainingEvents)
+	assert.Nil(t, bookmark.events)
 }
@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// 配信に失敗したWebhookの通知を表すエンティティ。
+//
+// 再試行の上限に達した通知をデッドレターとして記録する。
+type DeadLetter struct {
+	id         ID        // ID (配信ID)
+	userID     UserID    // 配信先のWebhookの所有者のユーザID
+	webhookID  ID        // 配信先のWebhookのID
+	eventName  string    // イベント名
+	bookmarkID ID        // イベントが起きたブックマークのID
+	payload    []byte    // 送信したペイロード
+	attempts   int       // 試行回数
+	lastError  string    // 最後の試行で発生したエラー
+	createdAt  time.Time // 作成日時
+}
+
+// 配信に失敗したWebhookの通知を表すエンティティを生成する。
+//
+// nilを指定した場合はエラーを返却する。
+// 試行回数が1未満の場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+func NewDeadLetter(id *ID, userID *UserID, webhookID *ID, eventName string, bookmarkID *ID, payload []byte, attempts int, lastError string) (*DeadLetter, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if webhookID == nil {
+		return nil, fmt.Errorf("argument \"webhookID\" is nil")
+	}
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("argument \"payload\" is nil")
+	}
+	if attempts < 1 {
+		return nil, fmt.Errorf("attempts less than 1: %d", attempts)
+	}
+	return &DeadLetter{*id, *userID, *webhookID, eventName, *bookmarkID, append([]byte{}, payload...), attempts, lastError, time.Time{}}, nil
+}
+
+// フィールド id を取得する。
+func (d *DeadLetter) ID() ID {
+	return d.id
+}
+
+// フィールド userID を取得する。
+func (d *DeadLetter) UserID() UserID {
+	return d.userID
+}
+
+// フィールド webhookID を取得する。
+func (d *DeadLetter) WebhookID() ID {
+	return d.webhookID
+}
+
+// フィールド eventName を取得する。
+func (d *DeadLetter) EventName() string {
+	return d.eventName
+}
+
+// フィールド bookmarkID を取得する。
+func (d *DeadLetter) BookmarkID() ID {
+	return d.bookmarkID
+}
+
+// フィールド payload を取得する。
+//
+// 複製したスライスを返却する。
+func (d *DeadLetter) Payload() []byte {
+	return append([]byte{}, d.payload...)
+}
+
+// フィールド attempts を取得する。
+func (d *DeadLetter) Attempts() int {
+	return d.attempts
+}
+
+// フィールド lastError を取得する。
+func (d *DeadLetter) LastError() string {
+	return d.lastError
+}
+
+// フィールド createdAt を取得する。
+//
+// 永続化されていない場合はゼロ値を返却する。
+func (d *DeadLetter) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// フィールド createdAt を設定する。
+//
+// リポジトリが永続化した日時を反映するために用いる。
+func (d *DeadLetter) SetCreatedAt(createdAt time.Time) {
+	d.createdAt = createdAt
+}
+
+// インスタンスをディープコピーする。
+func (d DeadLetter) DeepCopy() *DeadLetter {
+	copy := &d
+	copy.payload = append([]byte{}, d.payload...)
+	return copy
+}
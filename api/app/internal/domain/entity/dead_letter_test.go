@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeadLetter(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "100")
+	userID := toUserId(t, "alice")
+	webhookID := toId(t, "10")
+	bookmarkID := toId(t, "1")
+	payload := []byte(`{"event":"BookmarkDeleted"}`)
+	cases := map[string]struct {
+		id                 *ID
+		userID             *UserID
+		webhookID          *ID
+		bookmarkID         *ID
+		payload            []byte
+		attempts           int
+		expectedDeadLetter *DeadLetter
+		expectedErr        error
+	}{
+		"valid arguments": {
+			id, userID, webhookID, bookmarkID, payload, 5,
+			&DeadLetter{*id, *userID, *webhookID, EventBookmarkDeleted, *bookmarkID, payload, 5, "status 500", time.Time{}},
+			nil,
+		},
+		"nil id": {
+			nil, userID, webhookID, bookmarkID, payload, 5,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil user id": {
+			id, nil, webhookID, bookmarkID, payload, 5,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil webhook id": {
+			id, userID, nil, bookmarkID, payload, 5,
+			nil,
+			errors.New("argument \"webhookID\" is nil"),
+		},
+		"nil bookmark id": {
+			id, userID, webhookID, nil, payload, 5,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil payload": {
+			id, userID, webhookID, bookmarkID, nil, 5,
+			nil,
+			errors.New("argument \"payload\" is nil"),
+		},
+		"zero attempts": {
+			id, userID, webhookID, bookmarkID, payload, 0,
+			nil,
+			errors.New("attempts less than 1: 0"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualDeadLetter, actualErr := NewDeadLetter(tc.id, tc.userID, tc.webhookID, EventBookmarkDeleted, tc.bookmarkID, tc.payload, tc.attempts, "status 500")
+			// then
+			assert.Exactly(t, tc.expectedDeadLetter, actualDeadLetter)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDeadLetter_Accessors(t *testing.T) {
+	t.Parallel()
+	// given
+	deadLetter, _ := NewDeadLetter(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 5, "status 500")
+	// when
+	deadLetter.SetCreatedAt(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	// then
+	assert.Exactly(t, *toId(t, "100"), deadLetter.ID())
+	assert.Exactly(t, *toUserId(t, "alice"), deadLetter.UserID())
+	assert.Exactly(t, *toId(t, "10"), deadLetter.WebhookID())
+	assert.Exactly(t, EventBookmarkDeleted, deadLetter.EventName())
+	assert.Exactly(t, *toId(t, "1"), deadLetter.BookmarkID())
+	assert.Exactly(t, []byte(`{}`), deadLetter.Payload())
+	assert.Exactly(t, 5, deadLetter.Attempts())
+	assert.Exactly(t, "status 500", deadLetter.LastError())
+	assert.Exactly(t, time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), deadLetter.CreatedAt())
+}
+
+func TestDeadLetter_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewDeadLetter(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 5, "status 500")
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, &original.payload[0], &copy.payload[0])
+}
@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// 配信待ちのWebhookの通知を表すエンティティ。
+//
+// 送信箱から読み出したドメインイベントを購読ごとの通知として記録し、配信を終えるかデッドレターとして記録するまで保持する。
+type Delivery struct {
+	id            ID        // ID (配信ID)
+	userID        UserID    // 配信先のWebhookの所有者のユーザID
+	webhookID     ID        // 配信先のWebhookのID
+	eventName     string    // イベント名
+	bookmarkID    ID        // イベントが起きたブックマークのID
+	payload       []byte    // 送信するペイロード
+	attempts      int       // 試行回数
+	lastError     string    // 最後の試行で発生したエラー
+	nextAttemptAt time.Time // 次に試行する日時
+}
+
+// 配信待ちのWebhookの通知を表すエンティティを生成する。
+//
+// nilを指定した場合はエラーを返却する。
+// 試行回数が0未満の場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+func NewDelivery(id *ID, userID *UserID, webhookID *ID, eventName string, bookmarkID *ID, payload []byte, attempts int, lastError string, nextAttemptAt time.Time) (*Delivery, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if webhookID == nil {
+		return nil, fmt.Errorf("argument \"webhookID\" is nil")
+	}
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("argument \"payload\" is nil")
+	}
+	if attempts < 0 {
+		return nil, fmt.Errorf("attempts less than 0: %d", attempts)
+	}
+	return &Delivery{*id, *userID, *webhookID, eventName, *bookmarkID, append([]byte{}, payload...), attempts, lastError, nextAttemptAt}, nil
+}
+
+// フィールド id を取得する。
+func (d *Delivery) ID() ID {
+	return d.id
+}
+
+// フィールド userID を取得する。
+func (d *Delivery) UserID() UserID {
+	return d.userID
+}
+
+// フィールド webhookID を取得する。
+func (d *Delivery) WebhookID() ID {
+	return d.webhookID
+}
+
+// フィールド eventName を取得する。
+func (d *Delivery) EventName() string {
+	return d.eventName
+}
+
+// フィールド bookmarkID を取得する。
+func (d *Delivery) BookmarkID() ID {
+	return d.bookmarkID
+}
+
+// フィールド payload を取得する。
+//
+// 複製したスライスを返却する。
+func (d *Delivery) Payload() []byte {
+	return append([]byte{}, d.payload...)
+}
+
+// フィールド attempts を取得する。
+func (d *Delivery) Attempts() int {
+	return d.attempts
+}
+
+// フィールド lastError を取得する。
+func (d *Delivery) LastError() string {
+	return d.lastError
+}
+
+// フィールド nextAttemptAt を取得する。
+func (d *Delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+// 次に試行する日時を期限まで延ばす。
+//
+// 配信ワーカーが取り出した通知を期限まで他の配信ワーカーに取り出させないために用いる。
+func (d *Delivery) Lease(until time.Time) {
+	d.nextAttemptAt = until
+}
+
+// 試行の失敗を記録する。
+//
+// 試行回数を1増やし、発生したエラーと次に試行する日時を設定する。
+func (d *Delivery) Fail(lastError string, nextAttemptAt time.Time) {
+	d.attempts++
+	d.lastError = lastError
+	d.nextAttemptAt = nextAttemptAt
+}
+
+// 再試行を打ち切った通知をデッドレターに変換する。
+//
+// 試行回数が1未満の場合はエラーを返却する。
+func (d *Delivery) ToDeadLetter() (*DeadLetter, error) {
+	return NewDeadLetter(&d.id, &d.userID, &d.webhookID, d.eventName, &d.bookmarkID, d.payload, d.attempts, d.lastError)
+}
+
+// インスタンスをディープコピーする。
+func (d Delivery) DeepCopy() *Delivery {
+	copy := &d
+	copy.payload = append([]byte{}, d.payload...)
+	return copy
+}
@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDelivery(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "100")
+	userID := toUserId(t, "alice")
+	webhookID := toId(t, "10")
+	bookmarkID := toId(t, "1")
+	payload := []byte(`{"event":"BookmarkDeleted"}`)
+	nextAttemptAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		id               *ID
+		userID           *UserID
+		webhookID        *ID
+		bookmarkID       *ID
+		payload          []byte
+		attempts         int
+		expectedDelivery *Delivery
+		expectedErr      error
+	}{
+		"valid arguments": {
+			id, userID, webhookID, bookmarkID, payload, 2,
+			&Delivery{*id, *userID, *webhookID, EventBookmarkDeleted, *bookmarkID, payload, 2, "status 500", nextAttemptAt},
+			nil,
+		},
+		"zero attempts": {
+			id, userID, webhookID, bookmarkID, payload, 0,
+			&Delivery{*id, *userID, *webhookID, EventBookmarkDeleted, *bookmarkID, payload, 0, "status 500", nextAttemptAt},
+			nil,
+		},
+		"nil id": {
+			nil, userID, webhookID, bookmarkID, payload, 0,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil user id": {
+			id, nil, webhookID, bookmarkID, payload, 0,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil webhook id": {
+			id, userID, nil, bookmarkID, payload, 0,
+			nil,
+			errors.New("argument \"webhookID\" is nil"),
+		},
+		"nil bookmark id": {
+			id, userID, webhookID, nil, payload, 0,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil payload": {
+			id, userID, webhookID, bookmarkID, nil, 0,
+			nil,
+			errors.New("argument \"payload\" is nil"),
+		},
+		"negative attempts": {
+			id, userID, webhookID, bookmarkID, payload, -1,
+			nil,
+			errors.New("attempts less than 0: -1"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualDelivery, actualErr := NewDelivery(tc.id, tc.userID, tc.webhookID, EventBookmarkDeleted, tc.bookmarkID, tc.payload, tc.attempts, "status 500", nextAttemptAt)
+			// then
+			assert.Exactly(t, tc.expectedDelivery, actualDelivery)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDelivery_Accessors(t *testing.T) {
+	t.Parallel()
+	// given
+	nextAttemptAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	// when
+	delivery, _ := NewDelivery(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 2, "status 500", nextAttemptAt)
+	// then
+	assert.Exactly(t, *toId(t, "100"), delivery.ID())
+	assert.Exactly(t, *toUserId(t, "alice"), delivery.UserID())
+	assert.Exactly(t, *toId(t, "10"), delivery.WebhookID())
+	assert.Exactly(t, EventBookmarkDeleted, delivery.EventName())
+	assert.Exactly(t, *toId(t, "1"), delivery.BookmarkID())
+	assert.Exactly(t, []byte(`{}`), delivery.Payload())
+	assert.Exactly(t, 2, delivery.Attempts())
+	assert.Exactly(t, "status 500", delivery.LastError())
+	assert.Exactly(t, nextAttemptAt, delivery.NextAttemptAt())
+}
+
+func TestDelivery_Lease(t *testing.T) {
+	t.Parallel()
+	// given
+	delivery, _ := NewDelivery(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 1, "status 500", time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	until := time.Date(2022, 1, 2, 0, 1, 0, 0, time.UTC)
+	// when
+	delivery.Lease(until)
+	// then
+	assert.Exactly(t, 1, delivery.Attempts())
+	assert.Exactly(t, "status 500", delivery.LastError())
+	assert.Exactly(t, until, delivery.NextAttemptAt())
+}
+
+func TestDelivery_Fail(t *testing.T) {
+	t.Parallel()
+	// given
+	delivery, _ := NewDelivery(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 0, "", time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	nextAttemptAt := time.Date(2022, 1, 2, 0, 0, 1, 0, time.UTC)
+	// when
+	delivery.Fail("status 502", nextAttemptAt)
+	// then
+	assert.Exactly(t, 1, delivery.Attempts())
+	assert.Exactly(t, "status 502", delivery.LastError())
+	assert.Exactly(t, nextAttemptAt, delivery.NextAttemptAt())
+}
+
+func TestDelivery_ToDeadLetter(t *testing.T) {
+	t.Parallel()
+	t.Run("attempted delivery", func(t *testing.T) {
+		t.Parallel()
+		// given
+		delivery, _ := NewDelivery(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 5, "status 500", time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+		// when
+		actualDeadLetter, actualErr := delivery.ToDeadLetter()
+		// then
+		expectedDeadLetter, _ := NewDeadLetter(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 5, "status 500")
+		assert.Exactly(t, expectedDeadLetter, actualDeadLetter)
+		assert.NoError(t, actualErr)
+	})
+	t.Run("unattempted delivery", func(t *testing.T) {
+		t.Parallel()
+		// given
+		delivery, _ := NewDelivery(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 0, "", time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+		// when
+		actualDeadLetter, actualErr := delivery.ToDeadLetter()
+		// then
+		assert.Nil(t, actualDeadLetter)
+		assert.EqualError(t, actualErr, "attempts less than 1: 0")
+	})
+}
+
+func TestDelivery_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewDelivery(toId(t, "100"), toUserId(t, "alice"), toId(t, "10"), EventBookmarkDeleted, toId(t, "1"), []byte(`{}`), 2, "status 500", time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, &original.payload[0], &copy.payload[0])
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 説明の最大文字数。
+const MaxDescriptionLength = 2000
+
+// ブックマークの説明を表す値オブジェクト。
+type Description struct {
+	value string
+}
+
+// 説明を検証する。
+//
+// ブックマーク名と異なり改行とタブを許可する。
+func validateDescription(s string) error {
+	if n := utf8.RuneCountInString(s); n > MaxDescriptionLength {
+		return fmt.Errorf("string length exceeds %d: %d", MaxDescriptionLength, n)
+	}
+	for i, r := range s {
+		if r == '\n' || r == '\r' || r == '\t' {
+			continue
+		}
+		if unicode.IsControl(r) {
+			return fmt.Errorf("contains control character: %U (index: %d)", r, i)
+		}
+	}
+	return nil
+}
+
+// ブックマークの説明を表す値オブジェクトを生成する。
+//
+// 文字列長が0の場合は説明なしとみなす。
+//
+// 文字数が MaxDescriptionLength を超える場合はエラーを返却する。
+// 改行とタブ以外の制御文字を含む場合はエラーを返却する。
+func NewDescription(v string) (*Description, error) {
+	if err := validateDescription(v); err != nil {
+		return nil, err
+	}
+	return &Description{v}, nil
+}
+
+// 値を取得する。
+func (description *Description) Value() string {
+	return description.value
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDescription(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		v                   string
+		expectedDescription *Description
+		expectedErr         error
+	}{
+		"non-empty string": {
+			"Hello, 世界",
+			&Description{"Hello, 世界"},
+			nil,
+		},
+		"empty string": {
+			"",
+			&Description{""},
+			nil,
+		},
+		"contains newline and tab": {
+			"Hello,\r\n\t世界",
+			&Description{"Hello,\r\n\t世界"},
+			nil,
+		},
+		"maximum length": {
+			strings.Repeat("世", MaxDescriptionLength),
+			&Description{strings.Repeat("世", MaxDescriptionLength)},
+			nil,
+		},
+		"exceeds maximum length": {
+			strings.Repeat("世", MaxDescriptionLength+1),
+			nil,
+			errors.New("string length exceeds 2000: 2001"),
+		},
+		"contains control character": {
+			"Hello,\u0000世界",
+			nil,
+			errors.New("contains control character: U+0000 (index: 6)"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualDescription, actualErr := NewDescription(tc.v)
+			// then
+			assert.Exactly(t, tc.expectedDescription, actualDescription)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDescription_Equals(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		xv            string
+		yv            string
+		expectedSame  bool
+		expectedEquiv bool
+	}{
+		"equivalent value": {
+			"Hello,\n世界",
+			"Hello,\n世界",
+			false,
+			true,
+		},
+		"non-equivalent value": {
+			"Hello,\n世界",
+			"Hello,\nWorld",
+			false,
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			x, _ := NewDescription(tc.xv)
+			y, _ := NewDescription(tc.yv)
+			// when
+			actualSame := x == y
+			actualEquiv := *x == *y
+			// then
+			assert.Exactly(t, tc.expectedSame, actualSame)
+			assert.Exactly(t, tc.expectedEquiv, actualEquiv)
+		})
+	}
+}
+
+func TestDescription_Value(t *testing.T) {
+	t.Parallel()
+	// given
+	description, _ := NewDescription("Hello,\n世界")
+	// when
+	actualValue := description.Value()
+	// then
+	expectedValue := "Hello,\n世界"
+	assert.Exactly(t, expectedValue, actualValue)
+}
@@ -0,0 +1,662 @@
+package entity
+
+import (
+	"fmt"
+)
+
+// イベント名。
+const (
+	EventBookmarkRegistered    = "BookmarkRegistered"    // ブックマークが登録された
+	EventBookmarkRenamed       = "BookmarkRenamed"       // ブックマーク名が変更された
+	EventBookmarkURIRewritten  = "BookmarkURIRewritten"  // URIが書き換えられた
+	EventBookmarkTagged        = "BookmarkTagged"        // タグが付与された
+	EventBookmarkDeleted       = "BookmarkDeleted"       // ゴミ箱に移動された
+	EventBookmarkUntagged      = "BookmarkUntagged"      // タグが外された
+	EventBookmarkDescribed     = "BookmarkDescribed"     // 説明が変更された
+	EventBookmarkMoved         = "BookmarkMoved"         // 所属するフォルダが変更された
+	EventBookmarkStatusChanged = "BookmarkStatusChanged" // 状態が変更された
+	EventBookmarkStarred       = "BookmarkStarred"       // お気に入りに登録された
+	EventBookmarkUnstarred     = "BookmarkUnstarred"     // お気に入りから外された
+	EventBookmarkRestored      = "BookmarkRestored"      // ゴミ箱から復元された
+	EventBookmarkPurged        = "BookmarkPurged"        // 完全に削除された
+)
+
+// ブックマークに起きた出来事を表すドメインイベントのインターフェース。
+//
+// 集約の変更時に記録され、永続化に成功した後に発行される。
+// 永続化したドメインイベントは各イベントの New で始まる関数で復元する。
+type Event interface {
+	// イベント名を取得する。
+	EventName() string
+
+	// イベントが起きたブックマークのIDを取得する。
+	BookmarkID() ID
+
+	// イベントが起きたブックマークの所有者のユーザIDを取得する。
+	UserID() UserID
+}
+
+// ブックマークが登録されたことを表すドメインイベント。
+type BookmarkRegistered struct {
+	bookmarkID  ID          // ブックマークのID
+	userID      UserID      // ブックマークの所有者のユーザID
+	name        Name        // ブックマーク名
+	uri         URI         // URI
+	description Description // 説明
+	tags        []Tag       // タグ一覧
+}
+
+// ブックマークが登録されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+func NewBookmarkRegistered(bookmarkID *ID, userID *UserID, name *Name, uri *URI, description *Description, tags []Tag) (*BookmarkRegistered, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if name == nil {
+		return nil, fmt.Errorf("argument \"name\" is nil")
+	}
+	if uri == nil {
+		return nil, fmt.Errorf("argument \"uri\" is nil")
+	}
+	if description == nil {
+		return nil, fmt.Errorf("argument \"description\" is nil")
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("argument \"tags\" is nil")
+	}
+	return &BookmarkRegistered{*bookmarkID, *userID, *name, *uri, *description, append([]Tag{}, tags...)}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkRegistered) EventName() string {
+	return EventBookmarkRegistered
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkRegistered) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkRegistered) UserID() UserID {
+	return e.userID
+}
+
+// フィールド name を取得する。
+func (e BookmarkRegistered) Name() Name {
+	return e.name
+}
+
+// フィールド uri を取得する。
+func (e BookmarkRegistered) URI() URI {
+	return e.uri
+}
+
+// フィールド description を取得する。
+func (e BookmarkRegistered) Description() Description {
+	return e.description
+}
+
+// フィールド tags を取得する。
+//
+// 複製したスライスを返却する。
+func (e BookmarkRegistered) Tags() []Tag {
+	return append([]Tag{}, e.tags...)
+}
+
+// ブックマーク名が変更されたことを表すドメインイベント。
+type BookmarkRenamed struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+	before     Name   // 変更前のブックマーク名
+	after      Name   // 変更後のブックマーク名
+}
+
+// ブックマーク名が変更されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkRenamed(bookmarkID *ID, userID *UserID, before *Name, after *Name) (*BookmarkRenamed, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if before == nil {
+		return nil, fmt.Errorf("argument \"before\" is nil")
+	}
+	if after == nil {
+		return nil, fmt.Errorf("argument \"after\" is nil")
+	}
+	return &BookmarkRenamed{*bookmarkID, *userID, *before, *after}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkRenamed) EventName() string {
+	return EventBookmarkRenamed
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkRenamed) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkRenamed) UserID() UserID {
+	return e.userID
+}
+
+// フィールド before を取得する。
+func (e BookmarkRenamed) Before() Name {
+	return e.before
+}
+
+// フィールド after を取得する。
+func (e BookmarkRenamed) After() Name {
+	return e.after
+}
+
+// URIが書き換えられたことを表すドメインイベント。
+type BookmarkURIRewritten struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+	before     URI    // 変更前のURI
+	after      URI    // 変更後のURI
+}
+
+// URIが書き換えられたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkURIRewritten(bookmarkID *ID, userID *UserID, before *URI, after *URI) (*BookmarkURIRewritten, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if before == nil {
+		return nil, fmt.Errorf("argument \"before\" is nil")
+	}
+	if after == nil {
+		return nil, fmt.Errorf("argument \"after\" is nil")
+	}
+	return &BookmarkURIRewritten{*bookmarkID, *userID, *before, *after}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkURIRewritten) EventName() string {
+	return EventBookmarkURIRewritten
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkURIRewritten) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkURIRewritten) UserID() UserID {
+	return e.userID
+}
+
+// フィールド before を取得する。
+func (e BookmarkURIRewritten) Before() URI {
+	return e.before
+}
+
+// フィールド after を取得する。
+func (e BookmarkURIRewritten) After() URI {
+	return e.after
+}
+
+// タグが付与されたことを表すドメインイベント。
+type BookmarkTagged struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+	tags       []Tag  // 新たに付与されたタグ一覧
+}
+
+// タグが付与されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+func NewBookmarkTagged(bookmarkID *ID, userID *UserID, tags []Tag) (*BookmarkTagged, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("argument \"tags\" is nil")
+	}
+	return &BookmarkTagged{*bookmarkID, *userID, append([]Tag{}, tags...)}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkTagged) EventName() string {
+	return EventBookmarkTagged
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkTagged) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkTagged) UserID() UserID {
+	return e.userID
+}
+
+// フィールド tags を取得する。
+//
+// 複製したスライスを返却する。
+func (e BookmarkTagged) Tags() []Tag {
+	return append([]Tag{}, e.tags...)
+}
+
+// ブックマークがゴミ箱に移動されたことを表すドメインイベント。
+type BookmarkDeleted struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+}
+
+// ブックマークがゴミ箱に移動されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkDeleted(bookmarkID *ID, userID *UserID) (*BookmarkDeleted, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	return &BookmarkDeleted{*bookmarkID, *userID}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkDeleted) EventName() string {
+	return EventBookmarkDeleted
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkDeleted) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkDeleted) UserID() UserID {
+	return e.userID
+}
+
+// タグが外されたことを表すドメインイベント。
+type BookmarkUntagged struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+	tags       []Tag  // 外されたタグ一覧
+}
+
+// タグが外されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+func NewBookmarkUntagged(bookmarkID *ID, userID *UserID, tags []Tag) (*BookmarkUntagged, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("argument \"tags\" is nil")
+	}
+	return &BookmarkUntagged{*bookmarkID, *userID, append([]Tag{}, tags...)}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkUntagged) EventName() string {
+	return EventBookmarkUntagged
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkUntagged) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkUntagged) UserID() UserID {
+	return e.userID
+}
+
+// フィールド tags を取得する。
+//
+// 複製したスライスを返却する。
+func (e BookmarkUntagged) Tags() []Tag {
+	return append([]Tag{}, e.tags...)
+}
+
+// 説明が変更されたことを表すドメインイベント。
+type BookmarkDescribed struct {
+	bookmarkID ID          // ブックマークのID
+	userID     UserID      // ブックマークの所有者のユーザID
+	before     Description // 変更前の説明
+	after      Description // 変更後の説明
+}
+
+// 説明が変更されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkDescribed(bookmarkID *ID, userID *UserID, before *Description, after *Description) (*BookmarkDescribed, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if before == nil {
+		return nil, fmt.Errorf("argument \"before\" is nil")
+	}
+	if after == nil {
+		return nil, fmt.Errorf("argument \"after\" is nil")
+	}
+	return &BookmarkDescribed{*bookmarkID, *userID, *before, *after}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkDescribed) EventName() string {
+	return EventBookmarkDescribed
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkDescribed) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkDescribed) UserID() UserID {
+	return e.userID
+}
+
+// フィールド before を取得する。
+func (e BookmarkDescribed) Before() Description {
+	return e.before
+}
+
+// フィールド after を取得する。
+func (e BookmarkDescribed) After() Description {
+	return e.after
+}
+
+// 所属するフォルダが変更されたことを表すドメインイベント。
+type BookmarkMoved struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+	before     *ID    // 変更前のフォルダのID (最上位の場合はnil)
+	after      *ID    // 変更後のフォルダのID (最上位の場合はnil)
+}
+
+// 所属するフォルダが変更されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+// 最上位を表す場合はフォルダのIDにnilを指定する。
+//
+// ブックマークのIDにnilを指定した場合はエラーを返却する。
+func NewBookmarkMoved(bookmarkID *ID, userID *UserID, before *ID, after *ID) (*BookmarkMoved, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	return &BookmarkMoved{*bookmarkID, *userID, copyID(before), copyID(after)}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkMoved) EventName() string {
+	return EventBookmarkMoved
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkMoved) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkMoved) UserID() UserID {
+	return e.userID
+}
+
+// フィールド before を取得する。
+//
+// 最上位の場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (e BookmarkMoved) Before() *ID {
+	return copyID(e.before)
+}
+
+// フィールド after を取得する。
+//
+// 最上位の場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (e BookmarkMoved) After() *ID {
+	return copyID(e.after)
+}
+
+// 状態が変更されたことを表すドメインイベント。
+type BookmarkStatusChanged struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+	before     Status // 変更前の状態
+	after      Status // 変更後の状態
+}
+
+// 状態が変更されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkStatusChanged(bookmarkID *ID, userID *UserID, before *Status, after *Status) (*BookmarkStatusChanged, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if before == nil {
+		return nil, fmt.Errorf("argument \"before\" is nil")
+	}
+	if after == nil {
+		return nil, fmt.Errorf("argument \"after\" is nil")
+	}
+	return &BookmarkStatusChanged{*bookmarkID, *userID, *before, *after}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkStatusChanged) EventName() string {
+	return EventBookmarkStatusChanged
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkStatusChanged) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkStatusChanged) UserID() UserID {
+	return e.userID
+}
+
+// フィールド before を取得する。
+func (e BookmarkStatusChanged) Before() Status {
+	return e.before
+}
+
+// フィールド after を取得する。
+func (e BookmarkStatusChanged) After() Status {
+	return e.after
+}
+
+// お気に入りに登録されたことを表すドメインイベント。
+type BookmarkStarred struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+}
+
+// お気に入りに登録されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkStarred(bookmarkID *ID, userID *UserID) (*BookmarkStarred, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	return &BookmarkStarred{*bookmarkID, *userID}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkStarred) EventName() string {
+	return EventBookmarkStarred
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkStarred) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkStarred) UserID() UserID {
+	return e.userID
+}
+
+// お気に入りから外されたことを表すドメインイベント。
+type BookmarkUnstarred struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+}
+
+// お気に入りから外されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkUnstarred(bookmarkID *ID, userID *UserID) (*BookmarkUnstarred, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	return &BookmarkUnstarred{*bookmarkID, *userID}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkUnstarred) EventName() string {
+	return EventBookmarkUnstarred
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkUnstarred) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkUnstarred) UserID() UserID {
+	return e.userID
+}
+
+// ブックマークがゴミ箱から復元されたことを表すドメインイベント。
+type BookmarkRestored struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+}
+
+// ブックマークがゴミ箱から復元されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkRestored(bookmarkID *ID, userID *UserID) (*BookmarkRestored, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	return &BookmarkRestored{*bookmarkID, *userID}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkRestored) EventName() string {
+	return EventBookmarkRestored
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkRestored) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkRestored) UserID() UserID {
+	return e.userID
+}
+
+// ブックマークが完全に削除されたことを表すドメインイベント。
+type BookmarkPurged struct {
+	bookmarkID ID     // ブックマークのID
+	userID     UserID // ブックマークの所有者のユーザID
+}
+
+// ブックマークが完全に削除されたことを表すドメインイベントを生成する。
+//
+// 永続化されたドメインイベントの復元に用いる。
+//
+// nilを指定した場合はエラーを返却する。
+func NewBookmarkPurged(bookmarkID *ID, userID *UserID) (*BookmarkPurged, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	return &BookmarkPurged{*bookmarkID, *userID}, nil
+}
+
+// イベント名を取得する。
+func (e BookmarkPurged) EventName() string {
+	return EventBookmarkPurged
+}
+
+// フィールド bookmarkID を取得する。
+func (e BookmarkPurged) BookmarkID() ID {
+	return e.bookmarkID
+}
+
+// フィールド userID を取得する。
+func (e BookmarkPurged) UserID() UserID {
+	return e.userID
+}
@@ -0,0 +1,806 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarkRegistered(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	description := toDescription(t, "Example\nDomain")
+	tags := toTags(t, "foo", "bar")
+	// given
+	event := BookmarkRegistered{*id, *userID, *name, *uri, *description, tags}
+	// then
+	assert.Exactly(t, EventBookmarkRegistered, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, *name, event.Name())
+	assert.Exactly(t, *uri, event.URI())
+	assert.Exactly(t, *description, event.Description())
+	assert.Exactly(t, tags, event.Tags())
+}
+
+func TestBookmarkRenamed(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := toName(t, "Example")
+	after := toName(t, "EXAMPLE")
+	// given
+	event := BookmarkRenamed{*id, *userID, *before, *after}
+	// then
+	assert.Exactly(t, EventBookmarkRenamed, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, *before, event.Before())
+	assert.Exactly(t, *after, event.After())
+}
+
+func TestBookmarkURIRewritten(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := toUri(t, "https://example.com")
+	after := toUri(t, "http://example.com")
+	// given
+	event := BookmarkURIRewritten{*id, *userID, *before, *after}
+	// then
+	assert.Exactly(t, EventBookmarkURIRewritten, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, *before, event.Before())
+	assert.Exactly(t, *after, event.After())
+}
+
+func TestBookmarkTagged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	tags := toTags(t, "foo", "bar")
+	// given
+	event := BookmarkTagged{*id, *userID, tags}
+	// then
+	assert.Exactly(t, EventBookmarkTagged, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, tags, event.Tags())
+}
+
+func TestBookmarkDeleted(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	// given
+	event := BookmarkDeleted{*id, *userID}
+	// then
+	assert.Exactly(t, EventBookmarkDeleted, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+}
+
+func TestBookmarkUntagged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	tags := toTags(t, "foo", "bar")
+	// given
+	event := BookmarkUntagged{*id, *userID, tags}
+	// then
+	assert.Exactly(t, EventBookmarkUntagged, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, tags, event.Tags())
+}
+
+func TestBookmarkDescribed(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := toDescription(t, "")
+	after := toDescription(t, "Example Domain")
+	// given
+	event := BookmarkDescribed{*id, *userID, *before, *after}
+	// then
+	assert.Exactly(t, EventBookmarkDescribed, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, *before, event.Before())
+	assert.Exactly(t, *after, event.After())
+}
+
+func TestBookmarkMoved(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	after := toId(t, "10")
+	// given
+	event := BookmarkMoved{*id, *userID, nil, after}
+	// then
+	assert.Exactly(t, EventBookmarkMoved, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Nil(t, event.Before())
+	assert.Exactly(t, after, event.After())
+}
+
+func TestBookmarkStatusChanged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	// given
+	event := BookmarkStatusChanged{*id, *userID, StatusUnread, StatusArchived}
+	// then
+	assert.Exactly(t, EventBookmarkStatusChanged, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+	assert.Exactly(t, StatusUnread, event.Before())
+	assert.Exactly(t, StatusArchived, event.After())
+}
+
+func TestBookmarkStarred(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	// given
+	event := BookmarkStarred{*id, *userID}
+	// then
+	assert.Exactly(t, EventBookmarkStarred, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+}
+
+func TestBookmarkUnstarred(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	// given
+	event := BookmarkUnstarred{*id, *userID}
+	// then
+	assert.Exactly(t, EventBookmarkUnstarred, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+}
+
+func TestBookmarkRestored(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	// given
+	event := BookmarkRestored{*id, *userID}
+	// then
+	assert.Exactly(t, EventBookmarkRestored, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+}
+
+func TestBookmarkPurged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	// given
+	event := BookmarkPurged{*id, *userID}
+	// then
+	assert.Exactly(t, EventBookmarkPurged, event.EventName())
+	assert.Exactly(t, *id, event.BookmarkID())
+	assert.Exactly(t, *userID, event.UserID())
+}
+
+func TestNewBookmarkRegistered(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	description := toDescription(t, "Example\nDomain")
+	tags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		name          *Name
+		uri           *URI
+		description   *Description
+		tags          []Tag
+		expectedEvent *BookmarkRegistered
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, name, uri, description, tags,
+			&BookmarkRegistered{*id, *userID, *name, *uri, *description, tags},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, name, uri, description, tags,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil name": {
+			id, userID, nil, uri, description, tags,
+			nil,
+			errors.New("argument \"name\" is nil"),
+		},
+		"nil uri": {
+			id, userID, name, nil, description, tags,
+			nil,
+			errors.New("argument \"uri\" is nil"),
+		},
+		"nil description": {
+			id, userID, name, uri, nil, tags,
+			nil,
+			errors.New("argument \"description\" is nil"),
+		},
+		"nil tags": {
+			id, userID, name, uri, description, nil,
+			nil,
+			errors.New("argument \"tags\" is nil"),
+		},
+		"nil userID": {
+			id, nil, name, uri, description, tags,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkRegistered(tc.id, tc.userID, tc.name, tc.uri, tc.description, tc.tags)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkRenamed(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := toName(t, "Example")
+	after := toName(t, "EXAMPLE")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		before        *Name
+		after         *Name
+		expectedEvent *BookmarkRenamed
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, before, after,
+			&BookmarkRenamed{*id, *userID, *before, *after},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, before, after,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil before": {
+			id, userID, nil, after,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"nil after": {
+			id, userID, before, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+		"nil userID": {
+			id, nil, before, after,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkRenamed(tc.id, tc.userID, tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkURIRewritten(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := toUri(t, "https://example.com")
+	after := toUri(t, "http://example.com")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		before        *URI
+		after         *URI
+		expectedEvent *BookmarkURIRewritten
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, before, after,
+			&BookmarkURIRewritten{*id, *userID, *before, *after},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, before, after,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil before": {
+			id, userID, nil, after,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"nil after": {
+			id, userID, before, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+		"nil userID": {
+			id, nil, before, after,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkURIRewritten(tc.id, tc.userID, tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkTagged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	tags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		tags          []Tag
+		expectedEvent *BookmarkTagged
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, tags,
+			&BookmarkTagged{*id, *userID, tags},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, tags,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil tags": {
+			id, userID, nil,
+			nil,
+			errors.New("argument \"tags\" is nil"),
+		},
+		"nil userID": {
+			id, nil, tags,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkTagged(tc.id, tc.userID, tc.tags)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkDeleted(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		expectedEvent *BookmarkDeleted
+		expectedErr   error
+	}{
+		"non-nil argument": {
+			id, userID,
+			&BookmarkDeleted{*id, *userID},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil userID": {
+			id, nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkDeleted(tc.id, tc.userID)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkUntagged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	tags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		tags          []Tag
+		expectedEvent *BookmarkUntagged
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, tags,
+			&BookmarkUntagged{*id, *userID, tags},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, tags,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil tags": {
+			id, userID, nil,
+			nil,
+			errors.New("argument \"tags\" is nil"),
+		},
+		"nil userID": {
+			id, nil, tags,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkUntagged(tc.id, tc.userID, tc.tags)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkDescribed(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := toDescription(t, "")
+	after := toDescription(t, "Example Domain")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		before        *Description
+		after         *Description
+		expectedEvent *BookmarkDescribed
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, before, after,
+			&BookmarkDescribed{*id, *userID, *before, *after},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, before, after,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil before": {
+			id, userID, nil, after,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"nil after": {
+			id, userID, before, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+		"nil userID": {
+			id, nil, before, after,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkDescribed(tc.id, tc.userID, tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkMoved(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	folder := toId(t, "10")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		before        *ID
+		after         *ID
+		expectedEvent *BookmarkMoved
+		expectedErr   error
+	}{
+		"into folder": {
+			id, userID, nil, folder,
+			&BookmarkMoved{*id, *userID, nil, folder},
+			nil,
+		},
+		"to root": {
+			id, userID, folder, nil,
+			&BookmarkMoved{*id, *userID, folder, nil},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, nil, folder,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil userID": {
+			id, nil, nil, folder,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkMoved(tc.id, tc.userID, tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkStatusChanged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	before := StatusUnread
+	after := StatusRead
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		before        *Status
+		after         *Status
+		expectedEvent *BookmarkStatusChanged
+		expectedErr   error
+	}{
+		"non-nil arguments": {
+			id, userID, &before, &after,
+			&BookmarkStatusChanged{*id, *userID, before, after},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID, &before, &after,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil before": {
+			id, userID, nil, &after,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"nil after": {
+			id, userID, &before, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+		"nil userID": {
+			id, nil, &before, &after,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkStatusChanged(tc.id, tc.userID, tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkStarred(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		expectedEvent *BookmarkStarred
+		expectedErr   error
+	}{
+		"non-nil argument": {
+			id, userID,
+			&BookmarkStarred{*id, *userID},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil userID": {
+			id, nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkStarred(tc.id, tc.userID)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkUnstarred(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		expectedEvent *BookmarkUnstarred
+		expectedErr   error
+	}{
+		"non-nil argument": {
+			id, userID,
+			&BookmarkUnstarred{*id, *userID},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil userID": {
+			id, nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkUnstarred(tc.id, tc.userID)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkRestored(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		expectedEvent *BookmarkRestored
+		expectedErr   error
+	}{
+		"non-nil argument": {
+			id, userID,
+			&BookmarkRestored{*id, *userID},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil userID": {
+			id, nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkRestored(tc.id, tc.userID)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestNewBookmarkPurged(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	cases := map[string]struct {
+		id            *ID
+		userID        *UserID
+		expectedEvent *BookmarkPurged
+		expectedErr   error
+	}{
+		"non-nil argument": {
+			id, userID,
+			&BookmarkPurged{*id, *userID},
+			nil,
+		},
+		"nil bookmarkID": {
+			nil, userID,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil userID": {
+			id, nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualEvent, actualErr := NewBookmarkPurged(tc.id, tc.userID)
+			// then
+			assert.Exactly(t, tc.expectedEvent, actualEvent)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"fmt"
+)
+
+// フォルダを表すエンティティ。
+type Folder struct {
+	id       ID     // ID
+	userID   UserID // 所有者のユーザID
+	name     Name   // フォルダ名
+	parent   *ID    // 親フォルダのID (最上位の場合はnil)
+	position int    // 同じ親フォルダ内での並び順
+}
+
+// 親フォルダと並び順を検証する。
+func validatePlacement(id ID, parent *ID, position int) error {
+	if parent != nil && *parent == id {
+		return fmt.Errorf("parent is itself: %s", id.Value())
+	}
+	if position < 0 {
+		return fmt.Errorf("negative position: %d", position)
+	}
+	return nil
+}
+
+// フォルダを表すエンティティを生成する。
+//
+// 親フォルダにnilを指定した場合は最上位のフォルダとする。
+//
+// ID、ユーザIDまたはフォルダ名にnilを指定した場合はエラーを返却する。
+// 親フォルダが自身の場合はエラーを返却する。
+// 並び順が負の場合はエラーを返却する。
+func NewFolder(id *ID, userID *UserID, name *Name, parent *ID, position int) (*Folder, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if name == nil {
+		return nil, fmt.Errorf("argument \"name\" is nil")
+	}
+	if err := validatePlacement(*id, parent, position); err != nil {
+		return nil, err
+	}
+	return &Folder{*id, *userID, *name, copyID(parent), position}, nil
+}
+
+// IDを複製する。
+//
+// nilを指定した場合はnilを返却する。
+func copyID(id *ID) *ID {
+	if id == nil {
+		return nil
+	}
+	copy := *id
+	return &copy
+}
+
+// IDが等しいか判定する。
+//
+// いずれもnilの場合は等しいとみなす。
+func equalID(a, b *ID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// フィールド id を取得する。
+func (f *Folder) ID() ID {
+	return f.id
+}
+
+// フィールド userID を取得する。
+func (f *Folder) UserID() UserID {
+	return f.userID
+}
+
+// フィールド name を取得する。
+func (f *Folder) Name() Name {
+	return f.name
+}
+
+// フィールド parent を取得する。
+//
+// 最上位のフォルダの場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (f *Folder) Parent() *ID {
+	return copyID(f.parent)
+}
+
+// フィールド position を取得する。
+func (f *Folder) Position() int {
+	return f.position
+}
+
+// フォルダ名を変更する。
+//
+// nilを指定した場合はエラーを返却する。
+func (f *Folder) Rename(name *Name) error {
+	if name == nil {
+		return fmt.Errorf("argument \"name\" is nil")
+	}
+	f.name = *name
+	return nil
+}
+
+// 親フォルダと並び順を変更する。
+//
+// 親フォルダにnilを指定した場合は最上位に移動する。
+//
+// 親フォルダが自身の場合はエラーを返却する。
+// 並び順が負の場合はエラーを返却する。
+func (f *Folder) Move(parent *ID, position int) error {
+	if err := validatePlacement(f.id, parent, position); err != nil {
+		return err
+	}
+	f.parent = copyID(parent)
+	f.position = position
+	return nil
+}
+
+// インスタンスをディープコピーする。
+func (f Folder) DeepCopy() *Folder {
+	copy := &f
+	copy.parent = copyID(f.parent)
+	return copy
+}
@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFolder(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	name := toName(t, "Reading List")
+	parent := toId(t, "2")
+	cases := map[string]struct {
+		id             *ID
+		userID         *UserID
+		name           *Name
+		parent         *ID
+		position       int
+		expectedFolder *Folder
+		expectedErr    error
+	}{
+		"top level folder": {
+			id, userID, name, nil, 0,
+			&Folder{*id, *userID, *name, nil, 0},
+			nil,
+		},
+		"nested folder": {
+			id, userID, name, parent, 3,
+			&Folder{*id, *userID, *name, parent, 3},
+			nil,
+		},
+		"nil id": {
+			nil, userID, name, nil, 0,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil user id": {
+			id, nil, name, nil, 0,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil name": {
+			id, userID, nil, nil, 0,
+			nil,
+			errors.New("argument \"name\" is nil"),
+		},
+		"parent is itself": {
+			id, userID, name, toId(t, "1"), 0,
+			nil,
+			errors.New("parent is itself: 1"),
+		},
+		"negative position": {
+			id, userID, name, nil, -1,
+			nil,
+			errors.New("negative position: -1"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualFolder, actualErr := NewFolder(tc.id, tc.userID, tc.name, tc.parent, tc.position)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+	t.Run("parent pointer", func(t *testing.T) {
+		t.Parallel()
+		// when
+		folder, _ := NewFolder(id, userID, name, parent, 0)
+		// then
+		assert.NotSame(t, parent, folder.parent)
+	})
+}
+
+func TestFolder_ID(t *testing.T) {
+	t.Parallel()
+	// given
+	folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), nil, 0)
+	// when
+	actualID := folder.ID()
+	// then
+	expectedID := *toId(t, "1")
+	assert.Exactly(t, expectedID, actualID)
+}
+
+func TestFolder_UserID(t *testing.T) {
+	t.Parallel()
+	// given
+	folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), nil, 0)
+	// when
+	actualUserID := folder.UserID()
+	// then
+	expectedUserID := *toUserId(t, "alice")
+	assert.Exactly(t, expectedUserID, actualUserID)
+}
+
+func TestFolder_Name(t *testing.T) {
+	t.Parallel()
+	// given
+	folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), nil, 0)
+	// when
+	actualName := folder.Name()
+	// then
+	expectedName := *toName(t, "Reading List")
+	assert.Exactly(t, expectedName, actualName)
+}
+
+func TestFolder_Parent(t *testing.T) {
+	t.Parallel()
+	t.Run("top level folder", func(t *testing.T) {
+		t.Parallel()
+		// given
+		folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), nil, 0)
+		// when
+		actualParent := folder.Parent()
+		// then
+		assert.Nil(t, actualParent)
+	})
+	t.Run("nested folder", func(t *testing.T) {
+		t.Parallel()
+		// given
+		folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), toId(t, "2"), 0)
+		// when
+		actualParent := folder.Parent()
+		// then
+		expectedParent := toId(t, "2")
+		assert.Exactly(t, expectedParent, actualParent)
+		assert.NotSame(t, folder.parent, actualParent)
+	})
+}
+
+func TestFolder_Position(t *testing.T) {
+	t.Parallel()
+	// given
+	folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), nil, 3)
+	// when
+	actualPosition := folder.Position()
+	// then
+	expectedPosition := 3
+	assert.Exactly(t, expectedPosition, actualPosition)
+}
+
+func TestFolder_Rename(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		name         *Name
+		expectedName Name
+		expectedErr  error
+	}{
+		"non-nil name": {
+			toName(t, "Later"),
+			*toName(t, "Later"),
+			nil,
+		},
+		"nil name": {
+			nil,
+			*toName(t, "Reading List"),
+			errors.New("argument \"name\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), nil, 0)
+			// when
+			actualErr := folder.Rename(tc.name)
+			actualName := folder.name
+			// then
+			assert.Exactly(t, tc.expectedName, actualName)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_Move(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		parent           *ID
+		position         int
+		expectedParent   *ID
+		expectedPosition int
+		expectedErr      error
+	}{
+		"another folder": {
+			toId(t, "3"), 1,
+			toId(t, "3"), 1,
+			nil,
+		},
+		"top level": {
+			nil, 0,
+			nil, 0,
+			nil,
+		},
+		"itself": {
+			toId(t, "1"), 0,
+			toId(t, "2"), 5,
+			errors.New("parent is itself: 1"),
+		},
+		"negative position": {
+			toId(t, "3"), -1,
+			toId(t, "2"), 5,
+			errors.New("negative position: -1"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// given
+			folder, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), toId(t, "2"), 5)
+			// when
+			actualErr := folder.Move(tc.parent, tc.position)
+			actualParent := folder.parent
+			actualPosition := folder.position
+			// then
+			assert.Exactly(t, tc.expectedParent, actualParent)
+			assert.Exactly(t, tc.expectedPosition, actualPosition)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewFolder(toId(t, "1"), toUserId(t, "alice"), toName(t, "Reading List"), toId(t, "2"), 0)
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, original.parent, copy.parent)
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ブックマークの改訂を表すエンティティ。
+type Revision struct {
+	id         ID        // ID
+	bookmarkID ID        // 変更したブックマークのID
+	version    uint64    // 変更後のブックマークの版数
+	actor      string    // 変更者 (不明な場合は空文字列)
+	before     Snapshot  // 変更前の内容
+	after      Snapshot  // 変更後の内容
+	createdAt  time.Time // 作成日時
+}
+
+// ブックマークの改訂を表すエンティティを生成する。
+//
+// nilを指定した場合はエラーを返却する。
+func NewRevision(id *ID, bookmarkID *ID, version uint64, actor string, before *Snapshot, after *Snapshot) (*Revision, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	if before == nil {
+		return nil, fmt.Errorf("argument \"before\" is nil")
+	}
+	if after == nil {
+		return nil, fmt.Errorf("argument \"after\" is nil")
+	}
+	return &Revision{*id, *bookmarkID, version, actor, *before.DeepCopy(), *after.DeepCopy(), time.Time{}}, nil
+}
+
+// フィールド id を取得する。
+func (r *Revision) ID() ID {
+	return r.id
+}
+
+// フィールド bookmarkID を取得する。
+func (r *Revision) BookmarkID() ID {
+	return r.bookmarkID
+}
+
+// フィールド version を取得する。
+func (r *Revision) Version() uint64 {
+	return r.version
+}
+
+// フィールド actor を取得する。
+func (r *Revision) Actor() string {
+	return r.actor
+}
+
+// フィールド before を取得する。
+//
+// 複製したインスタンスを返却する。
+func (r *Revision) Before() Snapshot {
+	return *r.before.DeepCopy()
+}
+
+// フィールド after を取得する。
+//
+// 複製したインスタンスを返却する。
+func (r *Revision) After() Snapshot {
+	return *r.after.DeepCopy()
+}
+
+// フィールド createdAt を取得する。
+//
+// 永続化されていない場合はゼロ値を返却する。
+func (r *Revision) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// フィールド createdAt を設定する。
+//
+// リポジトリが永続化した日時を反映するために用いる。
+func (r *Revision) SetCreatedAt(createdAt time.Time) {
+	r.createdAt = createdAt
+}
+
+// インスタンスをディープコピーする。
+func (r Revision) DeepCopy() *Revision {
+	copy := &r
+	copy.before = *r.before.DeepCopy()
+	copy.after = *r.after.DeepCopy()
+	return copy
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func toSnapshot(t *testing.T, nv, uv string, tvs ...string) *Snapshot {
+	t.Helper()
+	snapshot, err := NewSnapshot(toName(t, nv), toUri(t, uv), toDescription(t, ""), toTags(t, tvs...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return snapshot
+}
+
+func TestNewRevision(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "100")
+	bookmarkID := toId(t, "1")
+	before := toSnapshot(t, "Example", "https://example.com", "foo")
+	after := toSnapshot(t, "Example Domain", "https://example.com", "foo")
+	cases := map[string]struct {
+		id               *ID
+		bookmarkID       *ID
+		before           *Snapshot
+		after            *Snapshot
+		expectedRevision *Revision
+		expectedErr      error
+	}{
+		"non-nil arguments": {
+			id, bookmarkID, before, after,
+			&Revision{*id, *bookmarkID, 2, "alice", *before, *after, time.Time{}},
+			nil,
+		},
+		"nil id": {
+			nil, bookmarkID, before, after,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil bookmark id": {
+			id, nil, before, after,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"nil before": {
+			id, bookmarkID, nil, after,
+			nil,
+			errors.New("argument \"before\" is nil"),
+		},
+		"nil after": {
+			id, bookmarkID, before, nil,
+			nil,
+			errors.New("argument \"after\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualRevision, actualErr := NewRevision(tc.id, tc.bookmarkID, 2, "alice", tc.before, tc.after)
+			// then
+			assert.Exactly(t, tc.expectedRevision, actualRevision)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRevision_Accessors(t *testing.T) {
+	t.Parallel()
+	// given
+	before := toSnapshot(t, "Example", "https://example.com", "foo")
+	after := toSnapshot(t, "Example Domain", "https://example.org", "bar")
+	revision, _ := NewRevision(toId(t, "100"), toId(t, "1"), 2, "alice", before, after)
+	// when
+	revision.SetCreatedAt(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	// then
+	assert.Exactly(t, *toId(t, "100"), revision.ID())
+	assert.Exactly(t, *toId(t, "1"), revision.BookmarkID())
+	assert.Exactly(t, uint64(2), revision.Version())
+	assert.Exactly(t, "alice", revision.Actor())
+	assert.Exactly(t, *before, revision.Before())
+	assert.Exactly(t, *after, revision.After())
+	assert.Exactly(t, time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), revision.CreatedAt())
+}
+
+func TestRevision_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewRevision(toId(t, "100"), toId(t, "1"), 2, "alice", toSnapshot(t, "Example", "https://example.com", "foo"), toSnapshot(t, "Example", "https://example.com"))
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, &original.before.tags[0], &copy.before.tags[0])
+}
@@ -0,0 +1,181 @@
+package entity
+
+import (
+	"fmt"
+)
+
+// 共有の権限を表す値オブジェクト。
+//
+// 編集者は閲覧者に許可された操作を全て許可される。
+type ShareRole int
+
+const (
+	ShareRoleViewer ShareRole = iota // 閲覧者
+	ShareRoleEditor                  // 編集者
+)
+
+// 権限の文字列表現。
+var shareRoleValues = map[ShareRole]string{
+	ShareRoleViewer: "viewer",
+	ShareRoleEditor: "editor",
+}
+
+// 共有の権限を表す値オブジェクトを生成する。
+//
+// 文字列表現 ("viewer", "editor") から生成する。
+//
+// 未知の権限を指定した場合はエラーを返却する。
+func NewShareRole(v string) (*ShareRole, error) {
+	for role, value := range shareRoleValues {
+		if value == v {
+			return &role, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown role: %s", v)
+}
+
+// 値を取得する。
+func (role ShareRole) Value() string {
+	return shareRoleValues[role]
+}
+
+// 指定した権限の操作を許可するか判定する。
+func (role ShareRole) Allows(required ShareRole) bool {
+	return role >= required
+}
+
+// ブックマークの共有を表すエンティティ。
+//
+// 所有者のブックマーク1件、または所有者のブックマークのうちタグが付与されたもの (コレクション) を共有先のユーザに共有する。
+type Share struct {
+	id         ID        // ID
+	owner      UserID    // 共有元のユーザID (ブックマークの所有者)
+	grantee    UserID    // 共有先のユーザID
+	bookmarkID *ID       // 共有するブックマークのID (コレクションを共有する場合はnil)
+	tag        *Tag      // 共有するコレクションのタグ (ブックマークを共有する場合はnil)
+	role       ShareRole // 権限
+}
+
+// ブックマークの共有を表すエンティティを生成する。
+//
+// ブックマークを共有する場合はブックマークのIDを、コレクションを共有する場合はタグを指定する。
+//
+// ID、共有元または共有先のユーザIDにnilを指定した場合はエラーを返却する。
+// 共有先のユーザIDが共有元のユーザIDと等しい場合はエラーを返却する。
+// ブックマークのIDとタグのいずれも指定しない場合、あるいは両方を指定した場合はエラーを返却する。
+//
+// 複製したポインタをフィールドに設定する。
+func NewShare(id *ID, owner *UserID, grantee *UserID, bookmarkID *ID, tag *Tag, role ShareRole) (*Share, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if owner == nil {
+		return nil, fmt.Errorf("argument \"owner\" is nil")
+	}
+	if grantee == nil {
+		return nil, fmt.Errorf("argument \"grantee\" is nil")
+	}
+	if *grantee == *owner {
+		return nil, fmt.Errorf("grantee is owner: %s", grantee.Value())
+	}
+	if bookmarkID == nil && tag == nil {
+		return nil, fmt.Errorf("either bookmarkID or tag is required")
+	}
+	if bookmarkID != nil && tag != nil {
+		return nil, fmt.Errorf("both bookmarkID and tag are specified")
+	}
+	return &Share{*id, *owner, *grantee, copyID(bookmarkID), copyTag(tag), role}, nil
+}
+
+// タグを複製する。
+//
+// nilを指定した場合はnilを返却する。
+func copyTag(tag *Tag) *Tag {
+	if tag == nil {
+		return nil
+	}
+	copy := *tag
+	return &copy
+}
+
+// フィールド id を取得する。
+func (s *Share) ID() ID {
+	return s.id
+}
+
+// フィールド owner を取得する。
+func (s *Share) Owner() UserID {
+	return s.owner
+}
+
+// フィールド grantee を取得する。
+func (s *Share) Grantee() UserID {
+	return s.grantee
+}
+
+// フィールド bookmarkID を取得する。
+//
+// コレクションを共有する場合はnilを返却する。
+// 複製したポインタを返却する。
+func (s *Share) BookmarkID() *ID {
+	return copyID(s.bookmarkID)
+}
+
+// フィールド tag を取得する。
+//
+// ブックマークを共有する場合はnilを返却する。
+// 複製したポインタを返却する。
+func (s *Share) Tag() *Tag {
+	return copyTag(s.tag)
+}
+
+// フィールド role を取得する。
+func (s *Share) Role() ShareRole {
+	return s.role
+}
+
+// 権限を変更する。
+func (s *Share) Grant(role ShareRole) {
+	s.role = role
+}
+
+// 共有元、共有先および共有対象が等しいか判定する。
+func (s *Share) SameTarget(other Share) bool {
+	if s.owner != other.owner || s.grantee != other.grantee {
+		return false
+	}
+	if s.bookmarkID != nil && other.bookmarkID != nil {
+		return *s.bookmarkID == *other.bookmarkID
+	}
+	if s.tag != nil && other.tag != nil {
+		return *s.tag == *other.tag
+	}
+	return false
+}
+
+// ブックマークが共有の対象に含まれるか判定する。
+//
+// 共有元のユーザが所有しないブックマークは含まれない。
+// コレクションを共有する場合はタグが付与されたブックマークを含む。
+func (s *Share) Covers(bookmark Bookmark) bool {
+	if bookmark.userID != s.owner {
+		return false
+	}
+	if s.bookmarkID != nil {
+		return bookmark.id == *s.bookmarkID
+	}
+	for _, tag := range bookmark.tags {
+		if tag == *s.tag {
+			return true
+		}
+	}
+	return false
+}
+
+// インスタンスをディープコピーする。
+func (s Share) DeepCopy() *Share {
+	copy := &s
+	copy.bookmarkID = copyID(s.bookmarkID)
+	copy.tag = copyTag(s.tag)
+	return copy
+}
@@ -0,0 +1,310 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func toUserId(t *testing.T, v string) *UserID {
+	t.Helper()
+	userID, err := NewUserID(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return userID
+}
+
+func toTag(t *testing.T, v string) *Tag {
+	t.Helper()
+	tag, err := NewTag(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tag
+}
+
+func TestNewShareRole(t *testing.T) {
+	t.Parallel()
+	viewer, editor := ShareRoleViewer, ShareRoleEditor
+	cases := map[string]struct {
+		v            string
+		expectedRole *ShareRole
+		expectedErr  error
+	}{
+		"viewer": {
+			"viewer",
+			&viewer,
+			nil,
+		},
+		"editor": {
+			"editor",
+			&editor,
+			nil,
+		},
+		"empty string": {
+			"",
+			nil,
+			errors.New("unknown role: "),
+		},
+		"unknown role": {
+			"owner",
+			nil,
+			errors.New("unknown role: owner"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualRole, actualErr := NewShareRole(tc.v)
+			// then
+			assert.Exactly(t, tc.expectedRole, actualRole)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestShareRole_Value(t *testing.T) {
+	t.Parallel()
+	assert.Exactly(t, "viewer", ShareRoleViewer.Value())
+	assert.Exactly(t, "editor", ShareRoleEditor.Value())
+}
+
+func TestShareRole_Allows(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		role     ShareRole
+		required ShareRole
+		expected bool
+	}{
+		"viewer requires viewer": {
+			ShareRoleViewer,
+			ShareRoleViewer,
+			true,
+		},
+		"viewer requires editor": {
+			ShareRoleViewer,
+			ShareRoleEditor,
+			false,
+		},
+		"editor requires viewer": {
+			ShareRoleEditor,
+			ShareRoleViewer,
+			true,
+		},
+		"editor requires editor": {
+			ShareRoleEditor,
+			ShareRoleEditor,
+			true,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actual := tc.role.Allows(tc.required)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestNewShare(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	owner := toUserId(t, "alice")
+	grantee := toUserId(t, "bob")
+	bookmarkID := toId(t, "10")
+	tag := toTag(t, "golang")
+	cases := map[string]struct {
+		id            *ID
+		owner         *UserID
+		grantee       *UserID
+		bookmarkID    *ID
+		tag           *Tag
+		role          ShareRole
+		expectedShare *Share
+		expectedErr   error
+	}{
+		"bookmark": {
+			id, owner, grantee, bookmarkID, nil, ShareRoleViewer,
+			&Share{*id, *owner, *grantee, bookmarkID, nil, ShareRoleViewer},
+			nil,
+		},
+		"collection": {
+			id, owner, grantee, nil, tag, ShareRoleEditor,
+			&Share{*id, *owner, *grantee, nil, tag, ShareRoleEditor},
+			nil,
+		},
+		"nil id": {
+			nil, owner, grantee, bookmarkID, nil, ShareRoleViewer,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil owner": {
+			id, nil, grantee, bookmarkID, nil, ShareRoleViewer,
+			nil,
+			errors.New("argument \"owner\" is nil"),
+		},
+		"nil grantee": {
+			id, owner, nil, bookmarkID, nil, ShareRoleViewer,
+			nil,
+			errors.New("argument \"grantee\" is nil"),
+		},
+		"grantee is owner": {
+			id, owner, owner, bookmarkID, nil, ShareRoleViewer,
+			nil,
+			errors.New("grantee is owner: alice"),
+		},
+		"neither bookmark id nor tag": {
+			id, owner, grantee, nil, nil, ShareRoleViewer,
+			nil,
+			errors.New("either bookmarkID or tag is required"),
+		},
+		"both bookmark id and tag": {
+			id, owner, grantee, bookmarkID, tag, ShareRoleViewer,
+			nil,
+			errors.New("both bookmarkID and tag are specified"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualShare, actualErr := NewShare(tc.id, tc.owner, tc.grantee, tc.bookmarkID, tc.tag, tc.role)
+			// then
+			assert.Exactly(t, tc.expectedShare, actualShare)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestShare_Accessors(t *testing.T) {
+	t.Parallel()
+	// given
+	share, _ := NewShare(toId(t, "1"), toUserId(t, "alice"), toUserId(t, "bob"), toId(t, "10"), nil, ShareRoleViewer)
+	// when
+	share.Grant(ShareRoleEditor)
+	// then
+	assert.Exactly(t, *toId(t, "1"), share.ID())
+	assert.Exactly(t, *toUserId(t, "alice"), share.Owner())
+	assert.Exactly(t, *toUserId(t, "bob"), share.Grantee())
+	assert.Exactly(t, toId(t, "10"), share.BookmarkID())
+	assert.Nil(t, share.Tag())
+	assert.Exactly(t, ShareRoleEditor, share.Role())
+}
+
+func TestShare_SameTarget(t *testing.T) {
+	t.Parallel()
+	share, _ := NewShare(toId(t, "1"), toUserId(t, "alice"), toUserId(t, "bob"), nil, toTag(t, "golang"), ShareRoleViewer)
+	cases := map[string]struct {
+		grantee    string
+		bookmarkID *ID
+		tag        *Tag
+		expected   bool
+	}{
+		"same tag": {
+			"bob", nil, toTag(t, "golang"),
+			true,
+		},
+		"different tag": {
+			"bob", nil, toTag(t, "rust"),
+			false,
+		},
+		"different grantee": {
+			"carol", nil, toTag(t, "golang"),
+			false,
+		},
+		"bookmark": {
+			"bob", toId(t, "10"), nil,
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			other, _ := NewShare(toId(t, "2"), toUserId(t, "alice"), toUserId(t, tc.grantee), tc.bookmarkID, tc.tag, ShareRoleEditor)
+			// when
+			actual := share.SameTarget(*other)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestShare_Covers(t *testing.T) {
+	t.Parallel()
+	owned := func(userID string, id string, tags ...string) Bookmark {
+		bookmark, _ := NewBookmark(toId(t, id), toName(t, "Example"), toUri(t, "https://example.com"), toTags(t, tags...))
+		bookmark.AssignTo(toUserId(t, userID))
+		return *bookmark
+	}
+	cases := map[string]struct {
+		bookmarkID *ID
+		tag        *Tag
+		bookmark   Bookmark
+		expected   bool
+	}{
+		"shared bookmark": {
+			toId(t, "10"), nil,
+			owned("alice", "10"),
+			true,
+		},
+		"other bookmark": {
+			toId(t, "10"), nil,
+			owned("alice", "20"),
+			false,
+		},
+		"bookmark owned by another user": {
+			toId(t, "10"), nil,
+			owned("carol", "10"),
+			false,
+		},
+		"bookmark in collection": {
+			nil, toTag(t, "golang"),
+			owned("alice", "10", "rust", "golang"),
+			true,
+		},
+		"bookmark out of collection": {
+			nil, toTag(t, "golang"),
+			owned("alice", "10", "rust"),
+			false,
+		},
+		"tagged bookmark owned by another user": {
+			nil, toTag(t, "golang"),
+			owned("carol", "10", "golang"),
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			share, _ := NewShare(toId(t, "1"), toUserId(t, "alice"), toUserId(t, "bob"), tc.bookmarkID, tc.tag, ShareRoleViewer)
+			// when
+			actual := share.Covers(tc.bookmark)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestShare_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewShare(toId(t, "1"), toUserId(t, "alice"), toUserId(t, "bob"), toId(t, "10"), nil, ShareRoleViewer)
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, original.bookmarkID, copy.bookmarkID)
+}
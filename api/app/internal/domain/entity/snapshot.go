@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"fmt"
+)
+
+// ブックマークの内容を表す値オブジェクト。
+//
+// 改訂履歴において変更前後の内容を記録するために用いる。
+// 内容に加えて、所属するフォルダ、状態、お気に入りへの登録、ゴミ箱への移動の有無を持つ。
+type Snapshot struct {
+	name        Name        // ブックマーク名
+	uri         URI         // URI
+	description Description // 説明
+	tags        []Tag       // タグ一覧
+	folder      *ID         // 所属するフォルダのID (最上位の場合はnil)
+	status      Status      // 状態
+	starred     bool        // お気に入りに登録されているか
+	trashed     bool        // ゴミ箱にあるか
+}
+
+// ブックマークの内容を表す値オブジェクトを生成する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+// 最上位に所属する未読のブックマークとして生成する。
+func NewSnapshot(name *Name, uri *URI, description *Description, tags []Tag) (*Snapshot, error) {
+	if name == nil {
+		return nil, fmt.Errorf("argument \"name\" is nil")
+	}
+	if uri == nil {
+		return nil, fmt.Errorf("argument \"uri\" is nil")
+	}
+	if description == nil {
+		return nil, fmt.Errorf("argument \"description\" is nil")
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("argument \"tags\" is nil")
+	}
+	return &Snapshot{name: *name, uri: *uri, description: *description, tags: append([]Tag{}, tags...)}, nil
+}
+
+// 所属するフォルダ、状態、お気に入りへの登録、ゴミ箱への移動の有無を設定する。
+//
+// リポジトリが保存した状態を反映するために用いる。
+// nilを指定した場合は最上位に所属する。
+func (s *Snapshot) SetState(folder *ID, status Status, starred bool, trashed bool) {
+	s.folder = copyID(folder)
+	s.status = status
+	s.starred = starred
+	s.trashed = trashed
+}
+
+// フィールド name を取得する。
+func (s Snapshot) Name() Name {
+	return s.name
+}
+
+// フィールド uri を取得する。
+func (s Snapshot) URI() URI {
+	return s.uri
+}
+
+// フィールド description を取得する。
+func (s Snapshot) Description() Description {
+	return s.description
+}
+
+// フィールド tags を取得する。
+//
+// 複製したスライスを返却する。
+func (s Snapshot) Tags() []Tag {
+	return append([]Tag{}, s.tags...)
+}
+
+// フィールド folder を取得する。
+//
+// 最上位に所属する場合はnilを返却する。
+// 複製したインスタンスを返却する。
+func (s Snapshot) Folder() *ID {
+	return copyID(s.folder)
+}
+
+// フィールド status を取得する。
+func (s Snapshot) Status() Status {
+	return s.status
+}
+
+// フィールド starred を取得する。
+func (s Snapshot) Starred() bool {
+	return s.starred
+}
+
+// フィールド trashed を取得する。
+func (s Snapshot) IsTrashed() bool {
+	return s.trashed
+}
+
+// 内容と状態が等しいか判定する。
+//
+// タグ一覧は順序も含めて比較する。
+func (s Snapshot) Equals(other Snapshot) bool {
+	if s.name != other.name || s.uri.String() != other.uri.String() || s.description != other.description {
+		return false
+	}
+	if !equalID(s.folder, other.folder) || s.status != other.status || s.starred != other.starred || s.trashed != other.trashed {
+		return false
+	}
+	if len(s.tags) != len(other.tags) {
+		return false
+	}
+	for i := range s.tags {
+		if s.tags[i] != other.tags[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// インスタンスをディープコピーする。
+func (s Snapshot) DeepCopy() *Snapshot {
+	copy := &s
+	copy.tags = append([]Tag{}, s.tags...)
+	copy.folder = copyID(s.folder)
+	return copy
+}
@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func toDescription(t *testing.T, v string) *Description {
+	t.Helper()
+	description, err := NewDescription(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return description
+}
+
+func TestNewSnapshot(t *testing.T) {
+	t.Parallel()
+	name := toName(t, "Example")
+	uri := toUri(t, "https://example.com")
+	description := toDescription(t, "Example Domain")
+	tags := toTags(t, "foo", "bar")
+	cases := map[string]struct {
+		name             *Name
+		uri              *URI
+		description      *Description
+		tags             []Tag
+		expectedSnapshot *Snapshot
+		expectedErr      error
+	}{
+		"non-nil arguments": {
+			name, uri, description, tags,
+			&Snapshot{*name, *uri, *description, tags, nil, StatusUnread, false, false},
+			nil,
+		},
+		"nil name": {
+			nil, uri, description, tags,
+			nil,
+			errors.New("argument \"name\" is nil"),
+		},
+		"nil uri": {
+			name, nil, description, tags,
+			nil,
+			errors.New("argument \"uri\" is nil"),
+		},
+		"nil description": {
+			name, uri, nil, tags,
+			nil,
+			errors.New("argument \"description\" is nil"),
+		},
+		"nil tags": {
+			name, uri, description, nil,
+			nil,
+			errors.New("argument \"tags\" is nil"),
+		},
+	}
+	for casename, tc := range cases {
+		tc := tc
+		t.Run(casename, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualSnapshot, actualErr := NewSnapshot(tc.name, tc.uri, tc.description, tc.tags)
+			// then
+			assert.Exactly(t, tc.expectedSnapshot, actualSnapshot)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSnapshot_Tags(t *testing.T) {
+	t.Parallel()
+	// given
+	snapshot, _ := NewSnapshot(toName(t, "Example"), toUri(t, "https://example.com"), toDescription(t, ""), toTags(t, "foo"))
+	// when
+	tags := snapshot.Tags()
+	tags[0] = toTags(t, "bar")[0]
+	// then
+	assert.Exactly(t, toTags(t, "foo"), snapshot.Tags())
+}
+
+func TestSnapshot_SetState(t *testing.T) {
+	t.Parallel()
+	t.Run("state", func(t *testing.T) {
+		t.Parallel()
+		// given
+		snapshot, _ := NewSnapshot(toName(t, "Example"), toUri(t, "https://example.com"), toDescription(t, ""), toTags(t, "foo"))
+		// when
+		snapshot.SetState(toId(t, "1"), StatusArchived, true, true)
+		// then
+		assert.Exactly(t, toId(t, "1"), snapshot.Folder())
+		assert.Exactly(t, StatusArchived, snapshot.Status())
+		assert.True(t, snapshot.Starred())
+		assert.True(t, snapshot.IsTrashed())
+	})
+	t.Run("folder pointer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		folder := toId(t, "1")
+		snapshot, _ := NewSnapshot(toName(t, "Example"), toUri(t, "https://example.com"), toDescription(t, ""), toTags(t, "foo"))
+		// when
+		snapshot.SetState(folder, StatusUnread, false, false)
+		// then
+		assert.NotSame(t, folder, snapshot.folder)
+		assert.NotSame(t, snapshot.folder, snapshot.Folder())
+	})
+}
+
+func TestSnapshot_Equals(t *testing.T) {
+	t.Parallel()
+	toSnapshot := func(nv, uv, dv string, tvs ...string) Snapshot {
+		snapshot, err := NewSnapshot(toName(t, nv), toUri(t, uv), toDescription(t, dv), toTags(t, tvs...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *snapshot
+	}
+	toStatefulSnapshot := func(folder *ID, status Status, starred bool, trashed bool) Snapshot {
+		snapshot := toSnapshot("Example", "https://example.com", "Example Domain", "foo", "bar")
+		snapshot.SetState(folder, status, starred, trashed)
+		return snapshot
+	}
+	base := toSnapshot("Example", "https://example.com", "Example Domain", "foo", "bar")
+	cases := map[string]struct {
+		other          Snapshot
+		expectedResult bool
+	}{
+		"same contents": {
+			toSnapshot("Example", "https://example.com", "Example Domain", "foo", "bar"),
+			true,
+		},
+		"different name": {
+			toSnapshot("Example!", "https://example.com", "Example Domain", "foo", "bar"),
+			false,
+		},
+		"different uri": {
+			toSnapshot("Example", "https://example.org", "Example Domain", "foo", "bar"),
+			false,
+		},
+		"different description": {
+			toSnapshot("Example", "https://example.com", "", "foo", "bar"),
+			false,
+		},
+		"different number of tags": {
+			toSnapshot("Example", "https://example.com", "Example Domain", "foo"),
+			false,
+		},
+		"different order of tags": {
+			toSnapshot("Example", "https://example.com", "Example Domain", "bar", "foo"),
+			false,
+		},
+		"same state": {
+			toStatefulSnapshot(nil, StatusUnread, false, false),
+			true,
+		},
+		"different folder": {
+			toStatefulSnapshot(toId(t, "1"), StatusUnread, false, false),
+			false,
+		},
+		"different status": {
+			toStatefulSnapshot(nil, StatusRead, false, false),
+			false,
+		},
+		"different starred": {
+			toStatefulSnapshot(nil, StatusUnread, true, false),
+			false,
+		},
+		"different trashed": {
+			toStatefulSnapshot(nil, StatusUnread, false, true),
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualResult := base.Equals(tc.other)
+			// then
+			assert.Exactly(t, tc.expectedResult, actualResult)
+		})
+	}
+}
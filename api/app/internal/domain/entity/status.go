@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"fmt"
+)
+
+// ブックマークの状態を表す値オブジェクト。
+//
+// ゼロ値は未読を表す。
+type Status int
+
+const (
+	StatusUnread   Status = iota // 未読
+	StatusRead                   // 既読
+	StatusArchived               // アーカイブ済み
+)
+
+// 状態の文字列表現。
+var statusValues = map[Status]string{
+	StatusUnread:   "unread",
+	StatusRead:     "read",
+	StatusArchived: "archived",
+}
+
+// ブックマークの状態を表す値オブジェクトを生成する。
+//
+// 文字列表現 ("unread", "read", "archived") から生成する。
+//
+// 未知の状態を指定した場合はエラーを返却する。
+func NewStatus(v string) (*Status, error) {
+	for status, value := range statusValues {
+		if value == v {
+			return &status, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown status: %s", v)
+}
+
+// 値を取得する。
+func (status Status) Value() string {
+	return statusValues[status]
+}
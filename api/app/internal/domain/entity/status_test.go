@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStatus(t *testing.T) {
+	t.Parallel()
+	unread, read, archived := StatusUnread, StatusRead, StatusArchived
+	cases := map[string]struct {
+		v              string
+		expectedStatus *Status
+		expectedErr    error
+	}{
+		"unread": {
+			"unread",
+			&unread,
+			nil,
+		},
+		"read": {
+			"read",
+			&read,
+			nil,
+		},
+		"archived": {
+			"archived",
+			&archived,
+			nil,
+		},
+		"empty string": {
+			"",
+			nil,
+			errors.New("unknown status: "),
+		},
+		"unknown status": {
+			"deleted",
+			nil,
+			errors.New("unknown status: deleted"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualStatus, actualErr := NewStatus(tc.v)
+			// then
+			assert.Exactly(t, tc.expectedStatus, actualStatus)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestStatus_Value(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		status        Status
+		expectedValue string
+	}{
+		"unread": {
+			StatusUnread,
+			"unread",
+		},
+		"read": {
+			StatusRead,
+			"read",
+		},
+		"archived": {
+			StatusArchived,
+			"archived",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualValue := tc.status.Value()
+			// then
+			assert.Exactly(t, tc.expectedValue, actualValue)
+		})
+	}
+}
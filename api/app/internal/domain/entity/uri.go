@@ -2,8 +2,13 @@ package entity
 
 import (
 	"fmt"
+	"net"
 	"net/url"
+	"sort"
+	"strings"
 	"unicode"
+
+	"golang.org/x/net/idna"
 )
 
 // URIを表す値オブジェクト。
@@ -57,3 +62,136 @@ func (uri *URI) Value() url.URL {
 func (uri *URI) String() string {
 	return uri.value.String()
 }
+
+// スキームごとの既定のポート番号。
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ftp":   "21",
+}
+
+// 正規化したURIを表す値オブジェクトを生成する。
+//
+// スキームとホストを小文字に変換し、既定のポート番号を取り除く。
+// 国際化ドメイン名のホストはPunycodeに変換する。
+// パスが空の場合は "/" とし、それ以外の場合は末尾のスラッシュを取り除く。
+// "!" で始まるもの以外のフラグメントを取り除く。
+// クエリパラメータをキーの辞書順に並べ替える。
+// removeTracking に true を指定した場合は "utm_" で始まるクエリパラメータを取り除く。
+func (uri *URI) Normalize(removeTracking bool) *URI {
+	u := uri.value
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Opaque == "" {
+		u.Host = normalizeHost(u.Scheme, u.Host)
+		u.Path = normalizePath(u.Host, u.Path)
+		u.RawPath = normalizePath(u.Host, u.RawPath)
+	}
+	if !strings.HasPrefix(u.Fragment, "!") {
+		u.Fragment = ""
+		u.RawFragment = ""
+	}
+	u.RawQuery = normalizeQuery(u.RawQuery, removeTracking)
+	u.ForceQuery = false
+	return &URI{u}
+}
+
+// 正規形の文字列を取得する。
+//
+// トラッキング用のクエリパラメータを取り除いて正規化する。
+// 正規形が一致するURIは同一のリソースを指すものとみなす。
+func (uri *URI) Canonical() string {
+	return uri.Normalize(true).String()
+}
+
+// ホストを正規化する。
+func normalizeHost(scheme, host string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ":")
+	if port, ok := defaultPorts[scheme]; ok {
+		host = strings.TrimSuffix(host, ":"+port)
+	}
+	hostname, port := splitHostPort(host)
+	if ascii, err := toASCIIHostname(hostname); err == nil {
+		hostname = ascii
+	}
+	if port == "" {
+		return hostname
+	}
+	return hostname + ":" + port
+}
+
+// ホストをホスト名とポート番号に分割する。
+//
+// IPv6アドレスのホスト名は角括弧を含めて返却する。
+func splitHostPort(host string) (string, string) {
+	i := strings.LastIndex(host, ":")
+	if i < 0 || i < strings.LastIndex(host, "]") {
+		return host, ""
+	}
+	return host[:i], host[i+1:]
+}
+
+// ホスト名の変換に用いるプロファイル。
+//
+// アンダースコアを含むホスト名も許容する。
+var hostnameProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.StrictDomainName(false))
+
+// ホスト名をASCII形式に変換する。
+//
+// 国際化ドメイン名はPunycodeに変換する。
+// IPアドレスはそのまま返却する。
+// 変換に失敗した場合はエラーを返却する。
+func toASCIIHostname(hostname string) (string, error) {
+	if hostname == "" || strings.HasPrefix(hostname, "[") || net.ParseIP(hostname) != nil {
+		return hostname, nil
+	}
+	return hostnameProfile.ToASCII(hostname)
+}
+
+// パスを正規化する。
+func normalizePath(host, path string) string {
+	if path == "" {
+		if host == "" {
+			return ""
+		}
+		return "/"
+	}
+	trimmed := strings.TrimRight(path, "/")
+	if trimmed == "" {
+		return "/"
+	}
+	return trimmed
+}
+
+// クエリ文字列を正規化する。
+//
+// 同一のキーを持つクエリパラメータは元の順序を維持する。
+func normalizeQuery(query string, removeTracking bool) string {
+	params := []string{}
+	for _, param := range strings.Split(query, "&") {
+		if param == "" {
+			continue
+		}
+		if removeTracking && isTrackingParam(queryKey(param)) {
+			continue
+		}
+		params = append(params, param)
+	}
+	sort.SliceStable(params, func(i, j int) bool {
+		return queryKey(params[i]) < queryKey(params[j])
+	})
+	return strings.Join(params, "&")
+}
+
+// クエリパラメータのキーを取得する。
+func queryKey(param string) string {
+	key := strings.SplitN(param, "=", 2)[0]
+	if unescaped, err := url.QueryUnescape(key); err == nil {
+		return unescaped
+	}
+	return key
+}
+
+// トラッキング用のクエリパラメータか判定する。
+func isTrackingParam(key string) bool {
+	return strings.HasPrefix(strings.ToLower(key), "utm_")
+}
@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const MaxURILength = 2048 // URIの既定の最大文字列長
+
+// 既定で許可するスキーム一覧。
+var DefaultURISchemes = []string{"http", "https", "ftp", "file", "mailto"}
+
+// スキームの形式を表す正規表現。
+var schemePattern = regexp.MustCompile(`^[a-z][a-z0-9+.-]*$`)
+
+// URIの制約を表す値オブジェクト。
+type URIPolicy struct {
+	schemes   map[string]bool // 許可するスキーム
+	maxLength int             // 最大文字列長
+}
+
+// URIの制約を表す値オブジェクトを生成する。
+//
+// スキームは大文字と小文字を区別しない。
+//
+// スキームを含まない場合はエラーを返却する。
+// スキームの形式が不正な場合はエラーを返却する。
+// 最大文字列長が0以下の場合はエラーを返却する。
+func NewURIPolicy(schemes []string, maxLength int) (*URIPolicy, error) {
+	if len(schemes) == 0 {
+		return nil, fmt.Errorf("no schemes")
+	}
+	allowed := map[string]bool{}
+	for _, scheme := range schemes {
+		scheme = strings.ToLower(scheme)
+		if !schemePattern.MatchString(scheme) {
+			return nil, fmt.Errorf("invalid scheme: %s", scheme)
+		}
+		allowed[scheme] = true
+	}
+	if maxLength <= 0 {
+		return nil, fmt.Errorf("non-positive max length: %d", maxLength)
+	}
+	return &URIPolicy{allowed, maxLength}, nil
+}
+
+// 既定のURIの制約を表す値オブジェクトを生成する。
+//
+// DefaultURISchemes を許可し、最大文字列長を MaxURILength とする。
+func DefaultURIPolicy() *URIPolicy {
+	policy, _ := NewURIPolicy(DefaultURISchemes, MaxURILength)
+	return policy
+}
+
+// URIが制約を満たすか検証する。
+//
+// nilを指定した場合はエラーを返却する。
+// 文字列長が最大文字列長を超える場合はエラーを返却する。
+// 絶対URIでない場合はエラーを返却する。
+// 許可されていないスキームの場合はエラーを返却する。
+// 既定のポート番号を持つスキームでホストを含まない場合はエラーを返却する。
+// ホスト名をPunycodeに変換できない場合はエラーを返却する。
+func (p *URIPolicy) Validate(uri *URI) error {
+	if uri == nil {
+		return fmt.Errorf("argument \"uri\" is nil")
+	}
+	u := uri.Value()
+	if length := len(uri.String()); length > p.maxLength {
+		return fmt.Errorf("string length exceeds %d: %d", p.maxLength, length)
+	}
+	if !u.IsAbs() {
+		return fmt.Errorf("not absolute URI: %s", uri.String())
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if !p.schemes[scheme] {
+		return fmt.Errorf("scheme not allowed: %s", scheme)
+	}
+	if _, ok := defaultPorts[scheme]; ok && u.Host == "" {
+		return fmt.Errorf("missing host: %s", uri.String())
+	}
+	if _, err := toASCIIHostname(u.Hostname()); err != nil {
+		return fmt.Errorf("invalid host: %s", u.Hostname())
+	}
+	return nil
+}
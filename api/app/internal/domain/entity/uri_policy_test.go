@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewURIPolicy(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		schemes        []string
+		maxLength      int
+		expectedPolicy *URIPolicy
+		expectedErr    error
+	}{
+		"valid arguments": {
+			[]string{"HTTPS", "mailto"},
+			100,
+			&URIPolicy{map[string]bool{"https": true, "mailto": true}, 100},
+			nil,
+		},
+		"no schemes": {
+			[]string{},
+			100,
+			nil,
+			errors.New("no schemes"),
+		},
+		"invalid scheme": {
+			[]string{"https", "1http"},
+			100,
+			nil,
+			errors.New("invalid scheme: 1http"),
+		},
+		"non-positive max length": {
+			[]string{"https"},
+			0,
+			nil,
+			errors.New("non-positive max length: 0"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualPolicy, actualErr := NewURIPolicy(tc.schemes, tc.maxLength)
+			// then
+			assert.Exactly(t, tc.expectedPolicy, actualPolicy)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDefaultURIPolicy(t *testing.T) {
+	t.Parallel()
+	// when
+	policy := DefaultURIPolicy()
+	// then
+	expectedPolicy := &URIPolicy{map[string]bool{"http": true, "https": true, "ftp": true, "file": true, "mailto": true}, MaxURILength}
+	assert.Exactly(t, expectedPolicy, policy)
+}
+
+func TestURIPolicy_Validate(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		v           string
+		expectedErr error
+	}{
+		"http URI": {
+			"http://example.com/foo?q=bar#baz",
+			nil,
+		},
+		"file URI": {
+			"file:///etc/hosts",
+			nil,
+		},
+		"mailto URI": {
+			"mailto:foo@example.com",
+			nil,
+		},
+		"upper case scheme": {
+			"HTTPS://example.com",
+			nil,
+		},
+		"internationalized domain name": {
+			"https://例え.jp/",
+			nil,
+		},
+		"IPv6 address": {
+			"https://[::1]:8443/",
+			nil,
+		},
+		"max length": {
+			"https://example.com/" + strings.Repeat("a", 20),
+			nil,
+		},
+		"exceeding max length": {
+			"https://example.com/" + strings.Repeat("a", 21),
+			errors.New("string length exceeds 40: 41"),
+		},
+		"relative URI": {
+			"/foo/bar",
+			errors.New("not absolute URI: /foo/bar"),
+		},
+		"disallowed scheme": {
+			"javascript:alert(1)",
+			errors.New("scheme not allowed: javascript"),
+		},
+		"missing host": {
+			"https:///foo",
+			errors.New("missing host: https:///foo"),
+		},
+		"invalid host": {
+			"https://xn--a.com/",
+			errors.New("invalid host: xn--a.com"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			policy, _ := NewURIPolicy(DefaultURISchemes, 40)
+			uri, _ := NewURI(tc.v)
+			// when
+			actualErr := policy.Validate(uri)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+	t.Run("nil uri", func(t *testing.T) {
+		t.Parallel()
+		// given
+		policy := DefaultURIPolicy()
+		// when
+		actualErr := policy.Validate(nil)
+		// then
+		expectedErr := errors.New("argument \"uri\" is nil")
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+}
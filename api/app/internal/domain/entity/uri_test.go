@@ -113,3 +113,138 @@ func TestURI_String(t *testing.T) {
 	expectedString := "https://example.com"
 	assert.Exactly(t, expectedString, actualString)
 }
+
+func TestURI_Normalize(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		v              string
+		removeTracking bool
+		expectedString string
+	}{
+		"upper case scheme and host": {
+			"HTTPS://Example.COM/Foo",
+			false,
+			"https://example.com/Foo",
+		},
+		"default port": {
+			"https://example.com:443/foo",
+			false,
+			"https://example.com/foo",
+		},
+		"non-default port": {
+			"https://example.com:8443/foo",
+			false,
+			"https://example.com:8443/foo",
+		},
+		"empty port": {
+			"http://example.com:/foo",
+			false,
+			"http://example.com/foo",
+		},
+		"empty path": {
+			"https://example.com",
+			false,
+			"https://example.com/",
+		},
+		"trailing slash": {
+			"https://example.com/foo/",
+			false,
+			"https://example.com/foo",
+		},
+		"trailing slashes": {
+			"https://example.com//",
+			false,
+			"https://example.com/",
+		},
+		"fragment": {
+			"https://example.com/foo#bar",
+			false,
+			"https://example.com/foo",
+		},
+		"hashbang fragment": {
+			"https://example.com/#!/foo",
+			false,
+			"https://example.com/#!/foo",
+		},
+		"unsorted query": {
+			"https://example.com/?q=2&a=1&q=1",
+			false,
+			"https://example.com/?a=1&q=2&q=1",
+		},
+		"empty query": {
+			"https://example.com/foo?",
+			false,
+			"https://example.com/foo",
+		},
+		"tracking parameters": {
+			"https://example.com/?utm_source=foo&q=bar&UTM_Medium=baz",
+			false,
+			"https://example.com/?UTM_Medium=baz&q=bar&utm_source=foo",
+		},
+		"tracking parameters to remove": {
+			"https://example.com/?utm_source=foo&q=bar&UTM_Medium=baz",
+			true,
+			"https://example.com/?q=bar",
+		},
+		"internationalized domain name": {
+			"https://例え.JP/",
+			false,
+			"https://xn--r8jz45g.jp/",
+		},
+		"IPv6 address": {
+			"http://[::1]:80/",
+			false,
+			"http://[::1]/",
+		},
+		"opaque URI": {
+			"MAILTO:foo@example.com",
+			false,
+			"mailto:foo@example.com",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			uri, _ := NewURI(tc.v)
+			// when
+			actualString := uri.Normalize(tc.removeTracking).String()
+			// then
+			assert.Exactly(t, tc.expectedString, actualString)
+		})
+	}
+}
+
+func TestURI_Canonical(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		xv            string
+		yv            string
+		expectedEquiv bool
+	}{
+		"equivalent URI": {
+			"HTTPS://Example.com:443/foo/?b=2&a=1&utm_source=bar#baz",
+			"https://example.com/foo?a=1&b=2",
+			true,
+		},
+		"non-equivalent URI": {
+			"https://example.com/foo",
+			"https://example.com/bar",
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			x, _ := NewURI(tc.xv)
+			y, _ := NewURI(tc.yv)
+			// when
+			actualEquiv := x.Canonical() == y.Canonical()
+			// then
+			assert.Exactly(t, tc.expectedEquiv, actualEquiv)
+		})
+	}
+}
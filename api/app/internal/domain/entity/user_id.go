@@ -0,0 +1,22 @@
+package entity
+
+// ユーザIDを表す値オブジェクト。
+type UserID struct {
+	value string
+}
+
+// ユーザIDを表す値オブジェクトを生成する。
+//
+// 文字列長が0の場合はエラーを返却する。
+// ハイフン、半角英数字以外の文字を含む場合はエラーを返却する。
+func NewUserID(v string) (*UserID, error) {
+	if err := validateID(v); err != nil {
+		return nil, err
+	}
+	return &UserID{v}, nil
+}
+
+// 値を取得する。
+func (id *UserID) Value() string {
+	return id.value
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserID(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		v              string
+		expectedUserID *UserID
+		expectedErr    error
+	}{
+		"empty string": {
+			"",
+			nil,
+			errors.New("string length is 0"),
+		},
+		"alphanumeric string": {
+			"alice",
+			&UserID{"alice"},
+			nil,
+		},
+		"string with hyphen": {
+			"00a0c91e-6bf6",
+			&UserID{"00a0c91e-6bf6"},
+			nil,
+		},
+		"string with space": {
+			"alice bob",
+			nil,
+			errors.New("contains invalid rune: ' ' (index: 5)"),
+		},
+		"string with at sign": {
+			"alice@example.com",
+			nil,
+			errors.New("contains invalid rune: '@' (index: 5)"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualUserID, actualErr := NewUserID(tc.v)
+			// then
+			assert.Exactly(t, tc.expectedUserID, actualUserID)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestUserID_Equals(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		xv            string
+		yv            string
+		expectedSame  bool
+		expectedEquiv bool
+	}{
+		"equivalent value": {
+			"alice",
+			"alice",
+			false,
+			true,
+		},
+		"non-equivalent value": {
+			"alice",
+			"Alice",
+			false,
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			x, _ := NewUserID(tc.xv)
+			y, _ := NewUserID(tc.yv)
+			// when
+			actualSame := x == y
+			actualEquiv := *x == *y
+			// then
+			assert.Exactly(t, tc.expectedSame, actualSame)
+			assert.Exactly(t, tc.expectedEquiv, actualEquiv)
+		})
+	}
+}
+
+func TestUserID_Value(t *testing.T) {
+	t.Parallel()
+	// given
+	userID, _ := NewUserID("alice")
+	// when
+	actualValue := userID.Value()
+	// then
+	expectedValue := "alice"
+	assert.Exactly(t, expectedValue, actualValue)
+}
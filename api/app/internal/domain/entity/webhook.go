@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Webhookの購読を表すエンティティ。
+//
+// ドメインイベントが発行されるたびに、購読するイベントであれば配信先のURIに通知する。
+type Webhook struct {
+	id     ID       // ID
+	userID UserID   // 所有者のユーザID
+	uri    URI      // 配信先のURI
+	events []string // 購読するイベント名一覧 (空の場合は全てのイベントを購読する)
+	secret string   // 署名に用いる共有シークレット
+}
+
+// イベント名であるか判定する。
+func isEventName(name string) bool {
+	switch name {
+	case EventBookmarkRegistered, EventBookmarkRenamed, EventBookmarkURIRewritten, EventBookmarkTagged, EventBookmarkDeleted,
+		EventBookmarkUntagged, EventBookmarkDescribed, EventBookmarkMoved, EventBookmarkStatusChanged,
+		EventBookmarkStarred, EventBookmarkUnstarred, EventBookmarkRestored, EventBookmarkPurged:
+		return true
+	}
+	return false
+}
+
+// Webhookの配信先のURIを検証する。
+//
+// スキームがhttpまたはhttpsでない場合はエラーを返却する。
+// ホストを含まない場合はエラーを返却する。
+func ValidateWebhookURI(uri URI) error {
+	u := uri.Value()
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("missing host: %s", uri.String())
+	}
+	return nil
+}
+
+// 外部に公開されたアドレスであるか判定する。
+//
+// ループバック、リンクローカル、プライベート、未指定、マルチキャストのアドレスはfalseを返却する。
+func IsPublicAddress(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast())
+}
+
+// Webhookの配信先が外部に公開されたホストであるか検証する。
+//
+// 内部のサービスへのリクエストの偽造 (SSRF) を防ぐために用いる。
+// ホスト名の名前解決は行わないため、名前解決後のアドレスは配信時に検証する。
+//
+// ホスト名がlocalhostの場合はエラーを返却する。
+// ホストが外部に公開されていないIPアドレスの場合はエラーを返却する。
+func ValidateWebhookDestination(uri URI) error {
+	u := uri.Value()
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("forbidden host: %s", u.Hostname())
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicAddress(ip) {
+		return fmt.Errorf("forbidden host: %s", u.Hostname())
+	}
+	return nil
+}
+
+// Webhookで購読するイベント名一覧を検証する。
+//
+// 未知のイベント名を含む場合はエラーを返却する。
+// 重複したイベント名を含む場合はエラーを返却する。
+func ValidateWebhookEvents(events []string) error {
+	seen := map[string]bool{}
+	for _, event := range events {
+		if !isEventName(event) {
+			return fmt.Errorf("unknown event: %s", event)
+		}
+		if seen[event] {
+			return fmt.Errorf("duplicate event: %s", event)
+		}
+		seen[event] = true
+	}
+	return nil
+}
+
+// Webhookの購読を表すエンティティを生成する。
+//
+// イベント名一覧に空のスライスを指定した場合は全てのイベントを購読する。
+//
+// nilを指定した場合はエラーを返却する。
+// URIのスキームがhttpまたはhttpsでない場合、あるいはホストを含まない場合はエラーを返却する。
+// 未知のイベント名、あるいは重複したイベント名を含む場合はエラーを返却する。
+// 共有シークレットが空文字列の場合はエラーを返却する。
+//
+// 複製したスライスをフィールドに設定する。
+func NewWebhook(id *ID, userID *UserID, uri *URI, events []string, secret string) (*Webhook, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if uri == nil {
+		return nil, fmt.Errorf("argument \"uri\" is nil")
+	}
+	if err := ValidateWebhookURI(*uri); err != nil {
+		return nil, err
+	}
+	if err := ValidateWebhookEvents(events); err != nil {
+		return nil, err
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("secret is empty")
+	}
+	return &Webhook{*id, *userID, *uri, append([]string{}, events...), secret}, nil
+}
+
+// フィールド id を取得する。
+func (w *Webhook) ID() ID {
+	return w.id
+}
+
+// フィールド userID を取得する。
+func (w *Webhook) UserID() UserID {
+	return w.userID
+}
+
+// フィールド uri を取得する。
+func (w *Webhook) URI() URI {
+	return w.uri
+}
+
+// フィールド events を取得する。
+//
+// 複製したスライスを返却する。
+func (w *Webhook) Events() []string {
+	return append([]string{}, w.events...)
+}
+
+// フィールド secret を取得する。
+func (w *Webhook) Secret() string {
+	return w.secret
+}
+
+// イベントを購読しているか判定する。
+//
+// イベント名一覧が空の場合は常にtrueを返却する。
+func (w *Webhook) Subscribes(name string) bool {
+	if len(w.events) == 0 {
+		return true
+	}
+	for _, event := range w.events {
+		if event == name {
+			return true
+		}
+	}
+	return false
+}
+
+// インスタンスをディープコピーする。
+func (w Webhook) DeepCopy() *Webhook {
+	copy := &w
+	copy.events = append([]string{}, w.events...)
+	return copy
+}
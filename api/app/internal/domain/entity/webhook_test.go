@@ -0,0 +1,228 @@
+package entity
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhook(t *testing.T) {
+	t.Parallel()
+	id := toId(t, "1")
+	userID := toUserId(t, "alice")
+	uri := toUri(t, "https://example.com/hooks")
+	cases := map[string]struct {
+		id              *ID
+		userID          *UserID
+		uri             *URI
+		events          []string
+		secret          string
+		expectedWebhook *Webhook
+		expectedErr     error
+	}{
+		"valid arguments": {
+			id, userID, uri, []string{EventBookmarkRegistered, EventBookmarkDeleted}, "secret",
+			&Webhook{*id, *userID, *uri, []string{EventBookmarkRegistered, EventBookmarkDeleted}, "secret"},
+			nil,
+		},
+		"empty events": {
+			id, userID, uri, []string{}, "secret",
+			&Webhook{*id, *userID, *uri, []string{}, "secret"},
+			nil,
+		},
+		"nil id": {
+			nil, userID, uri, []string{}, "secret",
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"nil user id": {
+			id, nil, uri, []string{}, "secret",
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil uri": {
+			id, userID, nil, []string{}, "secret",
+			nil,
+			errors.New("argument \"uri\" is nil"),
+		},
+		"unsupported scheme": {
+			id, userID, toUri(t, "ftp://example.com"), []string{}, "secret",
+			nil,
+			errors.New("unsupported scheme: ftp"),
+		},
+		"missing host": {
+			id, userID, toUri(t, "http:///hooks"), []string{}, "secret",
+			nil,
+			errors.New("missing host: http:///hooks"),
+		},
+		"unknown event": {
+			id, userID, uri, []string{"BookmarkPinned"}, "secret",
+			nil,
+			errors.New("unknown event: BookmarkPinned"),
+		},
+		"duplicate event": {
+			id, userID, uri, []string{EventBookmarkRenamed, EventBookmarkRenamed}, "secret",
+			nil,
+			errors.New("duplicate event: BookmarkRenamed"),
+		},
+		"empty secret": {
+			id, userID, uri, []string{}, "",
+			nil,
+			errors.New("secret is empty"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualWebhook, actualErr := NewWebhook(tc.id, tc.userID, tc.uri, tc.events, tc.secret)
+			// then
+			assert.Exactly(t, tc.expectedWebhook, actualWebhook)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		ip       net.IP
+		expected bool
+	}{
+		"public ipv4 address":  {net.ParseIP("93.184.216.34"), true},
+		"public ipv6 address":  {net.ParseIP("2606:2800:220:1::"), true},
+		"loopback address":     {net.ParseIP("127.0.0.1"), false},
+		"ipv6 loopback":        {net.ParseIP("::1"), false},
+		"link-local address":   {net.ParseIP("169.254.169.254"), false},
+		"ipv6 link-local":      {net.ParseIP("fe80::1"), false},
+		"private address":      {net.ParseIP("192.168.0.1"), false},
+		"ipv6 private address": {net.ParseIP("fd00::1"), false},
+		"unspecified address":  {net.ParseIP("0.0.0.0"), false},
+		"multicast address":    {net.ParseIP("224.0.0.1"), false},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actual := IsPublicAddress(tc.ip)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestValidateWebhookDestination(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		uri         *URI
+		expectedErr error
+	}{
+		"public host name": {
+			toUri(t, "https://example.com/hooks"),
+			nil,
+		},
+		"public address": {
+			toUri(t, "https://93.184.216.34/hooks"),
+			nil,
+		},
+		"localhost": {
+			toUri(t, "http://LocalHost:8080/hooks"),
+			errors.New("forbidden host: LocalHost"),
+		},
+		"subdomain of localhost": {
+			toUri(t, "http://api.localhost/hooks"),
+			errors.New("forbidden host: api.localhost"),
+		},
+		"loopback address": {
+			toUri(t, "http://127.0.0.1:8080/hooks"),
+			errors.New("forbidden host: 127.0.0.1"),
+		},
+		"link-local address": {
+			toUri(t, "http://169.254.169.254/latest/meta-data"),
+			errors.New("forbidden host: 169.254.169.254"),
+		},
+		"private address": {
+			toUri(t, "http://172.16.0.1/hooks"),
+			errors.New("forbidden host: 172.16.0.1"),
+		},
+		"ipv6 loopback": {
+			toUri(t, "http://[::1]/hooks"),
+			errors.New("forbidden host: ::1"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := ValidateWebhookDestination(*tc.uri)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_Accessors(t *testing.T) {
+	t.Parallel()
+	// given
+	webhook, _ := NewWebhook(toId(t, "1"), toUserId(t, "alice"), toUri(t, "https://example.com/hooks"), []string{EventBookmarkRenamed}, "secret")
+	// then
+	assert.Exactly(t, *toId(t, "1"), webhook.ID())
+	assert.Exactly(t, *toUserId(t, "alice"), webhook.UserID())
+	assert.Exactly(t, *toUri(t, "https://example.com/hooks"), webhook.URI())
+	assert.Exactly(t, []string{EventBookmarkRenamed}, webhook.Events())
+	assert.Exactly(t, "secret", webhook.Secret())
+}
+
+func TestWebhook_Subscribes(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		events   []string
+		name     string
+		expected bool
+	}{
+		"subscribed event": {
+			[]string{EventBookmarkRenamed, EventBookmarkDeleted},
+			EventBookmarkDeleted,
+			true,
+		},
+		"unsubscribed event": {
+			[]string{EventBookmarkRenamed},
+			EventBookmarkDeleted,
+			false,
+		},
+		"empty events": {
+			[]string{},
+			EventBookmarkTagged,
+			true,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			webhook, _ := NewWebhook(toId(t, "1"), toUserId(t, "alice"), toUri(t, "https://example.com/hooks"), tc.events, "secret")
+			// when
+			actual := webhook.Subscribes(tc.name)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestWebhook_DeepCopy(t *testing.T) {
+	t.Parallel()
+	// given
+	original, _ := NewWebhook(toId(t, "1"), toUserId(t, "alice"), toUri(t, "https://example.com/hooks"), []string{EventBookmarkRenamed}, "secret")
+	// when
+	copy := original.DeepCopy()
+	// then
+	assert.Exactly(t, original, copy)
+	assert.NotSame(t, original, copy)
+	assert.NotSame(t, &original.events[0], &copy.events[0])
+}
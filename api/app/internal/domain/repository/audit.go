@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 監査ログの検索条件。
+//
+// 値が空のフィールドは絞り込みに用いない。
+type AuditSpec struct {
+	BookmarkID *entity.ID     // 操作したブックマークのID
+	UserID     *entity.UserID // 操作したブックマークの所有者のユーザID
+	Actor      string         // 操作者
+	Since      time.Time      // 作成日時の下限 (この日時を含む)
+	Until      time.Time      // 作成日時の上限 (この日時を含まない)
+}
+
+// 監査ログの永続化を担うリポジトリのインターフェース。
+type Audit interface {
+	// IDを生成する。
+	NextID() *entity.ID
+
+	// 監査ログの記録を保存する。
+	//
+	// 保存に成功した場合は記録の作成日時を設定する。
+	// 監査ログは追記のみとし、保存済みの記録は変更しない。
+	Save(entry *entity.AuditEntry) error
+
+	// 検索条件から監査ログの記録一覧を検索する。
+	//
+	// 作成日時の降順、作成日時が等しい場合はIDの昇順に返却する。
+	// 該当する記録が存在しない場合は空のスライスを返却する。
+	FindBySpec(spec *AuditSpec) ([]entity.AuditEntry, error)
+}
@@ -1,27 +1,132 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 )
 
 // ブックマークの永続化を担うリポジトリのインターフェース。
+//
+// ブックマークは所有者ごとに分離する。
+// 検索と一括操作は指定したユーザIDが所有するブックマークに限定し、
+// 保存と削除はブックマークの所有者と保存されている所有者が一致する場合に限る。
+//
+// ブックマークを変更する操作は、変更前後の内容が異なる場合に変更者を添えた改訂を同じ書き込みで記録する。
+// ブックマークを登録、変更、削除する操作は、変更者を操作者とする監査ログの記録を同じ書き込みで追記する。
 type Bookmark interface {
 	// IDを生成する。
 	NextID() *entity.ID
 
 	// ブックマークを保存する。
-	Save(bookmark *entity.Bookmark) error
+	//
+	// 保存に成功した場合はブックマークの版数と更新日時を更新する。
+	// 新規に保存する場合は作成日時も設定する。
+	// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+	// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+	// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
+	Save(bookmark *entity.Bookmark, actor string) error
 
 	// ブックマーク一覧を検索する。
 	//
+	// ゴミ箱にあるブックマークは除外する。
 	// ブックマークが存在しない場合は空のスライスを返却する。
-	FindAll() ([]entity.Bookmark, error)
+	FindAll(userID *entity.UserID) ([]entity.Bookmark, error)
+
+	// 検索条件に該当するブックマーク一覧を検索する。
+	//
+	// ゴミ箱にあるブックマークは除外する。
+	// 該当するブックマークが存在しない場合は空のスライスを返却する。
+	FindBySpec(userID *entity.UserID, spec *BookmarkSpec) ([]entity.Bookmark, error)
 
 	// IDからブックマークを検索する。
 	//
+	// ゴミ箱にあるブックマークは除外する。
 	// 該当するブックマークが存在しない場合はnilを返却する。
-	FindByID(id *entity.ID) (*entity.Bookmark, error)
+	FindByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error)
+
+	// 正規形が一致するURIのブックマークを検索する。
+	//
+	// ゴミ箱にあるブックマークは除外する。
+	// 該当するブックマークが複数存在する場合はIDが最小のブックマークを返却する。
+	// 該当するブックマークが存在しない場合はnilを返却する。
+	FindByCanonicalURI(userID *entity.UserID, uri *entity.URI) (*entity.Bookmark, error)
 
 	// ブックマークを削除する。
-	Delete(bookmark *entity.Bookmark) error
+	//
+	// ゴミ箱を経由せずに完全に削除する。
+	// ブックマークが保存されていない場合は ErrConflict を返却する。
+	// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+	// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+	Delete(bookmark *entity.Bookmark, actor string) error
+
+	// ブックマークをゴミ箱に移動する。
+	//
+	// 移動に成功した場合はブックマークの版数と更新日時、ゴミ箱に移動した日時を更新する。
+	// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+	// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+	Trash(bookmark *entity.Bookmark, actor string) error
+
+	// ブックマークをゴミ箱から復元する。
+	//
+	// 復元に成功した場合はブックマークの版数と更新日時を更新し、ゴミ箱に移動した日時を消去する。
+	// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+	// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+	// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
+	Restore(bookmark *entity.Bookmark, actor string) error
+
+	// ゴミ箱にあるブックマーク一覧を検索する。
+	//
+	// ゴミ箱に移動した日時の降順、IDの昇順に返却する。
+	// ブックマークが存在しない場合は空のスライスを返却する。
+	FindTrash(userID *entity.UserID) ([]entity.Bookmark, error)
+
+	// IDからゴミ箱にあるブックマークを検索する。
+	//
+	// 該当するブックマークが存在しない場合はnilを返却する。
+	FindTrashByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error)
+
+	// 指定日時より前にゴミ箱に移動したブックマークを完全に削除する。
+	//
+	// 削除したブックマーク一覧をIDの昇順に返却する。
+	// 削除したブックマークには完全な削除を記録する。
+	PurgeTrash(userID *entity.UserID, before time.Time, actor string) ([]entity.Bookmark, error)
+
+	// タグごとにブックマーク数を集計する。
+	//
+	// ゴミ箱にあるブックマークは除外する。
+	// タグの辞書順に返却する。
+	// タグが存在しない場合は空のスライスを返却する。
+	CountTags(userID *entity.UserID) ([]TagCount, error)
+
+	// 統合元のタグを統合先のタグに置き換える。
+	//
+	// 所有するブックマークのうち、統合元のタグが付与されたゴミ箱にない全てのブックマークを対象とする。
+	// 置き換えたブックマーク一覧をIDの昇順に返却する。
+	// 置き換えたブックマークの版数と更新日時を更新し、タグの付与と取り外しを記録する。
+	// 検索後に他の書き込みがあった場合は ErrConflict を返却する。
+	MergeTags(userID *entity.UserID, sources []entity.Tag, target *entity.Tag, actor string) ([]entity.Bookmark, error)
+
+	// 正規形が一致するURIのブックマークを重複として集計する。
+	//
+	// ゴミ箱にあるブックマークは除外する。
+	// 正規形のURIの辞書順に返却する。
+	// 重複ごとのブックマークはIDの昇順に並べる。
+	// 重複が存在しない場合は空のスライスを返却する。
+	FindDuplicates(userID *entity.UserID) ([]Duplicate, error)
+
+	// ブックマークを統合する。
+	//
+	// 統合先のブックマークを保存し、統合元のブックマークを削除する。
+	// 保存と削除は全て成功するか全て失敗する。
+	// 保存に成功した場合は統合先のブックマークの版数と更新日時を更新する。
+	// 保存されている版数といずれかのブックマークの版数が異なる場合は ErrConflict を返却する。
+	// 保存されている所有者といずれかのブックマークの所有者が異なる場合は ErrConflict を返却する。
+	MergeBookmarks(target *entity.Bookmark, sources []entity.Bookmark, actor string) error
+
+	// フォルダに所属するブックマークが存在するか確認する。
+	//
+	// 指定したユーザIDが所有するブックマークに限定する。
+	// ゴミ箱から復元すると元のフォルダに戻るため、ゴミ箱にあるブックマークも対象とする。
+	ExistsInFolder(userID *entity.UserID, folder *entity.ID) (bool, error)
 }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 配信に失敗したWebhookの通知の永続化を担うリポジトリのインターフェース。
+type DeadLetter interface {
+	// デッドレターを保存する。
+	//
+	// 保存に成功した場合はデッドレターの作成日時を設定する。
+	// デッドレターは追記のみとし、保存済みのデッドレターは変更しない。
+	Save(deadLetter *entity.DeadLetter) error
+
+	// WebhookのIDからデッドレター一覧を検索する。
+	//
+	// 指定したユーザIDが所有するWebhookのデッドレターに限定する。
+	// WebhookのIDにnilを指定した場合はユーザの全てのデッドレターを検索する。
+	// 作成日時の降順、作成日時が等しい場合はIDの昇順に返却する。
+	// 該当するデッドレターが存在しない場合は空のスライスを返却する。
+	FindByWebhookID(userID *entity.UserID, webhookID *entity.ID) ([]entity.DeadLetter, error)
+}
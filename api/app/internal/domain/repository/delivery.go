@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 配信待ちのWebhookの通知の永続化を担うリポジトリのインターフェース。
+//
+// 通知は配信ワーカーが取り出して配信し、配信を終えるかデッドレターとして記録した後に削除する。
+type Delivery interface {
+	// 通知一覧を保存する。
+	Save(deliveries []entity.Delivery) error
+
+	// 次に試行する日時が指定した日時を過ぎた通知を1件取り出す。
+	//
+	// 次に試行する日時の昇順、次に試行する日時が等しい場合はIDの昇順に取り出す。
+	// 取り出した通知は次に試行する日時を期限に延ばし、期限までは他の配信ワーカーが取り出さないようにする。
+	// 配信を終えずに期限を過ぎた通知は再び取り出す。
+	// 該当する通知が存在しない場合はnilを返却する。
+	Claim(now time.Time, until time.Time) (*entity.Delivery, error)
+
+	// 通知の試行回数と最後の試行で発生したエラー、次に試行する日時を更新する。
+	Update(delivery *entity.Delivery) error
+
+	// 通知を削除する。
+	Delete(delivery *entity.Delivery) error
+}
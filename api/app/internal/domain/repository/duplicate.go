@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 正規形のURIと一致するブックマーク一覧の組。
+type Duplicate struct {
+	CanonicalURI string            // 正規形のURI
+	Bookmarks    []entity.Bookmark // ブックマーク一覧
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "errors"
+
+// 保存されている版数とエンティティの版数が一致しないことを表すエラー。
+var ErrConflict = errors.New("version conflict")
+
+// 所有者が同じで正規形が一致するURIのゴミ箱にない別のエンティティが保存されていることを表すエラー。
+var ErrDuplicate = errors.New("duplicate uri")
+
+// 再開トークンが不正であるか、再開できる範囲を過ぎていることを表すエラー。
+var ErrInvalidResumeToken = errors.New("invalid resume token")
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// フォルダの永続化を担うリポジトリのインターフェース。
+//
+// フォルダは所有者ごとに分離する。
+// 検索は指定したユーザIDが所有するフォルダに限定し、削除はフォルダの所有者と保存されている所有者が一致する場合に限る。
+type Folder interface {
+	// IDを生成する。
+	NextID() *entity.ID
+
+	// フォルダを保存する。
+	Save(folder *entity.Folder) error
+
+	// IDからフォルダを検索する。
+	//
+	// 該当するフォルダが存在しない場合はnilを返却する。
+	FindByID(userID *entity.UserID, id *entity.ID) (*entity.Folder, error)
+
+	// 親フォルダのIDから子フォルダ一覧を検索する。
+	//
+	// nilを指定した場合は最上位のフォルダ一覧を検索する。
+	// 並び順の昇順、並び順が等しい場合はIDの昇順に返却する。
+	// 該当するフォルダが存在しない場合は空のスライスを返却する。
+	FindByParent(userID *entity.UserID, parent *entity.ID) ([]entity.Folder, error)
+
+	// フォルダを削除する。
+	Delete(folder *entity.Folder) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマークの改訂履歴の永続化を担うリポジトリのインターフェース。
+type Revision interface {
+	// IDを生成する。
+	NextID() *entity.ID
+
+	// 改訂を保存する。
+	//
+	// 保存に成功した場合は改訂の作成日時を設定する。
+	// 改訂履歴は追記のみとし、保存済みの改訂は変更しない。
+	Save(revision *entity.Revision) error
+
+	// IDから改訂を検索する。
+	//
+	// 該当する改訂が存在しない場合はnilを返却する。
+	FindByID(id *entity.ID) (*entity.Revision, error)
+
+	// ブックマークのIDから改訂一覧を検索する。
+	//
+	// 作成日時の降順、作成日時が等しい場合は版数の降順に返却する。
+	// 該当する改訂が存在しない場合は空のスライスを返却する。
+	FindByBookmarkID(bookmarkID *entity.ID) ([]entity.Revision, error)
+}
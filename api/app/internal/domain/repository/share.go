@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマークの共有の永続化を担うリポジトリのインターフェース。
+type Share interface {
+	// IDを生成する。
+	NextID() *entity.ID
+
+	// 共有を保存する。
+	Save(share *entity.Share) error
+
+	// IDから共有を検索する。
+	//
+	// 該当する共有が存在しない場合はnilを返却する。
+	FindByID(id *entity.ID) (*entity.Share, error)
+
+	// 共有元のユーザIDから共有一覧を検索する。
+	//
+	// IDの昇順に返却する。
+	// 該当する共有が存在しない場合は空のスライスを返却する。
+	FindByOwner(owner *entity.UserID) ([]entity.Share, error)
+
+	// 共有先のユーザIDから共有一覧を検索する。
+	//
+	// IDの昇順に返却する。
+	// 該当する共有が存在しない場合は空のスライスを返却する。
+	FindByGrantee(grantee *entity.UserID) ([]entity.Share, error)
+
+	// 共有を削除する。
+	Delete(share *entity.Share) error
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマークの並び替えに用いるキー。
+type BookmarkSortKey int
+
+const (
+	SortByID        BookmarkSortKey = iota // IDで並び替える
+	SortByName                             // ブックマーク名で並び替える
+	SortByURI                              // URIで並び替える
+	SortByCreatedAt                        // 作成日時で並び替える
+	SortByUpdatedAt                        // 更新日時で並び替える
+)
+
+// ブックマークの検索条件。
+//
+// 値が空のフィールドは絞り込みに用いない。
+// 並び替えのキーが等しいブックマークはIDの昇順に並べる。
+type BookmarkSpec struct {
+	Tags         []entity.Tag    // いずれかを含むタグ一覧
+	MatchAllTags bool            // Tags を全て含むブックマークに限定するか
+	NameContains string          // ブックマーク名に含まれる文字列 (大文字と小文字を区別しない)
+	URIContains  string          // URIに含まれる文字列 (大文字と小文字を区別しない)
+	Folder       *entity.ID      // 所属するフォルダのID
+	Status       *entity.Status  // 状態
+	StarredOnly  bool            // お気に入りに登録されたブックマークに限定するか
+	SortKey      BookmarkSortKey // 並び替えのキー
+	Descending   bool            // 降順に並び替えるか
+	After        *BookmarkCursor // 再開位置 (nilの場合は先頭から取得する)
+	Limit        int             // 取得する最大件数 (0の場合は無制限)
+}
+
+// ブックマークの検索を再開する位置。
+//
+// 並び替えた結果のうち、キーの値とIDが示す位置より後のブックマークに限定する。
+type BookmarkCursor struct {
+	Value string    // ブックマーク名、URI、IDで並び替える場合のキーの値
+	Time  time.Time // 作成日時、更新日時で並び替える場合のキーの値
+	ID    entity.ID // ブックマークのID
+}
+
+// ブックマークの直後から検索を再開する位置を生成する。
+func NewBookmarkCursor(bookmark *entity.Bookmark, key BookmarkSortKey) *BookmarkCursor {
+	id := bookmark.ID()
+	cursor := &BookmarkCursor{ID: id}
+	switch key {
+	case SortByName:
+		name := bookmark.Name()
+		cursor.Value = name.Value()
+	case SortByURI:
+		uri := bookmark.URI()
+		cursor.Value = uri.String()
+	case SortByCreatedAt:
+		cursor.Time = bookmark.CreatedAt()
+	case SortByUpdatedAt:
+		cursor.Time = bookmark.UpdatedAt()
+	default:
+		cursor.Value = id.Value()
+	}
+	return cursor
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// タグと付与されたブックマーク数の組。
+type TagCount struct {
+	Tag   entity.Tag // タグ
+	Count int        // タグが付与されたブックマーク数
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// ブックマークの変更の種類。
+type ChangeType string
+
+const (
+	ChangeCreated ChangeType = "created" // 作成
+	ChangeUpdated ChangeType = "updated" // 更新 (ゴミ箱からの復元を含む)
+	ChangeDeleted ChangeType = "deleted" // 削除 (ゴミ箱への移動を含む)
+)
+
+// ブックマークの変更。
+type BookmarkChange struct {
+	Type        ChangeType       // 変更の種類
+	ID          entity.ID        // 変更されたブックマークのID
+	UserID      entity.UserID    // 変更されたブックマークの所有者のユーザID
+	Bookmark    *entity.Bookmark // 変更後のブックマーク (完全に削除された場合はnil)
+	ResumeToken string           // この変更の直後から監視を再開するためのトークン
+}
+
+// ブックマークの変更の監視を担うインターフェース。
+type BookmarkWatcher interface {
+	// ブックマークの変更を監視する。
+	//
+	// 指定したユーザIDが所有するブックマークの変更が発生した順にハンドラを呼び出す。
+	// 完全な削除を含め、他のユーザが所有するブックマークの変更は対象外とする。
+	// 再開トークンを指定した場合はトークンが表す変更の直後から、空文字列の場合は呼び出し以降の変更を対象とする。
+	// コンテキストが終了した場合はコンテキストのエラーを返却する。
+	// ハンドラがエラーを返却した場合は監視を終了し、そのエラーを返却する。
+	// 再開トークンが不正な場合、あるいは再開できる範囲を過ぎている場合は ErrInvalidResumeToken を返却する。
+	Watch(ctx context.Context, userID *entity.UserID, resumeToken string, handler func(BookmarkChange) error) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// Webhookの購読の永続化を担うリポジトリのインターフェース。
+//
+// 購読は所有者ごとに分離する。
+// 検索は指定したユーザIDが所有する購読に限定し、削除は購読の所有者と保存されている所有者が一致する場合に限る。
+type Webhook interface {
+	// IDを生成する。
+	NextID() *entity.ID
+
+	// Webhookの購読を保存する。
+	Save(webhook *entity.Webhook) error
+
+	// IDからWebhookの購読を検索する。
+	//
+	// 該当する購読が存在しない場合はnilを返却する。
+	FindByID(userID *entity.UserID, id *entity.ID) (*entity.Webhook, error)
+
+	// Webhookの購読を一覧取得する。
+	//
+	// IDの昇順に返却する。
+	// 購読が存在しない場合は空のスライスを返却する。
+	FindAll(userID *entity.UserID) ([]entity.Webhook, error)
+
+	// Webhookの購読を削除する。
+	Delete(webhook *entity.Webhook) error
+}
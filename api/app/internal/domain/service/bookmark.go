@@ -27,17 +27,21 @@ func NewBookmarkService(repository repository.Bookmark) Bookmark {
 
 // ブックマークが存在するか確認する。
 //
+// 所有者が同じで正規形が一致するURIの別のブックマークが存在する場合は存在するものとみなす。
+// 同じIDのブックマークは存在確認の対象外とする。
+//
 // nilを指定した場合はエラーを返却する。
 // ブックマークの検索に失敗した場合はエラーを返却する。
 func (s *bookmarkService) Exists(bookmark *entity.Bookmark) (bool, error) {
 	if bookmark == nil {
 		return false, fmt.Errorf("argument \"bookmark\" is nil")
 	}
-	id := bookmark.ID()
-	object, err := s.repository.FindByID(&id)
+	userID := bookmark.UserID()
+	uri := bookmark.URI()
+	object, err := s.repository.FindByCanonicalURI(&userID, &uri)
 	if err != nil {
-		return false, fmt.Errorf("failed at repository.FindByID: %w", err)
+		return false, fmt.Errorf("failed at repository.FindByCanonicalURI: %w", err)
 	}
-	exists := object != nil
+	exists := object != nil && object.ID() != bookmark.ID()
 	return exists, nil
 }
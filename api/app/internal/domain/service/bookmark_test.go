@@ -54,7 +54,7 @@ func TestBookmark_Exists(t *testing.T) {
 	}{
 		"existing bookmark": {
 			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
+				repository.EXPECT().FindByCanonicalURI(helper.ToUserID(t, helper.UserID), helper.ToURI(t, "https://example.com")).Return(helper.ToBookmark(t, "2", "EXAMPLE", "https://example.com/"), nil)
 			},
 			helper.ToBookmark(t, "1", "Example", "https://example.com"),
 			true,
@@ -62,7 +62,15 @@ func TestBookmark_Exists(t *testing.T) {
 		},
 		"non-existing bookmark": {
 			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(nil, nil)
+				repository.EXPECT().FindByCanonicalURI(helper.ToUserID(t, helper.UserID), helper.ToURI(t, "https://example.com")).Return(nil, nil)
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com"),
+			false,
+			nil,
+		},
+		"bookmark itself": {
+			func(repository *mock_repository.MockBookmark) {
+				repository.EXPECT().FindByCanonicalURI(helper.ToUserID(t, helper.UserID), helper.ToURI(t, "https://example.com")).Return(helper.ToBookmark(t, "1", "Example", "https://example.com"), nil)
 			},
 			helper.ToBookmark(t, "1", "Example", "https://example.com"),
 			false,
@@ -74,13 +82,13 @@ func TestBookmark_Exists(t *testing.T) {
 			false,
 			errors.New("argument \"bookmark\" is nil"),
 		},
-		"failed at repository.FindByID": {
+		"failed at repository.FindByCanonicalURI": {
 			func(repository *mock_repository.MockBookmark) {
-				repository.EXPECT().FindByID(helper.ToID(t, "1")).Return(nil, errors.New("some error"))
+				repository.EXPECT().FindByCanonicalURI(helper.ToUserID(t, helper.UserID), helper.ToURI(t, "https://example.com")).Return(nil, errors.New("some error"))
 			},
 			helper.ToBookmark(t, "1", "Example", "https://example.com"),
 			false,
-			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+			fmt.Errorf("failed at repository.FindByCanonicalURI: %w", errors.New("some error")),
 		},
 	}
 	for name, tc := range cases {
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// フォルダに関するドメインサービスのインターフェース。
+type Folder interface {
+	// フォルダを親フォルダの配下に移動すると循環が生じるか確認する。
+	CreatesCycle(folder *entity.Folder, parent *entity.ID) (bool, error)
+}
+
+// フォルダに関するドメインサービスの具象型。
+type folderService struct {
+	repository repository.Folder // リポジトリ
+}
+
+// フォルダに関するドメインサービスを生成する。
+func NewFolderService(repository repository.Folder) Folder {
+	return &folderService{
+		repository: repository,
+	}
+}
+
+// フォルダを親フォルダの配下に移動すると循環が生じるか確認する。
+//
+// 親フォルダからフォルダの所有者が所有する祖先を辿り、フォルダ自身に到達する場合は循環が生じるものとみなす。
+// 祖先が既に循環している場合も循環が生じるものとみなす。
+// 親フォルダにnilを指定した場合は循環が生じないものとみなす。
+//
+// フォルダにnilを指定した場合はエラーを返却する。
+// フォルダの検索に失敗した場合はエラーを返却する。
+func (s *folderService) CreatesCycle(folder *entity.Folder, parent *entity.ID) (bool, error) {
+	if folder == nil {
+		return false, fmt.Errorf("argument \"folder\" is nil")
+	}
+	id := folder.ID()
+	userID := folder.UserID()
+	visited := make(map[entity.ID]bool)
+	for current := parent; current != nil; {
+		if *current == id || visited[*current] {
+			return true, nil
+		}
+		visited[*current] = true
+		ancestor, err := s.repository.FindByID(&userID, current)
+		if err != nil {
+			return false, fmt.Errorf("failed at repository.FindByID: %w", err)
+		}
+		if ancestor == nil {
+			return false, nil
+		}
+		current = ancestor.Parent()
+	}
+	return false, nil
+}
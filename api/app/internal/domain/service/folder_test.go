@@ -0,0 +1,141 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	mock_repository "github.com/kkntzw/bookmark/test/mock/domain/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFolderService(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Run("implementing service.Folder", func(t *testing.T) {
+		t.Parallel()
+		// given
+		repository := mock_repository.NewMockFolder(ctrl)
+		// when
+		object := NewFolderService(repository)
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*Folder)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		repository := mock_repository.NewMockFolder(ctrl)
+		abstractService := NewFolderService(repository)
+		// when
+		concreteService, ok := abstractService.(*folderService)
+		actualRepository := concreteService.repository
+		// then
+		assert.True(t, ok)
+		expectedRepository := repository
+		assert.Exactly(t, expectedRepository, actualRepository)
+	})
+}
+
+func TestFolder_CreatesCycle(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare        func(*mock_repository.MockFolder)
+		folder         *entity.Folder
+		parent         *entity.ID
+		expectedCycles bool
+		expectedErr    error
+	}{
+		"parent under other tree": {
+			func(repository *mock_repository.MockFolder) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "3")).Return(helper.ToFolder(t, "3", "Go", "2", 0), nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Work", "", 0), nil)
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			helper.ToID(t, "3"),
+			false,
+			nil,
+		},
+		"parent is descendant": {
+			func(repository *mock_repository.MockFolder) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "3")).Return(helper.ToFolder(t, "3", "Go", "2", 0), nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Work", "1", 0), nil)
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			helper.ToID(t, "3"),
+			true,
+			nil,
+		},
+		"parent is itself": {
+			func(repository *mock_repository.MockFolder) {},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			helper.ToID(t, "1"),
+			true,
+			nil,
+		},
+		"ancestors already cycle": {
+			func(repository *mock_repository.MockFolder) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(helper.ToFolder(t, "2", "Work", "3", 0), nil)
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "3")).Return(helper.ToFolder(t, "3", "Go", "2", 0), nil)
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			helper.ToID(t, "2"),
+			true,
+			nil,
+		},
+		"unstored parent": {
+			func(repository *mock_repository.MockFolder) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, nil)
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			helper.ToID(t, "2"),
+			false,
+			nil,
+		},
+		"nil parent": {
+			func(repository *mock_repository.MockFolder) {},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			nil,
+			false,
+			nil,
+		},
+		"nil folder": {
+			func(repository *mock_repository.MockFolder) {},
+			nil,
+			helper.ToID(t, "2"),
+			false,
+			errors.New("argument \"folder\" is nil"),
+		},
+		"failed at repository.FindByID": {
+			func(repository *mock_repository.MockFolder) {
+				repository.EXPECT().FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "2")).Return(nil, errors.New("some error"))
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			helper.ToID(t, "2"),
+			false,
+			fmt.Errorf("failed at repository.FindByID: %w", errors.New("some error")),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			repository := mock_repository.NewMockFolder(ctrl)
+			tc.prepare(repository)
+			// given
+			service := NewFolderService(repository)
+			// when
+			actualCycles, actualErr := service.CreatesCycle(tc.folder, tc.parent)
+			// then
+			assert.Exactly(t, tc.expectedCycles, actualCycles)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
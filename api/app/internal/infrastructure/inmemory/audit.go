@@ -0,0 +1,105 @@
+package inmemory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// 監査ログの永続化を担うリポジトリの具象型。
+type auditRepository struct {
+	store map[entity.ID]entity.AuditEntry // ストレージ
+	clock clock.Clock                     // 時計
+}
+
+// 監査ログの永続化を担うリポジトリを生成する。
+func NewAuditRepository(clock clock.Clock) repository.Audit {
+	return &auditRepository{
+		store: make(map[entity.ID]entity.AuditEntry),
+		clock: clock,
+	}
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *auditRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// 監査ログの記録を保存する。
+//
+// 保存に成功した場合は記録の作成日時を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+// 同じIDの記録が保存されている場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *auditRepository) Save(entry *entity.AuditEntry) error {
+	if entry == nil {
+		return fmt.Errorf("argument \"entry\" is nil")
+	}
+	id := entry.ID()
+	if _, ok := r.store[id]; ok {
+		return fmt.Errorf("audit entry already exists: %s", id.Value())
+	}
+	entry.SetCreatedAt(r.clock.Now())
+	r.store[id] = *entry.DeepCopy()
+	return nil
+}
+
+// 検索条件から監査ログの記録一覧を検索する。
+//
+// 作成日時の降順、作成日時が等しい場合はIDの昇順に返却する。
+// 該当する記録が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当する記録が存在する場合は複製したインスタンスを返却する。
+func (r *auditRepository) FindBySpec(spec *repository.AuditSpec) ([]entity.AuditEntry, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("argument \"spec\" is nil")
+	}
+	entries := []entity.AuditEntry{}
+	for _, entry := range r.store {
+		if satisfiesAuditSpec(entry, spec) {
+			entries = append(entries, *entry.DeepCopy())
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		x, y := entries[i].CreatedAt(), entries[j].CreatedAt()
+		if x.Equal(y) {
+			a, b := entries[i].ID(), entries[j].ID()
+			return a.Value() < b.Value()
+		}
+		return x.After(y)
+	})
+	return entries, nil
+}
+
+// 監査ログの記録が検索条件を満たすか判定する。
+func satisfiesAuditSpec(entry entity.AuditEntry, spec *repository.AuditSpec) bool {
+	if spec.BookmarkID != nil && entry.BookmarkID() != *spec.BookmarkID {
+		return false
+	}
+	if spec.UserID != nil && entry.UserID() != *spec.UserID {
+		return false
+	}
+	if spec.Actor != "" && entry.Actor() != spec.Actor {
+		return false
+	}
+	createdAt := entry.CreatedAt()
+	if !spec.Since.IsZero() && createdAt.Before(spec.Since) {
+		return false
+	}
+	if !spec.Until.IsZero() && !createdAt.Before(spec.Until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,183 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.Audit", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewAuditRepository(helper.ToFixedClock(t, now))
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.Audit)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewAuditRepository(helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*auditRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := map[entity.ID]entity.AuditEntry{}
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestAudit_NextID(t *testing.T) {
+	t.Parallel()
+	// given
+	repository := NewAuditRepository(helper.ToFixedClock(t, now))
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+}
+
+func TestAudit_Save(t *testing.T) {
+	t.Parallel()
+	before := helper.ToSnapshot(t, "Example", "https://example.com")
+	after := helper.ToSnapshot(t, "Example Domain", "https://example.com")
+	cases := map[string]struct {
+		prepare       func(repository.Audit)
+		entry         *entity.AuditEntry
+		expectedEntry *entity.AuditEntry
+		expectedErr   error
+	}{
+		"new entry": {
+			func(r repository.Audit) {},
+			helper.ToAuditEntry(t, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after),
+			helper.ToTimestampedAuditEntry(t, now, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after),
+			nil,
+		},
+		"stored entry": {
+			func(r repository.Audit) {
+				r.Save(helper.ToAuditEntry(t, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after))
+			},
+			helper.ToAuditEntry(t, "100", "bob", entity.AuditOperationDelete, "1", helper.UserID, after, nil),
+			helper.ToAuditEntry(t, "100", "bob", entity.AuditOperationDelete, "1", helper.UserID, after, nil),
+			errors.New("audit entry already exists: 100"),
+		},
+		"nil entry": {
+			func(r repository.Audit) {},
+			nil,
+			nil,
+			errors.New("argument \"entry\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewAuditRepository(helper.ToFixedClock(t, now))
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Save(tc.entry)
+			// then
+			assert.Exactly(t, tc.expectedEntry, tc.entry)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestAudit_FindBySpec(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := helper.ToSnapshot(t, "Example A", "https://example.com")
+	b := helper.ToSnapshot(t, "Example B", "https://example.com")
+	prepare := func(r *auditRepository) {
+		r.store[*helper.ToID(t, "100")] = *helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a)
+		r.store[*helper.ToID(t, "101")] = *helper.ToTimestampedAuditEntry(t, now, "101", "bob", entity.AuditOperationUpdate, "1", helper.UserID, a, b)
+		r.store[*helper.ToID(t, "102")] = *helper.ToTimestampedAuditEntry(t, now, "102", "alice", entity.AuditOperationDelete, "2", helper.UserID, b, nil)
+	}
+	cases := map[string]struct {
+		spec            *repository.AuditSpec
+		expectedEntries []entity.AuditEntry
+		expectedErr     error
+	}{
+		"empty spec": {
+			&repository.AuditSpec{},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, now, "101", "bob", entity.AuditOperationUpdate, "1", helper.UserID, a, b),
+				*helper.ToTimestampedAuditEntry(t, now, "102", "alice", entity.AuditOperationDelete, "2", helper.UserID, b, nil),
+				*helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a),
+			},
+			nil,
+		},
+		"bookmark id": {
+			&repository.AuditSpec{BookmarkID: helper.ToID(t, "1")},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, now, "101", "bob", entity.AuditOperationUpdate, "1", helper.UserID, a, b),
+				*helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a),
+			},
+			nil,
+		},
+		"actor": {
+			&repository.AuditSpec{Actor: "alice"},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, now, "102", "alice", entity.AuditOperationDelete, "2", helper.UserID, b, nil),
+				*helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a),
+			},
+			nil,
+		},
+		"since": {
+			&repository.AuditSpec{Since: now},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, now, "101", "bob", entity.AuditOperationUpdate, "1", helper.UserID, a, b),
+				*helper.ToTimestampedAuditEntry(t, now, "102", "alice", entity.AuditOperationDelete, "2", helper.UserID, b, nil),
+			},
+			nil,
+		},
+		"until": {
+			&repository.AuditSpec{Until: now},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a),
+			},
+			nil,
+		},
+		"all conditions": {
+			&repository.AuditSpec{BookmarkID: helper.ToID(t, "1"), Actor: "alice", Since: earlier, Until: now},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, a),
+			},
+			nil,
+		},
+		"no entries": {
+			&repository.AuditSpec{Actor: "carol"},
+			[]entity.AuditEntry{},
+			nil,
+		},
+		"nil spec": {
+			nil,
+			nil,
+			errors.New("argument \"spec\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewAuditRepository(helper.ToFixedClock(t, now))
+			prepare(repository.(*auditRepository))
+			// when
+			actualEntries, actualErr := repository.FindBySpec(tc.spec)
+			// then
+			assert.Exactly(t, tc.expectedEntries, actualEntries)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
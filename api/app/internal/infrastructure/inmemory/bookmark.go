@@ -2,22 +2,107 @@ package inmemory
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/repository"
 )
 
 // ブックマークの永続化を担うリポジトリの具象型。
 type bookmarkRepository struct {
-	store map[entity.ID]entity.Bookmark // ストレージ
+	store       map[entity.ID]entity.Bookmark // ストレージ
+	clock       clock.Clock                   // 時計
+	broadcaster *BookmarkBroadcaster          // 変更の配信先 (配信しない場合はnil)
+	revisions   repository.Revision           // 改訂履歴の記録先 (記録しない場合はnil)
+	audits      repository.Audit              // 監査ログの記録先 (記録しない場合はnil)
 }
 
 // ブックマークの永続化を担うリポジトリを生成する。
-func NewBookmarkRepository() repository.Bookmark {
+//
+// 配信先を指定した場合はストレージの変更を配信する。
+// 改訂履歴の記録先を指定した場合はブックマークの内容の変更を改訂として記録する。
+// 監査ログの記録先を指定した場合はブックマークの登録、変更、削除を監査ログに記録する。
+func NewBookmarkRepository(clock clock.Clock, broadcaster *BookmarkBroadcaster, revisions repository.Revision, audits repository.Audit) repository.Bookmark {
 	return &bookmarkRepository{
-		store: make(map[entity.ID]entity.Bookmark),
+		store:       make(map[entity.ID]entity.Bookmark),
+		clock:       clock,
+		broadcaster: broadcaster,
+		revisions:   revisions,
+		audits:      audits,
+	}
+}
+
+// ブックマークの変更を配信する。
+//
+// 配信先が指定されていない場合は何もしない。
+func (r *bookmarkRepository) notify(changeType repository.ChangeType, id entity.ID, userID entity.UserID, bookmark *entity.Bookmark) {
+	if r.broadcaster == nil {
+		return
 	}
+	r.broadcaster.publish(changeType, id, userID, bookmark)
+}
+
+// ブックマークの操作を改訂と監査ログに記録する。
+//
+// 変更前の内容がある場合は改訂を記録する。
+// 登録と復元は変更後の内容、削除は変更前の内容、更新は変更前後の内容を監査ログに記録する。
+// 更新で変更前後の内容が等しい場合はいずれにも記録しない。
+//
+// 改訂または監査ログの保存に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) record(bookmark *entity.Bookmark, before *entity.Snapshot, actor string, operation entity.AuditOperation) error {
+	after := bookmark.Snapshot()
+	if before != nil && before.Equals(after) {
+		return nil
+	}
+	if r.revisions != nil && before != nil {
+		id := bookmark.ID()
+		revision, _ := entity.NewRevision(r.revisions.NextID(), &id, bookmark.Version(), actor, before, &after)
+		if err := r.revisions.Save(revision); err != nil {
+			return fmt.Errorf("failed at revisions.Save: %w", err)
+		}
+	}
+	switch operation {
+	case entity.AuditOperationRegister, entity.AuditOperationRestore:
+		return r.audit(bookmark, actor, operation, nil, &after)
+	case entity.AuditOperationDelete:
+		return r.audit(bookmark, actor, operation, before, nil)
+	default:
+		return r.audit(bookmark, actor, operation, before, &after)
+	}
+}
+
+// ブックマークの操作を監査ログに記録する。
+//
+// 記録先が指定されていない場合は何もしない。
+//
+// 監査ログの保存に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) audit(bookmark *entity.Bookmark, actor string, operation entity.AuditOperation, before, after *entity.Snapshot) error {
+	if r.audits == nil {
+		return nil
+	}
+	id := bookmark.ID()
+	userID := bookmark.UserID()
+	entry, _ := entity.NewAuditEntry(r.audits.NextID(), actor, operation, &id, &userID, before, after)
+	if err := r.audits.Save(entry); err != nil {
+		return fmt.Errorf("failed at audits.Save: %w", err)
+	}
+	return nil
+}
+
+// ストレージに保存されているブックマークの内容を取得する。
+//
+// ブックマークが保存されていない場合はnilを返却する。
+func (r *bookmarkRepository) preImage(id entity.ID) *entity.Snapshot {
+	stored, ok := r.store[id]
+	if !ok {
+		return nil
+	}
+	snapshot := stored.Snapshot()
+	return &snapshot
 }
 
 // IDを生成する。
@@ -31,55 +116,651 @@ func (r *bookmarkRepository) NextID() *entity.ID {
 
 // ブックマークを保存する。
 //
+// 保存に成功した場合はブックマークの版数と更新日時を更新する。
+// 新規に保存する場合は作成日時も設定する。
+//
 // nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
 //
 // 複製したインスタンスをストレージに保存する。
-func (r *bookmarkRepository) Save(bookmark *entity.Bookmark) error {
+// 発行前のドメインイベントは保存しない。
+// 保存されていた内容から変更された場合は改訂と更新を記録し、保存されていなかった場合は登録を記録する。
+func (r *bookmarkRepository) Save(bookmark *entity.Bookmark, actor string) error {
 	if bookmark == nil {
 		return fmt.Errorf("argument \"bookmark\" is nil")
 	}
-	r.store[bookmark.ID()] = *bookmark.DeepCopy()
-	return nil
+	operation := entity.AuditOperationUpdate
+	if _, ok := r.store[bookmark.ID()]; !ok {
+		operation = entity.AuditOperationRegister
+	}
+	return r.save(bookmark, actor, operation)
+}
+
+// ブックマークを保存し、操作を改訂と監査ログに記録する。
+//
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
+func (r *bookmarkRepository) save(bookmark *entity.Bookmark, actor string, operation entity.AuditOperation) error {
+	if r.conflicts(bookmark) {
+		return repository.ErrConflict
+	}
+	if r.duplicates(bookmark) {
+		return repository.ErrDuplicate
+	}
+	now := r.clock.Now()
+	createdAt := bookmark.CreatedAt()
+	if bookmark.Version() == 0 {
+		createdAt = now
+	}
+	changeType := repository.ChangeUpdated
+	if bookmark.Version() == 0 {
+		changeType = repository.ChangeCreated
+	} else if bookmark.IsTrashed() {
+		changeType = repository.ChangeDeleted
+	}
+	before := r.preImage(bookmark.ID())
+	bookmark.SetVersion(bookmark.Version() + 1)
+	bookmark.SetTimestamps(createdAt, now)
+	stored := bookmark.DeepCopy()
+	stored.PullEvents()
+	r.store[bookmark.ID()] = *stored
+	r.notify(changeType, bookmark.ID(), bookmark.UserID(), stored)
+	return r.record(bookmark, before, actor, operation)
+}
+
+// ストレージに保存されているブックマークと所有者または版数が異なるか判定する。
+//
+// ブックマークが保存されていない場合は版数を0として比較する。
+func (r *bookmarkRepository) conflicts(bookmark *entity.Bookmark) bool {
+	stored, ok := r.store[bookmark.ID()]
+	if !ok {
+		return bookmark.Version() != 0
+	}
+	return stored.UserID() != bookmark.UserID() || stored.Version() != bookmark.Version()
+}
+
+// ゴミ箱にないブックマークについて、所有者が同じで正規形が一致するURIの別のブックマークが保存されているか判定する。
+//
+// 除外するIDのブックマークは判定の対象外とする。
+func (r *bookmarkRepository) duplicates(bookmark *entity.Bookmark, excluded ...entity.ID) bool {
+	if bookmark.IsTrashed() {
+		return false
+	}
+	skipped := map[entity.ID]bool{bookmark.ID(): true}
+	for _, id := range excluded {
+		skipped[id] = true
+	}
+	userID := bookmark.UserID()
+	uri := bookmark.URI()
+	for id, stored := range r.store {
+		if skipped[id] || stored.IsTrashed() || !ownedBy(&stored, &userID) {
+			continue
+		}
+		if storedURI := stored.URI(); storedURI.Canonical() == uri.Canonical() {
+			return true
+		}
+	}
+	return false
+}
+
+// 所有者が一致するか判定する。
+func ownedBy(bookmark *entity.Bookmark, userID *entity.UserID) bool {
+	return bookmark.UserID() == *userID
 }
 
 // ブックマーク一覧を検索する。
 //
+// ゴミ箱にあるブックマークは除外する。
 // ブックマークが存在しない場合は空のスライスを返却する。
 //
+// nilを指定した場合はエラーを返却する。
+//
 // ブックマークが存在する場合は複製したインスタンスを返却する。
-func (r *bookmarkRepository) FindAll() ([]entity.Bookmark, error) {
+func (r *bookmarkRepository) FindAll(userID *entity.UserID) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
 	bookmarks := []entity.Bookmark{}
 	for _, bookmark := range r.store {
+		if bookmark.IsTrashed() || !ownedBy(&bookmark, userID) {
+			continue
+		}
 		bookmarks = append(bookmarks, *bookmark.DeepCopy())
 	}
 	return bookmarks, nil
 }
 
+// 検索条件に該当するブックマーク一覧を検索する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// 該当するブックマークが存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当するブックマークが存在する場合は複製したインスタンスを返却する。
+func (r *bookmarkRepository) FindBySpec(userID *entity.UserID, spec *repository.BookmarkSpec) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("argument \"spec\" is nil")
+	}
+	bookmarks := []entity.Bookmark{}
+	for _, bookmark := range r.store {
+		if ownedBy(&bookmark, userID) && satisfies(&bookmark, spec) {
+			bookmarks = append(bookmarks, *bookmark.DeepCopy())
+		}
+	}
+	sort.Slice(bookmarks, func(i, j int) bool {
+		x, y := sortValue(&bookmarks[i], spec.SortKey), sortValue(&bookmarks[j], spec.SortKey)
+		if x == y {
+			return idValue(&bookmarks[i]) < idValue(&bookmarks[j])
+		}
+		return (x < y) != spec.Descending
+	})
+	if spec.After != nil {
+		bookmarks = bookmarks[sort.Search(len(bookmarks), func(i int) bool {
+			return isAfter(&bookmarks[i], spec)
+		}):]
+	}
+	if spec.Limit > 0 && spec.Limit < len(bookmarks) {
+		bookmarks = bookmarks[:spec.Limit]
+	}
+	return bookmarks, nil
+}
+
+// ブックマークが検索条件に該当するか判定する。
+func satisfies(bookmark *entity.Bookmark, spec *repository.BookmarkSpec) bool {
+	if bookmark.IsTrashed() {
+		return false
+	}
+	if len(spec.Tags) > 0 {
+		tags := map[entity.Tag]bool{}
+		for _, tag := range bookmark.Tags() {
+			tags[tag] = true
+		}
+		matched := 0
+		for _, tag := range spec.Tags {
+			if tags[tag] {
+				matched++
+			}
+		}
+		if matched == 0 || (spec.MatchAllTags && matched < len(spec.Tags)) {
+			return false
+		}
+	}
+	name := bookmark.Name()
+	if !containsFold(name.Value(), spec.NameContains) {
+		return false
+	}
+	uri := bookmark.URI()
+	if !containsFold(uri.String(), spec.URIContains) {
+		return false
+	}
+	if spec.Folder != nil {
+		folder := bookmark.Folder()
+		if folder == nil || *folder != *spec.Folder {
+			return false
+		}
+	}
+	if spec.Status != nil && bookmark.Status() != *spec.Status {
+		return false
+	}
+	if spec.StarredOnly && !bookmark.Starred() {
+		return false
+	}
+	return true
+}
+
+// 大文字と小文字を区別せずに部分文字列を含むか判定する。
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// 並び替えのキーに対応する値を取得する。
+func sortValue(bookmark *entity.Bookmark, key repository.BookmarkSortKey) string {
+	switch key {
+	case repository.SortByName:
+		name := bookmark.Name()
+		return name.Value()
+	case repository.SortByURI:
+		uri := bookmark.URI()
+		return uri.String()
+	case repository.SortByCreatedAt:
+		return timeValue(bookmark.CreatedAt())
+	case repository.SortByUpdatedAt:
+		return timeValue(bookmark.UpdatedAt())
+	default:
+		return idValue(bookmark)
+	}
+}
+
+// ブックマークが再開位置より後に並ぶか判定する。
+func isAfter(bookmark *entity.Bookmark, spec *repository.BookmarkSpec) bool {
+	x, y := sortValue(bookmark, spec.SortKey), cursorValue(spec.After, spec.SortKey)
+	if x == y {
+		return idValue(bookmark) > spec.After.ID.Value()
+	}
+	return (x > y) != spec.Descending
+}
+
+// 再開位置から並び替えに用いる値を取得する。
+func cursorValue(cursor *repository.BookmarkCursor, key repository.BookmarkSortKey) string {
+	switch key {
+	case repository.SortByCreatedAt, repository.SortByUpdatedAt:
+		return timeValue(cursor.Time)
+	default:
+		return cursor.Value
+	}
+}
+
+// 日時を辞書順で比較できる固定長の文字列に変換する。
+func timeValue(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000000000")
+}
+
+// IDの値を取得する。
+func idValue(bookmark *entity.Bookmark) string {
+	id := bookmark.ID()
+	return id.Value()
+}
+
 // IDからブックマークを検索する。
 //
+// ゴミ箱にあるブックマークは除外する。
 // 該当するブックマークが存在しない場合はnilを返却する。
 //
 // nilを指定した場合はエラーを返却する。
 //
 // 該当するブックマークが存在する場合は複製したインスタンスを返却する。
-func (r *bookmarkRepository) FindByID(id *entity.ID) (*entity.Bookmark, error) {
+func (r *bookmarkRepository) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
 	if id == nil {
 		return nil, fmt.Errorf("argument \"id\" is nil")
 	}
 	bookmark, ok := r.store[*id]
-	if !ok {
+	if !ok || bookmark.IsTrashed() || !ownedBy(&bookmark, userID) {
 		return nil, nil
 	}
 	return bookmark.DeepCopy(), nil
 }
 
+// 正規形が一致するURIのブックマークを検索する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// 該当するブックマークが複数存在する場合はIDが最小のブックマークを返却する。
+// 該当するブックマークが存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当するブックマークが存在する場合は複製したインスタンスを返却する。
+func (r *bookmarkRepository) FindByCanonicalURI(userID *entity.UserID, uri *entity.URI) (*entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if uri == nil {
+		return nil, fmt.Errorf("argument \"uri\" is nil")
+	}
+	canonical := uri.Canonical()
+	var found *entity.Bookmark
+	for _, bookmark := range r.store {
+		bookmark := bookmark
+		storedURI := bookmark.URI()
+		if bookmark.IsTrashed() || !ownedBy(&bookmark, userID) || storedURI.Canonical() != canonical {
+			continue
+		}
+		if found == nil || idValue(&bookmark) < idValue(found) {
+			found = &bookmark
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	return found.DeepCopy(), nil
+}
+
 // ブックマークを削除する。
 //
+// ゴミ箱を経由せずに完全に削除する。
+//
 // nilを指定した場合はエラーを返却する。
-func (r *bookmarkRepository) Delete(bookmark *entity.Bookmark) error {
+// ブックマークが保存されていない場合は ErrConflict を返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+//
+// 完全な削除を監査ログに記録する。
+func (r *bookmarkRepository) Delete(bookmark *entity.Bookmark, actor string) error {
 	if bookmark == nil {
 		return fmt.Errorf("argument \"bookmark\" is nil")
 	}
+	if _, ok := r.store[bookmark.ID()]; !ok || r.conflicts(bookmark) {
+		return repository.ErrConflict
+	}
+	return r.purge(bookmark, actor)
+}
+
+// ブックマークをストレージから削除し、完全な削除を監査ログに記録する。
+//
+// 監査ログの保存に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) purge(bookmark *entity.Bookmark, actor string) error {
 	delete(r.store, bookmark.ID())
+	r.notify(repository.ChangeDeleted, bookmark.ID(), bookmark.UserID(), nil)
+	before := bookmark.Snapshot()
+	return r.audit(bookmark, actor, entity.AuditOperationPurge, &before, nil)
+}
+
+// ブックマークをゴミ箱に移動する。
+//
+// 移動に成功した場合はブックマークの版数と更新日時、ゴミ箱に移動した日時を更新する。
+//
+// nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+func (r *bookmarkRepository) Trash(bookmark *entity.Bookmark, actor string) error {
+	if bookmark == nil {
+		return fmt.Errorf("argument \"bookmark\" is nil")
+	}
+	return r.changeDeletedAt(bookmark, r.clock.Now(), actor, entity.AuditOperationDelete)
+}
+
+// ブックマークをゴミ箱から復元する。
+//
+// 復元に成功した場合はブックマークの版数と更新日時を更新し、ゴミ箱に移動した日時を消去する。
+//
+// nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
+func (r *bookmarkRepository) Restore(bookmark *entity.Bookmark, actor string) error {
+	if bookmark == nil {
+		return fmt.Errorf("argument \"bookmark\" is nil")
+	}
+	return r.changeDeletedAt(bookmark, time.Time{}, actor, entity.AuditOperationRestore)
+}
+
+// ゴミ箱に移動した日時を変更して保存する。
+//
+// 指定した操作として監査ログに記録する。
+//
+// 保存されていないブックマークを指定した場合は ErrConflict を返却する。
+func (r *bookmarkRepository) changeDeletedAt(bookmark *entity.Bookmark, deletedAt time.Time, actor string, operation entity.AuditOperation) error {
+	if _, ok := r.store[bookmark.ID()]; !ok || r.conflicts(bookmark) {
+		return repository.ErrConflict
+	}
+	previous := bookmark.DeletedAt()
+	bookmark.SetDeletedAt(deletedAt)
+	if err := r.save(bookmark, actor, operation); err != nil {
+		bookmark.SetDeletedAt(previous)
+		return err
+	}
 	return nil
 }
+
+// ゴミ箱にあるブックマーク一覧を検索する。
+//
+// ゴミ箱に移動した日時の降順、IDの昇順に返却する。
+// ブックマークが存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// ブックマークが存在する場合は複製したインスタンスを返却する。
+func (r *bookmarkRepository) FindTrash(userID *entity.UserID) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	bookmarks := []entity.Bookmark{}
+	for _, bookmark := range r.store {
+		if bookmark.IsTrashed() && ownedBy(&bookmark, userID) {
+			bookmarks = append(bookmarks, *bookmark.DeepCopy())
+		}
+	}
+	sort.Slice(bookmarks, func(i, j int) bool {
+		x, y := bookmarks[i].DeletedAt(), bookmarks[j].DeletedAt()
+		if x.Equal(y) {
+			return idValue(&bookmarks[i]) < idValue(&bookmarks[j])
+		}
+		return x.After(y)
+	})
+	return bookmarks, nil
+}
+
+// IDからゴミ箱にあるブックマークを検索する。
+//
+// 該当するブックマークが存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当するブックマークが存在する場合は複製したインスタンスを返却する。
+func (r *bookmarkRepository) FindTrashByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	bookmark, ok := r.store[*id]
+	if !ok || !bookmark.IsTrashed() || !ownedBy(&bookmark, userID) {
+		return nil, nil
+	}
+	return bookmark.DeepCopy(), nil
+}
+
+// 指定日時より前にゴミ箱に移動したブックマークを完全に削除する。
+//
+// 削除したブックマーク一覧をIDの昇順に返却する。
+// 削除したブックマークには完全な削除を記録する。
+//
+// nilを指定した場合はエラーを返却する。
+// 監査ログの保存に失敗した場合はエラーを返却する。
+//
+// 削除したブックマークごとに完全な削除を監査ログに記録する。
+// 複製したインスタンスを返却する。
+func (r *bookmarkRepository) PurgeTrash(userID *entity.UserID, before time.Time, actor string) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	bookmarks := []entity.Bookmark{}
+	for _, bookmark := range r.store {
+		if bookmark.IsTrashed() && ownedBy(&bookmark, userID) && bookmark.DeletedAt().Before(before) {
+			purged := bookmark.DeepCopy()
+			purged.Purge()
+			if err := r.purge(purged, actor); err != nil {
+				return nil, err
+			}
+			bookmarks = append(bookmarks, *purged)
+		}
+	}
+	sort.Slice(bookmarks, func(i, j int) bool {
+		return idValue(&bookmarks[i]) < idValue(&bookmarks[j])
+	})
+	return bookmarks, nil
+}
+
+// タグごとにブックマーク数を集計する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// タグの辞書順に返却する。
+// タグが存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+func (r *bookmarkRepository) CountTags(userID *entity.UserID) ([]repository.TagCount, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	counts := map[entity.Tag]int{}
+	for _, bookmark := range r.store {
+		if bookmark.IsTrashed() || !ownedBy(&bookmark, userID) {
+			continue
+		}
+		for _, tag := range bookmark.Tags() {
+			counts[tag]++
+		}
+	}
+	tagCounts := []repository.TagCount{}
+	for tag, count := range counts {
+		tagCounts = append(tagCounts, repository.TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(tagCounts, func(i, j int) bool {
+		return tagCounts[i].Tag.Value() < tagCounts[j].Tag.Value()
+	})
+	return tagCounts, nil
+}
+
+// 統合元のタグを統合先のタグに置き換える。
+//
+// 所有するブックマークのうち、統合元のタグが付与されたゴミ箱にない全てのブックマークを対象とする。
+// 置き換えたブックマーク一覧をIDの昇順に返却する。
+// 置き換えたブックマークの版数と更新日時を更新し、タグの付与と取り外しを記録する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 置き換えによって重複するタグは1つにまとめる。
+// 置き換えたブックマークごとに改訂と更新を記録する。
+// 複製したインスタンスを返却する。
+func (r *bookmarkRepository) MergeTags(userID *entity.UserID, sources []entity.Tag, target *entity.Tag, actor string) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if sources == nil {
+		return nil, fmt.Errorf("argument \"sources\" is nil")
+	}
+	if target == nil {
+		return nil, fmt.Errorf("argument \"target\" is nil")
+	}
+	now := r.clock.Now()
+	bookmarks := []entity.Bookmark{}
+	for id, stored := range r.store {
+		if stored.IsTrashed() || !ownedBy(&stored, userID) {
+			continue
+		}
+		before := stored.Snapshot()
+		bookmark := stored.DeepCopy()
+		bookmark.MergeTags(sources, target)
+		if len(bookmark.Events()) == 0 {
+			continue
+		}
+		bookmark.SetVersion(bookmark.Version() + 1)
+		bookmark.SetTimestamps(bookmark.CreatedAt(), now)
+		merged := bookmark.DeepCopy()
+		merged.PullEvents()
+		r.store[id] = *merged
+		r.notify(repository.ChangeUpdated, id, merged.UserID(), merged)
+		if err := r.record(bookmark, &before, actor, entity.AuditOperationUpdate); err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, *bookmark)
+	}
+	sort.Slice(bookmarks, func(i, j int) bool {
+		return idValue(&bookmarks[i]) < idValue(&bookmarks[j])
+	})
+	return bookmarks, nil
+}
+
+// 正規形が一致するURIのブックマークを重複として集計する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// 正規形のURIの辞書順に返却する。
+// 重複ごとのブックマークはIDの昇順に並べる。
+// 重複が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したインスタンスを返却する。
+func (r *bookmarkRepository) FindDuplicates(userID *entity.UserID) ([]repository.Duplicate, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	groups := map[string][]entity.Bookmark{}
+	for _, bookmark := range r.store {
+		if bookmark.IsTrashed() || !ownedBy(&bookmark, userID) {
+			continue
+		}
+		uri := bookmark.URI()
+		canonical := uri.Canonical()
+		groups[canonical] = append(groups[canonical], *bookmark.DeepCopy())
+	}
+	duplicates := []repository.Duplicate{}
+	for canonical, bookmarks := range groups {
+		if len(bookmarks) < 2 {
+			continue
+		}
+		sort.Slice(bookmarks, func(i, j int) bool {
+			return idValue(&bookmarks[i]) < idValue(&bookmarks[j])
+		})
+		duplicates = append(duplicates, repository.Duplicate{CanonicalURI: canonical, Bookmarks: bookmarks})
+	}
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].CanonicalURI < duplicates[j].CanonicalURI
+	})
+	return duplicates, nil
+}
+
+// ブックマークを統合する。
+//
+// 統合先のブックマークを保存し、統合元のブックマークを削除する。
+// 保存と削除は全て成功するか全て失敗する。
+// 保存に成功した場合は統合先のブックマークの版数と更新日時を更新する。
+//
+// nilを指定した場合はエラーを返却する。
+// 保存されている版数といずれかのブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者といずれかのブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 統合元のブックマークが保存されていない場合は ErrConflict を返却する。
+//
+// 全てのブックマークの版数を検証してからストレージを更新する。
+// 統合元のブックマークごとに完全な削除を監査ログに記録する。
+func (r *bookmarkRepository) MergeBookmarks(target *entity.Bookmark, sources []entity.Bookmark, actor string) error {
+	if target == nil {
+		return fmt.Errorf("argument \"target\" is nil")
+	}
+	if sources == nil {
+		return fmt.Errorf("argument \"sources\" is nil")
+	}
+	if r.conflicts(target) {
+		return repository.ErrConflict
+	}
+	excluded := make([]entity.ID, len(sources))
+	for i, source := range sources {
+		if _, ok := r.store[source.ID()]; !ok || r.conflicts(&source) {
+			return repository.ErrConflict
+		}
+		excluded[i] = source.ID()
+	}
+	if r.duplicates(target, excluded...) {
+		return repository.ErrDuplicate
+	}
+	for i := range sources {
+		if err := r.purge(&sources[i], actor); err != nil {
+			return err
+		}
+	}
+	return r.Save(target, actor)
+}
+
+// フォルダに所属するブックマークが存在するか確認する。
+//
+// 指定したユーザIDが所有するブックマークに限定する。
+// ゴミ箱から復元すると元のフォルダに戻るため、ゴミ箱にあるブックマークも対象とする。
+//
+// nilを指定した場合はエラーを返却する。
+func (r *bookmarkRepository) ExistsInFolder(userID *entity.UserID, folder *entity.ID) (bool, error) {
+	if userID == nil {
+		return false, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if folder == nil {
+		return false, fmt.Errorf("argument \"folder\" is nil")
+	}
+	for _, bookmark := range r.store {
+		if bookmark.UserID() != *userID {
+			continue
+		}
+		if current := bookmark.Folder(); current != nil && *current == *folder {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -2,7 +2,9 @@ package inmemory
 
 import (
 	"errors"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/repository"
@@ -10,12 +12,26 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var (
+	now     = time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC) // 時計が返却する現在時刻
+	earlier = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) // 現在時刻より前の時刻
+)
+
+// 重複の検査を経由せずにブックマークを保存する。
+//
+// 一意性の制約を導入する前から保存されている重複したブックマークを再現する。
+func seed(r repository.Bookmark, bookmark *entity.Bookmark) {
+	bookmark.SetVersion(1)
+	bookmark.SetTimestamps(now, now)
+	r.(*bookmarkRepository).store[bookmark.ID()] = *bookmark
+}
+
 func TestNewBookmarkRepository(t *testing.T) {
 	t.Parallel()
 	t.Run("implementing repository.Bookmark", func(t *testing.T) {
 		t.Parallel()
 		// when
-		object := NewBookmarkRepository()
+		object := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
 		// then
 		assert.NotNil(t, object)
 		interfaceObject := (*repository.Bookmark)(nil)
@@ -24,7 +40,7 @@ func TestNewBookmarkRepository(t *testing.T) {
 	t.Run("fields", func(t *testing.T) {
 		t.Parallel()
 		// given
-		abstractRepository := NewBookmarkRepository()
+		abstractRepository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
 		// when
 		concreteRepository, ok := abstractRepository.(*bookmarkRepository)
 		actualStore := concreteRepository.store
@@ -38,7 +54,7 @@ func TestNewBookmarkRepository(t *testing.T) {
 func TestBookmark_NextID(t *testing.T) {
 	t.Parallel()
 	// given
-	repository := NewBookmarkRepository()
+	repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
 	// when
 	id := repository.NextID()
 	// then
@@ -50,14 +66,60 @@ func TestBookmark_NextID(t *testing.T) {
 func TestBookmark_Save(t *testing.T) {
 	t.Parallel()
 	cases := map[string]struct {
-		bookmark    *entity.Bookmark
-		expectedErr error
+		prepare          func(repository.Bookmark)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
 	}{
 		"non-nil bookmark": {
+			func(r repository.Bookmark) {},
 			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"stored bookmark with same version": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 2, earlier, now, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"stored bookmark with different version": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToBookmark(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"),
+			helper.ToBookmark(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"stored bookmark with different owner": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "EXAMPLE", "https://example.com"),
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "EXAMPLE", "https://example.com"),
+			repository.ErrConflict,
+		},
+		"duplicate uri": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "2", "Example", "https://example.com/"), "Actor")
+			},
+			helper.ToBookmark(t, "1", "Example", "HTTPS://EXAMPLE.COM"),
+			helper.ToBookmark(t, "1", "Example", "HTTPS://EXAMPLE.COM"),
+			repository.ErrDuplicate,
+		},
+		"duplicate uri of another owner": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToOwnedBookmark(t, "bob", "2", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com"),
 			nil,
 		},
 		"nil bookmark": {
+			func(r repository.Bookmark) {},
+			nil,
 			nil,
 			errors.New("argument \"bookmark\" is nil"),
 		},
@@ -67,15 +129,136 @@ func TestBookmark_Save(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			// given
-			repository := NewBookmarkRepository()
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
 			// when
-			actualErr := repository.Save(tc.bookmark)
+			actualErr := repository.Save(tc.bookmark, "Actor")
 			// then
+			assert.Exactly(t, tc.expectedBookmark, tc.bookmark)
 			assert.Exactly(t, tc.expectedErr, actualErr)
 		})
 	}
 }
 
+func TestBookmark_SaveWithEvents(t *testing.T) {
+	t.Parallel()
+	// given
+	repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+	bookmark := helper.ToRegisteredBookmark(t, "1", "Example", "https://example.com")
+	// when
+	err := repository.Save(bookmark, "Actor")
+	stored, _ := repository.FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1"))
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, bookmark.Events(), 1)
+	expectedBookmark := helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com")
+	assert.Exactly(t, expectedBookmark, stored)
+}
+
+func TestBookmark_SaveWithRevisions(t *testing.T) {
+	t.Parallel()
+	// given
+	revisions := NewRevisionRepository(helper.ToFixedClock(t, now))
+	repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, revisions, nil)
+	userID := helper.ToUserID(t, helper.UserID)
+	bookmark := helper.ToBookmark(t, "1", "Example", "https://example.com", "golang")
+	// when
+	repository.Save(bookmark, "alice")
+	bookmark.Rename(helper.ToName(t, "Example Domain"))
+	repository.Save(bookmark, "bob")
+	repository.Save(bookmark, "bob")
+	repository.Trash(bookmark, "carol")
+	repository.Restore(bookmark, "carol")
+	repository.MergeTags(userID, helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0], "dave")
+	actualRevisions, err := revisions.FindByBookmarkID(helper.ToID(t, "1"))
+	// then
+	assert.NoError(t, err)
+	type revision struct {
+		version uint64
+		actor   string
+		before  entity.Snapshot
+		after   entity.Snapshot
+	}
+	trashed := helper.ToStatefulSnapshot(t, nil, entity.StatusUnread, false, true, "Example Domain", "https://example.com", "golang")
+	expectedRevisions := []revision{
+		{6, "dave", *helper.ToSnapshot(t, "Example Domain", "https://example.com", "golang"), *helper.ToSnapshot(t, "Example Domain", "https://example.com", "go")},
+		{5, "carol", *trashed, *helper.ToSnapshot(t, "Example Domain", "https://example.com", "golang")},
+		{4, "carol", *helper.ToSnapshot(t, "Example Domain", "https://example.com", "golang"), *trashed},
+		{2, "bob", *helper.ToSnapshot(t, "Example", "https://example.com", "golang"), *helper.ToSnapshot(t, "Example Domain", "https://example.com", "golang")},
+	}
+	actual := make([]revision, len(actualRevisions))
+	for i, r := range actualRevisions {
+		actual[i] = revision{r.Version(), r.Actor(), r.Before(), r.After()}
+	}
+	assert.Exactly(t, expectedRevisions, actual)
+}
+
+func TestBookmark_WriteWithAudits(t *testing.T) {
+	t.Parallel()
+	// given
+	audits := NewAuditRepository(helper.ToFixedClock(t, now))
+	bookmarkRepository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, audits)
+	userID := helper.ToUserID(t, helper.UserID)
+	bookmark := helper.ToBookmark(t, "1", "Example", "https://example.com", "golang")
+	deleted := helper.ToBookmark(t, "2", "Example B", "https://bar.example.com")
+	purged := helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "3", "Example C", "https://baz.example.com")
+	target := helper.ToBookmark(t, "4", "Example D", "https://qux.example.com")
+	source := helper.ToBookmark(t, "5", "Example E", "https://quux.example.com")
+	// when
+	bookmarkRepository.Save(bookmark, "a1")
+	bookmarkRepository.Save(bookmark, "a2")
+	bookmark.Rename(helper.ToName(t, "Example Domain"))
+	bookmarkRepository.Save(bookmark, "a3")
+	bookmarkRepository.Trash(bookmark, "a4")
+	bookmarkRepository.Restore(bookmark, "a5")
+	bookmarkRepository.MergeTags(userID, helper.ToTags(t, "golang"), &helper.ToTags(t, "go")[0], "a6")
+	bookmarkRepository.Save(deleted, "a7")
+	bookmarkRepository.Delete(deleted, "a8")
+	bookmarkRepository.Save(purged, "a9")
+	bookmarkRepository.PurgeTrash(userID, now, "b1")
+	bookmarkRepository.Save(target, "b2")
+	bookmarkRepository.Save(source, "b3")
+	target.Rename(helper.ToName(t, "Example Domain D"))
+	bookmarkRepository.MergeBookmarks(target, []entity.Bookmark{*source}, "b4")
+	actualEntries, err := audits.FindBySpec(&repository.AuditSpec{})
+	// then
+	assert.NoError(t, err)
+	type entry struct {
+		actor      string
+		operation  string
+		bookmarkID string
+		before     bool
+		after      bool
+	}
+	expectedEntries := []entry{
+		{"a1", "register", "1", false, true},
+		{"a3", "update", "1", true, true},
+		{"a4", "delete", "1", true, false},
+		{"a5", "restore", "1", false, true},
+		{"a6", "update", "1", true, true},
+		{"a7", "register", "2", false, true},
+		{"a8", "purge", "2", true, false},
+		{"a9", "register", "3", false, true},
+		{"b1", "purge", "3", true, false},
+		{"b2", "register", "4", false, true},
+		{"b3", "register", "5", false, true},
+		{"b4", "update", "4", true, true},
+		{"b4", "purge", "5", true, false},
+	}
+	actual := make([]entry, len(actualEntries))
+	for i, e := range actualEntries {
+		bookmarkID := e.BookmarkID()
+		actual[i] = entry{e.Actor(), e.Operation().Value(), bookmarkID.Value(), e.Before() != nil, e.After() != nil}
+	}
+	sort.Slice(actual, func(i, j int) bool {
+		if actual[i].actor == actual[j].actor {
+			return actual[i].bookmarkID < actual[j].bookmarkID
+		}
+		return actual[i].actor < actual[j].actor
+	})
+	assert.Exactly(t, expectedEntries, actual)
+}
+
 func TestBookmark_FindAll(t *testing.T) {
 	t.Parallel()
 	cases := map[string]struct {
@@ -85,14 +268,34 @@ func TestBookmark_FindAll(t *testing.T) {
 	}{
 		"stored bookmarks": {
 			func(r repository.Bookmark) {
-				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"))
-				r.Save(helper.ToBookmark(t, "2", "Example B", "https://bar.example.com"))
-				r.Save(helper.ToBookmark(t, "3", "Example C", "https://baz.example.com"))
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"), "Actor")
+				r.Save(helper.ToBookmark(t, "2", "Example B", "https://bar.example.com"), "Actor")
+				r.Save(helper.ToBookmark(t, "3", "Example C", "https://baz.example.com"), "Actor")
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://foo.example.com"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://bar.example.com"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://baz.example.com"),
+			},
+			nil,
+		},
+		"trashed bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"), "Actor")
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "2", "Example B", "https://bar.example.com"), "Actor")
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://foo.example.com"),
+			},
+			nil,
+		},
+		"bookmarks owned by another user": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"), "Actor")
+				r.Save(helper.ToOwnedBookmark(t, "bob", "2", "Example B", "https://bar.example.com"), "Actor")
 			},
 			[]entity.Bookmark{
-				*helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"),
-				*helper.ToBookmark(t, "2", "Example B", "https://bar.example.com"),
-				*helper.ToBookmark(t, "3", "Example C", "https://baz.example.com"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://foo.example.com"),
 			},
 			nil,
 		},
@@ -107,10 +310,10 @@ func TestBookmark_FindAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			// given
-			repository := NewBookmarkRepository()
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
 			tc.prepare(repository)
 			// when
-			actualBookmarks, actualErr := repository.FindAll()
+			actualBookmarks, actualErr := repository.FindAll(helper.ToUserID(t, helper.UserID))
 			// then
 			assert.ElementsMatch(t, tc.expectedBookmarks, actualBookmarks)
 			assert.Exactly(t, tc.expectedErr, actualErr)
@@ -118,6 +321,163 @@ func TestBookmark_FindAll(t *testing.T) {
 	}
 }
 
+func TestBookmark_FindBySpec(t *testing.T) {
+	t.Parallel()
+	prepare := func(r repository.Bookmark) {
+		r.Save(helper.ToBookmark(t, "1", "Example C", "https://foo.example.com", "foo"), "Actor")
+		r.Save(helper.ToBookmark(t, "2", "Example A", "https://bar.example.com", "foo", "bar"), "Actor")
+		archived := helper.ToFiledBookmark(t, "10", "3", "Sample B", "https://baz.example.org", "bar", "baz")
+		archived.Archive()
+		archived.Star()
+		r.Save(archived, "Actor")
+	}
+	filed := helper.ToTimestampedBookmark(t, 1, now, now, "3", "Sample B", "https://baz.example.org", "bar", "baz")
+	filed.MoveTo(helper.ToID(t, "10"))
+	filed.Archive()
+	filed.Star()
+	filed.PullEvents()
+	unread, archived := entity.StatusUnread, entity.StatusArchived
+	cases := map[string]struct {
+		spec              *repository.BookmarkSpec
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"empty spec": {
+			&repository.BookmarkSpec{},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example C", "https://foo.example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+				*filed,
+			},
+			nil,
+		},
+		"any tags": {
+			&repository.BookmarkSpec{Tags: helper.ToTags(t, "foo", "baz")},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example C", "https://foo.example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+				*filed,
+			},
+			nil,
+		},
+		"all tags": {
+			&repository.BookmarkSpec{Tags: helper.ToTags(t, "foo", "bar"), MatchAllTags: true},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"folder": {
+			&repository.BookmarkSpec{Folder: helper.ToID(t, "10")},
+			[]entity.Bookmark{
+				*filed,
+			},
+			nil,
+		},
+		"unread status": {
+			&repository.BookmarkSpec{Status: &unread},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example C", "https://foo.example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"archived status": {
+			&repository.BookmarkSpec{Status: &archived},
+			[]entity.Bookmark{
+				*filed,
+			},
+			nil,
+		},
+		"starred only": {
+			&repository.BookmarkSpec{StarredOnly: true},
+			[]entity.Bookmark{
+				*filed,
+			},
+			nil,
+		},
+		"name contains": {
+			&repository.BookmarkSpec{NameContains: "example"},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example C", "https://foo.example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"uri contains": {
+			&repository.BookmarkSpec{URIContains: ".ORG"},
+			[]entity.Bookmark{
+				*filed,
+			},
+			nil,
+		},
+		"sort by name": {
+			&repository.BookmarkSpec{SortKey: repository.SortByName},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example C", "https://foo.example.com", "foo"),
+				*filed,
+			},
+			nil,
+		},
+		"sort by uri descending": {
+			&repository.BookmarkSpec{SortKey: repository.SortByURI, Descending: true},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example C", "https://foo.example.com", "foo"),
+				*filed,
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"cursor and limit": {
+			&repository.BookmarkSpec{After: &repository.BookmarkCursor{Value: "1", ID: *helper.ToID(t, "1")}, Limit: 1},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"cursor sorted by name descending": {
+			&repository.BookmarkSpec{SortKey: repository.SortByName, Descending: true, After: &repository.BookmarkCursor{Value: "Example C", ID: *helper.ToID(t, "1")}},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"cursor with equal sort key": {
+			&repository.BookmarkSpec{SortKey: repository.SortByCreatedAt, After: &repository.BookmarkCursor{Time: now, ID: *helper.ToID(t, "1")}},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example A", "https://bar.example.com", "foo", "bar"),
+				*filed,
+			},
+			nil,
+		},
+		"cursor at last bookmark": {
+			&repository.BookmarkSpec{After: &repository.BookmarkCursor{Value: "3", ID: *helper.ToID(t, "3")}},
+			[]entity.Bookmark{},
+			nil,
+		},
+		"nil spec": {
+			nil,
+			nil,
+			errors.New("argument \"spec\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			prepare(repository)
+			// when
+			actualBookmarks, actualErr := repository.FindBySpec(helper.ToUserID(t, helper.UserID), tc.spec)
+			// then
+			assert.Exactly(t, tc.expectedBookmarks, actualBookmarks)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
 func TestBookmark_FindByID(t *testing.T) {
 	t.Parallel()
 	cases := map[string]struct {
@@ -128,10 +488,26 @@ func TestBookmark_FindByID(t *testing.T) {
 	}{
 		"id of stored bookmark": {
 			func(r repository.Bookmark) {
-				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"))
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
 			},
 			helper.ToID(t, "1"),
-			helper.ToBookmark(t, "1", "Example", "https://example.com"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of trashed bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"id of bookmark owned by another user": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToID(t, "1"),
+			nil,
 			nil,
 		},
 		"id of unstored bookmark": {
@@ -152,10 +528,67 @@ func TestBookmark_FindByID(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			// given
-			repository := NewBookmarkRepository()
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualBookmark, actualErr := repository.FindByID(helper.ToUserID(t, helper.UserID), tc.id)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_FindByCanonicalURI(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare          func(repository.Bookmark)
+		uri              *entity.URI
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"uri of stored bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToURI(t, "https://example.com"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"equivalent uri of stored bookmarks": {
+			func(r repository.Bookmark) {
+				seed(r, helper.ToBookmark(t, "2", "Example", "https://example.com/?utm_source=foo"))
+				seed(r, helper.ToBookmark(t, "1", "EXAMPLE", "HTTPS://EXAMPLE.COM:443/#top"))
+				seed(r, helper.ToBookmark(t, "3", "Example", "https://example.net"))
+			},
+			helper.ToURI(t, "https://example.com"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "EXAMPLE", "HTTPS://EXAMPLE.COM:443/#top"),
+			nil,
+		},
+		"uri of unstored bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com/foo"), "Actor")
+			},
+			helper.ToURI(t, "https://example.com"),
+			nil,
+			nil,
+		},
+		"nil uri": {
+			func(r repository.Bookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"uri\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
 			tc.prepare(repository)
 			// when
-			actualBookmark, actualErr := repository.FindByID(tc.id)
+			actualBookmark, actualErr := repository.FindByCanonicalURI(helper.ToUserID(t, helper.UserID), tc.uri)
 			// then
 			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
 			assert.Exactly(t, tc.expectedErr, actualErr)
@@ -172,19 +605,78 @@ func TestBookmark_Delete(t *testing.T) {
 	}{
 		"stored bookmark": {
 			func(r repository.Bookmark) {
-				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"))
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
 			},
-			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
 			nil,
 		},
+		"stored bookmark with different version": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
 		"unstored bookmark": {
 			func(r repository.Bookmark) {},
 			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"nil bookmark": {
+			func(r repository.Bookmark) {},
+			nil,
+			errors.New("argument \"bookmark\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Delete(tc.bookmark, "alice")
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Trash(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare          func(repository.Bookmark)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"stored bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 2, now, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
 			nil,
 		},
+		"stored bookmark with different version": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"unstored bookmark": {
+			func(r repository.Bookmark) {},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
 		"nil bookmark": {
 			func(r repository.Bookmark) {},
 			nil,
+			nil,
 			errors.New("argument \"bookmark\" is nil"),
 		},
 	}
@@ -193,12 +685,652 @@ func TestBookmark_Delete(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			// given
-			repository := NewBookmarkRepository()
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
 			tc.prepare(repository)
 			// when
-			actualErr := repository.Delete(tc.bookmark)
+			actualErr := repository.Trash(tc.bookmark, "Actor")
 			// then
+			assert.Exactly(t, tc.expectedBookmark, tc.bookmark)
 			assert.Exactly(t, tc.expectedErr, actualErr)
 		})
 	}
 }
+
+func TestBookmark_Restore(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare          func(repository.Bookmark)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"trashed bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToTrashedBookmark(t, 1, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"trashed bookmark with different version": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+			},
+			helper.ToTrashedBookmark(t, 2, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 2, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"trashed bookmark with duplicate uri": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"), "Actor")
+				r.Save(helper.ToBookmark(t, "2", "Example", "https://example.com/"), "Actor")
+			},
+			helper.ToTrashedBookmark(t, 1, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 1, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrDuplicate,
+		},
+		"unstored bookmark": {
+			func(r repository.Bookmark) {},
+			helper.ToTrashedBookmark(t, 1, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 1, now, now, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"nil bookmark": {
+			func(r repository.Bookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"bookmark\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Restore(tc.bookmark, "Actor")
+			// then
+			assert.Exactly(t, tc.expectedBookmark, tc.bookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_FindTrash(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare           func(repository.Bookmark)
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"trashed bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "3", "Example C", "https://baz.example.com"), "Actor")
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"), "Actor")
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, now, "4", "Example D", "https://qux.example.com"), "Actor")
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "2", "Example B", "https://bar.example.com"), "Actor")
+			},
+			[]entity.Bookmark{
+				*helper.ToTrashedBookmark(t, 1, now, now, now, "4", "Example D", "https://qux.example.com"),
+				*helper.ToTrashedBookmark(t, 1, now, now, earlier, "2", "Example B", "https://bar.example.com"),
+				*helper.ToTrashedBookmark(t, 1, now, now, earlier, "3", "Example C", "https://baz.example.com"),
+			},
+			nil,
+		},
+		"no trashed bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"), "Actor")
+			},
+			[]entity.Bookmark{},
+			nil,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualBookmarks, actualErr := repository.FindTrash(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(t, tc.expectedBookmarks, actualBookmarks)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_FindTrashByID(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare          func(repository.Bookmark)
+		id               *entity.ID
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"id of trashed bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToID(t, "1"),
+			helper.ToTrashedBookmark(t, 1, now, now, earlier, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of active bookmark": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"id of unstored bookmark": {
+			func(r repository.Bookmark) {},
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			func(r repository.Bookmark) {},
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualBookmark, actualErr := repository.FindTrashByID(helper.ToUserID(t, helper.UserID), tc.id)
+			// then
+			assert.Exactly(t, tc.expectedBookmark, actualBookmark)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_PurgeTrash(t *testing.T) {
+	t.Parallel()
+	prepare := func(r repository.Bookmark) {
+		r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"), "Actor")
+		r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "2", "Example B", "https://bar.example.com"), "Actor")
+		r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, now, "3", "Example C", "https://baz.example.com"), "Actor")
+	}
+	cases := map[string]struct {
+		before            time.Time
+		expectedCount     int
+		expectedBookmarks []entity.Bookmark
+	}{
+		"before now": {
+			now,
+			1,
+			[]entity.Bookmark{
+				*helper.ToTrashedBookmark(t, 1, now, now, now, "3", "Example C", "https://baz.example.com"),
+			},
+		},
+		"after now": {
+			now.Add(time.Second),
+			2,
+			[]entity.Bookmark{},
+		},
+		"before earlier": {
+			earlier,
+			0,
+			[]entity.Bookmark{
+				*helper.ToTrashedBookmark(t, 1, now, now, now, "3", "Example C", "https://baz.example.com"),
+				*helper.ToTrashedBookmark(t, 1, now, now, earlier, "2", "Example B", "https://bar.example.com"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			prepare(repository)
+			// when
+			actualPurged, actualErr := repository.PurgeTrash(helper.ToUserID(t, helper.UserID), tc.before, "alice")
+			// then
+			assert.Len(t, actualPurged, tc.expectedCount)
+			for _, bookmark := range actualPurged {
+				id := bookmark.ID()
+				purged, _ := entity.NewBookmarkPurged(&id, helper.ToUserID(t, helper.UserID))
+				assert.Exactly(t, []entity.Event{*purged}, bookmark.Events())
+			}
+			assert.NoError(t, actualErr)
+			actualBookmarks, _ := repository.FindTrash(helper.ToUserID(t, helper.UserID))
+			assert.Exactly(t, tc.expectedBookmarks, actualBookmarks)
+			activeBookmarks, _ := repository.FindAll(helper.ToUserID(t, helper.UserID))
+			assert.Len(t, activeBookmarks, 1)
+		})
+	}
+}
+
+func TestBookmark_CountTags(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare           func(repository.Bookmark)
+		expectedTagCounts []repository.TagCount
+		expectedErr       error
+	}{
+		"tagged bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com", "foo"), "Actor")
+				r.Save(helper.ToBookmark(t, "2", "Example B", "https://bar.example.com", "foo", "bar"), "Actor")
+				r.Save(helper.ToBookmark(t, "3", "Example C", "https://baz.example.com", "foo", "bar", "baz"), "Actor")
+				r.Save(helper.ToBookmark(t, "4", "Example D", "https://qux.example.com"), "Actor")
+			},
+			[]repository.TagCount{
+				{Tag: helper.ToTags(t, "bar")[0], Count: 2},
+				{Tag: helper.ToTags(t, "baz")[0], Count: 1},
+				{Tag: helper.ToTags(t, "foo")[0], Count: 3},
+			},
+			nil,
+		},
+		"untagged bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
+			},
+			[]repository.TagCount{},
+			nil,
+		},
+		"trashed bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com", "foo"), "Actor")
+				r.Save(helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "2", "Example B", "https://bar.example.com", "foo", "bar"), "Actor")
+			},
+			[]repository.TagCount{
+				{Tag: helper.ToTags(t, "foo")[0], Count: 1},
+			},
+			nil,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualTagCounts, actualErr := repository.CountTags(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(t, tc.expectedTagCounts, actualTagCounts)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MergeTags(t *testing.T) {
+	t.Parallel()
+	prepare := func(r repository.Bookmark) {
+		r.Save(helper.ToBookmark(t, "1", "Example A", "https://foo.example.com", "golang"), "Actor")
+		r.Save(helper.ToBookmark(t, "2", "Example B", "https://bar.example.com", "go", "golang", "foo"), "Actor")
+		r.Save(helper.ToBookmark(t, "3", "Example C", "https://baz.example.com", "golang", "go-lang", "bar"), "Actor")
+		r.Save(helper.ToBookmark(t, "4", "Example D", "https://qux.example.com", "baz"), "Actor")
+	}
+	cases := map[string]struct {
+		sources           []entity.Tag
+		target            *entity.Tag
+		expectedCount     int
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"1 source": {
+			helper.ToTags(t, "golang"),
+			&helper.ToTags(t, "go")[0],
+			3,
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example A", "https://foo.example.com", "go"),
+				*helper.ToTimestampedBookmark(t, 2, now, now, "2", "Example B", "https://bar.example.com", "go", "foo"),
+				*helper.ToTimestampedBookmark(t, 2, now, now, "3", "Example C", "https://baz.example.com", "go", "go-lang", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "4", "Example D", "https://qux.example.com", "baz"),
+			},
+			nil,
+		},
+		"2 sources": {
+			helper.ToTags(t, "golang", "go-lang", "go"),
+			&helper.ToTags(t, "go")[0],
+			3,
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example A", "https://foo.example.com", "go"),
+				*helper.ToTimestampedBookmark(t, 2, now, now, "2", "Example B", "https://bar.example.com", "go", "foo"),
+				*helper.ToTimestampedBookmark(t, 2, now, now, "3", "Example C", "https://baz.example.com", "go", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "4", "Example D", "https://qux.example.com", "baz"),
+			},
+			nil,
+		},
+		"unused source": {
+			helper.ToTags(t, "qux"),
+			&helper.ToTags(t, "go")[0],
+			0,
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://foo.example.com", "golang"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://bar.example.com", "go", "golang", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://baz.example.com", "golang", "go-lang", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "4", "Example D", "https://qux.example.com", "baz"),
+			},
+			nil,
+		},
+		"nil sources": {
+			nil,
+			&helper.ToTags(t, "go")[0],
+			0,
+			nil,
+			errors.New("argument \"sources\" is nil"),
+		},
+		"nil target": {
+			helper.ToTags(t, "golang"),
+			nil,
+			0,
+			nil,
+			errors.New("argument \"target\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			prepare(repository)
+			// when
+			actualMerged, actualErr := repository.MergeTags(helper.ToUserID(t, helper.UserID), tc.sources, tc.target, "Actor")
+			// then
+			assert.Len(t, actualMerged, tc.expectedCount)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+			if tc.expectedErr == nil {
+				actualBookmarks, _ := repository.FindAll(helper.ToUserID(t, helper.UserID))
+				assert.ElementsMatch(t, tc.expectedBookmarks, actualBookmarks)
+			}
+		})
+	}
+}
+
+func TestBookmark_FindDuplicates(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare            func(repository.Bookmark)
+		expectedDuplicates []repository.Duplicate
+	}{
+		"duplicated bookmarks": {
+			func(r repository.Bookmark) {
+				seed(r, helper.ToBookmark(t, "3", "Example C", "https://example.com/foo?utm_source=bar"))
+				seed(r, helper.ToBookmark(t, "1", "Example A", "https://example.com/foo/"))
+				seed(r, helper.ToBookmark(t, "2", "Example B", "https://example.com/bar"))
+				seed(r, helper.ToBookmark(t, "4", "Example D", "HTTPS://EXAMPLE.COM/bar#baz"))
+				seed(r, helper.ToBookmark(t, "5", "Example E", "https://example.com/baz"))
+			},
+			[]repository.Duplicate{
+				{
+					CanonicalURI: "https://example.com/bar",
+					Bookmarks: []entity.Bookmark{
+						*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/bar"),
+						*helper.ToTimestampedBookmark(t, 1, now, now, "4", "Example D", "HTTPS://EXAMPLE.COM/bar#baz"),
+					},
+				},
+				{
+					CanonicalURI: "https://example.com/foo",
+					Bookmarks: []entity.Bookmark{
+						*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com/foo/"),
+						*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://example.com/foo?utm_source=bar"),
+					},
+				},
+			},
+		},
+		"no duplicated bookmarks": {
+			func(r repository.Bookmark) {
+				seed(r, helper.ToBookmark(t, "1", "Example A", "https://example.com/foo"))
+				seed(r, helper.ToBookmark(t, "2", "Example B", "https://example.com/bar"))
+			},
+			[]repository.Duplicate{},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualDuplicates, actualErr := repository.FindDuplicates(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(t, tc.expectedDuplicates, actualDuplicates)
+			assert.NoError(t, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MergeBookmarks(t *testing.T) {
+	t.Parallel()
+	prepare := func(r repository.Bookmark) {
+		seed(r, helper.ToBookmark(t, "1", "Example A", "https://example.com", "foo"))
+		seed(r, helper.ToBookmark(t, "2", "Example B", "https://example.com/", "bar"))
+		seed(r, helper.ToBookmark(t, "3", "Example C", "https://example.com/#baz", "baz"))
+	}
+	cases := map[string]struct {
+		target            *entity.Bookmark
+		sources           []entity.Bookmark
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"stored bookmarks": {
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo", "bar", "baz"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://example.com/#baz", "baz"),
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example A", "https://example.com", "foo", "bar", "baz"),
+			},
+			nil,
+		},
+		"target with different version": {
+			helper.ToTimestampedBookmark(t, 2, now, now, "1", "Example A", "https://example.com", "foo", "bar"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://example.com/#baz", "baz"),
+			},
+			repository.ErrConflict,
+		},
+		"source with different version": {
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo", "bar", "baz"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 2, now, now, "3", "Example C", "https://example.com/#baz", "baz"),
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://example.com/#baz", "baz"),
+			},
+			repository.ErrConflict,
+		},
+		"unstored source": {
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo", "qux"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "4", "Example D", "https://example.com", "qux"),
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://example.com/#baz", "baz"),
+			},
+			repository.ErrConflict,
+		},
+		"duplicate outside sources": {
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo", "bar"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+			},
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, now, now, "3", "Example C", "https://example.com/#baz", "baz"),
+			},
+			repository.ErrDuplicate,
+		},
+		"nil target": {
+			nil,
+			[]entity.Bookmark{},
+			nil,
+			errors.New("argument \"target\" is nil"),
+		},
+		"nil sources": {
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example A", "https://example.com", "foo"),
+			nil,
+			nil,
+			errors.New("argument \"sources\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			prepare(repository)
+			// when
+			actualErr := repository.MergeBookmarks(tc.target, tc.sources, "Actor")
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+			if tc.expectedBookmarks != nil {
+				actualBookmarks, _ := repository.FindAll(helper.ToUserID(t, helper.UserID))
+				assert.ElementsMatch(t, tc.expectedBookmarks, actualBookmarks)
+			}
+		})
+	}
+}
+
+func TestBookmark_ExistsInFolder(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare        func(repository.Bookmark)
+		userID         *entity.UserID
+		folder         *entity.ID
+		expectedExists bool
+		expectedErr    error
+	}{
+		"folder with bookmarks": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToFiledBookmark(t, "10", "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			true,
+			nil,
+		},
+		"folder with bookmarks of another user": {
+			func(r repository.Bookmark) {
+				filed := helper.ToOwnedBookmark(t, "bob", "1", "Example", "https://example.com")
+				filed.MoveTo(helper.ToID(t, "10"))
+				r.Save(filed, "Actor")
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			false,
+			nil,
+		},
+		"folder with trashed bookmarks": {
+			func(r repository.Bookmark) {
+				trashed := helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example", "https://example.com")
+				trashed.MoveTo(helper.ToID(t, "10"))
+				r.Save(trashed, "Actor")
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			true,
+			nil,
+		},
+		"empty folder": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToFiledBookmark(t, "20", "1", "Example", "https://example.com"), "Actor")
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			false,
+			nil,
+		},
+		"nil user id": {
+			func(r repository.Bookmark) {},
+			nil,
+			helper.ToID(t, "10"),
+			false,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil folder": {
+			func(r repository.Bookmark) {},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			false,
+			errors.New("argument \"folder\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewBookmarkRepository(helper.ToFixedClock(t, now), nil, nil, nil)
+			tc.prepare(repository)
+			// when
+			actualExists, actualErr := repository.ExistsInFolder(tc.userID, tc.folder)
+			// then
+			assert.Exactly(t, tc.expectedExists, actualExists)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSortValue(t *testing.T) {
+	t.Parallel()
+	bookmark := helper.ToTimestampedBookmark(t, 1, earlier, now, "1", "Example", "https://example.com")
+	cases := map[string]struct {
+		key           repository.BookmarkSortKey
+		expectedValue string
+	}{
+		"sort by id": {
+			repository.SortByID,
+			"1",
+		},
+		"sort by name": {
+			repository.SortByName,
+			"Example",
+		},
+		"sort by uri": {
+			repository.SortByURI,
+			"https://example.com",
+		},
+		"sort by created at": {
+			repository.SortByCreatedAt,
+			"2022-01-01T00:00:00.000000000",
+		},
+		"sort by updated at": {
+			repository.SortByUpdatedAt,
+			"2022-01-02T00:00:00.000000000",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualValue := sortValue(bookmark, tc.key)
+			// then
+			assert.Exactly(t, tc.expectedValue, actualValue)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package inmemory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// 配信に失敗したWebhookの通知の永続化を担うリポジトリの具象型。
+//
+// 配信ワーカーから並行に保存されるため排他制御する。
+type deadLetterRepository struct {
+	mu    sync.RWMutex                    // 排他制御
+	store map[entity.ID]entity.DeadLetter // ストレージ
+	clock clock.Clock                     // 時計
+}
+
+// 配信に失敗したWebhookの通知の永続化を担うリポジトリを生成する。
+func NewDeadLetterRepository(clock clock.Clock) repository.DeadLetter {
+	return &deadLetterRepository{
+		store: make(map[entity.ID]entity.DeadLetter),
+		clock: clock,
+	}
+}
+
+// デッドレターを保存する。
+//
+// 保存に成功した場合はデッドレターの作成日時を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+// 同じIDのデッドレターが保存されている場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *deadLetterRepository) Save(deadLetter *entity.DeadLetter) error {
+	if deadLetter == nil {
+		return fmt.Errorf("argument \"deadLetter\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := deadLetter.ID()
+	if _, ok := r.store[id]; ok {
+		return fmt.Errorf("dead letter already exists: %s", id.Value())
+	}
+	deadLetter.SetCreatedAt(r.clock.Now())
+	r.store[id] = *deadLetter.DeepCopy()
+	return nil
+}
+
+// WebhookのIDからデッドレター一覧を検索する。
+//
+// 指定したユーザIDが所有するWebhookのデッドレターに限定する。
+// WebhookのIDにnilを指定した場合はユーザの全てのデッドレターを検索する。
+// 作成日時の降順、作成日時が等しい場合はIDの昇順に返却する。
+// 該当するデッドレターが存在しない場合は空のスライスを返却する。
+//
+// ユーザIDにnilを指定した場合はエラーを返却する。
+//
+// 該当するデッドレターが存在する場合は複製したインスタンスを返却する。
+func (r *deadLetterRepository) FindByWebhookID(userID *entity.UserID, webhookID *entity.ID) ([]entity.DeadLetter, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	deadLetters := []entity.DeadLetter{}
+	for _, deadLetter := range r.store {
+		if deadLetter.UserID() != *userID {
+			continue
+		}
+		if webhookID == nil || deadLetter.WebhookID() == *webhookID {
+			deadLetters = append(deadLetters, *deadLetter.DeepCopy())
+		}
+	}
+	sort.Slice(deadLetters, func(i, j int) bool {
+		x, y := deadLetters[i].CreatedAt(), deadLetters[j].CreatedAt()
+		if x.Equal(y) {
+			a, b := deadLetters[i].ID(), deadLetters[j].ID()
+			return a.Value() < b.Value()
+		}
+		return x.After(y)
+	})
+	return deadLetters, nil
+}
@@ -0,0 +1,152 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeadLetterRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.DeadLetter", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.DeadLetter)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*deadLetterRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := map[entity.ID]entity.DeadLetter{}
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestDeadLetter_Save(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare            func(repository.DeadLetter)
+		deadLetter         *entity.DeadLetter
+		expectedDeadLetter *entity.DeadLetter
+		expectedErr        error
+	}{
+		"new dead letter": {
+			func(r repository.DeadLetter) {},
+			helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			helper.ToTimestampedDeadLetter(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			nil,
+		},
+		"stored dead letter": {
+			func(r repository.DeadLetter) {
+				r.Save(helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"))
+			},
+			helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 3, "timeout"),
+			helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 3, "timeout"),
+			errors.New("dead letter already exists: 100"),
+		},
+		"nil dead letter": {
+			func(r repository.DeadLetter) {},
+			nil,
+			nil,
+			errors.New("argument \"deadLetter\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewDeadLetterRepository(helper.ToFixedClock(t, now))
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Save(tc.deadLetter)
+			// then
+			assert.Exactly(t, tc.expectedDeadLetter, tc.deadLetter)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDeadLetter_FindByWebhookID(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	prepare := func(r *deadLetterRepository) {
+		r.store[*helper.ToID(t, "100")] = *helper.ToTimestampedDeadLetter(t, earlier, "100", "10", entity.EventBookmarkRegistered, "1", `{}`, 5, "status 500")
+		r.store[*helper.ToID(t, "102")] = *helper.ToTimestampedDeadLetter(t, now, "102", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500")
+		r.store[*helper.ToID(t, "101")] = *helper.ToTimestampedDeadLetter(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 503")
+		other, _ := entity.NewDeadLetter(helper.ToID(t, "103"), helper.ToUserID(t, "bob"), helper.ToID(t, "30"), entity.EventBookmarkDeleted, helper.ToID(t, "2"), []byte(`{}`), 5, "status 500")
+		r.store[*helper.ToID(t, "103")] = *other
+	}
+	cases := map[string]struct {
+		userID              *entity.UserID
+		webhookID           *entity.ID
+		expectedDeadLetters []entity.DeadLetter
+		expectedErr         error
+	}{
+		"webhook with dead letters": {
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			[]entity.DeadLetter{
+				*helper.ToTimestampedDeadLetter(t, now, "102", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+				*helper.ToTimestampedDeadLetter(t, earlier, "100", "10", entity.EventBookmarkRegistered, "1", `{}`, 5, "status 500"),
+			},
+			nil,
+		},
+		"webhook without dead letters": {
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "40"),
+			[]entity.DeadLetter{},
+			nil,
+		},
+		"webhook owned by another user": {
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "30"),
+			[]entity.DeadLetter{},
+			nil,
+		},
+		"nil webhook id": {
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			[]entity.DeadLetter{
+				*helper.ToTimestampedDeadLetter(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 503"),
+				*helper.ToTimestampedDeadLetter(t, now, "102", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+				*helper.ToTimestampedDeadLetter(t, earlier, "100", "10", entity.EventBookmarkRegistered, "1", `{}`, 5, "status 500"),
+			},
+			nil,
+		},
+		"nil user id": {
+			nil,
+			helper.ToID(t, "10"),
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewDeadLetterRepository(helper.ToFixedClock(t, now))
+			prepare(repository.(*deadLetterRepository))
+			// when
+			actualDeadLetters, actualErr := repository.FindByWebhookID(tc.userID, tc.webhookID)
+			// then
+			assert.Exactly(t, tc.expectedDeadLetters, actualDeadLetters)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
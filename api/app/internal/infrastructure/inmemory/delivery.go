@@ -0,0 +1,116 @@
+package inmemory
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// 配信待ちのWebhookの通知の永続化を担うリポジトリの具象型。
+//
+// 送信箱の中継器と配信ワーカーから並行に操作されるため排他制御する。
+type deliveryRepository struct {
+	mu    sync.Mutex                    // 排他制御
+	store map[entity.ID]entity.Delivery // ストレージ
+}
+
+// 配信待ちのWebhookの通知の永続化を担うリポジトリを生成する。
+func NewDeliveryRepository() repository.Delivery {
+	return &deliveryRepository{
+		store: make(map[entity.ID]entity.Delivery),
+	}
+}
+
+// 通知一覧を保存する。
+//
+// 同じIDの通知が保存されている場合はエラーを返却し、いずれの通知も保存しない。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *deliveryRepository) Save(deliveries []entity.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, delivery := range deliveries {
+		id := delivery.ID()
+		if _, ok := r.store[id]; ok {
+			return fmt.Errorf("delivery already exists: %s", id.Value())
+		}
+	}
+	for _, delivery := range deliveries {
+		r.store[delivery.ID()] = *delivery.DeepCopy()
+	}
+	return nil
+}
+
+// 次に試行する日時が指定した日時を過ぎた通知を1件取り出す。
+//
+// 次に試行する日時の昇順、次に試行する日時が等しい場合はIDの昇順に取り出す。
+// 取り出した通知は次に試行する日時を期限に延ばし、期限までは他の配信ワーカーが取り出さないようにする。
+// 該当する通知が存在しない場合はnilを返却する。
+//
+// 該当する通知が存在する場合は複製したインスタンスを返却する。
+func (r *deliveryRepository) Claim(now time.Time, until time.Time) (*entity.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var claimed *entity.Delivery
+	for _, delivery := range r.store {
+		if delivery.NextAttemptAt().After(now) {
+			continue
+		}
+		if claimed == nil || claimsBefore(&delivery, claimed) {
+			claimed = delivery.DeepCopy()
+		}
+	}
+	if claimed == nil {
+		return nil, nil
+	}
+	claimed.Lease(until)
+	r.store[claimed.ID()] = *claimed.DeepCopy()
+	return claimed, nil
+}
+
+// 取り出す順序において通知xが通知yより先であるかを判定する。
+func claimsBefore(x, y *entity.Delivery) bool {
+	a, b := x.NextAttemptAt(), y.NextAttemptAt()
+	if a.Equal(b) {
+		i, j := x.ID(), y.ID()
+		return i.Value() < j.Value()
+	}
+	return a.Before(b)
+}
+
+// 通知の試行回数と最後の試行で発生したエラー、次に試行する日時を更新する。
+//
+// nilを指定した場合はエラーを返却する。
+// 通知が保存されていない場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *deliveryRepository) Update(delivery *entity.Delivery) error {
+	if delivery == nil {
+		return fmt.Errorf("argument \"delivery\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := delivery.ID()
+	if _, ok := r.store[id]; !ok {
+		return fmt.Errorf("delivery not found: %s", id.Value())
+	}
+	r.store[id] = *delivery.DeepCopy()
+	return nil
+}
+
+// 通知を削除する。
+//
+// nilを指定した場合はエラーを返却する。
+// 通知が保存されていない場合は何もしない。
+func (r *deliveryRepository) Delete(delivery *entity.Delivery) error {
+	if delivery == nil {
+		return fmt.Errorf("argument \"delivery\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.store, delivery.ID())
+	return nil
+}
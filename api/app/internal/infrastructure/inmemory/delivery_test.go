@@ -0,0 +1,249 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDeliveryRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.Delivery", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewDeliveryRepository()
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.Delivery)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewDeliveryRepository()
+		// when
+		concreteRepository, ok := abstractRepository.(*deliveryRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := map[entity.ID]entity.Delivery{}
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestDelivery_Save(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare       func(repository.Delivery)
+		deliveries    []entity.Delivery
+		expectedStore map[entity.ID]entity.Delivery
+		expectedErr   error
+	}{
+		"new deliveries": {
+			func(r repository.Delivery) {},
+			[]entity.Delivery{
+				*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+				*helper.ToDelivery(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+				*helper.ToID(t, "101"): *helper.ToDelivery(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			nil,
+		},
+		"stored delivery": {
+			func(r repository.Delivery) {
+				r.Save([]entity.Delivery{*helper.ToDelivery(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 0, "")})
+			},
+			[]entity.Delivery{
+				*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+				*helper.ToDelivery(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "101"): *helper.ToDelivery(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			errors.New("delivery already exists: 101"),
+		},
+		"no deliveries": {
+			func(r repository.Delivery) {},
+			[]entity.Delivery{},
+			map[entity.ID]entity.Delivery{},
+			nil,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewDeliveryRepository()
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Save(tc.deliveries)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*deliveryRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDelivery_Claim(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	until := now.Add(time.Minute)
+	cases := map[string]struct {
+		stored           []entity.Delivery
+		expectedDelivery *entity.Delivery
+		expectedStore    map[entity.ID]entity.Delivery
+	}{
+		"due deliveries": {
+			[]entity.Delivery{
+				*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+				*helper.ToDelivery(t, earlier, "102", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+				*helper.ToDelivery(t, earlier, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 2, "status 503"),
+			},
+			helper.ToDelivery(t, until, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 2, "status 503"),
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+				*helper.ToID(t, "101"): *helper.ToDelivery(t, until, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 2, "status 503"),
+				*helper.ToID(t, "102"): *helper.ToDelivery(t, earlier, "102", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			},
+		},
+		"no due deliveries": {
+			[]entity.Delivery{
+				*helper.ToDelivery(t, later, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			},
+			nil,
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, later, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			},
+		},
+		"no deliveries": {
+			[]entity.Delivery{},
+			nil,
+			map[entity.ID]entity.Delivery{},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewDeliveryRepository()
+			repository.Save(tc.stored)
+			// when
+			actualDelivery, actualErr := repository.Claim(now, until)
+			// then
+			assert.Exactly(t, tc.expectedDelivery, actualDelivery)
+			assert.NoError(t, actualErr)
+			assert.Exactly(t, tc.expectedStore, repository.(*deliveryRepository).store)
+		})
+	}
+	t.Run("claimed delivery", func(t *testing.T) {
+		t.Parallel()
+		// given
+		repository := NewDeliveryRepository()
+		repository.Save([]entity.Delivery{*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, "")})
+		repository.Claim(now, until)
+		// when
+		beforeExpiry, _ := repository.Claim(now, until)
+		afterExpiry, _ := repository.Claim(until, until.Add(time.Minute))
+		// then
+		assert.Nil(t, beforeExpiry)
+		assert.Exactly(t, helper.ToDelivery(t, until.Add(time.Minute), "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""), afterExpiry)
+	})
+}
+
+func TestDelivery_Update(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		delivery      *entity.Delivery
+		expectedStore map[entity.ID]entity.Delivery
+		expectedErr   error
+	}{
+		"stored delivery": {
+			helper.ToDelivery(t, now.Add(time.Second), "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now.Add(time.Second), "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			},
+			nil,
+		},
+		"unstored delivery": {
+			helper.ToDelivery(t, now, "101", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			errors.New("delivery not found: 101"),
+		},
+		"nil delivery": {
+			nil,
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			errors.New("argument \"delivery\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewDeliveryRepository()
+			repository.Save([]entity.Delivery{*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, "")})
+			// when
+			actualErr := repository.Update(tc.delivery)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*deliveryRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDelivery_Delete(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		delivery      *entity.Delivery
+		expectedStore map[entity.ID]entity.Delivery
+		expectedErr   error
+	}{
+		"stored delivery": {
+			helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			map[entity.ID]entity.Delivery{},
+			nil,
+		},
+		"unstored delivery": {
+			helper.ToDelivery(t, now, "101", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			nil,
+		},
+		"nil delivery": {
+			nil,
+			map[entity.ID]entity.Delivery{
+				*helper.ToID(t, "100"): *helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			errors.New("argument \"delivery\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewDeliveryRepository()
+			repository.Save([]entity.Delivery{*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, "")})
+			// when
+			actualErr := repository.Delete(tc.delivery)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*deliveryRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package inmemory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// フォルダの永続化を担うリポジトリの具象型。
+type folderRepository struct {
+	store map[entity.ID]entity.Folder // ストレージ
+}
+
+// フォルダの永続化を担うリポジトリを生成する。
+func NewFolderRepository() repository.Folder {
+	return &folderRepository{
+		store: make(map[entity.ID]entity.Folder),
+	}
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *folderRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// フォルダを保存する。
+//
+// nilを指定した場合はエラーを返却する。
+// 同じIDの他のユーザのフォルダが保存されている場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *folderRepository) Save(folder *entity.Folder) error {
+	if folder == nil {
+		return fmt.Errorf("argument \"folder\" is nil")
+	}
+	id := folder.ID()
+	if stored, ok := r.store[id]; ok && stored.UserID() != folder.UserID() {
+		return fmt.Errorf("folder owned by another user: %s", id.Value())
+	}
+	r.store[id] = *folder.DeepCopy()
+	return nil
+}
+
+// IDからフォルダを検索する。
+//
+// 該当するフォルダが存在しない場合はnilを返却する。
+// 他のユーザのフォルダはnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当するフォルダが存在する場合は複製したインスタンスを返却する。
+func (r *folderRepository) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Folder, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	folder, ok := r.store[*id]
+	if !ok || folder.UserID() != *userID {
+		return nil, nil
+	}
+	return folder.DeepCopy(), nil
+}
+
+// 親フォルダのIDから子フォルダ一覧を検索する。
+//
+// 親フォルダにnilを指定した場合は最上位のフォルダ一覧を検索する。
+// 並び順の昇順、並び順が等しい場合はIDの昇順に返却する。
+// 該当するフォルダが存在しない場合は空のスライスを返却する。
+//
+// ユーザIDにnilを指定した場合はエラーを返却する。
+//
+// 該当するフォルダが存在する場合は複製したインスタンスを返却する。
+func (r *folderRepository) FindByParent(userID *entity.UserID, parent *entity.ID) ([]entity.Folder, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	folders := []entity.Folder{}
+	for _, folder := range r.store {
+		if folder.UserID() == *userID && isChild(&folder, parent) {
+			folders = append(folders, *folder.DeepCopy())
+		}
+	}
+	sort.Slice(folders, func(i, j int) bool {
+		if folders[i].Position() == folders[j].Position() {
+			x, y := folders[i].ID(), folders[j].ID()
+			return x.Value() < y.Value()
+		}
+		return folders[i].Position() < folders[j].Position()
+	})
+	return folders, nil
+}
+
+// フォルダが親フォルダの直下にあるか判定する。
+//
+// 親フォルダがnilの場合は最上位にあるか判定する。
+func isChild(folder *entity.Folder, parent *entity.ID) bool {
+	actual := folder.Parent()
+	if actual == nil || parent == nil {
+		return actual == nil && parent == nil
+	}
+	return *actual == *parent
+}
+
+// フォルダを削除する。
+//
+// 他のユーザのフォルダは削除しない。
+//
+// nilを指定した場合はエラーを返却する。
+func (r *folderRepository) Delete(folder *entity.Folder) error {
+	if folder == nil {
+		return fmt.Errorf("argument \"folder\" is nil")
+	}
+	id := folder.ID()
+	if stored, ok := r.store[id]; ok && stored.UserID() == folder.UserID() {
+		delete(r.store, id)
+	}
+	return nil
+}
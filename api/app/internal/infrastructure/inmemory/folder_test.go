@@ -0,0 +1,255 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFolderRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.Folder", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewFolderRepository()
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.Folder)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewFolderRepository()
+		// when
+		concreteRepository, ok := abstractRepository.(*folderRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := make(map[entity.ID]entity.Folder)
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestFolder_NextID(t *testing.T) {
+	t.Parallel()
+	// given
+	repository := NewFolderRepository()
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+}
+
+func TestFolder_Save(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare     func(repository.Folder)
+		folder      *entity.Folder
+		expectedErr error
+	}{
+		"non-nil folder": {
+			func(r repository.Folder) {},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			nil,
+		},
+		"folder owned by another user": {
+			func(r repository.Folder) {
+				r.Save(helper.ToOwnedFolder(t, "bob", "1", "Work", "", 0))
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			errors.New("folder owned by another user: 1"),
+		},
+		"nil folder": {
+			func(r repository.Folder) {},
+			nil,
+			errors.New("argument \"folder\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewFolderRepository()
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Save(tc.folder)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+			if tc.expectedErr == nil {
+				id := tc.folder.ID()
+				actualFolder, _ := repository.FindByID(helper.ToUserID(t, helper.UserID), &id)
+				assert.Exactly(t, tc.folder, actualFolder)
+			}
+		})
+	}
+}
+
+func TestFolder_FindByID(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare        func(repository.Folder)
+		userID         *entity.UserID
+		id             *entity.ID
+		expectedFolder *entity.Folder
+		expectedErr    error
+	}{
+		"id of stored folder": {
+			func(r repository.Folder) {
+				r.Save(helper.ToFolder(t, "1", "Reading List", "2", 3))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			helper.ToFolder(t, "1", "Reading List", "2", 3),
+			nil,
+		},
+		"id of unstored folder": {
+			func(r repository.Folder) {},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"folder owned by another user": {
+			func(r repository.Folder) {
+				r.Save(helper.ToOwnedFolder(t, "bob", "1", "Reading List", "", 0))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil user id": {
+			func(r repository.Folder) {},
+			nil,
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil id": {
+			func(r repository.Folder) {},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewFolderRepository()
+			tc.prepare(repository)
+			// when
+			actualFolder, actualErr := repository.FindByID(tc.userID, tc.id)
+			// then
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_FindByParent(t *testing.T) {
+	t.Parallel()
+	prepare := func(r repository.Folder) {
+		r.Save(helper.ToFolder(t, "1", "Work", "", 1))
+		r.Save(helper.ToFolder(t, "2", "Private", "", 0))
+		r.Save(helper.ToFolder(t, "3", "Go", "1", 0))
+		r.Save(helper.ToFolder(t, "4", "Rust", "1", 0))
+		r.Save(helper.ToOwnedFolder(t, "bob", "5", "Shared", "", 0))
+		r.Save(helper.ToOwnedFolder(t, "bob", "6", "Python", "1", 0))
+	}
+	cases := map[string]struct {
+		parent          *entity.ID
+		expectedFolders []entity.Folder
+	}{
+		"top level": {
+			nil,
+			[]entity.Folder{
+				*helper.ToFolder(t, "2", "Private", "", 0),
+				*helper.ToFolder(t, "1", "Work", "", 1),
+			},
+		},
+		"parent with children": {
+			helper.ToID(t, "1"),
+			[]entity.Folder{
+				*helper.ToFolder(t, "3", "Go", "1", 0),
+				*helper.ToFolder(t, "4", "Rust", "1", 0),
+			},
+		},
+		"parent without children": {
+			helper.ToID(t, "2"),
+			[]entity.Folder{},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewFolderRepository()
+			prepare(repository)
+			// when
+			actualFolders, actualErr := repository.FindByParent(helper.ToUserID(t, helper.UserID), tc.parent)
+			// then
+			assert.Exactly(t, tc.expectedFolders, actualFolders)
+			assert.NoError(t, actualErr)
+		})
+	}
+	t.Run("nil user id", func(t *testing.T) {
+		t.Parallel()
+		// given
+		repository := NewFolderRepository()
+		// when
+		actualFolders, actualErr := repository.FindByParent(nil, nil)
+		// then
+		assert.Nil(t, actualFolders)
+		assert.Exactly(t, errors.New("argument \"userID\" is nil"), actualErr)
+	})
+}
+
+func TestFolder_Delete(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		folder         *entity.Folder
+		expectedFolder *entity.Folder
+		expectedErr    error
+	}{
+		"stored folder": {
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			nil,
+			nil,
+		},
+		"folder owned by another user": {
+			helper.ToOwnedFolder(t, "bob", "1", "Reading List", "", 0),
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			nil,
+		},
+		"nil folder": {
+			nil,
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			errors.New("argument \"folder\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewFolderRepository()
+			repository.Save(helper.ToFolder(t, "1", "Reading List", "", 0))
+			// when
+			actualErr := repository.Delete(tc.folder)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+			actualFolder, _ := repository.FindByID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "1"))
+			assert.Exactly(t, tc.expectedFolder, actualFolder)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package inmemory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// ブックマークの改訂履歴の永続化を担うリポジトリの具象型。
+type revisionRepository struct {
+	store map[entity.ID]entity.Revision // ストレージ
+	clock clock.Clock                   // 時計
+}
+
+// ブックマークの改訂履歴の永続化を担うリポジトリを生成する。
+func NewRevisionRepository(clock clock.Clock) repository.Revision {
+	return &revisionRepository{
+		store: make(map[entity.ID]entity.Revision),
+		clock: clock,
+	}
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *revisionRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// 改訂を保存する。
+//
+// 保存に成功した場合は改訂の作成日時を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+// 同じIDの改訂が保存されている場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *revisionRepository) Save(revision *entity.Revision) error {
+	if revision == nil {
+		return fmt.Errorf("argument \"revision\" is nil")
+	}
+	id := revision.ID()
+	if _, ok := r.store[id]; ok {
+		return fmt.Errorf("revision already exists: %s", id.Value())
+	}
+	revision.SetCreatedAt(r.clock.Now())
+	r.store[id] = *revision.DeepCopy()
+	return nil
+}
+
+// IDから改訂を検索する。
+//
+// 該当する改訂が存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当する改訂が存在する場合は複製したインスタンスを返却する。
+func (r *revisionRepository) FindByID(id *entity.ID) (*entity.Revision, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	revision, ok := r.store[*id]
+	if !ok {
+		return nil, nil
+	}
+	return revision.DeepCopy(), nil
+}
+
+// ブックマークのIDから改訂一覧を検索する。
+//
+// 作成日時の降順、作成日時が等しい場合は版数の降順に返却する。
+// 該当する改訂が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当する改訂が存在する場合は複製したインスタンスを返却する。
+func (r *revisionRepository) FindByBookmarkID(bookmarkID *entity.ID) ([]entity.Revision, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	revisions := []entity.Revision{}
+	for _, revision := range r.store {
+		if revision.BookmarkID() == *bookmarkID {
+			revisions = append(revisions, *revision.DeepCopy())
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		x, y := revisions[i].CreatedAt(), revisions[j].CreatedAt()
+		if x.Equal(y) {
+			return revisions[i].Version() > revisions[j].Version()
+		}
+		return x.After(y)
+	})
+	return revisions, nil
+}
@@ -0,0 +1,197 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRevisionRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.Revision", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewRevisionRepository(helper.ToFixedClock(t, now))
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.Revision)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewRevisionRepository(helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*revisionRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := map[entity.ID]entity.Revision{}
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestRevision_NextID(t *testing.T) {
+	t.Parallel()
+	// given
+	repository := NewRevisionRepository(helper.ToFixedClock(t, now))
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+}
+
+func TestRevision_Save(t *testing.T) {
+	t.Parallel()
+	before := helper.ToSnapshot(t, "Example", "https://example.com")
+	after := helper.ToSnapshot(t, "Example Domain", "https://example.com")
+	cases := map[string]struct {
+		prepare          func(repository.Revision)
+		revision         *entity.Revision
+		expectedRevision *entity.Revision
+		expectedErr      error
+	}{
+		"new revision": {
+			func(r repository.Revision) {},
+			helper.ToRevision(t, "100", "1", 2, "alice", before, after),
+			helper.ToTimestampedRevision(t, now, "100", "1", 2, "alice", before, after),
+			nil,
+		},
+		"stored revision": {
+			func(r repository.Revision) {
+				r.Save(helper.ToRevision(t, "100", "1", 2, "alice", before, after))
+			},
+			helper.ToRevision(t, "100", "1", 3, "bob", after, before),
+			helper.ToRevision(t, "100", "1", 3, "bob", after, before),
+			errors.New("revision already exists: 100"),
+		},
+		"nil revision": {
+			func(r repository.Revision) {},
+			nil,
+			nil,
+			errors.New("argument \"revision\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewRevisionRepository(helper.ToFixedClock(t, now))
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Save(tc.revision)
+			// then
+			assert.Exactly(t, tc.expectedRevision, tc.revision)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRevision_FindByID(t *testing.T) {
+	t.Parallel()
+	before := helper.ToSnapshot(t, "Example", "https://example.com")
+	after := helper.ToSnapshot(t, "Example Domain", "https://example.com")
+	cases := map[string]struct {
+		prepare          func(repository.Revision)
+		id               *entity.ID
+		expectedRevision *entity.Revision
+		expectedErr      error
+	}{
+		"id of stored revision": {
+			func(r repository.Revision) {
+				r.Save(helper.ToRevision(t, "100", "1", 2, "alice", before, after))
+			},
+			helper.ToID(t, "100"),
+			helper.ToTimestampedRevision(t, now, "100", "1", 2, "alice", before, after),
+			nil,
+		},
+		"id of unstored revision": {
+			func(r repository.Revision) {},
+			helper.ToID(t, "100"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			func(r repository.Revision) {},
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewRevisionRepository(helper.ToFixedClock(t, now))
+			tc.prepare(repository)
+			// when
+			actualRevision, actualErr := repository.FindByID(tc.id)
+			// then
+			assert.Exactly(t, tc.expectedRevision, actualRevision)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestRevision_FindByBookmarkID(t *testing.T) {
+	t.Parallel()
+	earlier := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := helper.ToSnapshot(t, "Example A", "https://example.com")
+	b := helper.ToSnapshot(t, "Example B", "https://example.com")
+	c := helper.ToSnapshot(t, "Example C", "https://example.com")
+	cases := map[string]struct {
+		prepare           func(*revisionRepository)
+		bookmarkID        *entity.ID
+		expectedRevisions []entity.Revision
+		expectedErr       error
+	}{
+		"bookmark with revisions": {
+			func(r *revisionRepository) {
+				r.store[*helper.ToID(t, "100")] = *helper.ToTimestampedRevision(t, earlier, "100", "1", 2, "alice", a, b)
+				r.store[*helper.ToID(t, "101")] = *helper.ToTimestampedRevision(t, now, "101", "1", 3, "bob", b, c)
+				r.store[*helper.ToID(t, "102")] = *helper.ToTimestampedRevision(t, now, "102", "1", 4, "bob", c, a)
+				r.store[*helper.ToID(t, "200")] = *helper.ToTimestampedRevision(t, now, "200", "2", 2, "alice", a, c)
+			},
+			helper.ToID(t, "1"),
+			[]entity.Revision{
+				*helper.ToTimestampedRevision(t, now, "102", "1", 4, "bob", c, a),
+				*helper.ToTimestampedRevision(t, now, "101", "1", 3, "bob", b, c),
+				*helper.ToTimestampedRevision(t, earlier, "100", "1", 2, "alice", a, b),
+			},
+			nil,
+		},
+		"bookmark without revisions": {
+			func(r *revisionRepository) {},
+			helper.ToID(t, "1"),
+			[]entity.Revision{},
+			nil,
+		},
+		"nil bookmark id": {
+			func(r *revisionRepository) {},
+			nil,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewRevisionRepository(helper.ToFixedClock(t, now))
+			tc.prepare(repository.(*revisionRepository))
+			// when
+			actualRevisions, actualErr := repository.FindByBookmarkID(tc.bookmarkID)
+			// then
+			assert.Exactly(t, tc.expectedRevisions, actualRevisions)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
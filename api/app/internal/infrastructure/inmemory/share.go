@@ -0,0 +1,132 @@
+package inmemory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// ブックマークの共有の永続化を担うリポジトリの具象型。
+type shareRepository struct {
+	mu    sync.RWMutex               // 排他制御
+	store map[entity.ID]entity.Share // ストレージ
+}
+
+// ブックマークの共有の永続化を担うリポジトリを生成する。
+func NewShareRepository() repository.Share {
+	return &shareRepository{
+		store: make(map[entity.ID]entity.Share),
+	}
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *shareRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// 共有を保存する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *shareRepository) Save(share *entity.Share) error {
+	if share == nil {
+		return fmt.Errorf("argument \"share\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.store[share.ID()] = *share.DeepCopy()
+	return nil
+}
+
+// IDから共有を検索する。
+//
+// 該当する共有が存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当する共有が存在する場合は複製したインスタンスを返却する。
+func (r *shareRepository) FindByID(id *entity.ID) (*entity.Share, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	share, ok := r.store[*id]
+	if !ok {
+		return nil, nil
+	}
+	return share.DeepCopy(), nil
+}
+
+// 共有元のユーザIDから共有一覧を検索する。
+//
+// IDの昇順に返却する。
+// 該当する共有が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したインスタンスを返却する。
+func (r *shareRepository) FindByOwner(owner *entity.UserID) ([]entity.Share, error) {
+	if owner == nil {
+		return nil, fmt.Errorf("argument \"owner\" is nil")
+	}
+	return r.filter(func(share entity.Share) bool {
+		return share.Owner() == *owner
+	}), nil
+}
+
+// 共有先のユーザIDから共有一覧を検索する。
+//
+// IDの昇順に返却する。
+// 該当する共有が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したインスタンスを返却する。
+func (r *shareRepository) FindByGrantee(grantee *entity.UserID) ([]entity.Share, error) {
+	if grantee == nil {
+		return nil, fmt.Errorf("argument \"grantee\" is nil")
+	}
+	return r.filter(func(share entity.Share) bool {
+		return share.Grantee() == *grantee
+	}), nil
+}
+
+// 条件を満たす共有一覧をIDの昇順に返却する。
+func (r *shareRepository) filter(match func(entity.Share) bool) []entity.Share {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	shares := []entity.Share{}
+	for _, share := range r.store {
+		if match(share) {
+			shares = append(shares, *share.DeepCopy())
+		}
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		x, y := shares[i].ID(), shares[j].ID()
+		return x.Value() < y.Value()
+	})
+	return shares
+}
+
+// 共有を削除する。
+//
+// nilを指定した場合はエラーを返却する。
+func (r *shareRepository) Delete(share *entity.Share) error {
+	if share == nil {
+		return fmt.Errorf("argument \"share\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.store, share.ID())
+	return nil
+}
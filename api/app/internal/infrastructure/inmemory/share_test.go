@@ -0,0 +1,244 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewShareRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.Share", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewShareRepository()
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.Share)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewShareRepository()
+		// when
+		concreteRepository, ok := abstractRepository.(*shareRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := map[entity.ID]entity.Share{}
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestShare_NextID(t *testing.T) {
+	t.Parallel()
+	// given
+	repository := NewShareRepository()
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+}
+
+func TestShare_Save(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		share         *entity.Share
+		expectedStore map[entity.ID]entity.Share
+		expectedErr   error
+	}{
+		"non-nil share": {
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			map[entity.ID]entity.Share{
+				*helper.ToID(t, "1"): *helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			},
+			nil,
+		},
+		"nil share": {
+			nil,
+			map[entity.ID]entity.Share{},
+			errors.New("argument \"share\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewShareRepository()
+			// when
+			actualErr := repository.Save(tc.share)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*shareRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestShare_FindByID(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		id            *entity.ID
+		expectedShare *entity.Share
+		expectedErr   error
+	}{
+		"id of stored share": {
+			helper.ToID(t, "1"),
+			helper.ToCollectionShare(t, "1", "alice", "bob", "golang", entity.ShareRoleEditor),
+			nil,
+		},
+		"id of unstored share": {
+			helper.ToID(t, "2"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewShareRepository()
+			repository.Save(helper.ToCollectionShare(t, "1", "alice", "bob", "golang", entity.ShareRoleEditor))
+			// when
+			actualShare, actualErr := repository.FindByID(tc.id)
+			// then
+			assert.Exactly(t, tc.expectedShare, actualShare)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestShare_FindByOwner(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		owner          *entity.UserID
+		expectedShares []entity.Share
+		expectedErr    error
+	}{
+		"owner of shares": {
+			helper.ToUserID(t, "alice"),
+			[]entity.Share{
+				*helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+				*helper.ToCollectionShare(t, "3", "alice", "carol", "golang", entity.ShareRoleEditor),
+			},
+			nil,
+		},
+		"user without shares": {
+			helper.ToUserID(t, "carol"),
+			[]entity.Share{},
+			nil,
+		},
+		"nil owner": {
+			nil,
+			nil,
+			errors.New("argument \"owner\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewShareRepository()
+			repository.Save(helper.ToCollectionShare(t, "3", "alice", "carol", "golang", entity.ShareRoleEditor))
+			repository.Save(helper.ToBookmarkShare(t, "2", "bob", "alice", "20", entity.ShareRoleViewer))
+			repository.Save(helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer))
+			// when
+			actualShares, actualErr := repository.FindByOwner(tc.owner)
+			// then
+			assert.Exactly(t, tc.expectedShares, actualShares)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestShare_FindByGrantee(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		grantee        *entity.UserID
+		expectedShares []entity.Share
+		expectedErr    error
+	}{
+		"grantee of shares": {
+			helper.ToUserID(t, "bob"),
+			[]entity.Share{
+				*helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+				*helper.ToCollectionShare(t, "3", "carol", "bob", "golang", entity.ShareRoleEditor),
+			},
+			nil,
+		},
+		"user without shares": {
+			helper.ToUserID(t, "carol"),
+			[]entity.Share{},
+			nil,
+		},
+		"nil grantee": {
+			nil,
+			nil,
+			errors.New("argument \"grantee\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewShareRepository()
+			repository.Save(helper.ToCollectionShare(t, "3", "carol", "bob", "golang", entity.ShareRoleEditor))
+			repository.Save(helper.ToBookmarkShare(t, "2", "bob", "alice", "20", entity.ShareRoleViewer))
+			repository.Save(helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer))
+			// when
+			actualShares, actualErr := repository.FindByGrantee(tc.grantee)
+			// then
+			assert.Exactly(t, tc.expectedShares, actualShares)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestShare_Delete(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		share         *entity.Share
+		expectedStore map[entity.ID]entity.Share
+		expectedErr   error
+	}{
+		"stored share": {
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			map[entity.ID]entity.Share{},
+			nil,
+		},
+		"nil share": {
+			nil,
+			map[entity.ID]entity.Share{
+				*helper.ToID(t, "1"): *helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			},
+			errors.New("argument \"share\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewShareRepository()
+			repository.Save(helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer))
+			// when
+			actualErr := repository.Delete(tc.share)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*shareRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
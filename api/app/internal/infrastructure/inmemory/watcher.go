@@ -0,0 +1,168 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// ブックマークの変更をプロセス内の購読者に配信する具象型。
+//
+// 再開トークンには変更の通し番号を用いる。
+// 直近の変更を履歴として保持し、再接続した購読者に見逃した変更を配信する。
+type BookmarkBroadcaster struct {
+	mu          sync.Mutex                        // 排他制御
+	sequence    uint64                            // 最後に配信した変更の通し番号
+	capacity    int                               // 保持する履歴の件数
+	history     []sequencedChange                 // 直近の変更履歴 (通し番号の昇順)
+	subscribers map[chan sequencedChange]struct{} // 購読者一覧
+}
+
+// 通し番号を付与したブックマークの変更。
+type sequencedChange struct {
+	sequence uint64                    // 通し番号
+	change   repository.BookmarkChange // 変更
+}
+
+// ブックマークの変更をプロセス内の購読者に配信する具象型を生成する。
+//
+// 履歴として直近の変更を指定した件数だけ保持する。
+// 1未満の件数を指定した場合は1件とする。
+func NewBookmarkBroadcaster(capacity int) *BookmarkBroadcaster {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &BookmarkBroadcaster{
+		capacity:    capacity,
+		history:     []sequencedChange{},
+		subscribers: make(map[chan sequencedChange]struct{}),
+	}
+}
+
+// ブックマークの変更を配信する。
+//
+// ブックマークを指定した場合は複製したインスタンスを配信する。
+// 受信が追いつかない購読者は購読を解除する。
+func (b *BookmarkBroadcaster) publish(changeType repository.ChangeType, id entity.ID, userID entity.UserID, bookmark *entity.Bookmark) {
+	if bookmark != nil {
+		bookmark = bookmark.DeepCopy()
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sequence++
+	sequence := b.sequence
+	change := sequencedChange{sequence, repository.BookmarkChange{
+		Type:        changeType,
+		ID:          id,
+		UserID:      userID,
+		Bookmark:    bookmark,
+		ResumeToken: strconv.FormatUint(sequence, 10),
+	}}
+	b.history = append(b.history, change)
+	if len(b.history) > b.capacity {
+		b.history = b.history[len(b.history)-b.capacity:]
+	}
+	for ch := range b.subscribers {
+		select {
+		case ch <- change:
+		default:
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// ブックマークの変更を監視する。
+//
+// 指定したユーザIDが所有するブックマークの変更が発生した順にハンドラを呼び出す。
+// 再開トークンを指定した場合はトークンが表す変更の直後から、空文字列の場合は呼び出し以降の変更を対象とする。
+//
+// nilを指定した場合はエラーを返却する。
+// 再開トークンが不正な場合、あるいは履歴に残っていない場合は ErrInvalidResumeToken を返却する。
+// コンテキストが終了した場合はコンテキストのエラーを返却する。
+// ハンドラがエラーを返却した場合はそのエラーを返却する。
+// 受信が追いつかずに購読を解除された場合はエラーを返却する。
+func (b *BookmarkBroadcaster) Watch(ctx context.Context, userID *entity.UserID, resumeToken string, handler func(repository.BookmarkChange) error) error {
+	if ctx == nil {
+		return fmt.Errorf("argument \"ctx\" is nil")
+	}
+	if userID == nil {
+		return fmt.Errorf("argument \"userID\" is nil")
+	}
+	if handler == nil {
+		return fmt.Errorf("argument \"handler\" is nil")
+	}
+	missed, ch, err := b.subscribe(resumeToken)
+	if err != nil {
+		return err
+	}
+	defer b.unsubscribe(ch)
+	for _, change := range missed {
+		if change.change.UserID != *userID {
+			continue
+		}
+		if err := handler(change.change); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change, ok := <-ch:
+			if !ok {
+				return fmt.Errorf("subscriber fell behind")
+			}
+			if change.change.UserID != *userID {
+				continue
+			}
+			if err := handler(change.change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// 購読を開始する。
+//
+// 再開トークンより後の変更履歴と、以降の変更を受信するチャネルを返却する。
+//
+// 再開トークンが不正な場合、あるいは履歴に残っていない場合は ErrInvalidResumeToken を返却する。
+func (b *BookmarkBroadcaster) subscribe(resumeToken string) ([]sequencedChange, chan sequencedChange, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	missed := []sequencedChange{}
+	if resumeToken != "" {
+		after, err := strconv.ParseUint(resumeToken, 10, 64)
+		if err != nil || after > b.sequence {
+			return nil, nil, repository.ErrInvalidResumeToken
+		}
+		if after < b.sequence && (len(b.history) == 0 || b.history[0].sequence > after+1) {
+			return nil, nil, repository.ErrInvalidResumeToken
+		}
+		for _, change := range b.history {
+			if change.sequence > after {
+				missed = append(missed, change)
+			}
+		}
+	}
+	ch := make(chan sequencedChange, b.capacity)
+	b.subscribers[ch] = struct{}{}
+	return missed, ch, nil
+}
+
+// 購読を解除する。
+//
+// 既に解除されている場合は何もしない。
+func (b *BookmarkBroadcaster) unsubscribe(ch chan sequencedChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[ch]; ok {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
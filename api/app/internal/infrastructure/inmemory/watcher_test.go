@@ -0,0 +1,310 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+// ハンドラが監視を終了するために返却するエラー。
+var errStop = errors.New("stop")
+
+// 指定した件数の変更を受信するまで監視する。
+//
+// テスト用のユーザが所有するブックマークの変更を対象とする。
+// 受信した変更の種類、ID、再開トークンを "種類:ID:トークン" の形式で返却する。
+func watchN(t *testing.T, watcher repository.BookmarkWatcher, resumeToken string, n int) ([]string, error) {
+	t.Helper()
+	changes := []string{}
+	err := watcher.Watch(context.Background(), helper.ToUserID(t, helper.UserID), resumeToken, func(change repository.BookmarkChange) error {
+		changes = append(changes, string(change.Type)+":"+change.ID.Value()+":"+change.ResumeToken)
+		if len(changes) >= n {
+			return errStop
+		}
+		return nil
+	})
+	return changes, err
+}
+
+func TestNewBookmarkBroadcaster(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.BookmarkWatcher", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewBookmarkBroadcaster(10)
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.BookmarkWatcher)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		cases := map[string]struct {
+			capacity         int
+			expectedCapacity int
+		}{
+			"positive capacity": {10, 10},
+			"zero capacity":     {0, 1},
+			"negative capacity": {-1, 1},
+		}
+		for name, tc := range cases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				// when
+				broadcaster := NewBookmarkBroadcaster(tc.capacity)
+				// then
+				assert.Exactly(t, tc.expectedCapacity, broadcaster.capacity)
+				assert.Exactly(t, uint64(0), broadcaster.sequence)
+				assert.Exactly(t, []sequencedChange{}, broadcaster.history)
+				assert.Empty(t, broadcaster.subscribers)
+			})
+		}
+	})
+}
+
+func TestBookmarkBroadcaster_Watch(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare         func(repository.Bookmark)
+		expectedChanges []string
+	}{
+		"create": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
+			},
+			[]string{"created:1:1"},
+		},
+		"update": {
+			func(r repository.Bookmark) {
+				bookmark := helper.ToBookmark(t, "1", "Example", "https://example.com")
+				r.Save(bookmark, "Actor")
+				bookmark.Rename(helper.ToName(t, "EXAMPLE"))
+				r.Save(bookmark, "Actor")
+			},
+			[]string{"created:1:1", "updated:1:2"},
+		},
+		"trash and restore": {
+			func(r repository.Bookmark) {
+				bookmark := helper.ToBookmark(t, "1", "Example", "https://example.com")
+				r.Save(bookmark, "Actor")
+				r.Trash(bookmark, "Actor")
+				r.Restore(bookmark, "Actor")
+			},
+			[]string{"created:1:1", "deleted:1:2", "updated:1:3"},
+		},
+		"delete": {
+			func(r repository.Bookmark) {
+				bookmark := helper.ToBookmark(t, "1", "Example", "https://example.com")
+				r.Save(bookmark, "Actor")
+				r.Delete(bookmark, "alice")
+			},
+			[]string{"created:1:1", "deleted:1:2"},
+		},
+		"purge trash": {
+			func(r repository.Bookmark) {
+				bookmark := helper.ToBookmark(t, "1", "Example", "https://example.com")
+				r.Save(bookmark, "Actor")
+				r.Trash(bookmark, "Actor")
+				r.PurgeTrash(helper.ToUserID(t, helper.UserID), now.AddDate(0, 0, 1), "alice")
+			},
+			[]string{"created:1:1", "deleted:1:2", "deleted:1:3"},
+		},
+		"merge tags": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), "Actor")
+				r.MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "foo"), &helper.ToTags(t, "bar")[0], "Actor")
+			},
+			[]string{"created:1:1", "updated:1:2"},
+		},
+		"merge bookmarks": {
+			func(r repository.Bookmark) {
+				target := helper.ToBookmark(t, "1", "Example A", "https://example.com")
+				source := helper.ToBookmark(t, "2", "Example B", "https://example.org")
+				r.Save(target, "Actor")
+				r.Save(source, "Actor")
+				r.MergeBookmarks(target, []entity.Bookmark{*source}, "Actor")
+			},
+			[]string{"created:1:1", "created:2:2", "deleted:2:3", "updated:1:4"},
+		},
+		"other owner": {
+			func(r repository.Bookmark) {
+				other := helper.ToOwnedBookmark(t, "bob", "1", "Example A", "https://example.com")
+				r.Save(other, "Actor")
+				r.Delete(other, "bob")
+				r.Save(helper.ToBookmark(t, "2", "Example B", "https://example.org"), "Actor")
+			},
+			[]string{"created:2:3"},
+		},
+		"conflict": {
+			func(r repository.Bookmark) {
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
+				r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com"), "Actor")
+			},
+			[]string{"created:1:1"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			broadcaster := NewBookmarkBroadcaster(10)
+			tc.prepare(NewBookmarkRepository(helper.ToFixedClock(t, now), broadcaster, nil, nil))
+			// when
+			actualChanges, actualErr := watchN(t, broadcaster, "0", len(tc.expectedChanges))
+			// then
+			assert.Exactly(t, tc.expectedChanges, actualChanges)
+			assert.Exactly(t, errStop, actualErr)
+		})
+	}
+}
+
+func TestBookmarkBroadcaster_Watch_ResumeToken(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		capacity        int
+		resumeToken     string
+		expectedChanges []string
+		expectedErr     error
+	}{
+		"token at beginning": {
+			10,
+			"0",
+			[]string{"created:1:1", "created:2:2", "created:3:3"},
+			errStop,
+		},
+		"token in middle": {
+			10,
+			"1",
+			[]string{"created:2:2", "created:3:3"},
+			errStop,
+		},
+		"token in retained history": {
+			2,
+			"1",
+			[]string{"created:2:2", "created:3:3"},
+			errStop,
+		},
+		"token before retained history": {
+			2,
+			"0",
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"token after latest change": {
+			10,
+			"4",
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"malformed token": {
+			10,
+			"abc",
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			broadcaster := NewBookmarkBroadcaster(tc.capacity)
+			r := NewBookmarkRepository(helper.ToFixedClock(t, now), broadcaster, nil, nil)
+			r.Save(helper.ToBookmark(t, "1", "Example A", "https://a.example.com"), "Actor")
+			r.Save(helper.ToBookmark(t, "2", "Example B", "https://b.example.com"), "Actor")
+			r.Save(helper.ToBookmark(t, "3", "Example C", "https://c.example.com"), "Actor")
+			// when
+			actualChanges, actualErr := watchN(t, broadcaster, tc.resumeToken, len(tc.expectedChanges))
+			// then
+			assert.Exactly(t, tc.expectedChanges, actualChanges)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmarkBroadcaster_Watch_Live(t *testing.T) {
+	t.Parallel()
+	t.Run("changes after subscribing", func(t *testing.T) {
+		t.Parallel()
+		// given
+		broadcaster := NewBookmarkBroadcaster(10)
+		r := NewBookmarkRepository(helper.ToFixedClock(t, now), broadcaster, nil, nil)
+		var wg sync.WaitGroup
+		var actualChanges []string
+		var actualErr error
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actualChanges, actualErr = watchN(t, broadcaster, "0", 2)
+		}()
+		// when
+		r.Save(helper.ToBookmark(t, "1", "Example A", "https://a.example.com"), "Actor")
+		r.Save(helper.ToBookmark(t, "2", "Example B", "https://b.example.com"), "Actor")
+		wg.Wait()
+		// then
+		assert.Exactly(t, []string{"created:1:1", "created:2:2"}, actualChanges)
+		assert.Exactly(t, errStop, actualErr)
+		assert.Empty(t, broadcaster.subscribers)
+	})
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+		// given
+		broadcaster := NewBookmarkBroadcaster(10)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		// when
+		actualErr := broadcaster.Watch(ctx, helper.ToUserID(t, helper.UserID), "", func(repository.BookmarkChange) error { return nil })
+		// then
+		assert.Exactly(t, context.Canceled, actualErr)
+		assert.Empty(t, broadcaster.subscribers)
+	})
+	t.Run("nil context", func(t *testing.T) {
+		t.Parallel()
+		// given
+		broadcaster := NewBookmarkBroadcaster(10)
+		// when
+		actualErr := broadcaster.Watch(nil, helper.ToUserID(t, helper.UserID), "", func(repository.BookmarkChange) error { return nil })
+		// then
+		assert.Exactly(t, errors.New("argument \"ctx\" is nil"), actualErr)
+	})
+	t.Run("nil handler", func(t *testing.T) {
+		t.Parallel()
+		// given
+		broadcaster := NewBookmarkBroadcaster(10)
+		// when
+		actualErr := broadcaster.Watch(context.Background(), helper.ToUserID(t, helper.UserID), "", nil)
+		// then
+		assert.Exactly(t, errors.New("argument \"handler\" is nil"), actualErr)
+	})
+	t.Run("nil user id", func(t *testing.T) {
+		t.Parallel()
+		// given
+		broadcaster := NewBookmarkBroadcaster(10)
+		// when
+		actualErr := broadcaster.Watch(context.Background(), nil, "", func(repository.BookmarkChange) error { return nil })
+		// then
+		assert.Exactly(t, errors.New("argument \"userID\" is nil"), actualErr)
+	})
+	t.Run("slow subscriber", func(t *testing.T) {
+		t.Parallel()
+		// given
+		broadcaster := NewBookmarkBroadcaster(1)
+		_, ch, _ := broadcaster.subscribe("")
+		// when
+		broadcaster.publish(repository.ChangeCreated, *helper.ToID(t, "1"), *helper.ToUserID(t, helper.UserID), nil)
+		broadcaster.publish(repository.ChangeCreated, *helper.ToID(t, "2"), *helper.ToUserID(t, helper.UserID), nil)
+		// then
+		assert.Empty(t, broadcaster.subscribers)
+		<-ch
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,124 @@
+package inmemory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// Webhookの購読の永続化を担うリポジトリの具象型。
+//
+// 配信ワーカーから並行に参照されるため排他制御する。
+type webhookRepository struct {
+	mu    sync.RWMutex                 // 排他制御
+	store map[entity.ID]entity.Webhook // ストレージ
+}
+
+// Webhookの購読の永続化を担うリポジトリを生成する。
+func NewWebhookRepository() repository.Webhook {
+	return &webhookRepository{
+		store: make(map[entity.ID]entity.Webhook),
+	}
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *webhookRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// Webhookの購読を保存する。
+//
+// nilを指定した場合はエラーを返却する。
+// 同じIDの他のユーザの購読が保存されている場合はエラーを返却する。
+//
+// 複製したインスタンスをストレージに保存する。
+func (r *webhookRepository) Save(webhook *entity.Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("argument \"webhook\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := webhook.ID()
+	if stored, ok := r.store[id]; ok && stored.UserID() != webhook.UserID() {
+		return fmt.Errorf("webhook owned by another user: %s", id.Value())
+	}
+	r.store[id] = *webhook.DeepCopy()
+	return nil
+}
+
+// IDからWebhookの購読を検索する。
+//
+// 該当する購読が存在しない場合はnilを返却する。
+// 他のユーザの購読はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 該当する購読が存在する場合は複製したインスタンスを返却する。
+func (r *webhookRepository) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Webhook, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	webhook, ok := r.store[*id]
+	if !ok || webhook.UserID() != *userID {
+		return nil, nil
+	}
+	return webhook.DeepCopy(), nil
+}
+
+// Webhookの購読を一覧取得する。
+//
+// IDの昇順に返却する。
+// 購読が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+//
+// 複製したインスタンスを返却する。
+func (r *webhookRepository) FindAll(userID *entity.UserID) ([]entity.Webhook, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	webhooks := []entity.Webhook{}
+	for _, webhook := range r.store {
+		if webhook.UserID() == *userID {
+			webhooks = append(webhooks, *webhook.DeepCopy())
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		x, y := webhooks[i].ID(), webhooks[j].ID()
+		return x.Value() < y.Value()
+	})
+	return webhooks, nil
+}
+
+// Webhookの購読を削除する。
+//
+// 他のユーザの購読は削除しない。
+//
+// nilを指定した場合はエラーを返却する。
+func (r *webhookRepository) Delete(webhook *entity.Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("argument \"webhook\" is nil")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := webhook.ID()
+	if stored, ok := r.store[id]; ok && stored.UserID() == webhook.UserID() {
+		delete(r.store, id)
+	}
+	return nil
+}
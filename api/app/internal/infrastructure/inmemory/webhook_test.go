@@ -0,0 +1,241 @@
+package inmemory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookRepository(t *testing.T) {
+	t.Parallel()
+	t.Run("implementing repository.Webhook", func(t *testing.T) {
+		t.Parallel()
+		// when
+		object := NewWebhookRepository()
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*repository.Webhook)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		abstractRepository := NewWebhookRepository()
+		// when
+		concreteRepository, ok := abstractRepository.(*webhookRepository)
+		actualStore := concreteRepository.store
+		// then
+		assert.True(t, ok)
+		expectedStore := map[entity.ID]entity.Webhook{}
+		assert.Exactly(t, expectedStore, actualStore)
+	})
+}
+
+func TestWebhook_NextID(t *testing.T) {
+	t.Parallel()
+	// given
+	repository := NewWebhookRepository()
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+}
+
+func TestWebhook_Save(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare       func(repository.Webhook)
+		webhook       *entity.Webhook
+		expectedStore map[entity.ID]entity.Webhook
+		expectedErr   error
+	}{
+		"non-nil webhook": {
+			func(r repository.Webhook) {},
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered),
+			map[entity.ID]entity.Webhook{
+				*helper.ToID(t, "1"): *helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered),
+			},
+			nil,
+		},
+		"webhook owned by another user": {
+			func(r repository.Webhook) {
+				r.Save(helper.ToOwnedWebhook(t, "bob", "1", "https://example.org/hooks", "secret"))
+			},
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			map[entity.ID]entity.Webhook{
+				*helper.ToID(t, "1"): *helper.ToOwnedWebhook(t, "bob", "1", "https://example.org/hooks", "secret"),
+			},
+			errors.New("webhook owned by another user: 1"),
+		},
+		"nil webhook": {
+			func(r repository.Webhook) {},
+			nil,
+			map[entity.ID]entity.Webhook{},
+			errors.New("argument \"webhook\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewWebhookRepository()
+			tc.prepare(repository)
+			// when
+			actualErr := repository.Save(tc.webhook)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*webhookRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_FindByID(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		userID          *entity.UserID
+		id              *entity.ID
+		expectedWebhook *entity.Webhook
+		expectedErr     error
+	}{
+		"id of stored webhook": {
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			nil,
+		},
+		"id of unstored webhook": {
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "2"),
+			nil,
+			nil,
+		},
+		"webhook owned by another user": {
+			helper.ToUserID(t, "bob"),
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil user id": {
+			nil,
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil id": {
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewWebhookRepository()
+			repository.Save(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"))
+			// when
+			actualWebhook, actualErr := repository.FindByID(tc.userID, tc.id)
+			// then
+			assert.Exactly(t, tc.expectedWebhook, actualWebhook)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_FindAll(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		prepare          func(repository.Webhook)
+		expectedWebhooks []entity.Webhook
+	}{
+		"stored webhooks": {
+			func(r repository.Webhook) {
+				r.Save(helper.ToWebhook(t, "2", "https://example.org/hooks", "secret"))
+				r.Save(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"))
+				r.Save(helper.ToOwnedWebhook(t, "bob", "3", "https://example.net/hooks", "secret"))
+			},
+			[]entity.Webhook{
+				*helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+				*helper.ToWebhook(t, "2", "https://example.org/hooks", "secret"),
+			},
+		},
+		"no webhooks": {
+			func(r repository.Webhook) {},
+			[]entity.Webhook{},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewWebhookRepository()
+			tc.prepare(repository)
+			// when
+			actualWebhooks, actualErr := repository.FindAll(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(t, tc.expectedWebhooks, actualWebhooks)
+			assert.NoError(t, actualErr)
+		})
+	}
+	t.Run("nil user id", func(t *testing.T) {
+		t.Parallel()
+		// given
+		repository := NewWebhookRepository()
+		// when
+		actualWebhooks, actualErr := repository.FindAll(nil)
+		// then
+		assert.Nil(t, actualWebhooks)
+		assert.Exactly(t, errors.New("argument \"userID\" is nil"), actualErr)
+	})
+}
+
+func TestWebhook_Delete(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		webhook       *entity.Webhook
+		expectedStore map[entity.ID]entity.Webhook
+		expectedErr   error
+	}{
+		"stored webhook": {
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			map[entity.ID]entity.Webhook{},
+			nil,
+		},
+		"webhook owned by another user": {
+			helper.ToOwnedWebhook(t, "bob", "1", "https://example.com/hooks", "secret"),
+			map[entity.ID]entity.Webhook{
+				*helper.ToID(t, "1"): *helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			},
+			nil,
+		},
+		"nil webhook": {
+			nil,
+			map[entity.ID]entity.Webhook{
+				*helper.ToID(t, "1"): *helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			},
+			errors.New("argument \"webhook\" is nil"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			repository := NewWebhookRepository()
+			repository.Save(helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"))
+			// when
+			actualErr := repository.Delete(tc.webhook)
+			// then
+			assert.Exactly(t, tc.expectedStore, repository.(*webhookRepository).store)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
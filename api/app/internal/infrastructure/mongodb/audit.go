@@ -0,0 +1,184 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 監査ログの永続化を担うリポジトリの具象型。
+type auditRepository struct {
+	collection *mongo.Collection // コレクション
+	clock      clock.Clock       // 時計
+}
+
+// 監査ログの永続化を担うリポジトリを生成する。
+func NewAuditRepository(collection *mongo.Collection, clock clock.Clock) repository.Audit {
+	return &auditRepository{
+		collection: collection,
+		clock:      clock,
+	}
+}
+
+// 監査ログの記録に関するドキュメント。
+type AuditEntryDocument struct {
+	ID         string            `bson:"_id"`        // ID
+	Actor      string            `bson:"actor"`      // 操作者
+	Operation  string            `bson:"operation"`  // 操作
+	BookmarkID string            `bson:"bookmarkID"` // 操作したブックマークのID
+	UserID     string            `bson:"userID"`     // 操作したブックマークの所有者のユーザID
+	Before     *SnapshotDocument `bson:"before"`     // 操作前の内容
+	After      *SnapshotDocument `bson:"after"`      // 操作後の内容
+	CreatedAt  time.Time         `bson:"createdAt"`  // 作成日時
+}
+
+// ブックマークの内容を表す値オブジェクトからドキュメントを生成する。
+//
+// nilを指定した場合はnilを返却する。
+func toNullableSnapshotDocument(snapshot *entity.Snapshot) *SnapshotDocument {
+	if snapshot == nil {
+		return nil
+	}
+	document := toSnapshotDocument(*snapshot)
+	return &document
+}
+
+// ドキュメントから監査ログの記録を表すエンティティを生成する。
+func (d *AuditEntryDocument) toEntity() *entity.AuditEntry {
+	id, _ := entity.NewID(d.ID)
+	operation, _ := entity.NewAuditOperation(d.Operation)
+	if operation == nil {
+		return nil
+	}
+	bookmarkID, _ := entity.NewID(d.BookmarkID)
+	userID, _ := entity.NewUserID(d.UserID)
+	var before, after *entity.Snapshot
+	if d.Before != nil {
+		before = d.Before.toValue()
+	}
+	if d.After != nil {
+		after = d.After.toValue()
+	}
+	entry, err := entity.NewAuditEntry(id, d.Actor, *operation, bookmarkID, userID, before, after)
+	if err != nil {
+		return nil
+	}
+	entry.SetCreatedAt(d.CreatedAt)
+	return entry
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *auditRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// 監査ログの記録を保存する。
+//
+// 保存に成功した場合は記録の作成日時を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+//
+//	db.audits.insertOne({
+//	  _id: "ID", actor: "Actor", operation: "update", bookmarkID: "BookmarkID", userID: "UserID",
+//	  before: {name: "Name", uri: "URI", description: "Description", tags: ["1"]},
+//	  after: {name: "Name", uri: "URI", description: "Description", tags: ["1", "2"]},
+//	  createdAt: ISODate("2022-01-02T00:00:00Z")
+//	})
+func (r *auditRepository) Save(entry *entity.AuditEntry) error {
+	if entry == nil {
+		return fmt.Errorf("argument \"entry\" is nil")
+	}
+	ctx := context.Background()
+	now := r.clock.Now()
+	id := entry.ID()
+	bookmarkID := entry.BookmarkID()
+	userID := entry.UserID()
+	document := AuditEntryDocument{
+		ID:         id.Value(),
+		Actor:      entry.Actor(),
+		Operation:  entry.Operation().Value(),
+		BookmarkID: bookmarkID.Value(),
+		UserID:     userID.Value(),
+		Before:     toNullableSnapshotDocument(entry.Before()),
+		After:      toNullableSnapshotDocument(entry.After()),
+		CreatedAt:  now,
+	}
+	if _, err := r.collection.InsertOne(ctx, document); err != nil {
+		return fmt.Errorf("failed at collection.InsertOne: %w", err)
+	}
+	entry.SetCreatedAt(now)
+	return nil
+}
+
+// 検索条件から監査ログの記録一覧を検索する。
+//
+// 作成日時の降順、作成日時が等しい場合はIDの昇順に返却する。
+// 該当する記録が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.audits.find({
+//	  bookmarkID: "BookmarkID", userID: "UserID", actor: "Actor",
+//	  createdAt: {$gte: ISODate("2022-01-01T00:00:00Z"), $lt: ISODate("2022-01-02T00:00:00Z")}
+//	}).sort({createdAt: -1, _id: 1})
+func (r *auditRepository) FindBySpec(spec *repository.AuditSpec) ([]entity.AuditEntry, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("argument \"spec\" is nil")
+	}
+	ctx := context.Background()
+	filter := toAuditFilter(spec)
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []AuditEntryDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	entries := make([]entity.AuditEntry, len(documents))
+	for i, document := range documents {
+		entries[i] = *document.toEntity()
+	}
+	return entries, nil
+}
+
+// 監査ログの検索条件からフィルタを生成する。
+func toAuditFilter(spec *repository.AuditSpec) bson.D {
+	filter := bson.D{}
+	if spec.BookmarkID != nil {
+		filter = append(filter, bson.E{Key: "bookmarkID", Value: spec.BookmarkID.Value()})
+	}
+	if spec.UserID != nil {
+		filter = append(filter, bson.E{Key: "userID", Value: spec.UserID.Value()})
+	}
+	if spec.Actor != "" {
+		filter = append(filter, bson.E{Key: "actor", Value: spec.Actor})
+	}
+	createdAt := bson.D{}
+	if !spec.Since.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$gte", Value: spec.Since})
+	}
+	if !spec.Until.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$lt", Value: spec.Until})
+	}
+	if len(createdAt) > 0 {
+		filter = append(filter, bson.E{Key: "createdAt", Value: createdAt})
+	}
+	return filter
+}
@@ -0,0 +1,237 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewAuditRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.Audit", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewAuditRepository(collection, helper.ToFixedClock(t, now))
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.Audit)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewAuditRepository(collection, helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*auditRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestAudit_NextID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	// given
+	collection := mt.Coll
+	repository := NewAuditRepository(collection, helper.ToFixedClock(t, now))
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+	expectedType := &entity.ID{}
+	assert.IsType(t, expectedType, id)
+}
+
+func TestAudit_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	before := helper.ToSnapshot(t, "Example", "https://example.com", "foo")
+	after := helper.ToSnapshot(t, "Example Domain", "https://example.com", "foo")
+	cases := map[string]struct {
+		prepare       func(*mtest.T)
+		entry         *entity.AuditEntry
+		expectedEntry *entity.AuditEntry
+		expectedErr   error
+	}{
+		"new entry": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			},
+			helper.ToAuditEntry(t, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after),
+			helper.ToTimestampedAuditEntry(t, now, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after),
+			nil,
+		},
+		"new entry without before": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			},
+			helper.ToAuditEntry(t, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, after),
+			helper.ToTimestampedAuditEntry(t, now, "100", "alice", entity.AuditOperationRegister, "1", helper.UserID, nil, after),
+			nil,
+		},
+		"nil entry": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"entry\" is nil"),
+		},
+		"failed at collection.InsertOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+			},
+			helper.ToAuditEntry(t, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after),
+			helper.ToAuditEntry(t, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, before, after),
+			errors.New("failed at collection.InsertOne: write exception: write errors: [duplicate key error]"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewAuditRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.Save(tc.entry)
+			// then
+			assert.Exactly(mt, tc.expectedEntry, tc.entry)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestAudit_FindBySpec(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	a := helper.ToSnapshot(t, "Example A", "https://example.com")
+	b := helper.ToSnapshot(t, "Example B", "https://example.com")
+	cases := map[string]struct {
+		prepare         func(*mtest.T)
+		spec            *repository.AuditSpec
+		expectedEntries []entity.AuditEntry
+		expectedErr     error
+	}{
+		"spec with entries": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToAuditEntryDocument(
+						t, now, "101", "bob", "delete", "1", helper.UserID,
+						helper.ToSnapshotDocument(t, "Example B", "https://example.com"),
+						nil,
+					)),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToAuditEntryDocument(
+						t, earlier, "100", "alice", "update", "1", helper.UserID,
+						helper.ToSnapshotDocument(t, "Example A", "https://example.com"),
+						helper.ToSnapshotDocument(t, "Example B", "https://example.com"),
+					)),
+				)
+			},
+			&repository.AuditSpec{BookmarkID: helper.ToID(t, "1")},
+			[]entity.AuditEntry{
+				*helper.ToTimestampedAuditEntry(t, now, "101", "bob", entity.AuditOperationDelete, "1", helper.UserID, b, nil),
+				*helper.ToTimestampedAuditEntry(t, earlier, "100", "alice", entity.AuditOperationUpdate, "1", helper.UserID, a, b),
+			},
+			nil,
+		},
+		"spec without entries": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			&repository.AuditSpec{Actor: "carol"},
+			[]entity.AuditEntry{},
+			nil,
+		},
+		"nil spec": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"spec\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			&repository.AuditSpec{},
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewAuditRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualEntries, actualErr := repository.FindBySpec(tc.spec)
+			// then
+			assert.Exactly(mt, tc.expectedEntries, actualEntries)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestToAuditFilter(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		spec           *repository.AuditSpec
+		expectedFilter bson.D
+	}{
+		"empty spec": {
+			&repository.AuditSpec{},
+			bson.D{},
+		},
+		"bookmark id and actor": {
+			&repository.AuditSpec{BookmarkID: helper.ToID(t, "1"), Actor: "alice"},
+			bson.D{{Key: "bookmarkID", Value: "1"}, {Key: "actor", Value: "alice"}},
+		},
+		"since": {
+			&repository.AuditSpec{Since: earlier},
+			bson.D{{Key: "createdAt", Value: bson.D{{Key: "$gte", Value: earlier}}}},
+		},
+		"since and until": {
+			&repository.AuditSpec{Since: earlier, Until: now},
+			bson.D{{Key: "createdAt", Value: bson.D{{Key: "$gte", Value: earlier}, {Key: "$lt", Value: now}}}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualFilter := toAuditFilter(tc.spec)
+			// then
+			assert.Exactly(t, tc.expectedFilter, actualFilter)
+		})
+	}
+}
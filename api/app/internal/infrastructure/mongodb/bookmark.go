@@ -3,11 +3,16 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/repository"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -15,21 +20,143 @@ import (
 // ブックマークの永続化を担うリポジトリの具象型。
 type bookmarkRepository struct {
 	collection *mongo.Collection // コレクション
+	outbox     *mongo.Collection // 送信箱のコレクション
+	revisions  *mongo.Collection // 改訂履歴のコレクション
+	audits     *mongo.Collection // 監査ログのコレクション
+	clock      clock.Clock       // 時計
 }
 
 // ブックマークの永続化を担うリポジトリを生成する。
-func NewBookmarkRepository(collection *mongo.Collection) repository.Bookmark {
+//
+// 送信箱のコレクションを指定した場合は、ブックマークの書き込みと同じトランザクションで発行前のドメインイベントを送信箱に記録する。
+// nilを指定した場合はドメインイベントを記録しない。
+// 改訂履歴のコレクションを指定した場合は、ブックマークの書き込みと同じトランザクションで改訂を記録する。
+// nilを指定した場合は改訂を記録しない。
+// 監査ログのコレクションを指定した場合は、ブックマークの書き込みと同じトランザクションで監査ログに記録する。
+// nilを指定した場合は監査ログに記録しない。
+func NewBookmarkRepository(collection *mongo.Collection, outbox *mongo.Collection, revisions *mongo.Collection, audits *mongo.Collection, clock clock.Clock) repository.Bookmark {
 	return &bookmarkRepository{
 		collection: collection,
+		outbox:     outbox,
+		revisions:  revisions,
+		audits:     audits,
+		clock:      clock,
 	}
 }
 
+// 所有者と正規形のURIの組に対する一意インデックスの名前。
+const CanonicalURIIndex = "userID_1_canonicalURI_1"
+
 // ブックマークに関するドキュメント。
 type BookmarkDocument struct {
-	ID   string   `bson:"_id"`  // ID
-	Name string   `bson:"name"` // ブックマーク名
-	URI  string   `bson:"uri"`  // URI
-	Tags []string `bson:"tags"` // タグ一覧
+	ID           string     `bson:"_id"`          // ID
+	UserID       string     `bson:"userID"`       // 所有者のユーザID
+	Name         string     `bson:"name"`         // ブックマーク名
+	URI          string     `bson:"uri"`          // URI
+	CanonicalURI string     `bson:"canonicalURI"` // 正規形のURI
+	Description  string     `bson:"description"`  // 説明
+	FolderID     string     `bson:"folderID"`     // 所属するフォルダのID (最上位の場合は空文字列)
+	Status       string     `bson:"status"`       // 状態 (存在しない場合は未読)
+	Starred      bool       `bson:"starred"`      // お気に入りに登録されているか
+	Tags         []string   `bson:"tags"`         // タグ一覧
+	Version      uint64     `bson:"version"`      // 版数
+	CreatedAt    time.Time  `bson:"createdAt"`    // 作成日時
+	UpdatedAt    time.Time  `bson:"updatedAt"`    // 更新日時
+	DeletedAt    *time.Time `bson:"deletedAt"`    // ゴミ箱に移動した日時 (ゴミ箱にない場合はnull)
+}
+
+// 重複の集計結果に関するドキュメント。
+type DuplicateDocument struct {
+	CanonicalURI string             `bson:"_id"`       // 正規形のURI
+	Bookmarks    []BookmarkDocument `bson:"bookmarks"` // ブックマーク一覧
+}
+
+// タグの集計結果に関するドキュメント。
+type TagCountDocument struct {
+	Tag   string `bson:"_id"`   // タグ
+	Count int    `bson:"count"` // タグが付与されたブックマーク数
+}
+
+// ドキュメントからブックマークを表すエンティティを生成する。
+//
+// ID、ブックマーク名、URI、説明、タグ一覧のいずれかが不正な場合はエラーを返却する。
+func (d *BookmarkDocument) toEntity() (*entity.Bookmark, error) {
+	id, err := entity.NewID(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := entity.NewName(d.Name)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := entity.NewURI(d.URI)
+	if err != nil {
+		return nil, err
+	}
+	description, err := entity.NewDescription(d.Description)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := toTags(d.Tags)
+	if err != nil {
+		return nil, err
+	}
+	bookmark, err := entity.NewBookmark(id, name, uri, tags)
+	if err != nil {
+		return nil, err
+	}
+	if userID, err := entity.NewUserID(d.UserID); err == nil {
+		bookmark.AssignTo(userID)
+	}
+	bookmark.Describe(description)
+	if folder, err := entity.NewID(d.FolderID); err == nil {
+		bookmark.MoveTo(folder)
+	}
+	if status, err := entity.NewStatus(d.Status); err == nil {
+		switch *status {
+		case entity.StatusRead:
+			bookmark.MarkRead()
+		case entity.StatusArchived:
+			bookmark.Archive()
+		}
+	}
+	if d.Starred {
+		bookmark.Star()
+	}
+	bookmark.SetVersion(d.Version)
+	bookmark.SetTimestamps(d.CreatedAt, d.UpdatedAt)
+	if d.DeletedAt != nil {
+		bookmark.SetDeletedAt(*d.DeletedAt)
+	}
+	// 復元のために記録したドメインイベントは発行しない。
+	bookmark.PullEvents()
+	return bookmark, nil
+}
+
+// ゴミ箱にないドキュメントを検索する条件。
+//
+// ゴミ箱に移動した日時がnullまたは存在しないドキュメントを対象とする。
+var activeCondition = bson.E{Key: "deletedAt", Value: nil}
+
+// ゴミ箱にあるドキュメントを検索する条件。
+var trashedCondition = bson.E{Key: "deletedAt", Value: bson.D{{Key: "$ne", Value: nil}}}
+
+// 所有者が一致するドキュメントを検索する条件を生成する。
+func ownerCondition(userID *entity.UserID) bson.E {
+	return bson.E{Key: "userID", Value: userID.Value()}
+}
+
+// IDと所有者、版数が一致するドキュメントを検索するフィルタを生成する。
+//
+// 版数が0の場合は版数を持たないドキュメントを対象とする。
+func versionFilter(bookmark *entity.Bookmark) bson.D {
+	id := bookmark.ID()
+	userID := bookmark.UserID()
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(&userID)}
+	if bookmark.Version() == 0 {
+		return append(filter, bson.E{Key: "version", Value: bson.D{{Key: "$exists", Value: false}}})
+	}
+	return append(filter, bson.E{Key: "version", Value: bookmark.Version()})
 }
 
 // IDを生成する。
@@ -43,93 +170,499 @@ func (r *bookmarkRepository) NextID() *entity.ID {
 
 // ブックマークを保存する。
 //
+// 保存に成功した場合はブックマークの版数と更新日時を更新する。
+// 新規に保存する場合は作成日時も設定する。
+//
 // nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
 // ドキュメントの保存に失敗した場合はエラーを返却する。
 //
+// 版数が0の場合は版数を持たないドキュメントを更新し、存在しなければ挿入する。
+//
 //	db.bookmarks.updateOne(
-//	  {_id: "ID"},
+//	  {_id: "ID", userID: "UserID", version: 1},
 //	  {
-//	    $set: {_id: "ID", name: "Name", tags: ["1", "2", "3"], uri: "URI"},
-//	    $currentDate: {lastModified: true}
+//	    $set: {
+//	      _id: "ID", userID: "UserID", name: "Name", uri: "URI", canonicalURI: "CanonicalURI", description: "Description",
+//	      folderID: "FolderID", status: "Status", starred: false, tags: ["1", "2", "3"], version: 2,
+//	      createdAt: ISODate("CreatedAt"), updatedAt: ISODate("Now"), deletedAt: null
+//	    }
 //	  },
-//	  {upsert: true}
+//	  {upsert: false}
 //	)
-func (r *bookmarkRepository) Save(bookmark *entity.Bookmark) error {
+//
+// 発行前のドメインイベントがある場合は、同じトランザクションで送信箱に記録する。
+// 改訂履歴のコレクションを持つ場合は、同じトランザクションで変更前のドキュメントを検索し、内容が変更されていれば改訂を記録する。
+// 監査ログのコレクションを持つ場合は、同じトランザクションで変更前のドキュメントが無ければ登録、内容が変更されていれば更新を監査ログに記録する。
+func (r *bookmarkRepository) Save(bookmark *entity.Bookmark, actor string) error {
 	if bookmark == nil {
 		return fmt.Errorf("argument \"bookmark\" is nil")
 	}
 	ctx := context.Background()
+	now := r.clock.Now()
+	result, err := r.writeWithOutbox(ctx, bookmark.Events(), now, func(ctx context.Context) (interface{}, error) {
+		return r.save(ctx, bookmark, actor, now)
+	})
+	if err != nil {
+		return err
+	}
+	bookmark.SetVersion(bookmark.Version() + 1)
+	bookmark.SetTimestamps(result.(time.Time), now)
+	return nil
+}
+
+// 発行前のドメインイベントを送信箱に記録しながらドキュメントを書き込む。
+//
+// ドメインイベントがある場合は、書き込みと送信箱への記録を1つのトランザクションで行う。
+// 改訂履歴または監査ログのコレクションを持つ場合は、書き込みで改訂や監査ログを記録するため常にトランザクションで行う。
+// それ以外の場合はトランザクションを開始せずに書き込む。
+// 書き込みの結果を返却する。
+//
+// セッションの開始に失敗した場合はエラーを返却する。
+// 書き込みに失敗した場合は書き込みのエラーを返却する。
+// 送信箱への記録に失敗した場合はエラーを返却する。
+//
+//	session.startTransaction()
+//	db.bookmarks.updateOne({_id: "ID", userID: "UserID", version: 1}, {$set: {...}})
+//	db.outbox.insertMany([{_id: "OutboxID", eventName: "BookmarkRenamed", bookmarkID: "ID", ..., position: 0, dispatchedAt: null}])
+//	session.commitTransaction()
+func (r *bookmarkRepository) writeWithOutbox(ctx context.Context, events []entity.Event, now time.Time, write func(context.Context) (interface{}, error)) (interface{}, error) {
+	if (len(events) == 0 || r.outbox == nil) && r.revisions == nil && r.audits == nil {
+		return write(ctx)
+	}
+	return r.transact(ctx, func(ctx context.Context) (interface{}, error) {
+		result, err := write(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.appendOutbox(ctx, events, now); err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// 1つのトランザクションで書き込む。
+//
+// 書き込みの結果を返却する。
+//
+// セッションの開始に失敗した場合はエラーを返却する。
+// 書き込みに失敗した場合は書き込みのエラーを返却する。
+func (r *bookmarkRepository) transact(ctx context.Context, write func(context.Context) (interface{}, error)) (interface{}, error) {
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed at client.StartSession: %w", err)
+	}
+	defer session.EndSession(ctx)
+	return session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return write(sc)
+	})
+}
+
+// ドメインイベントを送信箱に記録する。
+//
+// ドメインイベントが無い場合、あるいは送信箱のコレクションを持たない場合は何もしない。
+//
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) appendOutbox(ctx context.Context, events []entity.Event, now time.Time) error {
+	if len(events) == 0 || r.outbox == nil {
+		return nil
+	}
+	if _, err := r.outbox.InsertMany(ctx, newOutboxDocuments(events, now)); err != nil {
+		return fmt.Errorf("failed at outbox.InsertMany: %w", err)
+	}
+	return nil
+}
+
+// ブックマークのドキュメントを保存し、内容が変更されていれば改訂と監査ログに記録する。
+//
+// 変更前のドキュメントが無い場合は登録として監査ログに記録する。
+// 保存したドキュメントの作成日時を返却する。
+// ブックマークの版数と更新日時は更新しない。
+//
+// 変更前のドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントの保存に失敗した場合はエラーを返却する。
+// 改訂または監査ログの記録に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) save(ctx context.Context, bookmark *entity.Bookmark, actor string, now time.Time) (time.Time, error) {
+	before, err := r.preImage(ctx, bookmark)
+	if err != nil {
+		return time.Time{}, err
+	}
+	createdAt, err := r.upsert(ctx, bookmark, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	after := bookmark.Snapshot()
+	operation := entity.AuditOperationUpdate
+	if before == nil {
+		operation = entity.AuditOperationRegister
+	}
+	if err := r.record(ctx, bookmark, bookmark.Version()+1, actor, operation, before, &after, now); err != nil {
+		return time.Time{}, err
+	}
+	return createdAt, nil
+}
+
+// 保存されているブックマークの内容を検索する。
+//
+// IDと所有者、版数が一致するドキュメントを対象とする。
+// 改訂履歴と監査ログのコレクションを持たない場合、あるいは該当するドキュメントが存在しない場合はnilを返却する。
+//
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.findOne({_id: "ID", userID: "UserID", version: 1})
+func (r *bookmarkRepository) preImage(ctx context.Context, bookmark *entity.Bookmark) (*entity.Snapshot, error) {
+	if r.revisions == nil && r.audits == nil {
+		return nil, nil
+	}
+	stored, err := r.findOne(ctx, versionFilter(bookmark))
+	if err != nil || stored == nil {
+		return nil, err
+	}
+	snapshot := stored.Snapshot()
+	return &snapshot, nil
+}
+
+// ブックマークの操作を改訂と監査ログに記録する。
+//
+// 変更前後の内容が揃っている場合は改訂を記録する。
+// 更新で変更前後の内容が等しい場合はいずれにも記録しない。
+//
+// 改訂または監査ログの記録に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) record(ctx context.Context, bookmark *entity.Bookmark, version uint64, actor string, operation entity.AuditOperation, before, after *entity.Snapshot, now time.Time) error {
+	if err := r.appendRevision(ctx, bookmark.ID(), version, actor, before, after, now); err != nil {
+		return err
+	}
+	if operation == entity.AuditOperationUpdate && before.Equals(*after) {
+		return nil
+	}
+	return r.appendAudit(ctx, bookmark, actor, operation, before, after, now)
+}
+
+// 改訂を記録する。
+//
+// 改訂履歴のコレクションを持たない場合、変更前後のいずれかの内容が無い場合、あるいは変更前後の内容が等しい場合は何もしない。
+//
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+//
+//	db.revisions.insertOne({
+//	  _id: "RevisionID", bookmarkID: "ID", version: 2, actor: "Actor",
+//	  before: {name: "Name", ...}, after: {name: "Name", ...}, createdAt: ISODate("Now")
+//	})
+func (r *bookmarkRepository) appendRevision(ctx context.Context, id entity.ID, version uint64, actor string, before, after *entity.Snapshot, now time.Time) error {
+	if r.revisions == nil || before == nil || after == nil || before.Equals(*after) {
+		return nil
+	}
+	document := RevisionDocument{
+		ID:         r.NextID().Value(),
+		BookmarkID: id.Value(),
+		Version:    version,
+		Actor:      actor,
+		Before:     toSnapshotDocument(*before),
+		After:      toSnapshotDocument(*after),
+		CreatedAt:  now,
+	}
+	if _, err := r.revisions.InsertOne(ctx, document); err != nil {
+		return fmt.Errorf("failed at revisions.InsertOne: %w", err)
+	}
+	return nil
+}
+
+// 監査ログに記録する。
+//
+// 監査ログのコレクションを持たない場合は何もしない。
+//
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+//
+//	db.audits.insertOne({
+//	  _id: "AuditEntryID", actor: "Actor", operation: "update", bookmarkID: "ID", userID: "UserID",
+//	  before: {name: "Name", ...}, after: {name: "Name", ...}, createdAt: ISODate("Now")
+//	})
+func (r *bookmarkRepository) appendAudit(ctx context.Context, bookmark *entity.Bookmark, actor string, operation entity.AuditOperation, before, after *entity.Snapshot, now time.Time) error {
+	if r.audits == nil {
+		return nil
+	}
 	id := bookmark.ID()
+	userID := bookmark.UserID()
+	document := AuditEntryDocument{
+		ID:         r.NextID().Value(),
+		Actor:      actor,
+		Operation:  operation.Value(),
+		BookmarkID: id.Value(),
+		UserID:     userID.Value(),
+		Before:     toNullableSnapshotDocument(before),
+		After:      toNullableSnapshotDocument(after),
+		CreatedAt:  now,
+	}
+	if _, err := r.audits.InsertOne(ctx, document); err != nil {
+		return fmt.Errorf("failed at audits.InsertOne: %w", err)
+	}
+	return nil
+}
+
+// ブックマークのドキュメントを更新し、版数が0であれば挿入する。
+//
+// 保存したドキュメントの作成日時を返却する。
+// ブックマークの版数と更新日時は更新しない。
+//
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// ドキュメントの保存に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) upsert(ctx context.Context, bookmark *entity.Bookmark, now time.Time) (time.Time, error) {
+	id := bookmark.ID()
+	userID := bookmark.UserID()
 	name := bookmark.Name()
 	uri := bookmark.URI()
+	description := bookmark.Description()
 	tags := make([]string, len(bookmark.Tags()))
 	for i, tag := range bookmark.Tags() {
 		tags[i] = tag.Value()
 	}
+	folderID := ""
+	if folder := bookmark.Folder(); folder != nil {
+		folderID = folder.Value()
+	}
+	version := bookmark.Version()
+	createdAt := bookmark.CreatedAt()
+	if version == 0 {
+		createdAt = now
+	}
+	var deletedAt *time.Time
+	if bookmark.IsTrashed() {
+		t := bookmark.DeletedAt()
+		deletedAt = &t
+	}
 	document := BookmarkDocument{
-		ID:   id.Value(),
-		Name: name.Value(),
-		URI:  uri.String(),
-		Tags: tags,
+		ID:           id.Value(),
+		UserID:       userID.Value(),
+		Name:         name.Value(),
+		URI:          uri.String(),
+		CanonicalURI: uri.Canonical(),
+		Description:  description.Value(),
+		FolderID:     folderID,
+		Status:       bookmark.Status().Value(),
+		Starred:      bookmark.Starred(),
+		Tags:         tags,
+		Version:      version + 1,
+		CreatedAt:    createdAt,
+		UpdatedAt:    now,
+		DeletedAt:    deletedAt,
 	}
-	update := bson.M{"$set": document, "$currentDate": bson.M{"lastModified": true}}
-	opts := options.Update().SetUpsert(true)
-	if _, err := r.collection.UpdateByID(ctx, id.Value(), update, opts); err != nil {
-		return fmt.Errorf("failed at collection.UpdateByID: %w", err)
+	filter := versionFilter(bookmark)
+	update := bson.M{"$set": document}
+	opts := options.Update().SetUpsert(version == 0)
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if isDuplicateURIError(err) {
+		return time.Time{}, repository.ErrDuplicate
 	}
-	return nil
+	if mongo.IsDuplicateKeyError(err) {
+		return time.Time{}, repository.ErrConflict
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed at collection.UpdateOne: %w", err)
+	}
+	if result.MatchedCount == 0 && result.UpsertedCount == 0 {
+		return time.Time{}, repository.ErrConflict
+	}
+	return createdAt, nil
+}
+
+// 所有者と正規形のURIの組に対する一意インデックスに違反したエラーか判定する。
+func isDuplicateURIError(err error) bool {
+	return mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), CanonicalURIIndex)
 }
 
 // ブックマーク一覧を検索する。
 //
+// ゴミ箱にあるブックマークは除外する。
 // ブックマークが存在しない場合は空のスライスを返却する。
 //
+// nilを指定した場合はエラーを返却する。
 // ドキュメントの検索に失敗した場合はエラーを返却する。
 // ドキュメントのデコードに失敗した場合はエラーを返却する。
 //
-//	db.bookmarks.find({})
-func (r *bookmarkRepository) FindAll() ([]entity.Bookmark, error) {
+//	db.bookmarks.find({userID: "UserID", deletedAt: null})
+func (r *bookmarkRepository) FindAll(userID *entity.UserID) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
 	ctx := context.Background()
-	filter := bson.D{}
-	cursor, err := r.collection.Find(ctx, filter)
-	if err != nil {
-		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	filter := bson.D{ownerCondition(userID), activeCondition}
+	return r.find(ctx, filter)
+}
+
+// 検索条件に該当するブックマーク一覧を検索する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// 該当するブックマークが存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.find(
+//	  {
+//	    userID: "UserID",
+//	    deletedAt: null,
+//	    tags: {$in: ["1", "2"]},
+//	    name: {$regex: "Name", $options: "i"},
+//	    uri: {$regex: "URI", $options: "i"},
+//	    folderID: "FolderID",
+//	    status: {$in: ["unread", null]},
+//	    starred: true,
+//	    $or: [{name: {$gt: "Value"}}, {name: "Value", _id: {$gt: "ID"}}]
+//	  }
+//	).sort({name: 1, _id: 1}).limit(Limit)
+func (r *bookmarkRepository) FindBySpec(userID *entity.UserID, spec *repository.BookmarkSpec) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
 	}
-	var documents []BookmarkDocument
-	if err := cursor.All(ctx, &documents); err != nil {
-		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	if spec == nil {
+		return nil, fmt.Errorf("argument \"spec\" is nil")
 	}
-	bookmarks := make([]entity.Bookmark, len(documents))
-	for i, document := range documents {
-		id, _ := entity.NewID(document.ID)
-		name, _ := entity.NewName(document.Name)
-		uri, _ := entity.NewURI(document.URI)
-		tags := make([]entity.Tag, len(document.Tags))
-		for i, v := range document.Tags {
-			tag,  _ := entity.NewTag(v)
-			tags[i] = *tag
-		}
-		bookmark, _ := entity.NewBookmark(id, name, uri, tags)
-		bookmarks[i] = *bookmark
+	ctx := context.Background()
+	filter := toFilter(userID, spec)
+	opts := options.Find().SetSort(toSort(spec))
+	if spec.Limit > 0 {
+		opts.SetLimit(int64(spec.Limit))
+	}
+	return r.find(ctx, filter, opts)
+}
+
+// 検索条件からフィルタを生成する。
+//
+// 所有者が一致し、ゴミ箱にないドキュメントを対象とする。
+func toFilter(userID *entity.UserID, spec *repository.BookmarkSpec) bson.D {
+	filter := bson.D{ownerCondition(userID), activeCondition}
+	if len(spec.Tags) > 0 {
+		tags := make(bson.A, len(spec.Tags))
+		for i, tag := range spec.Tags {
+			tags[i] = tag.Value()
+		}
+		operator := "$in"
+		if spec.MatchAllTags {
+			operator = "$all"
+		}
+		filter = append(filter, bson.E{Key: "tags", Value: bson.D{{Key: operator, Value: tags}}})
+	}
+	if spec.NameContains != "" {
+		filter = append(filter, bson.E{Key: "name", Value: containsFold(spec.NameContains)})
+	}
+	if spec.URIContains != "" {
+		filter = append(filter, bson.E{Key: "uri", Value: containsFold(spec.URIContains)})
+	}
+	if spec.Folder != nil {
+		filter = append(filter, bson.E{Key: "folderID", Value: spec.Folder.Value()})
+	}
+	if spec.Status != nil {
+		filter = append(filter, bson.E{Key: "status", Value: statusCondition(*spec.Status)})
+	}
+	if spec.StarredOnly {
+		filter = append(filter, bson.E{Key: "starred", Value: true})
+	}
+	if spec.After != nil {
+		filter = append(filter, afterCondition(spec))
+	}
+	return filter
+}
+
+// 再開位置より後に並ぶドキュメントに一致する条件を生成する。
+//
+// 並び替えのキーが等しいドキュメントはIDが大きいものに限定する。
+func afterCondition(spec *repository.BookmarkSpec) bson.E {
+	operator := "$gt"
+	if spec.Descending {
+		operator = "$lt"
+	}
+	id := spec.After.ID.Value()
+	field := sortField(spec.SortKey)
+	if field == "_id" {
+		return bson.E{Key: "_id", Value: bson.D{{Key: operator, Value: id}}}
+	}
+	var value interface{} = spec.After.Value
+	if spec.SortKey == repository.SortByCreatedAt || spec.SortKey == repository.SortByUpdatedAt {
+		value = spec.After.Time
+	}
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: field, Value: bson.D{{Key: operator, Value: value}}}},
+		bson.D{{Key: field, Value: value}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: id}}}},
+	}}
+}
+
+// 状態に一致する条件を生成する。
+//
+// 状態を持たないドキュメントは未読とみなす。
+func statusCondition(status entity.Status) interface{} {
+	if status == entity.StatusUnread {
+		return bson.D{{Key: "$in", Value: bson.A{status.Value(), nil}}}
+	}
+	return status.Value()
+}
+
+// 大文字と小文字を区別せずに部分文字列と一致する正規表現を生成する。
+func containsFold(substr string) primitive.Regex {
+	return primitive.Regex{Pattern: regexp.QuoteMeta(substr), Options: "i"}
+}
+
+// 検索条件から並び順を生成する。
+func toSort(spec *repository.BookmarkSpec) bson.D {
+	order := 1
+	if spec.Descending {
+		order = -1
+	}
+	field := sortField(spec.SortKey)
+	if field == "_id" {
+		return bson.D{{Key: "_id", Value: order}}
+	}
+	return bson.D{{Key: field, Value: order}, {Key: "_id", Value: 1}}
+}
+
+// 並び替えのキーに対応するフィールド名を取得する。
+func sortField(key repository.BookmarkSortKey) string {
+	switch key {
+	case repository.SortByName:
+		return "name"
+	case repository.SortByURI:
+		return "uri"
+	case repository.SortByCreatedAt:
+		return "createdAt"
+	case repository.SortByUpdatedAt:
+		return "updatedAt"
+	default:
+		return "_id"
 	}
-	return bookmarks, nil
 }
 
 // IDからブックマークを検索する。
 //
+// ゴミ箱にあるブックマークは除外する。
 // 該当するブックマークが存在しない場合はnilを返却する。
 //
 // nilを指定した場合はエラーを返却する。
 // ドキュメントの検索に失敗した場合はエラーを返却する。
 //
-//	db.bookmarks.findOne({_id: "ID"})
-func (r *bookmarkRepository) FindByID(id *entity.ID) (*entity.Bookmark, error) {
+//	db.bookmarks.findOne({_id: "ID", userID: "UserID", deletedAt: null})
+func (r *bookmarkRepository) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
 	if id == nil {
 		return nil, fmt.Errorf("argument \"id\" is nil")
 	}
 	ctx := context.Background()
-	filter := bson.D{{Key: "_id", Value: id.Value()}}
-	result := r.collection.FindOne(ctx, filter)
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(userID), activeCondition}
+	return r.findOne(ctx, filter)
+}
+
+// フィルタに該当するドキュメントを1件検索する。
+//
+// 該当するドキュメントが存在しない場合はnilを返却する。
+//
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) findOne(ctx context.Context, filter bson.D, opts ...*options.FindOneOptions) (*entity.Bookmark, error) {
+	result := r.collection.FindOne(ctx, filter, opts...)
 	var document BookmarkDocument
 	err := result.Decode(&document)
 	if err == mongo.ErrNoDocuments {
@@ -138,32 +671,632 @@ func (r *bookmarkRepository) FindByID(id *entity.ID) (*entity.Bookmark, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed at collection.FindOne: %w", err)
 	}
-	name, _ := entity.NewName(document.Name)
-	uri, _ := entity.NewURI(document.URI)
-	tags := make([]entity.Tag, len(document.Tags))
-	for i, v := range document.Tags {
-		tag, _ := entity.NewTag(v)
-		tags[i] = *tag
+	bookmark, err := document.toEntity()
+	if err != nil {
+		return nil, fmt.Errorf("failed at document.toEntity: %w", err)
 	}
-	bookmark, _ := entity.NewBookmark(id, name, uri, tags)
 	return bookmark, nil
 }
 
+// 正規形が一致するURIのブックマークを検索する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// 該当するブックマークが複数存在する場合はIDが最小のブックマークを返却する。
+// 該当するブックマークが存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.find({canonicalURI: "CanonicalURI", userID: "UserID", deletedAt: null}).sort({_id: 1}).limit(1)
+func (r *bookmarkRepository) FindByCanonicalURI(userID *entity.UserID, uri *entity.URI) (*entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if uri == nil {
+		return nil, fmt.Errorf("argument \"uri\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "canonicalURI", Value: uri.Canonical()}, ownerCondition(userID), activeCondition}
+	opts := options.FindOne().SetSort(bson.D{{Key: "_id", Value: 1}})
+	return r.findOne(ctx, filter, opts)
+}
+
 // ブックマークを削除する。
 //
+// ゴミ箱を経由せずに完全に削除する。
+//
 // nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 墓標の記録に失敗した場合はエラーを返却する。
 // ドキュメントの削除に失敗した場合はエラーを返却する。
 //
-//	db.bookmarks.deleteOne({_id: "ID"})
-func (r *bookmarkRepository) Delete(bookmark *entity.Bookmark) error {
+//	db.bookmarks.updateOne({_id: "ID", userID: "UserID", version: 1}, {$set: {tombstone: {userID: "UserID"}}})
+//	db.bookmarks.deleteOne({_id: "ID", userID: "UserID", version: 1})
+//
+// 発行前のドメインイベントがある場合は、同じトランザクションで送信箱に記録する。
+// 監査ログのコレクションを持つ場合は、同じトランザクションで完全な削除を監査ログに記録する。
+func (r *bookmarkRepository) Delete(bookmark *entity.Bookmark, actor string) error {
 	if bookmark == nil {
 		return fmt.Errorf("argument \"bookmark\" is nil")
 	}
 	ctx := context.Background()
-	id := bookmark.ID()
-	filter := bson.D{{Key: "_id", Value: id.Value()}}
-	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+	now := r.clock.Now()
+	_, err := r.writeWithOutbox(ctx, bookmark.Events(), now, func(ctx context.Context) (interface{}, error) {
+		return nil, r.delete(ctx, bookmark, actor, now)
+	})
+	return err
+}
+
+// ブックマークのドキュメントを削除し、完全な削除を監査ログに記録する。
+//
+// 削除する前に所有者を墓標として記録する。
+// 変更ストリームの削除イベントはIDしか持たないため、変更の監視は墓標の記録を所有者付きの削除として扱う。
+//
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 墓標の記録に失敗した場合はエラーを返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+// 監査ログの記録に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) delete(ctx context.Context, bookmark *entity.Bookmark, actor string, now time.Time) error {
+	filter := versionFilter(bookmark)
+	userID := bookmark.UserID()
+	tombstone := bson.D{{Key: "$set", Value: bson.D{{Key: "tombstone", Value: bson.D{ownerCondition(&userID)}}}}}
+	marked, err := r.collection.UpdateOne(ctx, filter, tombstone)
+	if err != nil {
+		return fmt.Errorf("failed at collection.UpdateOne: %w", err)
+	}
+	if marked.MatchedCount == 0 {
+		return repository.ErrConflict
+	}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
 		return fmt.Errorf("failed at collection.DeleteOne: %w", err)
 	}
+	if result.DeletedCount == 0 {
+		return repository.ErrConflict
+	}
+	before := bookmark.Snapshot()
+	return r.appendAudit(ctx, bookmark, actor, entity.AuditOperationPurge, &before, nil, now)
+}
+
+// ブックマークをゴミ箱に移動する。
+//
+// 移動に成功した場合はブックマークの版数と更新日時、ゴミ箱に移動した日時を更新する。
+//
+// nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.updateOne(
+//	  {_id: "ID", userID: "UserID", version: 1},
+//	  {$set: {deletedAt: ISODate("Now"), updatedAt: ISODate("Now"), version: 2}}
+//	)
+//
+// 発行前のドメインイベントがある場合は、同じトランザクションで送信箱に記録する。
+// 改訂履歴のコレクションを持つ場合は、同じトランザクションで改訂を記録する。
+// 監査ログのコレクションを持つ場合は、同じトランザクションで削除を監査ログに記録する。
+func (r *bookmarkRepository) Trash(bookmark *entity.Bookmark, actor string) error {
+	if bookmark == nil {
+		return fmt.Errorf("argument \"bookmark\" is nil")
+	}
+	now := r.clock.Now()
+	return r.changeDeletedAt(bookmark, now, now, actor, entity.AuditOperationDelete)
+}
+
+// ブックマークをゴミ箱から復元する。
+//
+// 復元に成功した場合はブックマークの版数と更新日時を更新し、ゴミ箱に移動した日時を消去する。
+//
+// nilを指定した場合はエラーを返却する。
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// 所有者が同じで正規形が一致するURIのゴミ箱にない別のブックマークが保存されている場合は ErrDuplicate を返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.updateOne(
+//	  {_id: "ID", userID: "UserID", version: 1},
+//	  {$set: {deletedAt: null, updatedAt: ISODate("Now"), version: 2}}
+//	)
+//
+// 発行前のドメインイベントがある場合は、同じトランザクションで送信箱に記録する。
+// 改訂履歴のコレクションを持つ場合は、同じトランザクションで改訂を記録する。
+// 監査ログのコレクションを持つ場合は、同じトランザクションで復元を監査ログに記録する。
+func (r *bookmarkRepository) Restore(bookmark *entity.Bookmark, actor string) error {
+	if bookmark == nil {
+		return fmt.Errorf("argument \"bookmark\" is nil")
+	}
+	return r.changeDeletedAt(bookmark, time.Time{}, r.clock.Now(), actor, entity.AuditOperationRestore)
+}
+
+// ゴミ箱に移動した日時を変更してドキュメントを更新する。
+//
+// ゼロ値を指定した場合はゴミ箱に移動した日時をnullにする。
+// 更新に成功した場合はブックマークの版数と更新日時、ゴミ箱に移動した日時を更新する。
+// ゴミ箱に移動した日時のみを変更するため、ブックマークの内容を変更前の内容として改訂を記録する。
+// 削除の場合は変更前の内容、復元の場合は変更後の内容を指定した操作として監査ログに記録する。
+//
+// 保存されている版数とブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者とブックマークの所有者が異なる場合は ErrConflict を返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+// 改訂または監査ログの記録に失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) changeDeletedAt(bookmark *entity.Bookmark, deletedAt, now time.Time, actor string, operation entity.AuditOperation) error {
+	ctx := context.Background()
+	version := bookmark.Version()
+	var value interface{}
+	if !deletedAt.IsZero() {
+		value = deletedAt
+	}
+	filter := versionFilter(bookmark)
+	update := bson.M{"$set": bson.M{"deletedAt": value, "updatedAt": now, "version": version + 1}}
+	_, err := r.writeWithOutbox(ctx, bookmark.Events(), now, func(ctx context.Context) (interface{}, error) {
+		result, err := r.collection.UpdateOne(ctx, filter, update)
+		if isDuplicateURIError(err) {
+			return nil, repository.ErrDuplicate
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed at collection.UpdateOne: %w", err)
+		}
+		if result.MatchedCount == 0 {
+			return nil, repository.ErrConflict
+		}
+		before := bookmark.Snapshot()
+		changed := bookmark.DeepCopy()
+		changed.SetDeletedAt(deletedAt)
+		after := changed.Snapshot()
+		if err := r.appendRevision(ctx, bookmark.ID(), version+1, actor, &before, &after, now); err != nil {
+			return nil, err
+		}
+		if operation == entity.AuditOperationDelete {
+			return nil, r.appendAudit(ctx, bookmark, actor, operation, &before, nil, now)
+		}
+		return nil, r.appendAudit(ctx, bookmark, actor, operation, nil, &after, now)
+	})
+	if err != nil {
+		return err
+	}
+	bookmark.SetDeletedAt(deletedAt)
+	bookmark.SetVersion(version + 1)
+	bookmark.SetTimestamps(bookmark.CreatedAt(), now)
+	return nil
+}
+
+// ゴミ箱にあるブックマーク一覧を検索する。
+//
+// ゴミ箱に移動した日時の降順、IDの昇順に返却する。
+// ブックマークが存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.find({userID: "UserID", deletedAt: {$ne: null}}).sort({deletedAt: -1, _id: 1})
+func (r *bookmarkRepository) FindTrash(userID *entity.UserID) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{ownerCondition(userID), trashedCondition}
+	opts := options.Find().SetSort(bson.D{{Key: "deletedAt", Value: -1}, {Key: "_id", Value: 1}})
+	return r.find(ctx, filter, opts)
+}
+
+// IDからゴミ箱にあるブックマークを検索する。
+//
+// 該当するブックマークが存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.findOne({_id: "ID", userID: "UserID", deletedAt: {$ne: null}})
+func (r *bookmarkRepository) FindTrashByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(userID), trashedCondition}
+	return r.findOne(ctx, filter)
+}
+
+// 指定日時より前にゴミ箱に移動したブックマークを完全に削除する。
+//
+// 削除したブックマーク一覧をIDの昇順に返却する。
+// 削除したブックマークには完全な削除を記録する。
+//
+// nilを指定した場合はエラーを返却する。
+// セッションの開始に失敗した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+// 検索後に他の書き込みがあった場合は ErrConflict を返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+// 監査ログの記録に失敗した場合はエラーを返却する。
+// 送信箱への記録に失敗した場合はエラーを返却する。
+//
+// 1つのトランザクションで検索と削除、完全な削除の監査ログへの記録、ドメインイベントの送信箱への記録を行う。
+//
+//	session.startTransaction()
+//	db.bookmarks.find({userID: "UserID", deletedAt: {$lt: ISODate("Before")}}).sort({_id: 1})
+//	db.bookmarks.updateOne({_id: "ID1", userID: "UserID", version: 1}, {$set: {tombstone: {userID: "UserID"}}})
+//	db.bookmarks.deleteOne({_id: "ID1", userID: "UserID", version: 1})
+//	db.audits.insertOne({_id: "AuditEntryID1", operation: "purge", bookmarkID: "ID1", ...})
+//	db.bookmarks.updateOne({_id: "ID2", userID: "UserID", version: 1}, {$set: {tombstone: {userID: "UserID"}}})
+//	db.bookmarks.deleteOne({_id: "ID2", userID: "UserID", version: 1})
+//	db.audits.insertOne({_id: "AuditEntryID2", operation: "purge", bookmarkID: "ID2", ...})
+//	db.outbox.insertMany([{...}, {...}])
+//	session.commitTransaction()
+func (r *bookmarkRepository) PurgeTrash(userID *entity.UserID, before time.Time, actor string) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	now := r.clock.Now()
+	filter := bson.D{ownerCondition(userID), {Key: "deletedAt", Value: bson.D{{Key: "$lt", Value: before}}}}
+	result, err := r.transact(ctx, func(ctx context.Context) (interface{}, error) {
+		bookmarks, err := r.find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+		if err != nil {
+			return nil, err
+		}
+		events := []entity.Event{}
+		for i := range bookmarks {
+			bookmarks[i].Purge()
+			if err := r.delete(ctx, &bookmarks[i], actor, now); err != nil {
+				return nil, err
+			}
+			events = append(events, bookmarks[i].Events()...)
+		}
+		if err := r.appendOutbox(ctx, events, now); err != nil {
+			return nil, err
+		}
+		return bookmarks, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]entity.Bookmark), nil
+}
+
+// フィルタに該当するドキュメント一覧を検索する。
+//
+// 該当するドキュメントが存在しない場合は空のスライスを返却する。
+//
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+func (r *bookmarkRepository) find(ctx context.Context, filter bson.D, opts ...*options.FindOptions) ([]entity.Bookmark, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []BookmarkDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	bookmarks := make([]entity.Bookmark, len(documents))
+	for i, document := range documents {
+		bookmark, err := document.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed at document.toEntity: %w", err)
+		}
+		bookmarks[i] = *bookmark
+	}
+	return bookmarks, nil
+}
+
+// タグごとにブックマーク数を集計する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// タグの辞書順に返却する。
+// タグが存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの集計に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.aggregate([
+//	  {$match: {userID: "UserID", deletedAt: null}},
+//	  {$unwind: "$tags"},
+//	  {$group: {_id: "$tags", count: {$sum: 1}}},
+//	  {$sort: {_id: 1}}
+//	])
+func (r *bookmarkRepository) CountTags(userID *entity.UserID) ([]repository.TagCount, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{ownerCondition(userID), activeCondition}}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$tags"}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Aggregate: %w", err)
+	}
+	var documents []TagCountDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	tagCounts := make([]repository.TagCount, len(documents))
+	for i, document := range documents {
+		tag, _ := entity.NewTag(document.Tag)
+		tagCounts[i] = repository.TagCount{Tag: *tag, Count: document.Count}
+	}
+	return tagCounts, nil
+}
+
+// 統合元のタグを統合先のタグに置き換える。
+//
+// 所有するブックマークのうち、統合元のタグが付与されたゴミ箱にない全てのブックマークを対象とする。
+// 置き換えたブックマーク一覧をIDの昇順に返却する。
+// 置き換えたブックマークの版数と更新日時を更新し、タグの付与と取り外しを記録する。
+//
+// nilを指定した場合はエラーを返却する。
+// セッションの開始に失敗した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+// 検索後に他の書き込みがあった場合は ErrConflict を返却する。
+// 改訂または監査ログの記録に失敗した場合はエラーを返却する。
+// 送信箱への記録に失敗した場合はエラーを返却する。
+//
+// 1つのトランザクションで、配列フィルタを用いて統合元のタグを統合先のタグに一括で書き換えてから重複したタグを取り除く。
+// タグの並び順は最初に出現した位置を維持する。
+// 置き換えたブックマークごとに改訂と監査ログに記録し、ドメインイベントを送信箱に記録する。
+//
+//	session.startTransaction()
+//	db.bookmarks.find({tags: {$in: ["Source1", "Source2"]}, userID: "UserID", deletedAt: null}).sort({_id: 1})
+//	db.bookmarks.updateMany(
+//	  {tags: {$in: ["Source1", "Source2"]}, userID: "UserID", deletedAt: null},
+//	  {$set: {"tags.$[tag]": "Target", updatedAt: ISODate("Now")}, $inc: {version: 1}},
+//	  {arrayFilters: [{tag: {$in: ["Source1", "Source2"]}}]}
+//	)
+//	db.bookmarks.updateMany(
+//	  {_id: {$in: ["ID1", "ID2"]}, userID: "UserID"},
+//	  [{$set: {tags: {$reduce: {
+//	    input: "$tags", initialValue: [],
+//	    in: {$cond: [{$in: ["$$this", "$$value"]}, "$$value", {$concatArrays: ["$$value", ["$$this"]]}]}
+//	  }}}}]
+//	)
+//	db.revisions.insertOne({_id: "RevisionID1", bookmarkID: "ID1", version: 2, ...})
+//	db.audits.insertOne({_id: "AuditEntryID1", operation: "update", bookmarkID: "ID1", ...})
+//	db.outbox.insertMany([{...}, {...}])
+//	session.commitTransaction()
+func (r *bookmarkRepository) MergeTags(userID *entity.UserID, sources []entity.Tag, target *entity.Tag, actor string) ([]entity.Bookmark, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if sources == nil {
+		return nil, fmt.Errorf("argument \"sources\" is nil")
+	}
+	if target == nil {
+		return nil, fmt.Errorf("argument \"target\" is nil")
+	}
+	ctx := context.Background()
+	values := bson.A{}
+	for _, source := range sources {
+		if source != *target {
+			values = append(values, source.Value())
+		}
+	}
+	if len(values) == 0 {
+		return []entity.Bookmark{}, nil
+	}
+	now := r.clock.Now()
+	filter := bson.D{{Key: "tags", Value: bson.D{{Key: "$in", Value: values}}}, ownerCondition(userID), activeCondition}
+	result, err := r.transact(ctx, func(ctx context.Context) (interface{}, error) {
+		bookmarks, err := r.find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+		if err != nil || len(bookmarks) == 0 {
+			return bookmarks, err
+		}
+		update := bson.M{"$set": bson.M{"tags.$[tag]": target.Value(), "updatedAt": now}, "$inc": bson.M{"version": 1}}
+		opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"tag": bson.M{"$in": values}}}})
+		result, err := r.collection.UpdateMany(ctx, filter, update, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed at collection.UpdateMany: %w", err)
+		}
+		if int(result.ModifiedCount) != len(bookmarks) {
+			return nil, repository.ErrConflict
+		}
+		ids := make(bson.A, len(bookmarks))
+		for i := range bookmarks {
+			id := bookmarks[i].ID()
+			ids[i] = id.Value()
+		}
+		if _, err := r.collection.UpdateMany(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}, ownerCondition(userID)}, dedupeTags); err != nil {
+			return nil, fmt.Errorf("failed at collection.UpdateMany: %w", err)
+		}
+		events := []entity.Event{}
+		for i := range bookmarks {
+			before := bookmarks[i].Snapshot()
+			bookmarks[i].MergeTags(sources, target)
+			after := bookmarks[i].Snapshot()
+			if err := r.record(ctx, &bookmarks[i], bookmarks[i].Version()+1, actor, entity.AuditOperationUpdate, &before, &after, now); err != nil {
+				return nil, err
+			}
+			events = append(events, bookmarks[i].Events()...)
+		}
+		if err := r.appendOutbox(ctx, events, now); err != nil {
+			return nil, err
+		}
+		return bookmarks, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	bookmarks := result.([]entity.Bookmark)
+	for i := range bookmarks {
+		bookmarks[i].SetVersion(bookmarks[i].Version() + 1)
+		bookmarks[i].SetTimestamps(bookmarks[i].CreatedAt(), now)
+	}
+	return bookmarks, nil
+}
+
+// 重複したタグを取り除く更新のパイプライン。
+//
+// タグの並び順は最初に出現した位置を維持する。
+var dedupeTags = mongo.Pipeline{{{Key: "$set", Value: bson.D{
+	{Key: "tags", Value: bson.D{{Key: "$reduce", Value: bson.D{
+		{Key: "input", Value: "$tags"},
+		{Key: "initialValue", Value: bson.A{}},
+		{Key: "in", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$in", Value: bson.A{"$$this", "$$value"}}},
+			"$$value",
+			bson.D{{Key: "$concatArrays", Value: bson.A{"$$value", bson.A{"$$this"}}}},
+		}}}},
+	}}}},
+}}}}
+
+// 正規形が一致するURIのブックマークを重複として集計する。
+//
+// ゴミ箱にあるブックマークは除外する。
+// 正規形のURIの辞書順に返却する。
+// 重複ごとのブックマークはIDの昇順に並べる。
+// 重複が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの集計に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.aggregate([
+//	  {$match: {userID: "UserID", deletedAt: null}},
+//	  {$sort: {_id: 1}},
+//	  {$group: {_id: "$canonicalURI", bookmarks: {$push: "$$ROOT"}, count: {$sum: 1}}},
+//	  {$match: {count: {$gt: 1}}},
+//	  {$sort: {_id: 1}}
+//	])
+func (r *bookmarkRepository) FindDuplicates(userID *entity.UserID) ([]repository.Duplicate, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{ownerCondition(userID), activeCondition}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$canonicalURI"},
+			{Key: "bookmarks", Value: bson.D{{Key: "$push", Value: "$$ROOT"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Aggregate: %w", err)
+	}
+	var documents []DuplicateDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	duplicates := make([]repository.Duplicate, len(documents))
+	for i, document := range documents {
+		bookmarks := make([]entity.Bookmark, len(document.Bookmarks))
+		for j, bookmarkDocument := range document.Bookmarks {
+			bookmark, err := bookmarkDocument.toEntity()
+			if err != nil {
+				return nil, fmt.Errorf("failed at document.toEntity: %w", err)
+			}
+			bookmarks[j] = *bookmark
+		}
+		duplicates[i] = repository.Duplicate{CanonicalURI: document.CanonicalURI, Bookmarks: bookmarks}
+	}
+	return duplicates, nil
+}
+
+// ブックマークを統合する。
+//
+// 統合先のブックマークを保存し、統合元のブックマークを削除する。
+// 保存と削除は全て成功するか全て失敗する。
+// 保存に成功した場合は統合先のブックマークの版数と更新日時を更新する。
+//
+// nilを指定した場合はエラーを返却する。
+// 保存されている版数といずれかのブックマークの版数が異なる場合は ErrConflict を返却する。
+// 保存されている所有者といずれかのブックマークの所有者が異なる場合は ErrConflict を返却する。
+// セッションの開始に失敗した場合はエラーを返却する。
+// ドキュメントの保存または削除に失敗した場合はエラーを返却する。
+// 改訂または監査ログの記録に失敗した場合はエラーを返却する。
+//
+// 1つのトランザクションで保存と削除、統合先のブックマークの改訂の記録、
+// 統合先の更新と統合元の完全な削除の監査ログへの記録、発行前のドメインイベントの送信箱への記録を行う。
+//
+//	session.startTransaction()
+//	db.bookmarks.findOne({_id: "TargetID", userID: "UserID", version: 1})
+//	db.bookmarks.updateOne({_id: "TargetID", userID: "UserID", version: 1}, {$set: {...}})
+//	db.revisions.insertOne({_id: "RevisionID", bookmarkID: "TargetID", version: 2, ...})
+//	db.audits.insertOne({_id: "AuditEntryID", operation: "update", bookmarkID: "TargetID", ...})
+//	db.bookmarks.updateOne({_id: "SourceID1", userID: "UserID", version: 1}, {$set: {tombstone: {userID: "UserID"}}})
+//	db.bookmarks.deleteOne({_id: "SourceID1", userID: "UserID", version: 1})
+//	db.audits.insertOne({_id: "AuditEntryID1", operation: "purge", bookmarkID: "SourceID1", ...})
+//	db.bookmarks.updateOne({_id: "SourceID2", userID: "UserID", version: 1}, {$set: {tombstone: {userID: "UserID"}}})
+//	db.bookmarks.deleteOne({_id: "SourceID2", userID: "UserID", version: 1})
+//	db.audits.insertOne({_id: "AuditEntryID2", operation: "purge", bookmarkID: "SourceID2", ...})
+//	db.outbox.insertMany([{...}, {...}])
+//	session.commitTransaction()
+func (r *bookmarkRepository) MergeBookmarks(target *entity.Bookmark, sources []entity.Bookmark, actor string) error {
+	if target == nil {
+		return fmt.Errorf("argument \"target\" is nil")
+	}
+	if sources == nil {
+		return fmt.Errorf("argument \"sources\" is nil")
+	}
+	ctx := context.Background()
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("failed at client.StartSession: %w", err)
+	}
+	defer session.EndSession(ctx)
+	now := r.clock.Now()
+	result, err := session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		createdAt, err := r.save(sc, target, actor, now)
+		if err != nil {
+			return nil, err
+		}
+		events := target.Events()
+		for i := range sources {
+			if err := r.delete(sc, &sources[i], actor, now); err != nil {
+				return nil, err
+			}
+			events = append(events, sources[i].Events()...)
+		}
+		if err := r.appendOutbox(sc, events, now); err != nil {
+			return nil, err
+		}
+		return createdAt, nil
+	})
+	if err != nil {
+		return err
+	}
+	target.SetVersion(target.Version() + 1)
+	target.SetTimestamps(result.(time.Time), now)
 	return nil
 }
+
+// フォルダに所属するブックマークが存在するか確認する。
+//
+// 指定したユーザIDが所有するブックマークに限定する。
+// ゴミ箱から復元すると元のフォルダに戻るため、ゴミ箱にあるブックマークも対象とする。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの計数に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.countDocuments({userID: "UserID", folderID: "FolderID"}, {limit: 1})
+func (r *bookmarkRepository) ExistsInFolder(userID *entity.UserID, folder *entity.ID) (bool, error) {
+	if userID == nil {
+		return false, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if folder == nil {
+		return false, fmt.Errorf("argument \"folder\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{ownerCondition(userID), {Key: "folderID", Value: folder.Value()}}
+	opts := options.Count().SetLimit(1)
+	count, err := r.collection.CountDocuments(ctx, filter, opts)
+	if err != nil {
+		return false, fmt.Errorf("failed at collection.CountDocuments: %w", err)
+	}
+	return count > 0, nil
+}
@@ -2,16 +2,24 @@ package mongodb
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 	"github.com/kkntzw/bookmark/internal/domain/repository"
 	"github.com/kkntzw/bookmark/test/helper"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 )
 
+var (
+	now     = time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC) // 時計が返却する現在時刻
+	earlier = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) // 現在時刻より前の時刻
+)
+
 func TestNewBookmarkRepository(t *testing.T) {
 	t.Parallel()
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
@@ -21,7 +29,7 @@ func TestNewBookmarkRepository(t *testing.T) {
 		// given
 		collection := mt.Coll
 		// when
-		object := NewBookmarkRepository(collection)
+		object := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
 		// then
 		assert.NotNil(mt, object)
 		interfaceObject := (*repository.Bookmark)(nil)
@@ -31,14 +39,30 @@ func TestNewBookmarkRepository(t *testing.T) {
 		mt.Parallel()
 		// given
 		collection := mt.Coll
-		abstractRepository := NewBookmarkRepository(collection)
+		abstractRepository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
 		// when
 		concreteRepository, ok := abstractRepository.(*bookmarkRepository)
 		actualCollection := concreteRepository.collection
+		actualOutbox := concreteRepository.outbox
 		// then
 		assert.True(mt, ok)
 		expectedCollection := collection
 		assert.Exactly(mt, expectedCollection, actualCollection)
+		assert.Nil(mt, actualOutbox)
+	})
+	mt.Run("fields with outbox", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		outbox := mt.DB.Collection("outbox")
+		abstractRepository := NewBookmarkRepository(collection, outbox, nil, nil, helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*bookmarkRepository)
+		actualOutbox := concreteRepository.outbox
+		// then
+		assert.True(mt, ok)
+		expectedOutbox := outbox
+		assert.Exactly(mt, expectedOutbox, actualOutbox)
 	})
 }
 
@@ -48,7 +72,7 @@ func TestBookmark_NextID(t *testing.T) {
 	defer mt.Close()
 	// given
 	collection := mt.Coll
-	repository := NewBookmarkRepository(collection)
+	repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
 	// when
 	id := repository.NextID()
 	// then
@@ -62,28 +86,68 @@ func TestBookmark_Save(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
 	cases := map[string]struct {
-		prepare     func(*mtest.T)
-		bookmark    *entity.Bookmark
-		expectedErr error
+		prepare          func(*mtest.T)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
 	}{
-		"non-nil bookmark": {
+		"unstored bookmark": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(mtest.CreateSuccessResponse())
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "n", Value: 1},
+					bson.E{Key: "nModified", Value: 0},
+					bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: "1"}}}},
+				))
 			},
 			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"stored bookmark with same version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 2, earlier, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
 			nil,
 		},
+		"stored bookmark with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			},
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"duplicate bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"duplicate uri": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error index: userID_1_canonicalURI_1"}))
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrDuplicate,
+		},
 		"nil bookmark": {
 			func(mt *mtest.T) {},
 			nil,
+			nil,
 			errors.New("argument \"bookmark\" is nil"),
 		},
-		"failed at collection.UpdateByID": {
+		"failed at collection.UpdateOne": {
 			func(mt *mtest.T) {
 				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
 			},
 			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
-			errors.New("failed at collection.UpdateByID: command failed"),
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			errors.New("failed at collection.UpdateOne: command failed"),
 		},
 	}
 	for name, tc := range cases {
@@ -93,10 +157,11 @@ func TestBookmark_Save(t *testing.T) {
 			tc.prepare(mt)
 			// given
 			collection := mt.Coll
-			repository := NewBookmarkRepository(collection)
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
 			// when
-			actualErr := repository.Save(tc.bookmark)
+			actualErr := repository.Save(tc.bookmark, "Actor")
 			// then
+			assert.Exactly(mt, tc.expectedBookmark, tc.bookmark)
 			if tc.expectedErr == nil {
 				assert.NoError(mt, actualErr)
 			} else {
@@ -106,57 +171,66 @@ func TestBookmark_Save(t *testing.T) {
 	}
 }
 
-func TestBookmark_FindAll(t *testing.T) {
+func TestBookmark_SaveWithOutbox(t *testing.T) {
 	t.Parallel()
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
+	upserted := mtest.CreateSuccessResponse(
+		bson.E{Key: "n", Value: 1},
+		bson.E{Key: "nModified", Value: 0},
+		bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: "1"}}}},
+	)
+	registered := func() *entity.Bookmark {
+		return helper.ToRegisteredBookmark(t, "1", "Example", "https://example.com", "foo")
+	}
+	saved := func() *entity.Bookmark {
+		bookmark := registered()
+		bookmark.SetVersion(1)
+		bookmark.SetTimestamps(now, now)
+		return bookmark
+	}
 	cases := map[string]struct {
-		prepare           func(*mtest.T)
-		expectedBookmarks []entity.Bookmark
-		expectedErr       error
+		prepare          func(*mtest.T)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedCommands []string
+		expectedErr      error
 	}{
-		"stored bookmarks": {
+		"bookmark with events": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(
-					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example A", "https://foo.example.com")),
-				)
-				mt.AddMockResponses(
-					mtest.CreateCursorResponse(1, "foo.bar", mtest.NextBatch, helper.ToBookmarkDocument(t, "2", "Example B", "https://bar.example.com")),
-				)
-				mt.AddMockResponses(
-					mtest.CreateCursorResponse(1, "foo.bar", mtest.NextBatch, helper.ToBookmarkDocument(t, "3", "Example C", "https://baz.example.com")),
-				)
-				mt.AddMockResponses(
-					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
-				)
-			},
-			[]entity.Bookmark{
-				*helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"),
-				*helper.ToBookmark(t, "2", "Example B", "https://bar.example.com"),
-				*helper.ToBookmark(t, "3", "Example C", "https://baz.example.com"),
+				mt.AddMockResponses(upserted, mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}), mtest.CreateSuccessResponse())
 			},
+			registered(),
+			saved(),
+			[]string{"update", "insert", "commitTransaction"},
 			nil,
 		},
-		"unstored bookmarks": {
+		"bookmark without events": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+				mt.AddMockResponses(upserted)
 			},
-			[]entity.Bookmark{},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"),
+			helper.ToTimestampedBookmark(t, 1, now, now, "1", "Example", "https://example.com", "foo"),
+			[]string{"update"},
 			nil,
 		},
-		"failed at collection.Find": {
+		"stored bookmark with different version": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}), mtest.CreateSuccessResponse())
 			},
-			nil,
-			errors.New("failed at collection.Find: command failed"),
+			registered(),
+			registered(),
+			[]string{"update", "abortTransaction"},
+			repository.ErrConflict,
 		},
-		"failed at cursor.All": {
+		"failed at outbox.InsertMany": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{}))
+				mt.AddMockResponses(upserted, bson.D{{Key: "ok", Value: 0}}, mtest.CreateSuccessResponse())
 			},
-			nil,
-			errors.New("failed at cursor.All: no responses remaining"),
+			registered(),
+			registered(),
+			[]string{"update", "insert", "abortTransaction"},
+			errors.New("failed at outbox.InsertMany: command failed"),
 		},
 	}
 	for name, tc := range cases {
@@ -166,62 +240,146 @@ func TestBookmark_FindAll(t *testing.T) {
 			tc.prepare(mt)
 			// given
 			collection := mt.Coll
-			repository := NewBookmarkRepository(collection)
+			outbox := mt.DB.Collection("outbox")
+			repository := NewBookmarkRepository(collection, outbox, nil, nil, helper.ToFixedClock(t, now))
 			// when
-			actualBookmarks, actualErr := repository.FindAll()
+			actualErr := repository.Save(tc.bookmark, "Actor")
 			// then
-			assert.ElementsMatch(mt, tc.expectedBookmarks, actualBookmarks)
+			assert.Exactly(mt, tc.expectedBookmark, tc.bookmark)
 			if tc.expectedErr == nil {
 				assert.NoError(mt, actualErr)
 			} else {
 				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
 			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
 		})
 	}
 }
 
-func TestBookmark_FindByID(t *testing.T) {
+func TestBookmark_TrashWithOutbox(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("bookmark with events", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+		// given
+		bookmark := helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example", "https://example.com")
+		bookmark.Delete()
+		repository := NewBookmarkRepository(mt.Coll, mt.DB.Collection("outbox"), nil, nil, helper.ToFixedClock(t, now))
+		// when
+		err := repository.Trash(bookmark, "Actor")
+		// then
+		assert.NoError(mt, err)
+		assert.Exactly(mt, uint64(2), bookmark.Version())
+		assert.Exactly(mt, now, bookmark.DeletedAt())
+		actualCommands := []string{}
+		documents := bson.A{}
+		for _, event := range mt.GetAllStartedEvents() {
+			actualCommands = append(actualCommands, event.CommandName)
+			if event.CommandName == "insert" {
+				assert.NoError(mt, event.Command.Lookup("documents").Unmarshal(&documents))
+			}
+		}
+		expectedCommands := []string{"update", "insert", "commitTransaction"}
+		assert.Exactly(mt, expectedCommands, actualCommands)
+		if assert.Len(mt, documents, 1) {
+			document := documents[0].(bson.D).Map()
+			assert.Exactly(mt, "BookmarkDeleted", document["eventName"])
+			assert.Exactly(mt, "1", document["bookmarkID"])
+			assert.Nil(mt, document["dispatchedAt"])
+		}
+	})
+}
+
+func TestBookmark_SaveWithRevisions(t *testing.T) {
 	t.Parallel()
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
+	stored := mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, append(
+		helper.ToBookmarkDocument(t, "1", "Example", "https://example.com", "foo"),
+		bson.E{Key: "version", Value: 1},
+	))
+	updated := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+	renamed := func() *entity.Bookmark {
+		bookmark := helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo")
+		bookmark.Rename(helper.ToName(t, "Example Domain"))
+		bookmark.PullEvents()
+		return bookmark
+	}
 	cases := map[string]struct {
 		prepare          func(*mtest.T)
-		id               *entity.ID
-		expectedBookmark *entity.Bookmark
+		bookmark         *entity.Bookmark
+		expectedCommands []string
+		expectedBefore   string
+		expectedAfter    string
 		expectedErr      error
 	}{
-		"id of stored bookmark": {
+		"changed bookmark": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(
-					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example", "https://example.com")),
-				)
+				mt.AddMockResponses(stored, updated, mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}), mtest.CreateSuccessResponse())
 			},
-			helper.ToID(t, "1"),
-			helper.ToBookmark(t, "1", "Example", "https://example.com"),
+			renamed(),
+			[]string{"find", "update", "insert", "commitTransaction"},
+			"Example",
+			"Example Domain",
 			nil,
 		},
-		"id of unstored bookmark": {
+		"unchanged bookmark": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{}))
+				mt.AddMockResponses(stored, updated, mtest.CreateSuccessResponse())
 			},
-			helper.ToID(t, "1"),
-			nil,
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo"),
+			[]string{"find", "update", "commitTransaction"},
+			"",
+			"",
 			nil,
 		},
-		"nil id": {
-			func(mt *mtest.T) {},
-			nil,
+		"unstored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					mtest.CreateSuccessResponse(
+						bson.E{Key: "n", Value: 1},
+						bson.E{Key: "nModified", Value: 0},
+						bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: "1"}}}},
+					),
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"),
+			[]string{"find", "update", "commitTransaction"},
+			"",
+			"",
 			nil,
-			errors.New("argument \"id\" is nil"),
 		},
 		"failed at collection.FindOne": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}}, mtest.CreateSuccessResponse())
 			},
-			helper.ToID(t, "1"),
-			nil,
+			renamed(),
+			[]string{"find", "abortTransaction"},
+			"",
+			"",
 			errors.New("failed at collection.FindOne: command failed"),
 		},
+		"failed at revisions.InsertOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(stored, updated, bson.D{{Key: "ok", Value: 0}}, mtest.CreateSuccessResponse())
+			},
+			renamed(),
+			[]string{"find", "update", "insert", "abortTransaction"},
+			"Example",
+			"Example Domain",
+			errors.New("failed at revisions.InsertOne: command failed"),
+		},
 	}
 	for name, tc := range cases {
 		tc := tc
@@ -230,54 +388,262 @@ func TestBookmark_FindByID(t *testing.T) {
 			tc.prepare(mt)
 			// given
 			collection := mt.Coll
-			repository := NewBookmarkRepository(collection)
+			revisions := mt.DB.Collection("revisions")
+			repository := NewBookmarkRepository(collection, nil, revisions, nil, helper.ToFixedClock(t, now))
 			// when
-			actualBookmark, actualErr := repository.FindByID(tc.id)
+			actualErr := repository.Save(tc.bookmark, "Actor")
 			// then
-			assert.Exactly(mt, tc.expectedBookmark, actualBookmark)
 			if tc.expectedErr == nil {
 				assert.NoError(mt, actualErr)
 			} else {
 				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
 			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+				if event.CommandName != "insert" {
+					continue
+				}
+				documents := bson.A{}
+				assert.NoError(mt, event.Command.Lookup("documents").Unmarshal(&documents))
+				if assert.Len(mt, documents, 1) {
+					document := documents[0].(bson.D).Map()
+					assert.Exactly(mt, "1", document["bookmarkID"])
+					assert.Exactly(mt, int64(2), document["version"])
+					assert.Exactly(mt, "Actor", document["actor"])
+					assert.Exactly(mt, tc.expectedBefore, document["before"].(bson.D).Map()["name"])
+					assert.Exactly(mt, tc.expectedAfter, document["after"].(bson.D).Map()["name"])
+				}
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
 		})
 	}
 }
 
-func TestBookmark_Delete(t *testing.T) {
+func TestBookmark_TrashWithRevisions(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("trashed bookmark", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+		// given
+		bookmark := helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example", "https://example.com")
+		repository := NewBookmarkRepository(mt.Coll, nil, mt.DB.Collection("revisions"), nil, helper.ToFixedClock(t, now))
+		// when
+		err := repository.Trash(bookmark, "Actor")
+		// then
+		assert.NoError(mt, err)
+		actualCommands := []string{}
+		documents := bson.A{}
+		for _, event := range mt.GetAllStartedEvents() {
+			actualCommands = append(actualCommands, event.CommandName)
+			if event.CommandName == "insert" {
+				assert.NoError(mt, event.Command.Lookup("documents").Unmarshal(&documents))
+			}
+		}
+		expectedCommands := []string{"update", "insert", "commitTransaction"}
+		assert.Exactly(mt, expectedCommands, actualCommands)
+		if assert.Len(mt, documents, 1) {
+			document := documents[0].(bson.D).Map()
+			assert.Exactly(mt, int64(2), document["version"])
+			assert.Exactly(mt, false, document["before"].(bson.D).Map()["trashed"])
+			assert.Exactly(mt, true, document["after"].(bson.D).Map()["trashed"])
+		}
+	})
+}
+
+func TestBookmark_MergeTagsWithRevisions(t *testing.T) {
 	t.Parallel()
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
+	mt.Run("merged bookmarks", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+				append(helper.ToBookmarkDocument(t, "1", "Example A", "https://foo.example.com", "golang"), bson.E{Key: "version", Value: 1}),
+				append(helper.ToBookmarkDocument(t, "2", "Example B", "https://bar.example.com", "go-lang"), bson.E{Key: "version", Value: 1}),
+			),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 2}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+		// given
+		repository := NewBookmarkRepository(mt.Coll, nil, mt.DB.Collection("revisions"), nil, helper.ToFixedClock(t, now))
+		// when
+		_, err := repository.MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang", "go-lang"), &helper.ToTags(t, "go")[0], "Actor")
+		// then
+		assert.NoError(mt, err)
+		actualCommands := []string{}
+		bookmarkIDs := []interface{}{}
+		for _, event := range mt.GetAllStartedEvents() {
+			actualCommands = append(actualCommands, event.CommandName)
+			if event.CommandName == "insert" {
+				documents := bson.A{}
+				assert.NoError(mt, event.Command.Lookup("documents").Unmarshal(&documents))
+				for _, document := range documents {
+					bookmarkIDs = append(bookmarkIDs, document.(bson.D).Map()["bookmarkID"])
+				}
+			}
+		}
+		expectedCommands := []string{"find", "update", "update", "insert", "insert", "commitTransaction"}
+		assert.Exactly(mt, expectedCommands, actualCommands)
+		assert.Exactly(mt, []interface{}{"1", "2"}, bookmarkIDs)
+	})
+}
+
+func TestBookmark_WriteWithAudits(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	stored := mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, append(
+		helper.ToBookmarkDocument(t, "1", "Example", "https://example.com", "foo"),
+		bson.E{Key: "version", Value: 1},
+	))
+	modified := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+	inserted := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1})
+	committed := mtest.CreateSuccessResponse()
+	renamed := func() *entity.Bookmark {
+		bookmark := helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo")
+		bookmark.Rename(helper.ToName(t, "Example Domain"))
+		bookmark.PullEvents()
+		return bookmark
+	}
+	trashed := func() *entity.Bookmark {
+		bookmark := helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example", "https://example.com")
+		bookmark.SetDeletedAt(earlier)
+		return bookmark
+	}
 	cases := map[string]struct {
-		prepare     func(*mtest.T)
-		bookmark    *entity.Bookmark
-		expectedErr error
+		prepare            func(*mtest.T)
+		write              func(repository.Bookmark) error
+		expectedCommands   []string
+		expectedOperations []interface{}
+		expectedErr        error
 	}{
-		"stored bookmark": {
+		"registered bookmark": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "acknowledged", Value: true}, bson.E{Key: "n", Value: 1}))
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					mtest.CreateSuccessResponse(
+						bson.E{Key: "n", Value: 1},
+						bson.E{Key: "nModified", Value: 0},
+						bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: "1"}}}},
+					),
+					inserted,
+					committed,
+				)
 			},
-			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			func(r repository.Bookmark) error {
+				return r.Save(helper.ToBookmark(t, "1", "Example", "https://example.com", "foo"), "Actor")
+			},
+			[]string{"find", "update", "insert", "commitTransaction"},
+			[]interface{}{"register"},
 			nil,
 		},
-		"unstored bookmark": {
+		"updated bookmark": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "acknowledged", Value: true}, bson.E{Key: "n", Value: 0}))
+				mt.AddMockResponses(stored, modified, inserted, committed)
 			},
-			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			func(r repository.Bookmark) error {
+				return r.Save(renamed(), "Actor")
+			},
+			[]string{"find", "update", "insert", "commitTransaction"},
+			[]interface{}{"update"},
 			nil,
 		},
-		"nil bookmark": {
-			func(mt *mtest.T) {},
+		"unchanged bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(stored, modified, committed)
+			},
+			func(r repository.Bookmark) error {
+				return r.Save(helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo"), "Actor")
+			},
+			[]string{"find", "update", "commitTransaction"},
+			[]interface{}{},
 			nil,
-			errors.New("argument \"bookmark\" is nil"),
 		},
-		"failed at collection.DeleteOne": {
+		"trashed bookmark": {
 			func(mt *mtest.T) {
-				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+				mt.AddMockResponses(modified, inserted, committed)
 			},
-			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
-			errors.New("failed at collection.DeleteOne: command failed"),
+			func(r repository.Bookmark) error {
+				return r.Trash(helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example", "https://example.com"), "Actor")
+			},
+			[]string{"update", "insert", "commitTransaction"},
+			[]interface{}{"delete"},
+			nil,
+		},
+		"restored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(modified, inserted, committed)
+			},
+			func(r repository.Bookmark) error {
+				return r.Restore(trashed(), "Actor")
+			},
+			[]string{"update", "insert", "commitTransaction"},
+			[]interface{}{"restore"},
+			nil,
+		},
+		"deleted bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(modified, inserted, inserted, committed)
+			},
+			func(r repository.Bookmark) error {
+				return r.Delete(helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com"), "Actor")
+			},
+			[]string{"update", "delete", "insert", "commitTransaction"},
+			[]interface{}{"purge"},
+			nil,
+		},
+		"purged bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, append(
+						helper.ToBookmarkDocument(t, "1", "Example", "https://example.com"),
+						bson.E{Key: "version", Value: 1},
+						bson.E{Key: "deletedAt", Value: earlier},
+					)),
+					modified,
+					inserted,
+					inserted,
+					committed,
+				)
+			},
+			func(r repository.Bookmark) error {
+				_, err := r.PurgeTrash(helper.ToUserID(t, helper.UserID), now, "Actor")
+				return err
+			},
+			[]string{"find", "update", "delete", "insert", "commitTransaction"},
+			[]interface{}{"purge"},
+			nil,
+		},
+		"merged bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(stored, modified, inserted, modified, inserted, inserted, committed)
+			},
+			func(r repository.Bookmark) error {
+				sources := []entity.Bookmark{*helper.ToVersionedBookmark(t, 1, "2", "Example B", "https://example.com/")}
+				return r.MergeBookmarks(renamed(), sources, "Actor")
+			},
+			[]string{"find", "update", "insert", "update", "delete", "insert", "commitTransaction"},
+			[]interface{}{"update", "purge"},
+			nil,
+		},
+		"failed at audits.InsertOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(stored, modified, bson.D{{Key: "ok", Value: 0}}, committed)
+			},
+			func(r repository.Bookmark) error {
+				return r.Save(renamed(), "Actor")
+			},
+			[]string{"find", "update", "insert", "abortTransaction"},
+			[]interface{}{"update"},
+			errors.New("failed at audits.InsertOne: command failed"),
 		},
 	}
 	for name, tc := range cases {
@@ -286,16 +652,1475 @@ func TestBookmark_Delete(t *testing.T) {
 			mt.Parallel()
 			tc.prepare(mt)
 			// given
-			collection := mt.Coll
-			repository := NewBookmarkRepository(collection)
+			audits := mt.DB.Collection("audits")
+			repository := NewBookmarkRepository(mt.Coll, nil, nil, audits, helper.ToFixedClock(t, now))
 			// when
-			actualErr := repository.Delete(tc.bookmark)
+			actualErr := tc.write(repository)
 			// then
 			if tc.expectedErr == nil {
 				assert.NoError(mt, actualErr)
 			} else {
 				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
 			}
+			actualCommands := []string{}
+			actualOperations := []interface{}{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+				if event.CommandName != "insert" {
+					continue
+				}
+				documents := bson.A{}
+				assert.NoError(mt, event.Command.Lookup("documents").Unmarshal(&documents))
+				for _, document := range documents {
+					values := document.(bson.D).Map()
+					assert.Exactly(mt, "Actor", values["actor"])
+					assert.Exactly(mt, helper.UserID, values["userID"])
+					actualOperations = append(actualOperations, values["operation"])
+				}
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
+			assert.Exactly(mt, tc.expectedOperations, actualOperations)
 		})
 	}
 }
+
+func TestBookmark_FindAll(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"stored bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example A", "https://foo.example.com")),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.NextBatch, helper.ToBookmarkDocument(t, "2", "Example B", "https://bar.example.com")),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.NextBatch, helper.ToBookmarkDocument(t, "3", "Example C", "https://baz.example.com")),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+				)
+			},
+			[]entity.Bookmark{
+				*helper.ToBookmark(t, "1", "Example A", "https://foo.example.com"),
+				*helper.ToBookmark(t, "2", "Example B", "https://bar.example.com"),
+				*helper.ToBookmark(t, "3", "Example C", "https://baz.example.com"),
+			},
+			nil,
+		},
+		"unstored bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			[]entity.Bookmark{},
+			nil,
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"invalid document": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+					helper.ToBookmarkDocument(t, "1", "Example A", "https://foo.example.com"),
+					helper.ToBookmarkDocument(t, "2", "", "https://bar.example.com"),
+				))
+			},
+			nil,
+			fmt.Errorf("failed at document.toEntity: %w", helper.ToErrName(t, "")),
+		},
+		"failed at cursor.All": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{}))
+			},
+			nil,
+			errors.New("failed at cursor.All: no responses remaining"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmarks, actualErr := repository.FindAll(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.ElementsMatch(mt, tc.expectedBookmarks, actualBookmarks)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_FindBySpec(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		spec              *repository.BookmarkSpec
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"stored bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example A", "https://foo.example.com", "foo")),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.NextBatch, helper.ToBookmarkDocument(t, "2", "Example B", "https://bar.example.com", "foo", "bar")),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+				)
+			},
+			&repository.BookmarkSpec{Tags: helper.ToTags(t, "foo"), Limit: 2},
+			[]entity.Bookmark{
+				*helper.ToBookmark(t, "1", "Example A", "https://foo.example.com", "foo"),
+				*helper.ToBookmark(t, "2", "Example B", "https://bar.example.com", "foo", "bar"),
+			},
+			nil,
+		},
+		"unstored bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			&repository.BookmarkSpec{},
+			[]entity.Bookmark{},
+			nil,
+		},
+		"nil spec": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"spec\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			&repository.BookmarkSpec{},
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"failed at cursor.All": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{}))
+			},
+			&repository.BookmarkSpec{},
+			nil,
+			errors.New("failed at cursor.All: no responses remaining"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmarks, actualErr := repository.FindBySpec(helper.ToUserID(t, helper.UserID), tc.spec)
+			// then
+			assert.Exactly(mt, tc.expectedBookmarks, actualBookmarks)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestToFilter(t *testing.T) {
+	t.Parallel()
+	unread, archived := entity.StatusUnread, entity.StatusArchived
+	cases := map[string]struct {
+		spec           *repository.BookmarkSpec
+		expectedFilter bson.D
+	}{
+		"empty spec": {
+			&repository.BookmarkSpec{},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}},
+		},
+		"any tags": {
+			&repository.BookmarkSpec{Tags: helper.ToTags(t, "foo", "bar")},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "tags", Value: bson.D{{Key: "$in", Value: bson.A{"foo", "bar"}}}}},
+		},
+		"all tags": {
+			&repository.BookmarkSpec{Tags: helper.ToTags(t, "foo", "bar"), MatchAllTags: true},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "tags", Value: bson.D{{Key: "$all", Value: bson.A{"foo", "bar"}}}}},
+		},
+		"name and uri": {
+			&repository.BookmarkSpec{NameContains: "Example", URIContains: "example.com"},
+			bson.D{
+				{Key: "userID", Value: helper.UserID},
+				{Key: "deletedAt", Value: nil},
+				{Key: "name", Value: primitive.Regex{Pattern: "Example", Options: "i"}},
+				{Key: "uri", Value: primitive.Regex{Pattern: `example\.com`, Options: "i"}},
+			},
+		},
+		"folder": {
+			&repository.BookmarkSpec{Folder: helper.ToID(t, "10")},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "folderID", Value: "10"}},
+		},
+		"unread status": {
+			&repository.BookmarkSpec{Status: &unread},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{"unread", nil}}}}},
+		},
+		"archived status": {
+			&repository.BookmarkSpec{Status: &archived},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "status", Value: "archived"}},
+		},
+		"starred only": {
+			&repository.BookmarkSpec{StarredOnly: true},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "starred", Value: true}},
+		},
+		"cursor sorted by id": {
+			&repository.BookmarkSpec{After: &repository.BookmarkCursor{Value: "1", ID: *helper.ToID(t, "1")}},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: "1"}}}},
+		},
+		"cursor sorted by id descending": {
+			&repository.BookmarkSpec{Descending: true, After: &repository.BookmarkCursor{Value: "1", ID: *helper.ToID(t, "1")}},
+			bson.D{{Key: "userID", Value: helper.UserID}, {Key: "deletedAt", Value: nil}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: "1"}}}},
+		},
+		"cursor sorted by name descending": {
+			&repository.BookmarkSpec{SortKey: repository.SortByName, Descending: true, After: &repository.BookmarkCursor{Value: "Example", ID: *helper.ToID(t, "1")}},
+			bson.D{
+				{Key: "userID", Value: helper.UserID},
+				{Key: "deletedAt", Value: nil},
+				{Key: "$or", Value: bson.A{
+					bson.D{{Key: "name", Value: bson.D{{Key: "$lt", Value: "Example"}}}},
+					bson.D{{Key: "name", Value: "Example"}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: "1"}}}},
+				}},
+			},
+		},
+		"cursor sorted by created at": {
+			&repository.BookmarkSpec{SortKey: repository.SortByCreatedAt, After: &repository.BookmarkCursor{Time: now, ID: *helper.ToID(t, "1")}},
+			bson.D{
+				{Key: "userID", Value: helper.UserID},
+				{Key: "deletedAt", Value: nil},
+				{Key: "$or", Value: bson.A{
+					bson.D{{Key: "createdAt", Value: bson.D{{Key: "$gt", Value: now}}}},
+					bson.D{{Key: "createdAt", Value: now}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: "1"}}}},
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualFilter := toFilter(helper.ToUserID(t, helper.UserID), tc.spec)
+			// then
+			assert.Exactly(t, tc.expectedFilter, actualFilter)
+		})
+	}
+}
+
+func TestToSort(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		spec         *repository.BookmarkSpec
+		expectedSort bson.D
+	}{
+		"sort by id": {
+			&repository.BookmarkSpec{SortKey: repository.SortByID},
+			bson.D{{Key: "_id", Value: 1}},
+		},
+		"sort by name": {
+			&repository.BookmarkSpec{SortKey: repository.SortByName},
+			bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		"sort by uri descending": {
+			&repository.BookmarkSpec{SortKey: repository.SortByURI, Descending: true},
+			bson.D{{Key: "uri", Value: -1}, {Key: "_id", Value: 1}},
+		},
+		"sort by created at": {
+			&repository.BookmarkSpec{SortKey: repository.SortByCreatedAt},
+			bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		"sort by updated at descending": {
+			&repository.BookmarkSpec{SortKey: repository.SortByUpdatedAt, Descending: true},
+			bson.D{{Key: "updatedAt", Value: -1}, {Key: "_id", Value: 1}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualSort := toSort(tc.spec)
+			// then
+			assert.Exactly(t, tc.expectedSort, actualSort)
+		})
+	}
+}
+
+func TestBookmark_FindByID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		id               *entity.ID
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"id of stored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example", "https://example.com")),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToBookmark(t, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of stored bookmark with description": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, append(helper.ToBookmarkDocument(t, "1", "Example", "https://example.com"), bson.E{Key: "description", Value: "Example\nDomain"})),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToDescribedBookmark(t, "Example\nDomain", "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of stored bookmark in folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, append(helper.ToBookmarkDocument(t, "1", "Example", "https://example.com"), bson.E{Key: "folderID", Value: "10"})),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToFiledBookmark(t, "10", "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of stored bookmark with status": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, append(
+						helper.ToBookmarkDocument(t, "1", "Example", "https://example.com"),
+						bson.E{Key: "status", Value: "archived"},
+						bson.E{Key: "starred", Value: true},
+					)),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToMarkedBookmark(t, entity.StatusArchived, true, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of stored bookmark with version and timestamps": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, append(
+						helper.ToBookmarkDocument(t, "1", "Example", "https://example.com"),
+						bson.E{Key: "version", Value: int64(3)},
+						bson.E{Key: "createdAt", Value: primitive.NewDateTimeFromTime(earlier)},
+						bson.E{Key: "updatedAt", Value: primitive.NewDateTimeFromTime(now)},
+					)),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToTimestampedBookmark(t, 3, earlier, now, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of unstored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"invalid document": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example", "https://example.com", "")),
+				)
+			},
+			helper.ToID(t, "1"),
+			nil,
+			fmt.Errorf("failed at document.toEntity: %w", helper.ToErrTag(t, "")),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmark, actualErr := repository.FindByID(helper.ToUserID(t, helper.UserID), tc.id)
+			// then
+			assert.Exactly(mt, tc.expectedBookmark, actualBookmark)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_FindByCanonicalURI(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		uri              *entity.URI
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"uri of stored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToBookmarkDocument(t, "1", "Example", "https://example.com/?utm_source=foo")),
+				)
+			},
+			helper.ToURI(t, "https://example.com"),
+			helper.ToBookmark(t, "1", "Example", "https://example.com/?utm_source=foo"),
+			nil,
+		},
+		"uri of unstored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToURI(t, "https://example.com"),
+			nil,
+			nil,
+		},
+		"nil uri": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"uri\" is nil"),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToURI(t, "https://example.com"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmark, actualErr := repository.FindByCanonicalURI(helper.ToUserID(t, helper.UserID), tc.uri)
+			// then
+			assert.Exactly(mt, tc.expectedBookmark, actualBookmark)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_Delete(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		bookmark    *entity.Bookmark
+		expectedErr error
+	}{
+		"stored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+					mtest.CreateSuccessResponse(bson.E{Key: "acknowledged", Value: true}, bson.E{Key: "n", Value: 1}),
+				)
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"unstored bookmark or bookmark with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"nil bookmark": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"bookmark\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}), bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToBookmark(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.Delete(tc.bookmark, "Actor")
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_Trash(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"stored bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 2, earlier, now, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"unstored bookmark or bookmark with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			},
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"nil bookmark": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"bookmark\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToVersionedBookmark(t, 1, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.Trash(tc.bookmark, "Actor")
+			// then
+			assert.Exactly(mt, tc.expectedBookmark, tc.bookmark)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_Restore(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		bookmark         *entity.Bookmark
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"trashed bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTimestampedBookmark(t, 3, earlier, now, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"unstored bookmark or bookmark with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			},
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrConflict,
+		},
+		"duplicate uri": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error index: userID_1_canonicalURI_1"}))
+			},
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			repository.ErrDuplicate,
+		},
+		"nil bookmark": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"bookmark\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			helper.ToTrashedBookmark(t, 2, earlier, earlier, earlier, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.Restore(tc.bookmark, "Actor")
+			// then
+			assert.Exactly(mt, tc.expectedBookmark, tc.bookmark)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_FindTrash(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		expectedBookmarks []entity.Bookmark
+		expectedErr       error
+	}{
+		"trashed bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, append(
+						helper.ToBookmarkDocument(t, "2", "Example B", "https://bar.example.com"),
+						bson.E{Key: "deletedAt", Value: primitive.NewDateTimeFromTime(now)},
+					)),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, append(
+						helper.ToBookmarkDocument(t, "1", "Example A", "https://foo.example.com"),
+						bson.E{Key: "deletedAt", Value: primitive.NewDateTimeFromTime(earlier)},
+					)),
+				)
+			},
+			[]entity.Bookmark{
+				*helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, now, "2", "Example B", "https://bar.example.com"),
+				*helper.ToTrashedBookmark(t, 0, time.Time{}, time.Time{}, earlier, "1", "Example A", "https://foo.example.com"),
+			},
+			nil,
+		},
+		"no trashed bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			[]entity.Bookmark{},
+			nil,
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"failed at cursor.All": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{}))
+			},
+			nil,
+			errors.New("failed at cursor.All: no responses remaining"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmarks, actualErr := repository.FindTrash(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(mt, tc.expectedBookmarks, actualBookmarks)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_FindTrashByID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		id               *entity.ID
+		expectedBookmark *entity.Bookmark
+		expectedErr      error
+	}{
+		"id of trashed bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, append(
+						helper.ToBookmarkDocument(t, "1", "Example", "https://example.com"),
+						bson.E{Key: "version", Value: int64(2)},
+						bson.E{Key: "createdAt", Value: primitive.NewDateTimeFromTime(earlier)},
+						bson.E{Key: "updatedAt", Value: primitive.NewDateTimeFromTime(now)},
+						bson.E{Key: "deletedAt", Value: primitive.NewDateTimeFromTime(now)},
+					)),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToTrashedBookmark(t, 2, earlier, now, now, "1", "Example", "https://example.com"),
+			nil,
+		},
+		"id of untrashed bookmark": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmark, actualErr := repository.FindTrashByID(helper.ToUserID(t, helper.UserID), tc.id)
+			// then
+			assert.Exactly(mt, tc.expectedBookmark, actualBookmark)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_PurgeTrash(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	document := func(id, name, uri string) bson.D {
+		return append(helper.ToBookmarkDocument(t, id, name, uri),
+			bson.E{Key: "version", Value: 1},
+			bson.E{Key: "createdAt", Value: earlier},
+			bson.E{Key: "updatedAt", Value: earlier},
+			bson.E{Key: "deletedAt", Value: earlier},
+		)
+	}
+	purged := func(id, name, uri string) entity.Bookmark {
+		bookmark := helper.ToTrashedBookmark(t, 1, earlier, earlier, earlier, id, name, uri)
+		bookmark.Purge()
+		return *bookmark
+	}
+	marked := func(n int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n}, bson.E{Key: "nModified", Value: n})
+	}
+	deleted := func(n int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n})
+	}
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		expectedBookmarks []entity.Bookmark
+		expectedCommands  []string
+		expectedErr       error
+	}{
+		"trashed bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						document("1", "Example A", "https://foo.example.com"),
+						document("2", "Example B", "https://bar.example.com"),
+					),
+					marked(1),
+					deleted(1),
+					marked(1),
+					deleted(1),
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			[]entity.Bookmark{
+				purged("1", "Example A", "https://foo.example.com"),
+				purged("2", "Example B", "https://bar.example.com"),
+			},
+			[]string{"find", "update", "delete", "update", "delete", "commitTransaction"},
+			nil,
+		},
+		"no trashed bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch), mtest.CreateSuccessResponse())
+			},
+			[]entity.Bookmark{},
+			[]string{"find", "commitTransaction"},
+			nil,
+		},
+		"bookmark with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, document("1", "Example A", "https://foo.example.com")),
+					marked(0),
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			nil,
+			[]string{"find", "update", "abortTransaction"},
+			repository.ErrConflict,
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}}, mtest.CreateSuccessResponse())
+			},
+			nil,
+			[]string{"find", "abortTransaction"},
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmarks, actualErr := repository.PurgeTrash(helper.ToUserID(t, helper.UserID), now, "Actor")
+			// then
+			assert.Exactly(mt, tc.expectedBookmarks, actualBookmarks)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
+		})
+	}
+}
+
+func TestBookmark_CountTags(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		expectedTagCounts []repository.TagCount
+		expectedErr       error
+	}{
+		"tagged bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{{Key: "_id", Value: "bar"}, {Key: "count", Value: 2}}),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, bson.D{{Key: "_id", Value: "foo"}, {Key: "count", Value: 3}}),
+				)
+			},
+			[]repository.TagCount{
+				{Tag: helper.ToTags(t, "bar")[0], Count: 2},
+				{Tag: helper.ToTags(t, "foo")[0], Count: 3},
+			},
+			nil,
+		},
+		"untagged bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			[]repository.TagCount{},
+			nil,
+		},
+		"failed at collection.Aggregate": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			nil,
+			errors.New("failed at collection.Aggregate: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualTagCounts, actualErr := repository.CountTags(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(mt, tc.expectedTagCounts, actualTagCounts)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_MergeTags(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	document := func(id, name, uri string, tags ...string) bson.D {
+		return append(helper.ToBookmarkDocument(t, id, name, uri, tags...),
+			bson.E{Key: "version", Value: 1},
+			bson.E{Key: "createdAt", Value: earlier},
+			bson.E{Key: "updatedAt", Value: earlier},
+		)
+	}
+	merged := func(sources []entity.Tag, id, name, uri string, tags ...string) entity.Bookmark {
+		bookmark := helper.ToTimestampedBookmark(t, 1, earlier, earlier, id, name, uri, tags...)
+		bookmark.MergeTags(sources, &helper.ToTags(t, "go")[0])
+		bookmark.SetVersion(2)
+		bookmark.SetTimestamps(earlier, now)
+		return *bookmark
+	}
+	updated := func(n, modified int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n}, bson.E{Key: "nModified", Value: modified})
+	}
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		sources           []entity.Tag
+		target            *entity.Tag
+		expectedBookmarks []entity.Bookmark
+		expectedCommands  []string
+		expectedErr       error
+	}{
+		"2 sources": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						document("1", "Example A", "https://foo.example.com", "golang", "foo"),
+						document("2", "Example B", "https://bar.example.com", "go-lang", "go"),
+					),
+					updated(2, 2),
+					updated(2, 1),
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			helper.ToTags(t, "golang", "go-lang"),
+			&helper.ToTags(t, "go")[0],
+			[]entity.Bookmark{
+				merged(helper.ToTags(t, "golang", "go-lang"), "1", "Example A", "https://foo.example.com", "golang", "foo"),
+				merged(helper.ToTags(t, "golang", "go-lang"), "2", "Example B", "https://bar.example.com", "go-lang", "go"),
+			},
+			[]string{"find", "update", "update", "commitTransaction"},
+			nil,
+		},
+		"target only": {
+			func(mt *mtest.T) {},
+			helper.ToTags(t, "go"),
+			&helper.ToTags(t, "go")[0],
+			[]entity.Bookmark{},
+			[]string{},
+			nil,
+		},
+		"nil sources": {
+			func(mt *mtest.T) {},
+			nil,
+			&helper.ToTags(t, "go")[0],
+			nil,
+			[]string{},
+			errors.New("argument \"sources\" is nil"),
+		},
+		"nil target": {
+			func(mt *mtest.T) {},
+			helper.ToTags(t, "golang"),
+			nil,
+			nil,
+			[]string{},
+			errors.New("argument \"target\" is nil"),
+		},
+		"no bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			helper.ToTags(t, "golang"),
+			&helper.ToTags(t, "go")[0],
+			[]entity.Bookmark{},
+			[]string{"find", "commitTransaction"},
+			nil,
+		},
+		"bookmark modified after find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, document("1", "Example A", "https://foo.example.com", "golang")),
+					updated(0, 0),
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			helper.ToTags(t, "golang"),
+			&helper.ToTags(t, "go")[0],
+			nil,
+			[]string{"find", "update", "abortTransaction"},
+			repository.ErrConflict,
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}}, mtest.CreateSuccessResponse())
+			},
+			helper.ToTags(t, "golang"),
+			&helper.ToTags(t, "go")[0],
+			nil,
+			[]string{"find", "abortTransaction"},
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"failed at collection.UpdateMany": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, document("1", "Example A", "https://foo.example.com", "golang")),
+					bson.D{{Key: "ok", Value: 0}},
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			helper.ToTags(t, "golang"),
+			&helper.ToTags(t, "go")[0],
+			nil,
+			[]string{"find", "update", "abortTransaction"},
+			errors.New("failed at collection.UpdateMany: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualBookmarks, actualErr := repository.MergeTags(helper.ToUserID(t, helper.UserID), tc.sources, tc.target, "Actor")
+			// then
+			assert.Exactly(mt, tc.expectedBookmarks, actualBookmarks)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
+		})
+	}
+	mt.Run("updates", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+				document("1", "Example A", "https://a.example.com", "golang", "go"),
+				document("2", "Example B", "https://b.example.com", "go-lang"),
+			),
+			updated(2, 2),
+			updated(2, 1),
+			mtest.CreateSuccessResponse(),
+		)
+		// given
+		repository := NewBookmarkRepository(mt.Coll, nil, nil, nil, helper.ToFixedClock(t, now))
+		// when
+		_, err := repository.MergeTags(helper.ToUserID(t, helper.UserID), helper.ToTags(t, "golang", "go-lang"), &helper.ToTags(t, "go")[0], "Actor")
+		// then
+		assert.NoError(mt, err)
+		updates := []bson.Raw{}
+		for _, event := range mt.GetAllStartedEvents() {
+			if event.CommandName == "update" {
+				updates = append(updates, event.Command.Lookup("updates", "0").Document())
+			}
+		}
+		if assert.Len(mt, updates, 2) {
+			assert.True(mt, updates[0].Lookup("multi").Boolean())
+			expectedArrayFilters := bson.A{bson.D{{Key: "tag", Value: bson.D{{Key: "$in", Value: bson.A{"golang", "go-lang"}}}}}}
+			actualArrayFilters := bson.A{}
+			assert.NoError(mt, updates[0].Lookup("arrayFilters").Unmarshal(&actualArrayFilters))
+			assert.Exactly(mt, expectedArrayFilters, actualArrayFilters)
+			assert.True(mt, updates[1].Lookup("multi").Boolean())
+			expectedIDs := bson.A{"1", "2"}
+			actualIDs := bson.A{}
+			assert.NoError(mt, updates[1].Lookup("q", "_id", "$in").Unmarshal(&actualIDs))
+			assert.Exactly(mt, expectedIDs, actualIDs)
+		}
+	})
+}
+
+func TestBookmark_FindDuplicates(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare            func(*mtest.T)
+		expectedDuplicates []repository.Duplicate
+		expectedErr        error
+	}{
+		"duplicated bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{
+						{Key: "_id", Value: "https://example.com/"},
+						{Key: "bookmarks", Value: bson.A{
+							helper.ToBookmarkDocument(t, "1", "Example A", "https://example.com"),
+							helper.ToBookmarkDocument(t, "2", "Example B", "https://example.com/#foo"),
+						}},
+						{Key: "count", Value: 2},
+					}),
+				)
+			},
+			[]repository.Duplicate{
+				{
+					CanonicalURI: "https://example.com/",
+					Bookmarks: []entity.Bookmark{
+						*helper.ToBookmark(t, "1", "Example A", "https://example.com"),
+						*helper.ToBookmark(t, "2", "Example B", "https://example.com/#foo"),
+					},
+				},
+			},
+			nil,
+		},
+		"no duplicated bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			[]repository.Duplicate{},
+			nil,
+		},
+		"invalid document": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{
+						{Key: "_id", Value: "https://example.com/"},
+						{Key: "bookmarks", Value: bson.A{
+							helper.ToBookmarkDocument(t, "1", "Example A", "https://example.com"),
+							helper.ToBookmarkDocument(t, "2", "", "https://example.com/#foo"),
+						}},
+						{Key: "count", Value: 2},
+					}),
+				)
+			},
+			nil,
+			fmt.Errorf("failed at document.toEntity: %w", helper.ToErrName(t, "")),
+		},
+		"failed at collection.Aggregate": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			nil,
+			errors.New("failed at collection.Aggregate: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualDuplicates, actualErr := repository.FindDuplicates(helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(mt, tc.expectedDuplicates, actualDuplicates)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_MergeBookmarks(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	updated := func(n int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n}, bson.E{Key: "nModified", Value: n})
+	}
+	deleted := func(n int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n})
+	}
+	cases := map[string]struct {
+		prepare        func(*mtest.T)
+		target         *entity.Bookmark
+		sources        []entity.Bookmark
+		expectedTarget *entity.Bookmark
+		expectedErr    error
+	}{
+		"stored bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(updated(1), updated(1), deleted(1), updated(1), deleted(1), mtest.CreateSuccessResponse())
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, earlier, earlier, "2", "Example B", "https://example.com/", "bar"),
+				*helper.ToTimestampedBookmark(t, 1, earlier, earlier, "3", "Example C", "https://example.com/#baz"),
+			},
+			helper.ToTimestampedBookmark(t, 2, earlier, now, "1", "Example A", "https://example.com", "foo", "bar"),
+			nil,
+		},
+		"target with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(updated(0), mtest.CreateSuccessResponse())
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, earlier, earlier, "2", "Example B", "https://example.com/", "bar"),
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			repository.ErrConflict,
+		},
+		"source with different version": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(updated(1), updated(0), mtest.CreateSuccessResponse())
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, earlier, earlier, "2", "Example B", "https://example.com/", "bar"),
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			repository.ErrConflict,
+		},
+		"nil target": {
+			func(mt *mtest.T) {},
+			nil,
+			[]entity.Bookmark{},
+			nil,
+			errors.New("argument \"target\" is nil"),
+		},
+		"nil sources": {
+			func(mt *mtest.T) {},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			nil,
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			errors.New("argument \"sources\" is nil"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(updated(1), updated(1), bson.D{{Key: "ok", Value: 0}}, mtest.CreateSuccessResponse())
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			[]entity.Bookmark{
+				*helper.ToTimestampedBookmark(t, 1, earlier, earlier, "2", "Example B", "https://example.com/", "bar"),
+			},
+			helper.ToTimestampedBookmark(t, 1, earlier, earlier, "1", "Example A", "https://example.com", "foo", "bar"),
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewBookmarkRepository(collection, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.MergeBookmarks(tc.target, tc.sources, "Actor")
+			// then
+			assert.Exactly(mt, tc.expectedTarget, tc.target)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestBookmark_ExistsInFolder(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare        func(*mtest.T)
+		userID         *entity.UserID
+		folder         *entity.ID
+		expectedExists bool
+		expectedErr    error
+	}{
+		"folder with bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{{Key: "n", Value: 1}}))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			true,
+			nil,
+		},
+		"empty folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			false,
+			nil,
+		},
+		"nil user id": {
+			func(mt *mtest.T) {},
+			nil,
+			helper.ToID(t, "10"),
+			false,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil folder": {
+			func(mt *mtest.T) {},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			false,
+			errors.New("argument \"folder\" is nil"),
+		},
+		"failed at collection.CountDocuments": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			false,
+			errors.New("failed at collection.CountDocuments: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			repository := NewBookmarkRepository(mt.Coll, nil, nil, nil, helper.ToFixedClock(t, now))
+			// when
+			actualExists, actualErr := repository.ExistsInFolder(tc.userID, tc.folder)
+			// then
+			assert.Exactly(mt, tc.expectedExists, actualExists)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+	mt.Run("trashed bookmarks", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+		// given
+		repository := NewBookmarkRepository(mt.Coll, nil, nil, nil, helper.ToFixedClock(t, now))
+		// when
+		repository.ExistsInFolder(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10"))
+		// then
+		var pipeline []bson.D
+		assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("pipeline").Unmarshal(&pipeline))
+		expectedMatch := bson.D{{Key: "$match", Value: bson.D{{Key: "userID", Value: helper.UserID}, {Key: "folderID", Value: "10"}}}}
+		assert.Exactly(mt, expectedMatch, pipeline[0])
+	})
+}
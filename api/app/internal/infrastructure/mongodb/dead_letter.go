@@ -0,0 +1,136 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 配信に失敗したWebhookの通知の永続化を担うリポジトリの具象型。
+type deadLetterRepository struct {
+	collection *mongo.Collection // コレクション
+	clock      clock.Clock       // 時計
+}
+
+// 配信に失敗したWebhookの通知の永続化を担うリポジトリを生成する。
+func NewDeadLetterRepository(collection *mongo.Collection, clock clock.Clock) repository.DeadLetter {
+	return &deadLetterRepository{
+		collection: collection,
+		clock:      clock,
+	}
+}
+
+// デッドレターに関するドキュメント。
+type DeadLetterDocument struct {
+	ID         string    `bson:"_id"`        // ID
+	UserID     string    `bson:"userID"`     // 配信先のWebhookの所有者のユーザID
+	WebhookID  string    `bson:"webhookID"`  // 配信先のWebhookのID
+	EventName  string    `bson:"eventName"`  // イベント名
+	BookmarkID string    `bson:"bookmarkID"` // イベントが起きたブックマークのID
+	Payload    []byte    `bson:"payload"`    // 送信したペイロード
+	Attempts   int       `bson:"attempts"`   // 試行回数
+	LastError  string    `bson:"lastError"`  // 最後の試行で発生したエラー
+	CreatedAt  time.Time `bson:"createdAt"`  // 作成日時
+}
+
+// ドキュメントからデッドレターを表すエンティティを生成する。
+func (d *DeadLetterDocument) toEntity() *entity.DeadLetter {
+	id, _ := entity.NewID(d.ID)
+	userID, _ := entity.NewUserID(d.UserID)
+	webhookID, _ := entity.NewID(d.WebhookID)
+	bookmarkID, _ := entity.NewID(d.BookmarkID)
+	payload := d.Payload
+	if payload == nil {
+		payload = []byte{}
+	}
+	deadLetter, err := entity.NewDeadLetter(id, userID, webhookID, d.EventName, bookmarkID, payload, d.Attempts, d.LastError)
+	if err != nil {
+		return nil
+	}
+	deadLetter.SetCreatedAt(d.CreatedAt)
+	return deadLetter
+}
+
+// デッドレターを保存する。
+//
+// 保存に成功した場合はデッドレターの作成日時を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+//
+//	db.deadLetters.insertOne({
+//	  _id: "ID", userID: "UserID", webhookID: "WebhookID", eventName: "BookmarkDeleted", bookmarkID: "BookmarkID",
+//	  payload: BinData(0, "..."), attempts: 5, lastError: "LastError",
+//	  createdAt: ISODate("2022-01-02T00:00:00Z")
+//	})
+func (r *deadLetterRepository) Save(deadLetter *entity.DeadLetter) error {
+	if deadLetter == nil {
+		return fmt.Errorf("argument \"deadLetter\" is nil")
+	}
+	ctx := context.Background()
+	now := r.clock.Now()
+	id := deadLetter.ID()
+	userID := deadLetter.UserID()
+	webhookID := deadLetter.WebhookID()
+	bookmarkID := deadLetter.BookmarkID()
+	document := DeadLetterDocument{
+		ID:         id.Value(),
+		UserID:     userID.Value(),
+		WebhookID:  webhookID.Value(),
+		EventName:  deadLetter.EventName(),
+		BookmarkID: bookmarkID.Value(),
+		Payload:    deadLetter.Payload(),
+		Attempts:   deadLetter.Attempts(),
+		LastError:  deadLetter.LastError(),
+		CreatedAt:  now,
+	}
+	if _, err := r.collection.InsertOne(ctx, document); err != nil {
+		return fmt.Errorf("failed at collection.InsertOne: %w", err)
+	}
+	deadLetter.SetCreatedAt(now)
+	return nil
+}
+
+// WebhookのIDからデッドレター一覧を検索する。
+//
+// 指定したユーザIDが所有するWebhookのデッドレターに限定する。
+// WebhookのIDにnilを指定した場合はユーザの全てのデッドレターを検索する。
+// 作成日時の降順、作成日時が等しい場合はIDの昇順に返却する。
+// 該当するデッドレターが存在しない場合は空のスライスを返却する。
+//
+// ユーザIDにnilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.deadLetters.find({userID: "UserID", webhookID: "WebhookID"}).sort({createdAt: -1, _id: 1})
+func (r *deadLetterRepository) FindByWebhookID(userID *entity.UserID, webhookID *entity.ID) ([]entity.DeadLetter, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{ownerCondition(userID)}
+	if webhookID != nil {
+		filter = append(filter, bson.E{Key: "webhookID", Value: webhookID.Value()})
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []DeadLetterDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	deadLetters := make([]entity.DeadLetter, len(documents))
+	for i, document := range documents {
+		deadLetters[i] = *document.toEntity()
+	}
+	return deadLetters, nil
+}
@@ -0,0 +1,183 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewDeadLetterRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.DeadLetter", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewDeadLetterRepository(collection, helper.ToFixedClock(t, now))
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.DeadLetter)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewDeadLetterRepository(collection, helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*deadLetterRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestDeadLetter_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare            func(*mtest.T)
+		deadLetter         *entity.DeadLetter
+		expectedDeadLetter *entity.DeadLetter
+		expectedErr        error
+	}{
+		"new dead letter": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			},
+			helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			helper.ToTimestampedDeadLetter(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			nil,
+		},
+		"nil dead letter": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"deadLetter\" is nil"),
+		},
+		"failed at collection.InsertOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+			},
+			helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			helper.ToDeadLetter(t, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			errors.New("failed at collection.InsertOne: write exception: write errors: [duplicate key error]"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewDeadLetterRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.Save(tc.deadLetter)
+			// then
+			assert.Exactly(mt, tc.expectedDeadLetter, tc.deadLetter)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestDeadLetter_FindByWebhookID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare             func(*mtest.T)
+		userID              *entity.UserID
+		webhookID           *entity.ID
+		expectedDeadLetters []entity.DeadLetter
+		expectedErr         error
+	}{
+		"webhook with dead letters": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToDeadLetterDocument(t, now, "101", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500")),
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToDeadLetterDocument(t, earlier, "100", "10", entity.EventBookmarkRegistered, "1", `{}`, 5, "status 503")),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			[]entity.DeadLetter{
+				*helper.ToTimestampedDeadLetter(t, now, "101", "10", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+				*helper.ToTimestampedDeadLetter(t, earlier, "100", "10", entity.EventBookmarkRegistered, "1", `{}`, 5, "status 503"),
+			},
+			nil,
+		},
+		"webhook without dead letters": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			[]entity.DeadLetter{},
+			nil,
+		},
+		"nil webhook id": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, helper.ToDeadLetterDocument(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500")),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			[]entity.DeadLetter{
+				*helper.ToTimestampedDeadLetter(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 5, "status 500"),
+			},
+			nil,
+		},
+		"nil user id": {
+			func(mt *mtest.T) {},
+			nil,
+			helper.ToID(t, "10"),
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "10"),
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewDeadLetterRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualDeadLetters, actualErr := repository.FindByWebhookID(tc.userID, tc.webhookID)
+			// then
+			assert.Exactly(mt, tc.expectedDeadLetters, actualDeadLetters)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
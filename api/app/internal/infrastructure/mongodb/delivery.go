@@ -0,0 +1,179 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 配信待ちのWebhookの通知の永続化を担うリポジトリの具象型。
+type deliveryRepository struct {
+	collection *mongo.Collection // コレクション
+}
+
+// 配信待ちのWebhookの通知の永続化を担うリポジトリを生成する。
+func NewDeliveryRepository(collection *mongo.Collection) repository.Delivery {
+	return &deliveryRepository{
+		collection: collection,
+	}
+}
+
+// 配信待ちの通知に関するドキュメント。
+type DeliveryDocument struct {
+	ID            string    `bson:"_id"`           // ID
+	UserID        string    `bson:"userID"`        // 配信先のWebhookの所有者のユーザID
+	WebhookID     string    `bson:"webhookID"`     // 配信先のWebhookのID
+	EventName     string    `bson:"eventName"`     // イベント名
+	BookmarkID    string    `bson:"bookmarkID"`    // イベントが起きたブックマークのID
+	Payload       []byte    `bson:"payload"`       // 送信するペイロード
+	Attempts      int       `bson:"attempts"`      // 試行回数
+	LastError     string    `bson:"lastError"`     // 最後の試行で発生したエラー
+	NextAttemptAt time.Time `bson:"nextAttemptAt"` // 次に試行する日時
+}
+
+// 通知からドキュメントを生成する。
+func newDeliveryDocument(delivery *entity.Delivery) DeliveryDocument {
+	id := delivery.ID()
+	userID := delivery.UserID()
+	webhookID := delivery.WebhookID()
+	bookmarkID := delivery.BookmarkID()
+	return DeliveryDocument{
+		ID:            id.Value(),
+		UserID:        userID.Value(),
+		WebhookID:     webhookID.Value(),
+		EventName:     delivery.EventName(),
+		BookmarkID:    bookmarkID.Value(),
+		Payload:       delivery.Payload(),
+		Attempts:      delivery.Attempts(),
+		LastError:     delivery.LastError(),
+		NextAttemptAt: delivery.NextAttemptAt(),
+	}
+}
+
+// ドキュメントから配信待ちの通知を表すエンティティを生成する。
+func (d *DeliveryDocument) toEntity() *entity.Delivery {
+	id, _ := entity.NewID(d.ID)
+	userID, _ := entity.NewUserID(d.UserID)
+	webhookID, _ := entity.NewID(d.WebhookID)
+	bookmarkID, _ := entity.NewID(d.BookmarkID)
+	payload := d.Payload
+	if payload == nil {
+		payload = []byte{}
+	}
+	delivery, err := entity.NewDelivery(id, userID, webhookID, d.EventName, bookmarkID, payload, d.Attempts, d.LastError, d.NextAttemptAt)
+	if err != nil {
+		return nil
+	}
+	return delivery
+}
+
+// 通知一覧を保存する。
+//
+// 通知が空の場合は何もしない。
+//
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+//
+//	db.deliveries.insertMany([
+//	  {
+//	    _id: "ID", userID: "UserID", webhookID: "WebhookID", eventName: "BookmarkDeleted", bookmarkID: "BookmarkID",
+//	    payload: BinData(0, "..."), attempts: 0, lastError: "", nextAttemptAt: ISODate("2022-01-02T00:00:00Z")
+//	  }
+//	])
+func (r *deliveryRepository) Save(deliveries []entity.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	ctx := context.Background()
+	documents := make([]interface{}, len(deliveries))
+	for i := range deliveries {
+		documents[i] = newDeliveryDocument(&deliveries[i])
+	}
+	if _, err := r.collection.InsertMany(ctx, documents); err != nil {
+		return fmt.Errorf("failed at collection.InsertMany: %w", err)
+	}
+	return nil
+}
+
+// 次に試行する日時が指定した日時を過ぎた通知を1件取り出す。
+//
+// 次に試行する日時の昇順、次に試行する日時が等しい場合はIDの昇順に取り出す。
+// 取り出した通知は次に試行する日時を期限に延ばし、期限までは他の配信ワーカーが取り出さないようにする。
+// 該当する通知が存在しない場合はnilを返却する。
+//
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.deliveries.findOneAndUpdate(
+//	  {nextAttemptAt: {$lte: ISODate("Now")}},
+//	  {$set: {nextAttemptAt: ISODate("Until")}},
+//	  {sort: {nextAttemptAt: 1, _id: 1}, returnNewDocument: true}
+//	)
+func (r *deliveryRepository) Claim(now time.Time, until time.Time) (*entity.Delivery, error) {
+	ctx := context.Background()
+	filter := bson.D{{Key: "nextAttemptAt", Value: bson.D{{Key: "$lte", Value: now}}}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "nextAttemptAt", Value: until}}}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}, {Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+	result := r.collection.FindOneAndUpdate(ctx, filter, update, opts)
+	var document DeliveryDocument
+	err := result.Decode(&document)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.FindOneAndUpdate: %w", err)
+	}
+	return document.toEntity(), nil
+}
+
+// 通知の試行回数と最後の試行で発生したエラー、次に試行する日時を更新する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.deliveries.updateOne(
+//	  {_id: "ID"},
+//	  {$set: {attempts: 1, lastError: "LastError", nextAttemptAt: ISODate("2022-01-02T00:00:00Z")}}
+//	)
+func (r *deliveryRepository) Update(delivery *entity.Delivery) error {
+	if delivery == nil {
+		return fmt.Errorf("argument \"delivery\" is nil")
+	}
+	ctx := context.Background()
+	id := delivery.ID()
+	filter := bson.D{{Key: "_id", Value: id.Value()}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "attempts", Value: delivery.Attempts()},
+		{Key: "lastError", Value: delivery.LastError()},
+		{Key: "nextAttemptAt", Value: delivery.NextAttemptAt()},
+	}}}
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed at collection.UpdateOne: %w", err)
+	}
+	return nil
+}
+
+// 通知を削除する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+//
+//	db.deliveries.deleteOne({_id: "ID"})
+func (r *deliveryRepository) Delete(delivery *entity.Delivery) error {
+	if delivery == nil {
+		return fmt.Errorf("argument \"delivery\" is nil")
+	}
+	ctx := context.Background()
+	id := delivery.ID()
+	filter := bson.D{{Key: "_id", Value: id.Value()}}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		return fmt.Errorf("failed at collection.DeleteOne: %w", err)
+	}
+	return nil
+}
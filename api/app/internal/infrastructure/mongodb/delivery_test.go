@@ -0,0 +1,296 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewDeliveryRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.Delivery", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewDeliveryRepository(collection)
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.Delivery)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewDeliveryRepository(collection)
+		// when
+		concreteRepository, ok := abstractRepository.(*deliveryRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestDelivery_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		deliveries       []entity.Delivery
+		expectedCommands []string
+		expectedErr      error
+	}{
+		"new deliveries": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			},
+			[]entity.Delivery{
+				*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+				*helper.ToDelivery(t, now, "101", "20", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			[]string{"insert"},
+			nil,
+		},
+		"no deliveries": {
+			func(mt *mtest.T) {},
+			[]entity.Delivery{},
+			[]string{},
+			nil,
+		},
+		"failed at collection.InsertMany": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+			},
+			[]entity.Delivery{
+				*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			},
+			[]string{"insert"},
+			errors.New("failed at collection.InsertMany: bulk write exception: write errors: [duplicate key error]"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewDeliveryRepository(collection)
+			// when
+			actualErr := repository.Save(tc.deliveries)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
+		})
+	}
+	mt.Run("inserted documents", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		// given
+		repository := NewDeliveryRepository(mt.Coll)
+		// when
+		repository.Save([]entity.Delivery{*helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, "")})
+		// then
+		var documents []bson.D
+		assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("documents").Unmarshal(&documents))
+		expectedDocuments := []bson.D{helper.ToDeliveryDocument(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, "")}
+		for i := range expectedDocuments {
+			expectedDocuments[i][5].Value = primitive.Binary{Data: []byte(`{}`)}
+			expectedDocuments[i][6].Value = int32(0)
+		}
+		assert.Equal(mt, expectedDocuments, documents)
+	})
+}
+
+func TestDelivery_Claim(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	until := now.Add(time.Minute)
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		expectedDelivery *entity.Delivery
+		expectedErr      error
+	}{
+		"due delivery": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(
+					bson.E{Key: "value", Value: helper.ToDeliveryDocument(t, until, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 2, "status 500")},
+				))
+			},
+			helper.ToDelivery(t, until, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 2, "status 500"),
+			nil,
+		},
+		"no due deliveries": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}))
+			},
+			nil,
+			nil,
+		},
+		"failed at collection.FindOneAndUpdate": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			nil,
+			errors.New("failed at collection.FindOneAndUpdate: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewDeliveryRepository(collection)
+			// when
+			actualDelivery, actualErr := repository.Claim(now, until)
+			// then
+			assert.Exactly(mt, tc.expectedDelivery, actualDelivery)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+	mt.Run("claim command", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}))
+		// given
+		repository := NewDeliveryRepository(mt.Coll)
+		// when
+		repository.Claim(now, until)
+		// then
+		command := mt.GetStartedEvent().Command
+		var query, update, sort bson.D
+		assert.NoError(mt, command.Lookup("query").Unmarshal(&query))
+		assert.NoError(mt, command.Lookup("update").Unmarshal(&update))
+		assert.NoError(mt, command.Lookup("sort").Unmarshal(&sort))
+		assert.Equal(mt, bson.D{{Key: "nextAttemptAt", Value: bson.D{{Key: "$lte", Value: primitive.NewDateTimeFromTime(now)}}}}, query)
+		assert.Equal(mt, bson.D{{Key: "$set", Value: bson.D{{Key: "nextAttemptAt", Value: primitive.NewDateTimeFromTime(until)}}}}, update)
+		assert.Equal(mt, bson.D{{Key: "nextAttemptAt", Value: int32(1)}, {Key: "_id", Value: int32(1)}}, sort)
+		assert.True(mt, command.Lookup("new").Boolean())
+	})
+}
+
+func TestDelivery_Update(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		delivery    *entity.Delivery
+		expectedErr error
+	}{
+		"stored delivery": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			nil,
+		},
+		"nil delivery": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"delivery\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 1, "status 500"),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewDeliveryRepository(collection)
+			// when
+			actualErr := repository.Update(tc.delivery)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestDelivery_Delete(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		delivery    *entity.Delivery
+		expectedErr error
+	}{
+		"stored delivery": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+			},
+			helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			nil,
+		},
+		"nil delivery": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"delivery\" is nil"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToDelivery(t, now, "100", "10", entity.EventBookmarkDeleted, "1", `{}`, 0, ""),
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewDeliveryRepository(collection)
+			// when
+			actualErr := repository.Delete(tc.delivery)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// フォルダの永続化を担うリポジトリの具象型。
+type folderRepository struct {
+	collection *mongo.Collection // コレクション
+}
+
+// フォルダの永続化を担うリポジトリを生成する。
+func NewFolderRepository(collection *mongo.Collection) repository.Folder {
+	return &folderRepository{
+		collection: collection,
+	}
+}
+
+// フォルダに関するドキュメント。
+type FolderDocument struct {
+	ID       string `bson:"_id"`      // ID
+	UserID   string `bson:"userID"`   // 所有者のユーザID
+	Name     string `bson:"name"`     // フォルダ名
+	ParentID string `bson:"parentID"` // 親フォルダのID (最上位の場合は空文字列)
+	Position int    `bson:"position"` // 並び順
+}
+
+// ドキュメントからフォルダを表すエンティティを生成する。
+func (d *FolderDocument) toEntity() *entity.Folder {
+	id, _ := entity.NewID(d.ID)
+	userID, _ := entity.NewUserID(d.UserID)
+	name, _ := entity.NewName(d.Name)
+	parent, _ := entity.NewID(d.ParentID)
+	folder, err := entity.NewFolder(id, userID, name, parent, d.Position)
+	if err != nil {
+		return nil
+	}
+	return folder
+}
+
+// 親フォルダのIDを文字列に変換する。
+//
+// nilの場合は空文字列を返却する。
+func parentIDValue(parent *entity.ID) string {
+	if parent == nil {
+		return ""
+	}
+	return parent.Value()
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *folderRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// フォルダを保存する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの保存に失敗した場合はエラーを返却する。
+// 同じIDの他のユーザのフォルダが保存されている場合は、IDの重複によりドキュメントの保存に失敗する。
+//
+//	db.folders.updateOne(
+//	  {_id: "ID", userID: "UserID"},
+//	  {$set: {_id: "ID", userID: "UserID", name: "Name", parentID: "ParentID", position: 0}},
+//	  {upsert: true}
+//	)
+func (r *folderRepository) Save(folder *entity.Folder) error {
+	if folder == nil {
+		return fmt.Errorf("argument \"folder\" is nil")
+	}
+	ctx := context.Background()
+	id := folder.ID()
+	userID := folder.UserID()
+	name := folder.Name()
+	document := FolderDocument{
+		ID:       id.Value(),
+		UserID:   userID.Value(),
+		Name:     name.Value(),
+		ParentID: parentIDValue(folder.Parent()),
+		Position: folder.Position(),
+	}
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(&userID)}
+	update := bson.M{"$set": document}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.collection.UpdateOne(ctx, filter, update, opts); err != nil {
+		return fmt.Errorf("failed at collection.UpdateOne: %w", err)
+	}
+	return nil
+}
+
+// IDからフォルダを検索する。
+//
+// 該当するフォルダが存在しない場合はnilを返却する。
+// 他のユーザのフォルダはnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.folders.findOne({_id: "ID", userID: "UserID"})
+func (r *folderRepository) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Folder, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(userID)}
+	result := r.collection.FindOne(ctx, filter)
+	var document FolderDocument
+	err := result.Decode(&document)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.FindOne: %w", err)
+	}
+	return document.toEntity(), nil
+}
+
+// 親フォルダのIDから子フォルダ一覧を検索する。
+//
+// 親フォルダにnilを指定した場合は最上位のフォルダ一覧を検索する。
+// 並び順の昇順、並び順が等しい場合はIDの昇順に返却する。
+// 該当するフォルダが存在しない場合は空のスライスを返却する。
+//
+// ユーザIDにnilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.folders.find({userID: "UserID", parentID: "ParentID"}).sort({position: 1, _id: 1})
+func (r *folderRepository) FindByParent(userID *entity.UserID, parent *entity.ID) ([]entity.Folder, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{ownerCondition(userID), {Key: "parentID", Value: parentIDValue(parent)}}
+	opts := options.Find().SetSort(bson.D{{Key: "position", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []FolderDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	folders := make([]entity.Folder, len(documents))
+	for i, document := range documents {
+		folders[i] = *document.toEntity()
+	}
+	return folders, nil
+}
+
+// フォルダを削除する。
+//
+// 他のユーザのフォルダは削除しない。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+//
+//	db.folders.deleteOne({_id: "ID", userID: "UserID"})
+func (r *folderRepository) Delete(folder *entity.Folder) error {
+	if folder == nil {
+		return fmt.Errorf("argument \"folder\" is nil")
+	}
+	ctx := context.Background()
+	id := folder.ID()
+	userID := folder.UserID()
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(&userID)}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		return fmt.Errorf("failed at collection.DeleteOne: %w", err)
+	}
+	return nil
+}
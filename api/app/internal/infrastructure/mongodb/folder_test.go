@@ -0,0 +1,340 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewFolderRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.Folder", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewFolderRepository(collection)
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.Folder)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewFolderRepository(collection)
+		// when
+		concreteRepository, ok := abstractRepository.(*folderRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestFolder_NextID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	// given
+	collection := mt.Coll
+	repository := NewFolderRepository(collection)
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+	expectedType := &entity.ID{}
+	assert.IsType(t, expectedType, id)
+}
+
+func TestFolder_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		folder      *entity.Folder
+		expectedErr error
+	}{
+		"non-nil folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToFolder(t, "1", "Reading List", "2", 0),
+			nil,
+		},
+		"nil folder": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"folder\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToFolder(t, "1", "Reading List", "2", 0),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewFolderRepository(collection)
+			// when
+			actualErr := repository.Save(tc.folder)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestFolder_FindByID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare        func(*mtest.T)
+		userID         *entity.UserID
+		id             *entity.ID
+		expectedFolder *entity.Folder
+		expectedErr    error
+	}{
+		"id of stored top-level folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToFolderDocument(t, "1", "Reading List", "", 0)),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			nil,
+		},
+		"id of stored nested folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToFolderDocument(t, "1", "Reading List", "2", 3)),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			helper.ToFolder(t, "1", "Reading List", "2", 3),
+			nil,
+		},
+		"id of unstored folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil user id": {
+			func(mt *mtest.T) {},
+			nil,
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil id": {
+			func(mt *mtest.T) {},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewFolderRepository(collection)
+			// when
+			actualFolder, actualErr := repository.FindByID(tc.userID, tc.id)
+			// then
+			assert.Exactly(mt, tc.expectedFolder, actualFolder)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestFolder_FindByParent(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare         func(*mtest.T)
+		userID          *entity.UserID
+		parent          *entity.ID
+		expectedFolders []entity.Folder
+		expectedErr     error
+	}{
+		"parent with children": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToFolderDocument(t, "2", "Go", "1", 0)),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToFolderDocument(t, "3", "Rust", "1", 1)),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			[]entity.Folder{
+				*helper.ToFolder(t, "2", "Go", "1", 0),
+				*helper.ToFolder(t, "3", "Rust", "1", 1),
+			},
+			nil,
+		},
+		"parent without children": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			[]entity.Folder{},
+			nil,
+		},
+		"top level": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, helper.ToFolderDocument(t, "1", "Work", "", 0)),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			[]entity.Folder{
+				*helper.ToFolder(t, "1", "Work", "", 0),
+			},
+			nil,
+		},
+		"nil user id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"failed at cursor.All": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, bson.D{}))
+			},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			nil,
+			errors.New("failed at cursor.All: no responses remaining"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewFolderRepository(collection)
+			// when
+			actualFolders, actualErr := repository.FindByParent(tc.userID, tc.parent)
+			// then
+			assert.Exactly(mt, tc.expectedFolders, actualFolders)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestFolder_Delete(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		folder      *entity.Folder
+		expectedErr error
+	}{
+		"stored folder": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "acknowledged", Value: true}, bson.E{Key: "n", Value: 1}))
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			nil,
+		},
+		"nil folder": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"folder\" is nil"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToFolder(t, "1", "Reading List", "", 0),
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewFolderRepository(collection)
+			// when
+			actualErr := repository.Delete(tc.folder)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
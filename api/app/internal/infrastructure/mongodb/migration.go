@@ -0,0 +1,277 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 所有者が同じで正規形が一致するURIのゴミ箱にないブックマークが既に複数保存されていることを表すエラー。
+//
+// 重複を解消するまで所有者と正規形のURIの組に対する一意インデックスを作成できない。
+var ErrDuplicateBookmarks = errors.New("duplicate bookmarks exist")
+
+// 重複したブックマークの組数の集計に関するドキュメント。
+type DuplicateCountDocument struct {
+	Duplicates int `bson:"duplicates"` // 所有者と正規形のURIの組が一致するブックマークの組数
+}
+
+// ブックマークのコレクションを現在のスキーマに移行する。
+//
+// ゴミ箱に移動した日時を持たないドキュメントにnullを補完し、
+// 正規形のURIを持たないドキュメントに正規形のURIを補完してから、
+// 所有者と正規形のURIの組に対する一意インデックスを作成する。
+// 一意インデックスはゴミ箱にないドキュメントに限定する。
+// 補完したドキュメント数の合計を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ゴミ箱に移動した日時の補完に失敗した場合はエラーを返却する。
+// 正規形のURIの補完に失敗した場合はエラーを返却する。
+// 重複の集計に失敗した場合はエラーを返却する。
+// 既に重複したブックマークが存在する場合はインデックスを作成せずに ErrDuplicateBookmarks を返却する。
+// インデックスの作成に失敗した場合はエラーを返却する。
+func MigrateBookmarks(collection *mongo.Collection) (int, error) {
+	if collection == nil {
+		return 0, fmt.Errorf("argument \"collection\" is nil")
+	}
+	ctx := context.Background()
+	trashCount, err := backfillDeletedAt(ctx, collection)
+	if err != nil {
+		return 0, err
+	}
+	uriCount, err := backfillCanonicalURI(ctx, collection)
+	if err != nil {
+		return trashCount, err
+	}
+	count := trashCount + uriCount
+	duplicates, err := countDuplicateBookmarks(ctx, collection)
+	if err != nil {
+		return count, err
+	}
+	if duplicates > 0 {
+		return count, fmt.Errorf("%w: %d groups", ErrDuplicateBookmarks, duplicates)
+	}
+	if err := ensureCanonicalURIIndex(ctx, collection); err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// ゴミ箱に移動した日時を持たないドキュメントにnullを補完する。
+//
+// ゴミ箱を導入する前に保存されたドキュメントは日時のフィールドを持たない。
+// 検索ではゴミ箱にないものとして扱うが、一意インデックスの部分フィルタはnullのフィールドに限り対象とするため補完する。
+// 補完したドキュメント数を返却する。
+//
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.updateMany({deletedAt: {$exists: false}}, {$set: {deletedAt: null}})
+func backfillDeletedAt(ctx context.Context, collection *mongo.Collection) (int, error) {
+	filter := bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "deletedAt", Value: nil}}}}
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.UpdateMany: %w", err)
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// 正規形のURIを持たないドキュメントに正規形のURIを補完する。
+//
+// 補完したドキュメント数を返却する。
+//
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.find({canonicalURI: {$exists: false}}, {uri: 1})
+//	db.bookmarks.bulkWrite([
+//	  {updateOne: {filter: {_id: "ID", canonicalURI: {$exists: false}}, update: {$set: {canonicalURI: "CanonicalURI"}}}}
+//	])
+func backfillCanonicalURI(ctx context.Context, collection *mongo.Collection) (int, error) {
+	filter := bson.D{{Key: "canonicalURI", Value: bson.D{{Key: "$exists", Value: false}}}}
+	opts := options.Find().SetProjection(bson.D{{Key: "uri", Value: 1}})
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []BookmarkDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return 0, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	models := []mongo.WriteModel{}
+	for _, document := range documents {
+		uri, err := entity.NewURI(document.URI)
+		if err != nil {
+			continue
+		}
+		model := mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: document.ID}, filter[0]}).
+			SetUpdate(bson.D{{Key: "$set", Value: bson.D{{Key: "canonicalURI", Value: uri.Canonical()}}}})
+		models = append(models, model)
+	}
+	if len(models) == 0 {
+		return 0, nil
+	}
+	result, err := collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.BulkWrite: %w", err)
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// 所有者と正規形のURIの組が一致するゴミ箱にないドキュメントの組数を集計する。
+//
+// 一意インデックスは存在しないフィールドをnullとして扱うため、
+// 所有者を持たないドキュメントや正規形のURIを補完できなかったドキュメントもnull同士で重複とみなす。
+//
+// ドキュメントの集計に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.aggregate([
+//	  {$match: {deletedAt: {$type: "null"}}},
+//	  {$group: {_id: {userID: {$ifNull: ["$userID", null]}, canonicalURI: {$ifNull: ["$canonicalURI", null]}}, count: {$sum: 1}}},
+//	  {$match: {count: {$gt: 1}}},
+//	  {$count: "duplicates"}
+//	])
+func countDuplicateBookmarks(ctx context.Context, collection *mongo.Collection) (int, error) {
+	ifNull := func(field string) bson.D {
+		return bson.D{{Key: "$ifNull", Value: bson.A{"$" + field, nil}}}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$type", Value: "null"}}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "userID", Value: ifNull("userID")}, {Key: "canonicalURI", Value: ifNull("canonicalURI")}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+		{{Key: "$count", Value: "duplicates"}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.Aggregate: %w", err)
+	}
+	var documents []DuplicateCountDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return 0, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	if len(documents) == 0 {
+		return 0, nil
+	}
+	return documents[0].Duplicates, nil
+}
+
+// 所有者と正規形のURIの組に対する一意インデックスを作成する。
+//
+// インデックスの作成に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.createIndex(
+//	  {userID: 1, canonicalURI: 1},
+//	  {name: "userID_1_canonicalURI_1", unique: true, partialFilterExpression: {deletedAt: {$type: "null"}}}
+//	)
+func ensureCanonicalURIIndex(ctx context.Context, collection *mongo.Collection) error {
+	model := mongo.IndexModel{
+		Keys: bson.D{{Key: "userID", Value: 1}, {Key: "canonicalURI", Value: 1}},
+		Options: options.Index().
+			SetName(CanonicalURIIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$type", Value: "null"}}}}),
+	}
+	if _, err := collection.Indexes().CreateOne(ctx, model); err != nil {
+		return fmt.Errorf("failed at indexes.CreateOne: %w", err)
+	}
+	return nil
+}
+
+// 所有者を持たないドキュメントに既定の所有者を補完する。
+//
+// ユーザごとの分離を導入する前に保存されたドキュメントは所有者を持たず、どのユーザからも参照できない。
+// 指定したユーザIDを所有者として補完し、既存のブックマークやフォルダ、Webhook、デッドレター、送信箱を引き継ぐ。
+// 補完したドキュメント数を返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.updateMany({userID: null}, {$set: {userID: "UserID"}})
+func MigrateOwner(collection *mongo.Collection, userID *entity.UserID) (int, error) {
+	if collection == nil {
+		return 0, fmt.Errorf("argument \"collection\" is nil")
+	}
+	if userID == nil {
+		return 0, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "userID", Value: nil}}
+	update := bson.D{{Key: "$set", Value: bson.D{ownerCondition(userID)}}}
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.UpdateMany: %w", err)
+	}
+	return int(result.ModifiedCount), nil
+}
+
+// 所有者を持たないドキュメントを数える。
+//
+// 既定の所有者を設定せずに起動すると、所有者を持たないドキュメントはどのユーザからも参照できなくなる。
+// 起動前に数え、引き継ぎ先が設定されていないことを検出する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの集計に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.countDocuments({userID: null})
+func CountWithoutOwner(collection *mongo.Collection) (int, error) {
+	if collection == nil {
+		return 0, fmt.Errorf("argument \"collection\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "userID", Value: nil}}
+	count, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.CountDocuments: %w", err)
+	}
+	return int(count), nil
+}
+
+// 送信箱のコレクションに未配信のドメインイベントの読み出しと配信済みのドキュメントの削除に用いるインデックスを作成する。
+//
+// 中継器は未配信のドメインイベントを記録した順に繰り返し読み出すため、配信日時と記録した順序の複合インデックスを作成する。
+// 配信済みのドキュメントは保持期間が経過した後に削除されるよう、配信日時に対するTTLインデックスを作成する。
+// 未配信のドキュメントは配信日時がnullのため削除されない。
+//
+// nilを指定した場合はエラーを返却する。
+// 1秒未満の保持期間を指定した場合はエラーを返却する。
+// インデックスの作成に失敗した場合はエラーを返却する。
+//
+//	db.outbox.createIndexes([
+//	  {key: {dispatchedAt: 1, createdAt: 1, position: 1, _id: 1}, name: "dispatchedAt_1_createdAt_1_position_1__id_1"},
+//	  {key: {dispatchedAt: 1}, name: "dispatchedAt_1", expireAfterSeconds: 604800}
+//	])
+func MigrateOutbox(collection *mongo.Collection, retention time.Duration) error {
+	if collection == nil {
+		return fmt.Errorf("argument \"collection\" is nil")
+	}
+	if retention < time.Second {
+		return fmt.Errorf("argument \"retention\" is less than 1 second")
+	}
+	ctx := context.Background()
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "dispatchedAt", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "position", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName(PendingOutboxIndex),
+		},
+		{
+			Keys:    bson.D{{Key: "dispatchedAt", Value: 1}},
+			Options: options.Index().SetName(DispatchedOutboxIndex).SetExpireAfterSeconds(int32(retention / time.Second)),
+		},
+	}
+	if _, err := collection.Indexes().CreateMany(ctx, models); err != nil {
+		return fmt.Errorf("failed at indexes.CreateMany: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMigrateBookmarks(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	unchanged := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0})
+	noDuplicates := mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch)
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		expectedCount    int
+		expectedCommands []string
+		expectedErr      error
+	}{
+		"documents without canonical uri": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					unchanged,
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						bson.D{{Key: "_id", Value: "1"}, {Key: "uri", Value: "HTTPS://EXAMPLE.COM/foo/"}},
+						bson.D{{Key: "_id", Value: "2"}, {Key: "uri", Value: "https://example.com/bar"}},
+					),
+					mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 2}),
+					noDuplicates,
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			2,
+			[]string{"update", "find", "update", "aggregate", "createIndexes"},
+			nil,
+		},
+		"documents without deleted at": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					noDuplicates,
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			1,
+			[]string{"update", "find", "aggregate", "createIndexes"},
+			nil,
+		},
+		"no documents to backfill": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					unchanged,
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					noDuplicates,
+					mtest.CreateSuccessResponse(),
+				)
+			},
+			0,
+			[]string{"update", "find", "aggregate", "createIndexes"},
+			nil,
+		},
+		"failed at collection.UpdateMany": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			0,
+			[]string{"update"},
+			errors.New("failed at collection.UpdateMany: command failed"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(unchanged, bson.D{{Key: "ok", Value: 0}})
+			},
+			0,
+			[]string{"update", "find"},
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"failed at collection.BulkWrite": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					unchanged,
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{{Key: "_id", Value: "1"}, {Key: "uri", Value: "https://example.com"}}),
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			0,
+			[]string{"update", "find", "update"},
+			errors.New("failed at collection.BulkWrite: command failed"),
+		},
+		"duplicate bookmarks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					unchanged,
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{{Key: "duplicates", Value: 2}}),
+				)
+			},
+			0,
+			[]string{"update", "find", "aggregate"},
+			errors.New("duplicate bookmarks exist: 2 groups"),
+		},
+		"failed at collection.Aggregate": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					unchanged,
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			0,
+			[]string{"update", "find", "aggregate"},
+			errors.New("failed at collection.Aggregate: command failed"),
+		},
+		"failed at indexes.CreateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					unchanged,
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+					noDuplicates,
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			0,
+			[]string{"update", "find", "aggregate", "createIndexes"},
+			errors.New("failed at indexes.CreateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// when
+			actualCount, actualErr := MigrateBookmarks(mt.Coll)
+			// then
+			assert.Exactly(mt, tc.expectedCount, actualCount)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
+		})
+	}
+	mt.Run("backfilled deleted at", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+			mtest.CreateSuccessResponse(),
+		)
+		// when
+		MigrateBookmarks(mt.Coll)
+		// then
+		var updates []bson.D
+		assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("updates").Unmarshal(&updates))
+		expectedUpdate := bson.D{
+			{Key: "q", Value: bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}}}},
+			{Key: "u", Value: bson.D{{Key: "$set", Value: bson.D{{Key: "deletedAt", Value: nil}}}}},
+			{Key: "multi", Value: true},
+		}
+		assert.Exactly(mt, []bson.D{expectedUpdate}, updates)
+	})
+	mt.Run("duplicates seeded under different spellings", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(
+			unchanged,
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: "1"}, {Key: "uri", Value: "HTTPS://EXAMPLE.COM/foo/"}},
+				bson.D{{Key: "_id", Value: "2"}, {Key: "uri", Value: "https://example.com/foo"}},
+			),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 2}),
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{{Key: "duplicates", Value: 1}}),
+		)
+		// when
+		actualCount, actualErr := MigrateBookmarks(mt.Coll)
+		// then
+		assert.Exactly(mt, 2, actualCount)
+		assert.ErrorIs(mt, actualErr, ErrDuplicateBookmarks)
+		var pipeline []bson.D
+		assert.NoError(mt, mt.GetAllStartedEvents()[3].Command.Lookup("pipeline").Unmarshal(&pipeline))
+		ifNull := func(field string) bson.D {
+			return bson.D{{Key: "$ifNull", Value: bson.A{"$" + field, nil}}}
+		}
+		expectedPipeline := []bson.D{
+			{{Key: "$match", Value: bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$type", Value: "null"}}}}}},
+			{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{{Key: "userID", Value: ifNull("userID")}, {Key: "canonicalURI", Value: ifNull("canonicalURI")}}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: int32(1)}}},
+			}}},
+			{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: int32(1)}}}}}},
+			{{Key: "$count", Value: "duplicates"}},
+		}
+		assert.Exactly(mt, expectedPipeline, pipeline)
+		for _, event := range mt.GetAllStartedEvents() {
+			assert.NotEqual(mt, "createIndexes", event.CommandName)
+		}
+	})
+	mt.Run("nil collection", func(mt *mtest.T) {
+		// when
+		actualCount, actualErr := MigrateBookmarks(nil)
+		// then
+		assert.Exactly(mt, 0, actualCount)
+		assert.Exactly(mt, "argument \"collection\" is nil", actualErr.Error())
+	})
+}
+
+func TestMigrateOwner(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare       func(*mtest.T)
+		expectedCount int
+		expectedErr   error
+	}{
+		"documents without owner": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 2}))
+			},
+			2,
+			nil,
+		},
+		"no documents without owner": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			},
+			0,
+			nil,
+		},
+		"failed at collection.UpdateMany": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			0,
+			errors.New("failed at collection.UpdateMany: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// when
+			actualCount, actualErr := MigrateOwner(mt.Coll, helper.ToUserID(t, helper.UserID))
+			// then
+			assert.Exactly(mt, tc.expectedCount, actualCount)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			var updates []bson.D
+			assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("updates").Unmarshal(&updates))
+			expectedUpdate := bson.D{
+				{Key: "q", Value: bson.D{{Key: "userID", Value: nil}}},
+				{Key: "u", Value: bson.D{{Key: "$set", Value: bson.D{{Key: "userID", Value: helper.UserID}}}}},
+				{Key: "multi", Value: true},
+			}
+			assert.Exactly(mt, []bson.D{expectedUpdate}, updates)
+		})
+	}
+	mt.Run("nil collection", func(mt *mtest.T) {
+		// when
+		actualCount, actualErr := MigrateOwner(nil, helper.ToUserID(t, helper.UserID))
+		// then
+		assert.Exactly(mt, 0, actualCount)
+		assert.Exactly(mt, "argument \"collection\" is nil", actualErr.Error())
+	})
+	mt.Run("nil user id", func(mt *mtest.T) {
+		// when
+		actualCount, actualErr := MigrateOwner(mt.Coll, nil)
+		// then
+		assert.Exactly(mt, 0, actualCount)
+		assert.Exactly(mt, "argument \"userID\" is nil", actualErr.Error())
+	})
+}
+
+func TestCountWithoutOwner(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare       func(*mtest.T)
+		expectedCount int
+		expectedErr   error
+	}{
+		"documents without owner": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{{Key: "n", Value: 2}}))
+			},
+			2,
+			nil,
+		},
+		"no documents without owner": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			0,
+			nil,
+		},
+		"failed at collection.CountDocuments": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			0,
+			errors.New("failed at collection.CountDocuments: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// when
+			actualCount, actualErr := CountWithoutOwner(mt.Coll)
+			// then
+			assert.Exactly(mt, tc.expectedCount, actualCount)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			var pipeline []bson.D
+			assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("pipeline").Unmarshal(&pipeline))
+			assert.Exactly(mt, bson.D{{Key: "$match", Value: bson.D{{Key: "userID", Value: nil}}}}, pipeline[0])
+		})
+	}
+	mt.Run("nil collection", func(mt *mtest.T) {
+		// when
+		actualCount, actualErr := CountWithoutOwner(nil)
+		// then
+		assert.Exactly(mt, 0, actualCount)
+		assert.Exactly(mt, "argument \"collection\" is nil", actualErr.Error())
+	})
+}
+
+func TestMigrateOutbox(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		expectedErr error
+	}{
+		"created indexes": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			},
+			nil,
+		},
+		"failed at indexes.CreateMany": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			errors.New("failed at indexes.CreateMany: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// when
+			actualErr := MigrateOutbox(mt.Coll, 7*24*time.Hour)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			var indexes []bson.D
+			assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("indexes").Unmarshal(&indexes))
+			expectedIndexes := []bson.D{
+				{
+					{Key: "key", Value: bson.D{{Key: "dispatchedAt", Value: int32(1)}, {Key: "createdAt", Value: int32(1)}, {Key: "position", Value: int32(1)}, {Key: "_id", Value: int32(1)}}},
+					{Key: "name", Value: PendingOutboxIndex},
+				},
+				{
+					{Key: "key", Value: bson.D{{Key: "dispatchedAt", Value: int32(1)}}},
+					{Key: "expireAfterSeconds", Value: int32(604800)},
+					{Key: "name", Value: DispatchedOutboxIndex},
+				},
+			}
+			assert.Exactly(mt, expectedIndexes, indexes)
+		})
+	}
+	mt.Run("nil collection", func(mt *mtest.T) {
+		// when
+		actualErr := MigrateOutbox(nil, time.Hour)
+		// then
+		assert.Exactly(mt, "argument \"collection\" is nil", actualErr.Error())
+	})
+	mt.Run("retention less than 1 second", func(mt *mtest.T) {
+		// when
+		actualErr := MigrateOutbox(mt.Coll, time.Millisecond)
+		// then
+		assert.Exactly(mt, "argument \"retention\" is less than 1 second", actualErr.Error())
+	})
+}
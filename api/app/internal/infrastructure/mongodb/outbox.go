@@ -0,0 +1,419 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// 未配信のドメインイベントを記録した順に読み出すためのインデックスの名前。
+const PendingOutboxIndex = "dispatchedAt_1_createdAt_1_position_1__id_1"
+
+// 配信済みのドキュメントを保持期間の経過後に削除するTTLインデックスの名前。
+const DispatchedOutboxIndex = "dispatchedAt_1"
+
+// 送信箱に記録したドメインイベントに関するドキュメント。
+//
+// ブックマークのドキュメントと同じトランザクションで挿入する。
+type OutboxDocument struct {
+	ID           string     `bson:"_id"`                   // ID
+	EventName    string     `bson:"eventName"`             // イベント名
+	BookmarkID   string     `bson:"bookmarkID"`            // イベントが起きたブックマークのID
+	UserID       string     `bson:"userID"`                // イベントが起きたブックマークの所有者のユーザID
+	Name         string     `bson:"name,omitempty"`        // ブックマーク名 (BookmarkRegistered)
+	URI          string     `bson:"uri,omitempty"`         // URI (BookmarkRegistered)
+	Description  string     `bson:"description,omitempty"` // 説明 (BookmarkRegistered)
+	Tags         []string   `bson:"tags,omitempty"`        // タグ一覧 (BookmarkRegistered, BookmarkTagged, BookmarkUntagged)
+	Before       string     `bson:"before,omitempty"`      // 変更前の値 (BookmarkRenamed, BookmarkURIRewritten, BookmarkDescribed, BookmarkMoved, BookmarkStatusChanged)
+	After        string     `bson:"after,omitempty"`       // 変更後の値 (BookmarkRenamed, BookmarkURIRewritten, BookmarkDescribed, BookmarkMoved, BookmarkStatusChanged)
+	CreatedAt    time.Time  `bson:"createdAt"`             // 記録日時
+	Position     int        `bson:"position"`              // 同じ書き込みで記録したドメインイベントにおける順序
+	DispatchedAt *time.Time `bson:"dispatchedAt"`          // 配信日時 (未配信の場合はnull)
+}
+
+// ドメインイベントから送信箱のドキュメント一覧を生成する。
+//
+// ドメインイベントを記録した順に順序を付与する。
+func newOutboxDocuments(events []entity.Event, now time.Time) []interface{} {
+	documents := make([]interface{}, len(events))
+	for i, event := range events {
+		uuid, _ := uuid.NewRandom()
+		id := event.BookmarkID()
+		userID := event.UserID()
+		document := OutboxDocument{
+			ID:         uuid.String(),
+			EventName:  event.EventName(),
+			BookmarkID: id.Value(),
+			UserID:     userID.Value(),
+			CreatedAt:  now,
+			Position:   i,
+		}
+		switch e := event.(type) {
+		case entity.BookmarkRegistered:
+			name, uri, description := e.Name(), e.URI(), e.Description()
+			document.Name = name.Value()
+			document.URI = uri.String()
+			document.Description = description.Value()
+			document.Tags = tagValues(e.Tags())
+		case entity.BookmarkRenamed:
+			before, after := e.Before(), e.After()
+			document.Before = before.Value()
+			document.After = after.Value()
+		case entity.BookmarkURIRewritten:
+			before, after := e.Before(), e.After()
+			document.Before = before.String()
+			document.After = after.String()
+		case entity.BookmarkTagged:
+			document.Tags = tagValues(e.Tags())
+		case entity.BookmarkUntagged:
+			document.Tags = tagValues(e.Tags())
+		case entity.BookmarkDescribed:
+			before, after := e.Before(), e.After()
+			document.Before = before.Value()
+			document.After = after.Value()
+		case entity.BookmarkMoved:
+			document.Before = folderValue(e.Before())
+			document.After = folderValue(e.After())
+		case entity.BookmarkStatusChanged:
+			document.Before = e.Before().Value()
+			document.After = e.After().Value()
+		}
+		documents[i] = document
+	}
+	return documents
+}
+
+// タグ一覧を文字列のスライスに変換する。
+func tagValues(tags []entity.Tag) []string {
+	values := make([]string, len(tags))
+	for i, tag := range tags {
+		values[i] = tag.Value()
+	}
+	return values
+}
+
+// フォルダのIDを文字列に変換する。
+//
+// 最上位を表すnilの場合は空文字列に変換する。
+func folderValue(folder *entity.ID) string {
+	if folder == nil {
+		return ""
+	}
+	return folder.Value()
+}
+
+// 文字列をフォルダのIDに変換する。
+//
+// 空文字列の場合は最上位を表すnilに変換する。
+func toFolder(v string) (*entity.ID, error) {
+	if v == "" {
+		return nil, nil
+	}
+	return entity.NewID(v)
+}
+
+// ドキュメントからドメインイベントを復元する。
+//
+// 未知のイベント名の場合はエラーを返却する。
+// 値が不正な場合はエラーを返却する。
+func (d *OutboxDocument) toEvent() (entity.Event, error) {
+	id, err := entity.NewID(d.BookmarkID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := entity.NewUserID(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+	switch d.EventName {
+	case entity.EventBookmarkRegistered:
+		name, err := entity.NewName(d.Name)
+		if err != nil {
+			return nil, err
+		}
+		uri, err := entity.NewURI(d.URI)
+		if err != nil {
+			return nil, err
+		}
+		description, err := entity.NewDescription(d.Description)
+		if err != nil {
+			return nil, err
+		}
+		tags, err := toTags(d.Tags)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkRegistered(id, userID, name, uri, description, tags)
+		return *event, nil
+	case entity.EventBookmarkRenamed:
+		before, err := entity.NewName(d.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := entity.NewName(d.After)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkRenamed(id, userID, before, after)
+		return *event, nil
+	case entity.EventBookmarkURIRewritten:
+		before, err := entity.NewURI(d.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := entity.NewURI(d.After)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkURIRewritten(id, userID, before, after)
+		return *event, nil
+	case entity.EventBookmarkTagged:
+		tags, err := toTags(d.Tags)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkTagged(id, userID, tags)
+		return *event, nil
+	case entity.EventBookmarkDeleted:
+		event, _ := entity.NewBookmarkDeleted(id, userID)
+		return *event, nil
+	case entity.EventBookmarkUntagged:
+		tags, err := toTags(d.Tags)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkUntagged(id, userID, tags)
+		return *event, nil
+	case entity.EventBookmarkDescribed:
+		before, err := entity.NewDescription(d.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := entity.NewDescription(d.After)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkDescribed(id, userID, before, after)
+		return *event, nil
+	case entity.EventBookmarkMoved:
+		before, err := toFolder(d.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := toFolder(d.After)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkMoved(id, userID, before, after)
+		return *event, nil
+	case entity.EventBookmarkStatusChanged:
+		before, err := entity.NewStatus(d.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := entity.NewStatus(d.After)
+		if err != nil {
+			return nil, err
+		}
+		event, _ := entity.NewBookmarkStatusChanged(id, userID, before, after)
+		return *event, nil
+	case entity.EventBookmarkStarred:
+		event, _ := entity.NewBookmarkStarred(id, userID)
+		return *event, nil
+	case entity.EventBookmarkUnstarred:
+		event, _ := entity.NewBookmarkUnstarred(id, userID)
+		return *event, nil
+	case entity.EventBookmarkRestored:
+		event, _ := entity.NewBookmarkRestored(id, userID)
+		return *event, nil
+	case entity.EventBookmarkPurged:
+		event, _ := entity.NewBookmarkPurged(id, userID)
+		return *event, nil
+	}
+	return nil, fmt.Errorf("unknown event: %s", d.EventName)
+}
+
+// 文字列のスライスをタグ一覧に変換する。
+func toTags(values []string) ([]entity.Tag, error) {
+	tags := make([]entity.Tag, len(values))
+	for i, v := range values {
+		tag, err := entity.NewTag(v)
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = *tag
+	}
+	return tags, nil
+}
+
+// 送信箱に記録したドメインイベントを配信する中継器。
+//
+// 未配信のドメインイベントを記録した順に読み出してハンドラに渡し、ハンドラが処理を終えた後に配信済みにする。
+// ハンドラがエラーを返却した場合、あるいは配信済みにする前に異常終了した場合は同じドメインイベントを再び渡す。
+// 復元できないドキュメントは配信済みにせず、隔離先のコレクションに移して後から調査できるようにする。
+// 購読者には少なくとも1回の配信を保証する。
+type OutboxRelay struct {
+	collection *mongo.Collection                         // 送信箱のコレクション
+	poison     *mongo.Collection                         // 復元できないドキュメントの隔離先のコレクション
+	handler    func(context.Context, entity.Event) error // ドメインイベントを処理するハンドラ
+	clock      clock.Clock                               // 時計
+	logger     *zap.Logger                               // ロガー
+	interval   time.Duration                             // 未配信のドメインイベントが無い場合の待機時間
+	batchSize  int                                       // 1回に読み出すドメインイベントの最大件数
+	wake       chan struct{}                             // 待機を打ち切る通知
+}
+
+// 送信箱に記録したドメインイベントを配信する中継器を生成する。
+//
+// 最大件数に1未満を指定した場合は1件とする。
+func NewOutboxRelay(collection, poison *mongo.Collection, handler func(context.Context, entity.Event) error, clock clock.Clock, logger *zap.Logger, interval time.Duration, batchSize int) *OutboxRelay {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &OutboxRelay{
+		collection: collection,
+		poison:     poison,
+		handler:    handler,
+		clock:      clock,
+		logger:     logger,
+		interval:   interval,
+		batchSize:  batchSize,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// ドメインイベントの発行を通知する。
+//
+// 待機中の中継器は待機時間の経過を待たずに送信箱を読み出す。
+// ドメインイベント自体は送信箱から読み出すため、引数のドメインイベントは用いない。
+// 通知が既に保留されている場合は何もせず、発行元の処理を妨げない。
+func (r *OutboxRelay) Notify(entity.Event) {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// 送信箱に記録したドメインイベントを配信する。
+//
+// コンテキストが終了するまで配信を続ける。
+// 未配信のドメインイベントが最大件数に満たない場合、あるいは配信に失敗した場合は待機してから再び読み出す。
+// 待機中にドメインイベントの発行が通知された場合は直ちに読み出す。
+//
+// nilを指定した場合はエラーを返却する。
+// コンテキストが終了した場合はコンテキストのエラーを返却する。
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	if ctx == nil {
+		return fmt.Errorf("argument \"ctx\" is nil")
+	}
+	for {
+		count, err := r.relay(ctx)
+		if err != nil {
+			r.logger.Error("Failed to relay the outbox", zap.Error(err))
+		}
+		if err == nil && count == r.batchSize {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		timer := time.NewTimer(r.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-r.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// 未配信のドメインイベントを最大件数まで読み出して配信する。
+//
+// 読み出したドキュメントの件数を返却する。
+// 復元できないドキュメントはハンドラに渡さずに隔離先のコレクションに移す。
+// ハンドラがエラーを返却した場合は配信済みにせず、以降のドキュメントも次回に読み出す。
+//
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+// ドキュメントの隔離に失敗した場合はエラーを返却する。
+// ハンドラがエラーを返却した場合はエラーを返却する。
+// ドキュメントの更新に失敗した場合はエラーを返却する。
+//
+//	db.outbox.find({dispatchedAt: null}).sort({createdAt: 1, position: 1, _id: 1}).limit(100)
+//	db.outbox.updateOne({_id: "ID", dispatchedAt: null}, {$set: {dispatchedAt: ISODate("Now")}})
+func (r *OutboxRelay) relay(ctx context.Context) (int, error) {
+	filter := bson.D{{Key: "dispatchedAt", Value: nil}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "position", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(r.batchSize))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []OutboxDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return 0, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	for i, document := range documents {
+		event, err := document.toEvent()
+		if err != nil {
+			r.logger.Error("Failed to restore the event", zap.String("id", document.ID), zap.Error(err))
+			if err := r.quarantine(ctx, document, err); err != nil {
+				return i, err
+			}
+			continue
+		}
+		if err := r.handler(ctx, event); err != nil {
+			return i, fmt.Errorf("failed at handler: %w", err)
+		}
+		filter := bson.D{{Key: "_id", Value: document.ID}, {Key: "dispatchedAt", Value: nil}}
+		update := bson.M{"$set": bson.M{"dispatchedAt": r.clock.Now()}}
+		if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+			return i, fmt.Errorf("failed at collection.UpdateOne: %w", err)
+		}
+	}
+	return len(documents), nil
+}
+
+// 隔離した送信箱のドキュメント。
+type PoisonedOutboxDocument struct {
+	OutboxDocument `bson:",inline"`
+	Reason         string    `bson:"reason"`     // 復元できない理由
+	PoisonedAt     time.Time `bson:"poisonedAt"` // 隔離日時
+}
+
+// 復元できないドキュメントを隔離先のコレクションに移す。
+//
+// 隔離先に挿入してから送信箱から削除する。
+// 削除する前に異常終了した場合は次回に再び隔離するため、隔離先に同じIDのドキュメントが既にある場合は挿入を省く。
+//
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+//
+//	db.poison.insertOne({...document, reason: "Reason", poisonedAt: ISODate("Now")})
+//	db.outbox.deleteOne({_id: "ID", dispatchedAt: null})
+func (r *OutboxRelay) quarantine(ctx context.Context, document OutboxDocument, reason error) error {
+	poisoned := PoisonedOutboxDocument{
+		OutboxDocument: document,
+		Reason:         reason.Error(),
+		PoisonedAt:     r.clock.Now(),
+	}
+	if _, err := r.poison.InsertOne(ctx, poisoned); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed at poison.InsertOne: %w", err)
+	}
+	filter := bson.D{{Key: "_id", Value: document.ID}, {Key: "dispatchedAt", Value: nil}}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		return fmt.Errorf("failed at collection.DeleteOne: %w", err)
+	}
+	return nil
+}
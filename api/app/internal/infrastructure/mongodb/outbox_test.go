@@ -0,0 +1,391 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+)
+
+func toOutboxEvents(t *testing.T) map[string]entity.Event {
+	t.Helper()
+	id := helper.ToID(t, "1")
+	userID := helper.ToUserID(t, helper.UserID)
+	registered, _ := entity.NewBookmarkRegistered(id, userID, helper.ToName(t, "Example"), helper.ToURI(t, "https://example.com"), helper.ToDescription(t, "memo"), helper.ToTags(t, "foo", "bar"))
+	renamed, _ := entity.NewBookmarkRenamed(id, userID, helper.ToName(t, "Example"), helper.ToName(t, "EXAMPLE"))
+	rewritten, _ := entity.NewBookmarkURIRewritten(id, userID, helper.ToURI(t, "https://example.com"), helper.ToURI(t, "http://example.com"))
+	tagged, _ := entity.NewBookmarkTagged(id, userID, helper.ToTags(t, "baz"))
+	deleted, _ := entity.NewBookmarkDeleted(id, userID)
+	untagged, _ := entity.NewBookmarkUntagged(id, userID, helper.ToTags(t, "foo"))
+	described, _ := entity.NewBookmarkDescribed(id, userID, helper.ToDescription(t, ""), helper.ToDescription(t, "memo"))
+	moved, _ := entity.NewBookmarkMoved(id, userID, nil, helper.ToID(t, "10"))
+	statusChanged, _ := entity.NewBookmarkStatusChanged(id, userID, helper.ToStatus(t, "unread"), helper.ToStatus(t, "read"))
+	starred, _ := entity.NewBookmarkStarred(id, userID)
+	unstarred, _ := entity.NewBookmarkUnstarred(id, userID)
+	restored, _ := entity.NewBookmarkRestored(id, userID)
+	purged, _ := entity.NewBookmarkPurged(id, userID)
+	return map[string]entity.Event{
+		"BookmarkRegistered":    *registered,
+		"BookmarkRenamed":       *renamed,
+		"BookmarkURIRewritten":  *rewritten,
+		"BookmarkTagged":        *tagged,
+		"BookmarkDeleted":       *deleted,
+		"BookmarkUntagged":      *untagged,
+		"BookmarkDescribed":     *described,
+		"BookmarkMoved":         *moved,
+		"BookmarkStatusChanged": *statusChanged,
+		"BookmarkStarred":       *starred,
+		"BookmarkUnstarred":     *unstarred,
+		"BookmarkRestored":      *restored,
+		"BookmarkPurged":        *purged,
+	}
+}
+
+func TestNewOutboxDocuments(t *testing.T) {
+	t.Parallel()
+	events := toOutboxEvents(t)
+	// given
+	ordered := []entity.Event{events["BookmarkRenamed"], events["BookmarkTagged"]}
+	// when
+	documents := newOutboxDocuments(ordered, now)
+	// then
+	if assert.Len(t, documents, 2) {
+		first := documents[0].(OutboxDocument)
+		second := documents[1].(OutboxDocument)
+		assert.NotEmpty(t, first.ID)
+		assert.NotEqual(t, first.ID, second.ID)
+		assert.Exactly(t, OutboxDocument{ID: first.ID, EventName: "BookmarkRenamed", BookmarkID: "1", UserID: helper.UserID, Before: "Example", After: "EXAMPLE", CreatedAt: now, Position: 0}, first)
+		assert.Exactly(t, OutboxDocument{ID: second.ID, EventName: "BookmarkTagged", BookmarkID: "1", UserID: helper.UserID, Tags: []string{"baz"}, CreatedAt: now, Position: 1}, second)
+	}
+}
+
+func TestOutboxDocument_toEvent(t *testing.T) {
+	t.Parallel()
+	events := toOutboxEvents(t)
+	for name, event := range events {
+		event := event
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			document := newOutboxDocuments([]entity.Event{event}, now)[0].(OutboxDocument)
+			// when
+			actualEvent, actualErr := document.toEvent()
+			// then
+			assert.Exactly(t, event, actualEvent)
+			assert.NoError(t, actualErr)
+		})
+	}
+	t.Run("unknown event", func(t *testing.T) {
+		t.Parallel()
+		// given
+		document := OutboxDocument{ID: "100", EventName: "BookmarkArchived", BookmarkID: "1", UserID: helper.UserID}
+		// when
+		actualEvent, actualErr := document.toEvent()
+		// then
+		assert.Nil(t, actualEvent)
+		assert.Exactly(t, errors.New("unknown event: BookmarkArchived"), actualErr)
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		// given
+		document := OutboxDocument{ID: "100", EventName: "BookmarkRenamed", BookmarkID: "1", UserID: helper.UserID, Before: "Example"}
+		// when
+		actualEvent, actualErr := document.toEvent()
+		// then
+		assert.Nil(t, actualEvent)
+		assert.Error(t, actualErr)
+	})
+}
+
+func TestNewOutboxRelay(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		batchSize         int
+		expectedBatchSize int
+	}{
+		"positive batch size": {100, 100},
+		"zero batch size":     {0, 1},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			// given
+			collection := mt.Coll
+			poison := mt.DB.Collection("poison")
+			// when
+			relay := NewOutboxRelay(collection, poison, func(context.Context, entity.Event) error { return nil }, helper.ToFixedClock(t, now), zap.NewNop(), time.Second, tc.batchSize)
+			// then
+			assert.Exactly(mt, collection, relay.collection)
+			assert.Exactly(mt, poison, relay.poison)
+			assert.Exactly(mt, time.Second, relay.interval)
+			assert.Exactly(mt, tc.expectedBatchSize, relay.batchSize)
+		})
+	}
+}
+
+func TestOutboxRelay_relay(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	updated := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+	inserted := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1})
+	deleted := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1})
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		failedEvent      string
+		expectedCount    int
+		expectedEvents   []string
+		expectedCommands []string
+		expectedErr      error
+	}{
+		"pending documents": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkRenamed", "1", bson.E{Key: "before", Value: "Example"}, bson.E{Key: "after", Value: "EXAMPLE"}),
+						helper.ToOutboxDocument(t, "101", "BookmarkDeleted", "1"),
+					),
+					updated,
+					updated,
+				)
+			},
+			"",
+			2,
+			[]string{"BookmarkRenamed:1", "BookmarkDeleted:1"},
+			[]string{"find", "update", "update"},
+			nil,
+		},
+		"no pending documents": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			"",
+			0,
+			[]string{},
+			[]string{"find"},
+			nil,
+		},
+		"unrestorable document": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkArchived", "1"),
+						helper.ToOutboxDocument(t, "101", "BookmarkDeleted", "1"),
+					),
+					inserted,
+					deleted,
+					updated,
+				)
+			},
+			"",
+			2,
+			[]string{"BookmarkDeleted:1"},
+			[]string{"find", "insert", "delete", "update"},
+			nil,
+		},
+		"unrestorable document already poisoned": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkArchived", "1"),
+					),
+					mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}),
+					deleted,
+				)
+			},
+			"",
+			1,
+			[]string{},
+			[]string{"find", "insert", "delete"},
+			nil,
+		},
+		"failed at poison.InsertOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkArchived", "1"),
+						helper.ToOutboxDocument(t, "101", "BookmarkDeleted", "1"),
+					),
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			"",
+			0,
+			[]string{},
+			[]string{"find", "insert"},
+			errors.New("failed at poison.InsertOne: command failed"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkArchived", "1"),
+						helper.ToOutboxDocument(t, "101", "BookmarkDeleted", "1"),
+					),
+					inserted,
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			"",
+			0,
+			[]string{},
+			[]string{"find", "insert", "delete"},
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			"",
+			0,
+			[]string{},
+			[]string{"find"},
+			errors.New("failed at collection.Find: command failed"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkDeleted", "1"),
+						helper.ToOutboxDocument(t, "101", "BookmarkDeleted", "2"),
+					),
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			"",
+			0,
+			[]string{"BookmarkDeleted:1"},
+			[]string{"find", "update"},
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+		"failed at handler": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch,
+						helper.ToOutboxDocument(t, "100", "BookmarkDeleted", "1"),
+						helper.ToOutboxDocument(t, "101", "BookmarkDeleted", "2"),
+						helper.ToOutboxDocument(t, "102", "BookmarkDeleted", "3"),
+					),
+					updated,
+				)
+			},
+			"BookmarkDeleted:2",
+			1,
+			[]string{"BookmarkDeleted:1", "BookmarkDeleted:2"},
+			[]string{"find", "update"},
+			errors.New("failed at handler: error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			actualEvents := []string{}
+			handler := func(_ context.Context, event entity.Event) error {
+				id := event.BookmarkID()
+				actualEvent := event.EventName() + ":" + id.Value()
+				actualEvents = append(actualEvents, actualEvent)
+				if actualEvent == tc.failedEvent {
+					return errors.New("error")
+				}
+				return nil
+			}
+			relay := NewOutboxRelay(mt.Coll, mt.DB.Collection("poison"), handler, helper.ToFixedClock(t, now), zap.NewNop(), time.Second, 100)
+			// when
+			actualCount, actualErr := relay.relay(context.Background())
+			// then
+			assert.Exactly(mt, tc.expectedCount, actualCount)
+			assert.Exactly(mt, tc.expectedEvents, actualEvents)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+			actualCommands := []string{}
+			for _, event := range mt.GetAllStartedEvents() {
+				actualCommands = append(actualCommands, event.CommandName)
+			}
+			assert.Exactly(mt, tc.expectedCommands, actualCommands)
+		})
+	}
+}
+
+func TestOutboxRelay_Run(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("nil context", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		relay := NewOutboxRelay(mt.Coll, mt.DB.Collection("poison"), func(context.Context, entity.Event) error { return nil }, helper.ToFixedClock(t, now), zap.NewNop(), time.Millisecond, 100)
+		// when
+		var ctx context.Context
+		actualErr := relay.Run(ctx)
+		// then
+		assert.Exactly(mt, errors.New("argument \"ctx\" is nil"), actualErr)
+	})
+	mt.Run("dispatch until cancellation", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, helper.ToOutboxDocument(t, "100", "BookmarkDeleted", "1")),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		var once sync.Once
+		handled := make(chan entity.Event, 1)
+		relay := NewOutboxRelay(mt.Coll, mt.DB.Collection("poison"), func(_ context.Context, event entity.Event) error {
+			once.Do(func() { handled <- event })
+			return nil
+		}, helper.ToFixedClock(t, now), zap.NewNop(), time.Millisecond, 100)
+		done := make(chan error, 1)
+		// when
+		go func() { done <- relay.Run(ctx) }()
+		event := <-handled
+		cancel()
+		actualErr := <-done
+		// then
+		assert.Exactly(mt, "BookmarkDeleted", event.EventName())
+		assert.Exactly(mt, context.Canceled, actualErr)
+	})
+	mt.Run("wake on notification", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, helper.ToOutboxDocument(t, "100", "BookmarkDeleted", "1")),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		var once sync.Once
+		handled := make(chan entity.Event, 1)
+		relay := NewOutboxRelay(mt.Coll, mt.DB.Collection("poison"), func(_ context.Context, event entity.Event) error {
+			once.Do(func() { handled <- event })
+			return nil
+		}, helper.ToFixedClock(t, now), zap.NewNop(), time.Hour, 100)
+		done := make(chan error, 1)
+		// when
+		relay.Notify(nil)
+		relay.Notify(nil)
+		go func() { done <- relay.Run(ctx) }()
+		event := <-handled
+		cancel()
+		actualErr := <-done
+		// then
+		assert.Exactly(mt, "BookmarkDeleted", event.EventName())
+		assert.Exactly(mt, context.Canceled, actualErr)
+	})
+}
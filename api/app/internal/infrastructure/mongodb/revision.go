@@ -0,0 +1,216 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ブックマークの改訂履歴の永続化を担うリポジトリの具象型。
+type revisionRepository struct {
+	collection *mongo.Collection // コレクション
+	clock      clock.Clock       // 時計
+}
+
+// ブックマークの改訂履歴の永続化を担うリポジトリを生成する。
+func NewRevisionRepository(collection *mongo.Collection, clock clock.Clock) repository.Revision {
+	return &revisionRepository{
+		collection: collection,
+		clock:      clock,
+	}
+}
+
+// 改訂に関するドキュメント。
+type RevisionDocument struct {
+	ID         string           `bson:"_id"`        // ID
+	BookmarkID string           `bson:"bookmarkID"` // 変更したブックマークのID
+	Version    uint64           `bson:"version"`    // 変更後のブックマークの版数
+	Actor      string           `bson:"actor"`      // 変更者
+	Before     SnapshotDocument `bson:"before"`     // 変更前の内容
+	After      SnapshotDocument `bson:"after"`      // 変更後の内容
+	CreatedAt  time.Time        `bson:"createdAt"`  // 作成日時
+}
+
+// ブックマークの内容に関するドキュメント。
+type SnapshotDocument struct {
+	Name        string   `bson:"name"`        // ブックマーク名
+	URI         string   `bson:"uri"`         // URI
+	Description string   `bson:"description"` // 説明
+	Tags        []string `bson:"tags"`        // タグ一覧
+	FolderID    string   `bson:"folderID"`    // 所属するフォルダのID (最上位の場合は空文字列)
+	Status      string   `bson:"status"`      // 状態 (存在しない場合は未読)
+	Starred     bool     `bson:"starred"`     // お気に入りに登録されているか
+	Trashed     bool     `bson:"trashed"`     // ゴミ箱にあるか
+}
+
+// ブックマークの内容を表す値オブジェクトからドキュメントを生成する。
+func toSnapshotDocument(snapshot entity.Snapshot) SnapshotDocument {
+	name := snapshot.Name()
+	uri := snapshot.URI()
+	description := snapshot.Description()
+	tags := make([]string, len(snapshot.Tags()))
+	for i, tag := range snapshot.Tags() {
+		tags[i] = tag.Value()
+	}
+	folderID := ""
+	if folder := snapshot.Folder(); folder != nil {
+		folderID = folder.Value()
+	}
+	return SnapshotDocument{
+		Name:        name.Value(),
+		URI:         uri.String(),
+		Description: description.Value(),
+		Tags:        tags,
+		FolderID:    folderID,
+		Status:      snapshot.Status().Value(),
+		Starred:     snapshot.Starred(),
+		Trashed:     snapshot.IsTrashed(),
+	}
+}
+
+// ドキュメントからブックマークの内容を表す値オブジェクトを生成する。
+func (d *SnapshotDocument) toValue() *entity.Snapshot {
+	name, _ := entity.NewName(d.Name)
+	uri, _ := entity.NewURI(d.URI)
+	description, _ := entity.NewDescription(d.Description)
+	tags := make([]entity.Tag, len(d.Tags))
+	for i, v := range d.Tags {
+		tag, _ := entity.NewTag(v)
+		tags[i] = *tag
+	}
+	snapshot, err := entity.NewSnapshot(name, uri, description, tags)
+	if err != nil {
+		return nil
+	}
+	folder, _ := entity.NewID(d.FolderID)
+	status := entity.StatusUnread
+	if v, err := entity.NewStatus(d.Status); err == nil {
+		status = *v
+	}
+	snapshot.SetState(folder, status, d.Starred, d.Trashed)
+	return snapshot
+}
+
+// ドキュメントから改訂を表すエンティティを生成する。
+func (d *RevisionDocument) toEntity() *entity.Revision {
+	id, _ := entity.NewID(d.ID)
+	bookmarkID, _ := entity.NewID(d.BookmarkID)
+	revision, err := entity.NewRevision(id, bookmarkID, d.Version, d.Actor, d.Before.toValue(), d.After.toValue())
+	if err != nil {
+		return nil
+	}
+	revision.SetCreatedAt(d.CreatedAt)
+	return revision
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *revisionRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// 改訂を保存する。
+//
+// 保存に成功した場合は改訂の作成日時を設定する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの挿入に失敗した場合はエラーを返却する。
+//
+//	db.revisions.insertOne({
+//	  _id: "ID", bookmarkID: "BookmarkID", version: 2, actor: "Actor",
+//	  before: {name: "Name", uri: "URI", description: "Description", tags: ["1"], folderID: "", status: "unread", starred: false, trashed: false},
+//	  after: {name: "Name", uri: "URI", description: "Description", tags: ["1", "2"], folderID: "", status: "unread", starred: false, trashed: false},
+//	  createdAt: ISODate("2022-01-02T00:00:00Z")
+//	})
+func (r *revisionRepository) Save(revision *entity.Revision) error {
+	if revision == nil {
+		return fmt.Errorf("argument \"revision\" is nil")
+	}
+	ctx := context.Background()
+	now := r.clock.Now()
+	id := revision.ID()
+	bookmarkID := revision.BookmarkID()
+	document := RevisionDocument{
+		ID:         id.Value(),
+		BookmarkID: bookmarkID.Value(),
+		Version:    revision.Version(),
+		Actor:      revision.Actor(),
+		Before:     toSnapshotDocument(revision.Before()),
+		After:      toSnapshotDocument(revision.After()),
+		CreatedAt:  now,
+	}
+	if _, err := r.collection.InsertOne(ctx, document); err != nil {
+		return fmt.Errorf("failed at collection.InsertOne: %w", err)
+	}
+	revision.SetCreatedAt(now)
+	return nil
+}
+
+// IDから改訂を検索する。
+//
+// 該当する改訂が存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.revisions.findOne({_id: "ID"})
+func (r *revisionRepository) FindByID(id *entity.ID) (*entity.Revision, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "_id", Value: id.Value()}}
+	result := r.collection.FindOne(ctx, filter)
+	var document RevisionDocument
+	err := result.Decode(&document)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.FindOne: %w", err)
+	}
+	return document.toEntity(), nil
+}
+
+// ブックマークのIDから改訂一覧を検索する。
+//
+// 作成日時の降順、作成日時が等しい場合は版数の降順に返却する。
+// 該当する改訂が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.revisions.find({bookmarkID: "BookmarkID"}).sort({createdAt: -1, version: -1})
+func (r *revisionRepository) FindByBookmarkID(bookmarkID *entity.ID) ([]entity.Revision, error) {
+	if bookmarkID == nil {
+		return nil, fmt.Errorf("argument \"bookmarkID\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "bookmarkID", Value: bookmarkID.Value()}}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "version", Value: -1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []RevisionDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	revisions := make([]entity.Revision, len(documents))
+	for i, document := range documents {
+		revisions[i] = *document.toEntity()
+	}
+	return revisions, nil
+}
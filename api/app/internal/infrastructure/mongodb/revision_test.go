@@ -0,0 +1,291 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewRevisionRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.Revision", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewRevisionRepository(collection, helper.ToFixedClock(t, now))
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.Revision)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewRevisionRepository(collection, helper.ToFixedClock(t, now))
+		// when
+		concreteRepository, ok := abstractRepository.(*revisionRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestRevision_NextID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	// given
+	collection := mt.Coll
+	repository := NewRevisionRepository(collection, helper.ToFixedClock(t, now))
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+	expectedType := &entity.ID{}
+	assert.IsType(t, expectedType, id)
+}
+
+func TestRevision_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	before := helper.ToSnapshot(t, "Example", "https://example.com", "foo")
+	after := helper.ToSnapshot(t, "Example Domain", "https://example.com", "foo")
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		revision         *entity.Revision
+		expectedRevision *entity.Revision
+		expectedErr      error
+	}{
+		"new revision": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			},
+			helper.ToRevision(t, "100", "1", 2, "alice", before, after),
+			helper.ToTimestampedRevision(t, now, "100", "1", 2, "alice", before, after),
+			nil,
+		},
+		"nil revision": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"revision\" is nil"),
+		},
+		"failed at collection.InsertOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+			},
+			helper.ToRevision(t, "100", "1", 2, "alice", before, after),
+			helper.ToRevision(t, "100", "1", 2, "alice", before, after),
+			errors.New("failed at collection.InsertOne: write exception: write errors: [duplicate key error]"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewRevisionRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualErr := repository.Save(tc.revision)
+			// then
+			assert.Exactly(mt, tc.expectedRevision, tc.revision)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestRevision_FindByID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		id               *entity.ID
+		expectedRevision *entity.Revision
+		expectedErr      error
+	}{
+		"id of stored revision": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToRevisionDocument(
+						t, now, "100", "1", 2, "alice",
+						helper.ToSnapshotDocument(t, "Example", "https://example.com", "foo"),
+						helper.ToSnapshotDocument(t, "Example Domain", "https://example.com", "foo"),
+					)),
+				)
+			},
+			helper.ToID(t, "100"),
+			helper.ToTimestampedRevision(
+				t, now, "100", "1", 2, "alice",
+				helper.ToSnapshot(t, "Example", "https://example.com", "foo"),
+				helper.ToSnapshot(t, "Example Domain", "https://example.com", "foo"),
+			),
+			nil,
+		},
+		"id of stored revision with state": {
+			func(mt *mtest.T) {
+				after := append(
+					helper.ToSnapshotDocument(t, "Example", "https://example.com", "foo"),
+					bson.E{Key: "folderID", Value: "10"},
+					bson.E{Key: "status", Value: "archived"},
+					bson.E{Key: "starred", Value: true},
+					bson.E{Key: "trashed", Value: true},
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToRevisionDocument(
+						t, now, "100", "1", 2, "alice",
+						helper.ToSnapshotDocument(t, "Example", "https://example.com", "foo"),
+						after,
+					)),
+				)
+			},
+			helper.ToID(t, "100"),
+			helper.ToTimestampedRevision(
+				t, now, "100", "1", 2, "alice",
+				helper.ToSnapshot(t, "Example", "https://example.com", "foo"),
+				helper.ToStatefulSnapshot(t, helper.ToID(t, "10"), entity.StatusArchived, true, true, "Example", "https://example.com", "foo"),
+			),
+			nil,
+		},
+		"id of unstored revision": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToID(t, "100"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToID(t, "100"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewRevisionRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualRevision, actualErr := repository.FindByID(tc.id)
+			// then
+			assert.Exactly(mt, tc.expectedRevision, actualRevision)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestRevision_FindByBookmarkID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	a := helper.ToSnapshot(t, "Example A", "https://example.com")
+	b := helper.ToSnapshot(t, "Example B", "https://example.com")
+	cases := map[string]struct {
+		prepare           func(*mtest.T)
+		bookmarkID        *entity.ID
+		expectedRevisions []entity.Revision
+		expectedErr       error
+	}{
+		"bookmark with revisions": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToRevisionDocument(
+						t, now, "101", "1", 3, "bob",
+						helper.ToSnapshotDocument(t, "Example B", "https://example.com"),
+						helper.ToSnapshotDocument(t, "Example A", "https://example.com"),
+					)),
+				)
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToRevisionDocument(
+						t, earlier, "100", "1", 2, "alice",
+						helper.ToSnapshotDocument(t, "Example A", "https://example.com"),
+						helper.ToSnapshotDocument(t, "Example B", "https://example.com"),
+					)),
+				)
+			},
+			helper.ToID(t, "1"),
+			[]entity.Revision{
+				*helper.ToTimestampedRevision(t, now, "101", "1", 3, "bob", b, a),
+				*helper.ToTimestampedRevision(t, earlier, "100", "1", 2, "alice", a, b),
+			},
+			nil,
+		},
+		"bookmark without revisions": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToID(t, "1"),
+			[]entity.Revision{},
+			nil,
+		},
+		"nil bookmark id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"bookmarkID\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewRevisionRepository(collection, helper.ToFixedClock(t, now))
+			// when
+			actualRevisions, actualErr := repository.FindByBookmarkID(tc.bookmarkID)
+			// then
+			assert.Exactly(mt, tc.expectedRevisions, actualRevisions)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ブックマークの共有の永続化を担うリポジトリの具象型。
+type shareRepository struct {
+	collection *mongo.Collection // コレクション
+}
+
+// ブックマークの共有の永続化を担うリポジトリを生成する。
+func NewShareRepository(collection *mongo.Collection) repository.Share {
+	return &shareRepository{
+		collection: collection,
+	}
+}
+
+// ブックマークの共有に関するドキュメント。
+type ShareDocument struct {
+	ID         string `bson:"_id"`        // ID
+	Owner      string `bson:"owner"`      // 共有元のユーザID
+	Grantee    string `bson:"grantee"`    // 共有先のユーザID
+	BookmarkID string `bson:"bookmarkID"` // 共有するブックマークのID (コレクションを共有する場合は空文字列)
+	Tag        string `bson:"tag"`        // 共有するコレクションのタグ (ブックマークを共有する場合は空文字列)
+	Role       string `bson:"role"`       // 権限
+}
+
+// ドキュメントからブックマークの共有を表すエンティティを生成する。
+func (d *ShareDocument) toEntity() *entity.Share {
+	id, _ := entity.NewID(d.ID)
+	owner, _ := entity.NewUserID(d.Owner)
+	grantee, _ := entity.NewUserID(d.Grantee)
+	bookmarkID, _ := entity.NewID(d.BookmarkID)
+	tag, _ := entity.NewTag(d.Tag)
+	role, _ := entity.NewShareRole(d.Role)
+	if role == nil {
+		return nil
+	}
+	share, err := entity.NewShare(id, owner, grantee, bookmarkID, tag, *role)
+	if err != nil {
+		return nil
+	}
+	return share
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *shareRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// 共有を保存する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの保存に失敗した場合はエラーを返却する。
+//
+//	db.shares.updateOne(
+//	  {_id: "ID"},
+//	  {$set: {_id: "ID", owner: "Owner", grantee: "Grantee", bookmarkID: "BookmarkID", tag: "", role: "viewer"}},
+//	  {upsert: true}
+//	)
+func (r *shareRepository) Save(share *entity.Share) error {
+	if share == nil {
+		return fmt.Errorf("argument \"share\" is nil")
+	}
+	ctx := context.Background()
+	id := share.ID()
+	owner := share.Owner()
+	grantee := share.Grantee()
+	bookmarkID := ""
+	if v := share.BookmarkID(); v != nil {
+		bookmarkID = v.Value()
+	}
+	tag := ""
+	if v := share.Tag(); v != nil {
+		tag = v.Value()
+	}
+	document := ShareDocument{
+		ID:         id.Value(),
+		Owner:      owner.Value(),
+		Grantee:    grantee.Value(),
+		BookmarkID: bookmarkID,
+		Tag:        tag,
+		Role:       share.Role().Value(),
+	}
+	filter := bson.D{{Key: "_id", Value: id.Value()}}
+	update := bson.M{"$set": document}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.collection.UpdateOne(ctx, filter, update, opts); err != nil {
+		return fmt.Errorf("failed at collection.UpdateOne: %w", err)
+	}
+	return nil
+}
+
+// IDから共有を検索する。
+//
+// 該当する共有が存在しない場合はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.shares.findOne({_id: "ID"})
+func (r *shareRepository) FindByID(id *entity.ID) (*entity.Share, error) {
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "_id", Value: id.Value()}}
+	result := r.collection.FindOne(ctx, filter)
+	var document ShareDocument
+	err := result.Decode(&document)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.FindOne: %w", err)
+	}
+	return document.toEntity(), nil
+}
+
+// 共有元のユーザIDから共有一覧を検索する。
+//
+// IDの昇順に返却する。
+// 該当する共有が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.shares.find({owner: "Owner"}).sort({_id: 1})
+func (r *shareRepository) FindByOwner(owner *entity.UserID) ([]entity.Share, error) {
+	if owner == nil {
+		return nil, fmt.Errorf("argument \"owner\" is nil")
+	}
+	return r.find(bson.D{{Key: "owner", Value: owner.Value()}})
+}
+
+// 共有先のユーザIDから共有一覧を検索する。
+//
+// IDの昇順に返却する。
+// 該当する共有が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.shares.find({grantee: "Grantee"}).sort({_id: 1})
+func (r *shareRepository) FindByGrantee(grantee *entity.UserID) ([]entity.Share, error) {
+	if grantee == nil {
+		return nil, fmt.Errorf("argument \"grantee\" is nil")
+	}
+	return r.find(bson.D{{Key: "grantee", Value: grantee.Value()}})
+}
+
+// フィルタに該当する共有一覧をIDの昇順に検索する。
+func (r *shareRepository) find(filter bson.D) ([]entity.Share, error) {
+	ctx := context.Background()
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []ShareDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	shares := make([]entity.Share, len(documents))
+	for i, document := range documents {
+		shares[i] = *document.toEntity()
+	}
+	return shares, nil
+}
+
+// 共有を削除する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+//
+//	db.shares.deleteOne({_id: "ID"})
+func (r *shareRepository) Delete(share *entity.Share) error {
+	if share == nil {
+		return fmt.Errorf("argument \"share\" is nil")
+	}
+	ctx := context.Background()
+	id := share.ID()
+	filter := bson.D{{Key: "_id", Value: id.Value()}}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		return fmt.Errorf("failed at collection.DeleteOne: %w", err)
+	}
+	return nil
+}
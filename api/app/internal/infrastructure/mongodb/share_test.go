@@ -0,0 +1,356 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewShareRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.Share", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewShareRepository(collection)
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.Share)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewShareRepository(collection)
+		// when
+		concreteRepository, ok := abstractRepository.(*shareRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestShare_NextID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	// given
+	collection := mt.Coll
+	repository := NewShareRepository(collection)
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+	expectedType := &entity.ID{}
+	assert.IsType(t, expectedType, id)
+}
+
+func TestShare_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		share       *entity.Share
+		expectedErr error
+	}{
+		"non-nil share": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			nil,
+		},
+		"nil share": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"share\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewShareRepository(collection)
+			// when
+			actualErr := repository.Save(tc.share)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestShare_FindByID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare       func(*mtest.T)
+		id            *entity.ID
+		expectedShare *entity.Share
+		expectedErr   error
+	}{
+		"id of stored share": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToShareDocument(t, "1", "alice", "bob", "10", "", "viewer")),
+				)
+			},
+			helper.ToID(t, "1"),
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			nil,
+		},
+		"id of unstored share": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewShareRepository(collection)
+			// when
+			actualShare, actualErr := repository.FindByID(tc.id)
+			// then
+			assert.Exactly(mt, tc.expectedShare, actualShare)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestShare_FindByOwner(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare        func(*mtest.T)
+		owner          *entity.UserID
+		expectedShares []entity.Share
+		expectedErr    error
+	}{
+		"stored shares": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToShareDocument(t, "1", "alice", "bob", "10", "", "viewer")),
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToShareDocument(t, "2", "alice", "carol", "", "golang", "editor")),
+				)
+			},
+			helper.ToUserID(t, "alice"),
+			[]entity.Share{
+				*helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+				*helper.ToCollectionShare(t, "2", "alice", "carol", "golang", entity.ShareRoleEditor),
+			},
+			nil,
+		},
+		"no shares": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, "alice"),
+			[]entity.Share{},
+			nil,
+		},
+		"nil owner": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"owner\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, "alice"),
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewShareRepository(collection)
+			// when
+			actualShares, actualErr := repository.FindByOwner(tc.owner)
+			// then
+			assert.Exactly(mt, tc.expectedShares, actualShares)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestShare_FindByGrantee(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare        func(*mtest.T)
+		grantee        *entity.UserID
+		expectedShares []entity.Share
+		expectedErr    error
+	}{
+		"stored shares": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToShareDocument(t, "1", "alice", "bob", "10", "", "viewer")),
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToShareDocument(t, "2", "carol", "bob", "", "golang", "editor")),
+				)
+			},
+			helper.ToUserID(t, "bob"),
+			[]entity.Share{
+				*helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+				*helper.ToCollectionShare(t, "2", "carol", "bob", "golang", entity.ShareRoleEditor),
+			},
+			nil,
+		},
+		"no shares": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, "bob"),
+			[]entity.Share{},
+			nil,
+		},
+		"nil grantee": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"grantee\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, "bob"),
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewShareRepository(collection)
+			// when
+			actualShares, actualErr := repository.FindByGrantee(tc.grantee)
+			// then
+			assert.Exactly(mt, tc.expectedShares, actualShares)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestShare_Delete(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		share       *entity.Share
+		expectedErr error
+	}{
+		"stored share": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "acknowledged", Value: true}, bson.E{Key: "n", Value: 1}))
+			},
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			nil,
+		},
+		"nil share": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"share\" is nil"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToBookmarkShare(t, "1", "alice", "bob", "10", entity.ShareRoleViewer),
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewShareRepository(collection)
+			// when
+			actualErr := repository.Delete(tc.share)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
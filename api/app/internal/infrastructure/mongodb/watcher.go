@@ -0,0 +1,223 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ブックマークの変更の監視を担う具象型。
+//
+// MongoDBの変更ストリームを用いる。
+// 再開トークンには変更ストリームの再開トークンをBase64URLで符号化した文字列を用いる。
+type bookmarkWatcher struct {
+	collection *mongo.Collection // コレクション
+}
+
+// ブックマークの変更の監視を担う具象型を生成する。
+func NewBookmarkWatcher(collection *mongo.Collection) repository.BookmarkWatcher {
+	return &bookmarkWatcher{
+		collection: collection,
+	}
+}
+
+// 変更ストリームのイベントに関するドキュメント。
+type ChangeEventDocument struct {
+	OperationType     string             `bson:"operationType"`     // 操作の種類
+	DocumentKey       DocumentKey        `bson:"documentKey"`       // 変更されたドキュメントのキー
+	FullDocument      *BookmarkDocument  `bson:"fullDocument"`      // 変更後のドキュメント (削除された場合はnull)
+	UpdateDescription *UpdateDescription `bson:"updateDescription"` // 更新の内容 (更新以外の場合はnull)
+}
+
+// ドキュメントのキー。
+type DocumentKey struct {
+	ID string `bson:"_id"` // ID
+}
+
+// 更新の内容。
+type UpdateDescription struct {
+	UpdatedFields UpdatedFields `bson:"updatedFields"` // 更新されたフィールド
+}
+
+// 更新されたフィールドのうち、変更の監視で参照するフィールド。
+type UpdatedFields struct {
+	Tombstone *Tombstone `bson:"tombstone"` // 墓標 (完全な削除の直前に記録する)
+}
+
+// 完全に削除するドキュメントに記録する墓標。
+type Tombstone struct {
+	UserID string `bson:"userID"` // 削除するブックマークの所有者のユーザID
+}
+
+// 再開トークンを符号化する。
+func encodeResumeToken(token bson.Raw) string {
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// 再開トークンを復号する。
+//
+// 不正な再開トークンを指定した場合は ErrInvalidResumeToken を返却する。
+func decodeResumeToken(token string) (bson.Raw, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, repository.ErrInvalidResumeToken
+	}
+	raw := bson.Raw(bytes)
+	if err := raw.Validate(); err != nil {
+		return nil, repository.ErrInvalidResumeToken
+	}
+	return raw, nil
+}
+
+// 再開トークンから再開できないことを表すサーバのエラーコード。
+//
+// 形式は正しくても、履歴から失われた変更や他のコレクションの変更を表すトークンはサーバが拒否する。
+var unresumableErrorCodes = []int{
+	260, // InvalidResumeToken
+	280, // ChangeStreamFatalError
+	286, // ChangeStreamHistoryLost
+}
+
+// 再開トークンから再開できないことを表すエラーかを判定する。
+func isUnresumable(err error) bool {
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	for _, code := range unresumableErrorCodes {
+		if serverErr.HasErrorCode(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// ドキュメントからブックマークの変更を生成する。
+//
+// 挿入は作成として扱う。
+// 墓標の記録は完全な削除として扱う。
+// 更新と置換は、ゴミ箱に移動した場合は削除、それ以外の場合は更新として扱う。
+func (d *ChangeEventDocument) toChange(resumeToken string) repository.BookmarkChange {
+	id, _ := entity.NewID(d.DocumentKey.ID)
+	if d.UpdateDescription != nil && d.UpdateDescription.UpdatedFields.Tombstone != nil {
+		return repository.BookmarkChange{
+			Type:        repository.ChangeDeleted,
+			ID:          *id,
+			UserID:      toUserID(d.UpdateDescription.UpdatedFields.Tombstone.UserID),
+			ResumeToken: resumeToken,
+		}
+	}
+	var userID entity.UserID
+	var bookmark *entity.Bookmark
+	if d.FullDocument != nil {
+		userID = toUserID(d.FullDocument.UserID)
+		bookmark, _ = d.FullDocument.toEntity()
+	}
+	changeType := repository.ChangeUpdated
+	switch {
+	case d.OperationType == "insert":
+		changeType = repository.ChangeCreated
+	case bookmark == nil || bookmark.IsTrashed():
+		changeType = repository.ChangeDeleted
+	}
+	return repository.BookmarkChange{
+		Type:        changeType,
+		ID:          *id,
+		UserID:      userID,
+		Bookmark:    bookmark,
+		ResumeToken: resumeToken,
+	}
+}
+
+// 文字列からユーザIDを生成する。
+//
+// 不正なユーザIDの場合はゼロ値を返却する。
+func toUserID(v string) entity.UserID {
+	userID, err := entity.NewUserID(v)
+	if err != nil {
+		return entity.UserID{}
+	}
+	return *userID
+}
+
+// ブックマークの変更を監視する。
+//
+// 指定したユーザIDが所有するブックマークの変更が発生した順にハンドラを呼び出す。
+// 所有者による絞り込みは変更ストリームのパイプラインで行う。
+// 削除イベントは所有者を持たないため対象とせず、完全な削除は削除の直前に記録する墓標で検知する。
+// 再開トークンを指定した場合はトークンが表す変更の直後から、空文字列の場合は呼び出し以降の変更を対象とする。
+//
+// nilを指定した場合はエラーを返却する。
+// 再開トークンが不正な場合、あるいは再開できる範囲を過ぎている場合は ErrInvalidResumeToken を返却する。
+// 変更ストリームの開始に失敗した場合はエラーを返却する。
+// コンテキストが終了した場合はコンテキストのエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+// ハンドラがエラーを返却した場合はそのエラーを返却する。
+// 変更の受信に失敗した場合はエラーを返却する。
+//
+//	db.bookmarks.watch(
+//	  [{$match: {
+//	    operationType: {$in: ["insert", "update", "replace"]},
+//	    $or: [{"fullDocument.userID": "UserID"}, {"updateDescription.updatedFields.tombstone.userID": "UserID"}]
+//	  }}],
+//	  {fullDocument: "updateLookup", resumeAfter: {_data: "..."}}
+//	)
+func (w *bookmarkWatcher) Watch(ctx context.Context, userID *entity.UserID, resumeToken string, handler func(repository.BookmarkChange) error) error {
+	if ctx == nil {
+		return fmt.Errorf("argument \"ctx\" is nil")
+	}
+	if userID == nil {
+		return fmt.Errorf("argument \"userID\" is nil")
+	}
+	if handler == nil {
+		return fmt.Errorf("argument \"handler\" is nil")
+	}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		token, err := decodeResumeToken(resumeToken)
+		if err != nil {
+			return err
+		}
+		opts.SetResumeAfter(token)
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "fullDocument.userID", Value: userID.Value()}},
+				bson.D{{Key: "updateDescription.updatedFields.tombstone.userID", Value: userID.Value()}},
+			}},
+		}}},
+	}
+	stream, err := w.collection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		if resumeToken != "" && isUnresumable(err) {
+			return repository.ErrInvalidResumeToken
+		}
+		return fmt.Errorf("failed at collection.Watch: %w", err)
+	}
+	defer stream.Close(context.Background())
+	for stream.Next(ctx) {
+		var document ChangeEventDocument
+		if err := stream.Decode(&document); err != nil {
+			return fmt.Errorf("failed at changeStream.Decode: %w", err)
+		}
+		if err := handler(document.toChange(encodeResumeToken(stream.ResumeToken()))); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if resumeToken != "" && isUnresumable(stream.Err()) {
+		return repository.ErrInvalidResumeToken
+	}
+	return fmt.Errorf("failed at changeStream.Next: %w", stream.Err())
+}
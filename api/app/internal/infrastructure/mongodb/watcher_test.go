@@ -0,0 +1,255 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// ハンドラが監視を終了するために返却するエラー。
+var errStop = errors.New("stop")
+
+// 再開トークンを符号化した文字列を生成する。
+func toResumeToken(t *testing.T, data string) string {
+	t.Helper()
+	raw, err := bson.Marshal(bson.D{{Key: "_data", Value: data}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encodeResumeToken(raw)
+}
+
+func TestNewBookmarkWatcher(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.BookmarkWatcher", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewBookmarkWatcher(collection)
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.BookmarkWatcher)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractWatcher := NewBookmarkWatcher(collection)
+		// when
+		concreteWatcher, ok := abstractWatcher.(*bookmarkWatcher)
+		actualCollection := concreteWatcher.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestBookmarkWatcher_Watch(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	unresumable := func(code int32, name string) bson.D {
+		return bson.D{{Key: "ok", Value: 0}, {Key: "code", Value: code}, {Key: "codeName", Value: name}, {Key: "errmsg", Value: "error"}}
+	}
+	trashed := append(helper.ToBookmarkDocument(t, "2", "Example B", "https://b.example.com"), bson.E{Key: "deletedAt", Value: now})
+	cases := map[string]struct {
+		prepare         func(*mtest.T)
+		resumeToken     string
+		n               int
+		expectedChanges []string
+		expectedErr     error
+	}{
+		"changes": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch,
+					helper.ToChangeEventDocument(t, "A", "insert", "1", helper.ToBookmarkDocument(t, "1", "Example A", "https://a.example.com")),
+					helper.ToChangeEventDocument(t, "B", "update", "1", helper.ToBookmarkDocument(t, "1", "EXAMPLE A", "https://a.example.com")),
+					helper.ToChangeEventDocument(t, "C", "update", "2", trashed),
+					helper.ToChangeEventDocument(t, "D", "replace", "3", nil),
+					helper.ToTombstoneEventDocument(t, "E", "2", helper.UserID),
+				))
+			},
+			"",
+			5,
+			[]string{
+				"created:1:alice:" + toResumeToken(t, "A") + ":Example A",
+				"updated:1:alice:" + toResumeToken(t, "B") + ":EXAMPLE A",
+				"deleted:2:alice:" + toResumeToken(t, "C") + ":Example B",
+				"deleted:3::" + toResumeToken(t, "D") + ":",
+				"deleted:2:alice:" + toResumeToken(t, "E") + ":",
+			},
+			errStop,
+		},
+		"resume token": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch,
+					helper.ToChangeEventDocument(t, "B", "update", "1", helper.ToBookmarkDocument(t, "1", "EXAMPLE A", "https://a.example.com")),
+				))
+			},
+			toResumeToken(t, "A"),
+			1,
+			[]string{"updated:1:alice:" + toResumeToken(t, "B") + ":EXAMPLE A"},
+			errStop,
+		},
+		"malformed resume token": {
+			func(mt *mtest.T) {},
+			"!",
+			1,
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"invalid bson resume token": {
+			func(mt *mtest.T) {},
+			"AAAA",
+			1,
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"expired resume token": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(unresumable(286, "ChangeStreamHistoryLost"))
+			},
+			toResumeToken(t, "A"),
+			1,
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"foreign resume token": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(unresumable(260, "InvalidResumeToken"))
+			},
+			toResumeToken(t, "A"),
+			1,
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"resume token not found": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch),
+					unresumable(280, "ChangeStreamFatalError"),
+				)
+			},
+			toResumeToken(t, "A"),
+			1,
+			[]string{},
+			repository.ErrInvalidResumeToken,
+		},
+		"history lost without resume token": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(unresumable(286, "ChangeStreamHistoryLost"))
+			},
+			"",
+			1,
+			[]string{},
+			errors.New("failed at collection.Watch: (ChangeStreamHistoryLost) error"),
+		},
+		"failed at collection.Watch": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			"",
+			1,
+			[]string{},
+			errors.New("failed at collection.Watch: command failed"),
+		},
+		"failed at changeStream.Next": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch),
+					bson.D{{Key: "ok", Value: 0}},
+				)
+			},
+			"",
+			1,
+			[]string{},
+			errors.New("failed at changeStream.Next: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			watcher := NewBookmarkWatcher(mt.Coll)
+			actualChanges := []string{}
+			// when
+			actualErr := watcher.Watch(context.Background(), helper.ToUserID(t, helper.UserID), tc.resumeToken, func(change repository.BookmarkChange) error {
+				name := ""
+				if change.Bookmark != nil {
+					bookmarkName := change.Bookmark.Name()
+					name = bookmarkName.Value()
+				}
+				actualChanges = append(actualChanges, string(change.Type)+":"+change.ID.Value()+":"+change.UserID.Value()+":"+change.ResumeToken+":"+name)
+				if len(actualChanges) >= tc.n {
+					return errStop
+				}
+				return nil
+			})
+			// then
+			assert.Exactly(mt, tc.expectedChanges, actualChanges)
+			assert.EqualError(mt, actualErr, tc.expectedErr.Error())
+		})
+	}
+	mt.Run("canceled context", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch))
+		// given
+		watcher := NewBookmarkWatcher(mt.Coll)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		// when
+		actualErr := watcher.Watch(ctx, helper.ToUserID(t, helper.UserID), "", func(repository.BookmarkChange) error { return nil })
+		// then
+		assert.ErrorIs(mt, actualErr, context.Canceled)
+	})
+	mt.Run("nil handler", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		watcher := NewBookmarkWatcher(mt.Coll)
+		// when
+		actualErr := watcher.Watch(context.Background(), helper.ToUserID(t, helper.UserID), "", nil)
+		// then
+		assert.Exactly(mt, errors.New("argument \"handler\" is nil"), actualErr)
+	})
+	mt.Run("nil user id", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		watcher := NewBookmarkWatcher(mt.Coll)
+		// when
+		actualErr := watcher.Watch(context.Background(), nil, "", func(repository.BookmarkChange) error { return nil })
+		// then
+		assert.Exactly(mt, errors.New("argument \"userID\" is nil"), actualErr)
+	})
+	mt.Run("owner filter", func(mt *mtest.T) {
+		mt.Parallel()
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		// given
+		watcher := NewBookmarkWatcher(mt.Coll)
+		// when
+		watcher.Watch(context.Background(), helper.ToUserID(t, helper.UserID), "", func(repository.BookmarkChange) error { return nil })
+		// then
+		var pipeline []bson.D
+		assert.NoError(mt, mt.GetStartedEvent().Command.Lookup("pipeline").Unmarshal(&pipeline))
+		expectedStage := bson.D{{Key: "$match", Value: bson.D{
+			{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "fullDocument.userID", Value: helper.UserID}},
+				bson.D{{Key: "updateDescription.updatedFields.tombstone.userID", Value: helper.UserID}},
+			}},
+		}}}
+		assert.Exactly(mt, expectedStage, pipeline[len(pipeline)-1])
+	})
+}
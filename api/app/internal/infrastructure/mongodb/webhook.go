@@ -0,0 +1,178 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Webhookの購読の永続化を担うリポジトリの具象型。
+type webhookRepository struct {
+	collection *mongo.Collection // コレクション
+}
+
+// Webhookの購読の永続化を担うリポジトリを生成する。
+func NewWebhookRepository(collection *mongo.Collection) repository.Webhook {
+	return &webhookRepository{
+		collection: collection,
+	}
+}
+
+// Webhookの購読に関するドキュメント。
+type WebhookDocument struct {
+	ID     string   `bson:"_id"`    // ID
+	UserID string   `bson:"userID"` // 所有者のユーザID
+	URI    string   `bson:"uri"`    // 配信先のURI
+	Events []string `bson:"events"` // 購読するイベント名一覧
+	Secret string   `bson:"secret"` // 共有シークレット
+}
+
+// ドキュメントからWebhookの購読を表すエンティティを生成する。
+func (d *WebhookDocument) toEntity() *entity.Webhook {
+	id, _ := entity.NewID(d.ID)
+	userID, _ := entity.NewUserID(d.UserID)
+	uri, _ := entity.NewURI(d.URI)
+	events := d.Events
+	if events == nil {
+		events = []string{}
+	}
+	webhook, err := entity.NewWebhook(id, userID, uri, events, d.Secret)
+	if err != nil {
+		return nil
+	}
+	return webhook
+}
+
+// IDを生成する。
+//
+// バージョン4のUUIDを16進表記で生成する。
+func (r *webhookRepository) NextID() *entity.ID {
+	uuid, _ := uuid.NewRandom()
+	id, _ := entity.NewID(uuid.String())
+	return id
+}
+
+// Webhookの購読を保存する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの保存に失敗した場合はエラーを返却する。
+// 同じIDの他のユーザの購読が保存されている場合は、IDの重複によりドキュメントの保存に失敗する。
+//
+//	db.webhooks.updateOne(
+//	  {_id: "ID", userID: "UserID"},
+//	  {$set: {_id: "ID", userID: "UserID", uri: "URI", events: ["BookmarkRegistered"], secret: "Secret"}},
+//	  {upsert: true}
+//	)
+func (r *webhookRepository) Save(webhook *entity.Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("argument \"webhook\" is nil")
+	}
+	ctx := context.Background()
+	id := webhook.ID()
+	userID := webhook.UserID()
+	uri := webhook.URI()
+	document := WebhookDocument{
+		ID:     id.Value(),
+		UserID: userID.Value(),
+		URI:    uri.String(),
+		Events: webhook.Events(),
+		Secret: webhook.Secret(),
+	}
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(&userID)}
+	update := bson.M{"$set": document}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.collection.UpdateOne(ctx, filter, update, opts); err != nil {
+		return fmt.Errorf("failed at collection.UpdateOne: %w", err)
+	}
+	return nil
+}
+
+// IDからWebhookの購読を検索する。
+//
+// 該当する購読が存在しない場合はnilを返却する。
+// 他のユーザの購読はnilを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+//
+//	db.webhooks.findOne({_id: "ID", userID: "UserID"})
+func (r *webhookRepository) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Webhook, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	if id == nil {
+		return nil, fmt.Errorf("argument \"id\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(userID)}
+	result := r.collection.FindOne(ctx, filter)
+	var document WebhookDocument
+	err := result.Decode(&document)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.FindOne: %w", err)
+	}
+	return document.toEntity(), nil
+}
+
+// Webhookの購読を一覧取得する。
+//
+// IDの昇順に返却する。
+// 購読が存在しない場合は空のスライスを返却する。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの検索に失敗した場合はエラーを返却する。
+// ドキュメントのデコードに失敗した場合はエラーを返却する。
+//
+//	db.webhooks.find({userID: "UserID"}).sort({_id: 1})
+func (r *webhookRepository) FindAll(userID *entity.UserID) ([]entity.Webhook, error) {
+	if userID == nil {
+		return nil, fmt.Errorf("argument \"userID\" is nil")
+	}
+	ctx := context.Background()
+	filter := bson.D{ownerCondition(userID)}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed at collection.Find: %w", err)
+	}
+	var documents []WebhookDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed at cursor.All: %w", err)
+	}
+	webhooks := make([]entity.Webhook, len(documents))
+	for i, document := range documents {
+		webhooks[i] = *document.toEntity()
+	}
+	return webhooks, nil
+}
+
+// Webhookの購読を削除する。
+//
+// 他のユーザの購読は削除しない。
+//
+// nilを指定した場合はエラーを返却する。
+// ドキュメントの削除に失敗した場合はエラーを返却する。
+//
+//	db.webhooks.deleteOne({_id: "ID", userID: "UserID"})
+func (r *webhookRepository) Delete(webhook *entity.Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("argument \"webhook\" is nil")
+	}
+	ctx := context.Background()
+	id := webhook.ID()
+	userID := webhook.UserID()
+	filter := bson.D{{Key: "_id", Value: id.Value()}, ownerCondition(&userID)}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		return fmt.Errorf("failed at collection.DeleteOne: %w", err)
+	}
+	return nil
+}
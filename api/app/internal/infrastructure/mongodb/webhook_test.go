@@ -0,0 +1,300 @@
+package mongodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestNewWebhookRepository(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("implementing repository.Webhook", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		// when
+		object := NewWebhookRepository(collection)
+		// then
+		assert.NotNil(mt, object)
+		interfaceObject := (*repository.Webhook)(nil)
+		assert.Implements(mt, interfaceObject, object)
+	})
+	mt.Run("fields", func(mt *mtest.T) {
+		mt.Parallel()
+		// given
+		collection := mt.Coll
+		abstractRepository := NewWebhookRepository(collection)
+		// when
+		concreteRepository, ok := abstractRepository.(*webhookRepository)
+		actualCollection := concreteRepository.collection
+		// then
+		assert.True(mt, ok)
+		expectedCollection := collection
+		assert.Exactly(mt, expectedCollection, actualCollection)
+	})
+}
+
+func TestWebhook_NextID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	// given
+	collection := mt.Coll
+	repository := NewWebhookRepository(collection)
+	// when
+	id := repository.NextID()
+	// then
+	assert.NotNil(t, id)
+	expectedType := &entity.ID{}
+	assert.IsType(t, expectedType, id)
+}
+
+func TestWebhook_Save(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		webhook     *entity.Webhook
+		expectedErr error
+	}{
+		"non-nil webhook": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			},
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered),
+			nil,
+		},
+		"nil webhook": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"webhook\" is nil"),
+		},
+		"failed at collection.UpdateOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered),
+			errors.New("failed at collection.UpdateOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewWebhookRepository(collection)
+			// when
+			actualErr := repository.Save(tc.webhook)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestWebhook_FindByID(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare         func(*mtest.T)
+		userID          *entity.UserID
+		id              *entity.ID
+		expectedWebhook *entity.Webhook
+		expectedErr     error
+	}{
+		"id of stored webhook": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToWebhookDocument(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered)),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret", entity.EventBookmarkRegistered),
+			nil,
+		},
+		"id of unstored webhook": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			nil,
+			nil,
+		},
+		"nil user id": {
+			func(mt *mtest.T) {},
+			nil,
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"nil id": {
+			func(mt *mtest.T) {},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			nil,
+			errors.New("argument \"id\" is nil"),
+		},
+		"failed at collection.FindOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, helper.UserID),
+			helper.ToID(t, "1"),
+			nil,
+			errors.New("failed at collection.FindOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewWebhookRepository(collection)
+			// when
+			actualWebhook, actualErr := repository.FindByID(tc.userID, tc.id)
+			// then
+			assert.Exactly(mt, tc.expectedWebhook, actualWebhook)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestWebhook_FindAll(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare          func(*mtest.T)
+		userID           *entity.UserID
+		expectedWebhooks []entity.Webhook
+		expectedErr      error
+	}{
+		"stored webhooks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(
+					mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, helper.ToWebhookDocument(t, "1", "https://example.com/hooks", "secret")),
+					mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch, helper.ToWebhookDocument(t, "2", "https://example.org/hooks", "secret", entity.EventBookmarkDeleted)),
+				)
+			},
+			helper.ToUserID(t, helper.UserID),
+			[]entity.Webhook{
+				*helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+				*helper.ToWebhook(t, "2", "https://example.org/hooks", "secret", entity.EventBookmarkDeleted),
+			},
+			nil,
+		},
+		"no webhooks": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			},
+			helper.ToUserID(t, helper.UserID),
+			[]entity.Webhook{},
+			nil,
+		},
+		"nil user id": {
+			func(mt *mtest.T) {},
+			nil,
+			nil,
+			errors.New("argument \"userID\" is nil"),
+		},
+		"failed at collection.Find": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToUserID(t, helper.UserID),
+			nil,
+			errors.New("failed at collection.Find: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewWebhookRepository(collection)
+			// when
+			actualWebhooks, actualErr := repository.FindAll(tc.userID)
+			// then
+			assert.Exactly(mt, tc.expectedWebhooks, actualWebhooks)
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestWebhook_Delete(t *testing.T) {
+	t.Parallel()
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	cases := map[string]struct {
+		prepare     func(*mtest.T)
+		webhook     *entity.Webhook
+		expectedErr error
+	}{
+		"stored webhook": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "acknowledged", Value: true}, bson.E{Key: "n", Value: 1}))
+			},
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			nil,
+		},
+		"nil webhook": {
+			func(mt *mtest.T) {},
+			nil,
+			errors.New("argument \"webhook\" is nil"),
+		},
+		"failed at collection.DeleteOne": {
+			func(mt *mtest.T) {
+				mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+			},
+			helper.ToWebhook(t, "1", "https://example.com/hooks", "secret"),
+			errors.New("failed at collection.DeleteOne: command failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		mt.Run(name, func(mt *mtest.T) {
+			mt.Parallel()
+			tc.prepare(mt)
+			// given
+			collection := mt.Coll
+			repository := NewWebhookRepository(collection)
+			// when
+			actualErr := repository.Delete(tc.webhook)
+			// then
+			if tc.expectedErr == nil {
+				assert.NoError(mt, actualErr)
+			} else {
+				assert.Exactly(mt, tc.expectedErr.Error(), actualErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 通知の送信に用いるHTTPクライアントを生成する。
+//
+// 内部のサービスへのリクエストの偽造 (SSRF) を防ぐため、外部に公開されていないアドレスへは接続しない。
+// 名前解決後のアドレスを接続の直前に検証するため、登録後に名前解決の結果が変わった配信先やリダイレクト先にも適用する。
+// 環境変数のプロキシは用いない。
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: guardAddress}
+	transport := &http.Transport{
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// 接続先のアドレスを検証する。
+//
+// アドレスを解析できない場合はエラーを返却する。
+// 外部に公開されていないアドレスの場合はエラーを返却する。
+func guardAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("failed at net.SplitHostPort: %w", err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !entity.IsPublicAddress(ip) {
+		return fmt.Errorf("forbidden address: %s", address)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+	t.Run("loopback server", func(t *testing.T) {
+		t.Parallel()
+		// given
+		receiver, server := newReceiver(t)
+		client := NewClient(time.Second)
+		// when
+		_, err := client.Post(server.URL, "application/json", http.NoBody)
+		// then
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "forbidden address: "+server.Listener.Addr().String())
+		}
+		assert.Empty(t, receiver.received())
+	})
+}
+
+func TestGuardAddress(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		address     string
+		expectedErr error
+	}{
+		"public address": {
+			"93.184.216.34:443",
+			nil,
+		},
+		"public ipv6 address": {
+			"[2606:2800:220:1::]:443",
+			nil,
+		},
+		"loopback address": {
+			"127.0.0.1:80",
+			errors.New("forbidden address: 127.0.0.1:80"),
+		},
+		"link-local address": {
+			"169.254.169.254:80",
+			errors.New("forbidden address: 169.254.169.254:80"),
+		},
+		"private address": {
+			"10.0.0.1:80",
+			errors.New("forbidden address: 10.0.0.1:80"),
+		},
+		"ipv6 loopback": {
+			"[::1]:80",
+			errors.New("forbidden address: [::1]:80"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := guardAddress("tcp", tc.address, nil)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// ドメインイベントをWebhookの購読者に通知する配信ワーカー。
+//
+// Deliver で受け付けたイベントを購読ごとの通知として配信待ちのリポジトリに記録し、Run で起動した配信ワーカーが取り出して配信する。
+// 通知は一定数の配信ワーカーで並行して配信するため、購読者への到着順はイベントの発生順と一致しない場合がある。
+// 配信に失敗した通知は指数関数的に待機時間を延ばして再試行し、試行回数の上限に達した場合はデッドレターとして記録する。
+// 再試行は配信待ちのリポジトリに記録した日時まで待つため、応答しない購読者がいても他の通知の配信を妨げない。
+type Deliverer struct {
+	webhooks       repository.Webhook    // Webhookの購読のリポジトリ
+	deliveries     repository.Delivery   // 配信待ちの通知のリポジトリ
+	deadLetters    repository.DeadLetter // デッドレターのリポジトリ
+	client         *http.Client          // HTTPクライアント
+	clock          clock.Clock           // 時計
+	logger         *zap.Logger           // ロガー
+	workers        int                   // 並行して配信する配信ワーカーの数
+	maxAttempts    int                   // 1件の通知あたりの最大試行回数
+	initialBackoff time.Duration         // 初回の再試行までの待機時間
+	maxBackoff     time.Duration         // 再試行までの最大待機時間
+	interval       time.Duration         // 配信待ちの通知が無い場合の待機時間
+	lease          time.Duration         // 取り出した通知を他の配信ワーカーに取り出させない期間
+	wake           chan struct{}         // 待機を打ち切る通知
+	wg             sync.WaitGroup        // 起動中の配信ワーカー
+}
+
+// ドメインイベントをWebhookの購読者に通知する配信ワーカーを生成する。
+//
+// 配信ワーカーの数に1未満を指定した場合は1つとする。
+// 最大試行回数に1未満を指定した場合は1回とする。
+// 最大待機時間が初回の待機時間より短い場合は初回の待機時間とする。
+func NewDeliverer(webhooks repository.Webhook, deliveries repository.Delivery, deadLetters repository.DeadLetter, client *http.Client, clock clock.Clock, logger *zap.Logger, workers int, maxAttempts int, initialBackoff, maxBackoff, interval, lease time.Duration) *Deliverer {
+	if workers < 1 {
+		workers = 1
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if maxBackoff < initialBackoff {
+		maxBackoff = initialBackoff
+	}
+	return &Deliverer{
+		webhooks:       webhooks,
+		deliveries:     deliveries,
+		deadLetters:    deadLetters,
+		client:         client,
+		clock:          clock,
+		logger:         logger,
+		workers:        workers,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		interval:       interval,
+		lease:          lease,
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// ドメインイベントを購読ごとの通知として配信待ちのリポジトリに記録する。
+//
+// 送信箱の中継器のハンドラとして登録する。
+// イベントが起きたブックマークの所有者の購読に限り記録する。
+// 記録を終えた時点で復帰し、配信は待たない。
+// エラーを返却した場合、記録を終えた通知が再び記録されて購読者に重複して配信されることがある。
+//
+// nilを指定した場合はエラーを返却する。
+// コンテキストが終了している場合はコンテキストのエラーを返却する。
+// 購読一覧の取得に失敗した場合はエラーを返却する。
+// 通知の記録に失敗した場合はエラーを返却する。
+func (d *Deliverer) Deliver(ctx context.Context, event entity.Event) error {
+	if ctx == nil {
+		return fmt.Errorf("argument \"ctx\" is nil")
+	}
+	if event == nil {
+		return fmt.Errorf("argument \"event\" is nil")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	userID := event.UserID()
+	webhooks, err := d.webhooks.FindAll(&userID)
+	if err != nil {
+		return fmt.Errorf("failed at webhooks.FindAll: %w", err)
+	}
+	now := d.clock.Now()
+	bookmarkID := event.BookmarkID()
+	deliveries := []entity.Delivery{}
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event.EventName()) {
+			continue
+		}
+		uuid, _ := uuid.NewRandom()
+		id, _ := entity.NewID(uuid.String())
+		body, _ := json.Marshal(newPayload(id.Value(), event, now))
+		webhookID := webhook.ID()
+		delivery, _ := entity.NewDelivery(id, &userID, &webhookID, event.EventName(), &bookmarkID, body, 0, "", now)
+		deliveries = append(deliveries, *delivery)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := d.deliveries.Save(deliveries); err != nil {
+		return fmt.Errorf("failed at deliveries.Save: %w", err)
+	}
+	d.notify()
+	return nil
+}
+
+// 待機中の配信ワーカーを起こす。
+//
+// 通知が既に保留されている場合は何もしない。
+func (d *Deliverer) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// 配信ワーカーを起動する。
+//
+// コンテキストが終了するまで配信を続ける。
+// コンテキストが終了した場合は配信中の通知を打ち切り、配信ワーカーの停止を待ってから復帰する。
+// 打ち切った通知はデッドレターとして記録せず、取り出した期間を過ぎた後に再び配信する。
+//
+// nilを指定した場合はエラーを返却する。
+// コンテキストが終了した場合はコンテキストのエラーを返却する。
+func (d *Deliverer) Run(ctx context.Context) error {
+	if ctx == nil {
+		return fmt.Errorf("argument \"ctx\" is nil")
+	}
+	for i := 0; i < d.workers; i++ {
+		d.wg.Add(1)
+		go d.work(ctx)
+	}
+	d.wg.Wait()
+	return ctx.Err()
+}
+
+// 配信待ちの通知を配信する配信ワーカー。
+//
+// コンテキストが終了するまで通知を1件ずつ取り出して配信する。
+// 配信待ちの通知が無い場合、あるいは取り出しに失敗した場合は待機してから再び取り出す。
+// 待機中に通知の記録が知らされた場合は直ちに取り出す。
+func (d *Deliverer) work(ctx context.Context) {
+	defer d.wg.Done()
+	for {
+		claimed, err := d.next(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.logger.Error("Failed to deliver the webhook", zap.Error(err))
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if claimed && err == nil {
+			continue
+		}
+		timer := time.NewTimer(d.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-d.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// 配信待ちの通知を1件取り出して配信する。
+//
+// 通知を取り出した場合はtrueを返却する。
+// 通知を取り出した場合は他の配信ワーカーを起こし、残りの通知を並行して取り出させる。
+//
+// 通知の取り出しに失敗した場合はエラーを返却する。
+// 通知の配信に失敗した場合はエラーを返却する。
+func (d *Deliverer) next(ctx context.Context) (bool, error) {
+	now := d.clock.Now()
+	delivery, err := d.deliveries.Claim(now, now.Add(d.lease))
+	if err != nil {
+		return false, fmt.Errorf("failed at deliveries.Claim: %w", err)
+	}
+	if delivery == nil {
+		return false, nil
+	}
+	d.notify()
+	return true, d.deliver(ctx, delivery)
+}
+
+// 試行回数に応じた再試行までの待機時間を算出する。
+//
+// 初回の待機時間を試行のたびに2倍にし、最大待機時間を上限とする。
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	backoff := d.initialBackoff
+	for i := 1; i < attempts && backoff < d.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > d.maxBackoff {
+		return d.maxBackoff
+	}
+	return backoff
+}
+
+// 1件の通知を1回送信する。
+//
+// 送信に成功した場合は通知を削除する。
+// 配信先の購読が削除されている場合は送信せずに通知を削除する。
+// 送信に失敗した場合は試行回数に応じた待機時間の後に再試行するよう記録する。
+// 試行回数の上限に達した場合はデッドレターとして記録してから通知を削除する。
+// コンテキストが終了した場合は送信を打ち切り、試行回数に数えない。
+// 送信は取り出した期間で打ち切り、期間を過ぎた通知を他の配信ワーカーと重複して送信しないようにする。
+//
+// 購読の取得に失敗した場合はエラーを返却する。
+// コンテキストが終了した場合はコンテキストのエラーを返却する。
+// デッドレターの記録に失敗した場合はエラーを返却する。
+// 通知の更新、あるいは削除に失敗した場合はエラーを返却する。
+func (d *Deliverer) deliver(ctx context.Context, delivery *entity.Delivery) error {
+	deliveryID := delivery.ID()
+	userID := delivery.UserID()
+	webhookID := delivery.WebhookID()
+	webhook, err := d.webhooks.FindByID(&userID, &webhookID)
+	if err != nil {
+		return fmt.Errorf("failed at webhooks.FindByID: %w", err)
+	}
+	if webhook != nil {
+		postCtx, cancel := context.WithTimeout(ctx, d.lease)
+		err = d.post(postCtx, webhook, delivery)
+		cancel()
+	}
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			d.logger.Warn("Interrupted the delivery", zap.String("deliveryID", deliveryID.Value()), zap.Int("attempts", delivery.Attempts()), zap.Error(err))
+			return ctxErr
+		}
+		delivery.Fail(err.Error(), d.clock.Now().Add(d.backoff(delivery.Attempts()+1)))
+		if delivery.Attempts() < d.maxAttempts {
+			if err := d.deliveries.Update(delivery); err != nil {
+				return fmt.Errorf("failed at deliveries.Update: %w", err)
+			}
+			return nil
+		}
+		deadLetter, _ := delivery.ToDeadLetter()
+		if err := d.deadLetters.Save(deadLetter); err != nil {
+			d.logger.Error("Failed to save the dead letter", zap.String("deliveryID", deliveryID.Value()), zap.Error(err))
+			return fmt.Errorf("failed at deadLetters.Save: %w", err)
+		}
+	}
+	if err := d.deliveries.Delete(delivery); err != nil {
+		return fmt.Errorf("failed at deliveries.Delete: %w", err)
+	}
+	return nil
+}
+
+// 通知を1回送信する。
+//
+// 2xxの応答を受信した場合に成功とする。
+//
+// リクエストの生成、あるいは送信に失敗した場合はエラーを返却する。
+// 2xx以外の応答を受信した場合はエラーを返却する。
+func (d *Deliverer) post(ctx context.Context, webhook *entity.Webhook, delivery *entity.Delivery) error {
+	uri := webhook.URI()
+	id := delivery.ID()
+	body := delivery.Payload()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed at http.NewRequest: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret(), body))
+	req.Header.Set(HeaderEvent, delivery.EventName())
+	req.Header.Set(HeaderDelivery, id.Value())
+	res, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed at client.Do: %w", err)
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status: %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,591 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/internal/domain/repository"
+	"github.com/kkntzw/bookmark/internal/infrastructure/inmemory"
+	"github.com/kkntzw/bookmark/test/helper"
+	mock_repository "github.com/kkntzw/bookmark/test/mock/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// 受信した通知。
+type received struct {
+	header http.Header // HTTPヘッダ
+	body   []byte      // 本文
+}
+
+// 通知を受信するサーバ。
+//
+// statuses に指定した応答を順に返却し、使い切った後は 204 を返却する。
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []received
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, received{req.Header.Clone(), body})
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *receiver) received() []received {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]received{}, r.requests...)
+}
+
+func newReceiver(t *testing.T, statuses ...int) (*receiver, *httptest.Server) {
+	t.Helper()
+	receiver := &receiver{statuses: statuses}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+	return receiver, server
+}
+
+// 配信ワーカーを起動する。
+//
+// 返却した関数を呼び出すと配信ワーカーを停止し、配信中の通知の完了を待つ。
+func start(t *testing.T, deliverer *Deliverer) func() {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- deliverer.Run(ctx)
+	}()
+	return func() {
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+	}
+}
+
+func deletedEvent(t *testing.T) entity.Event {
+	t.Helper()
+	return toEvents(t, func(b *entity.Bookmark) { b.Delete() })[0]
+}
+
+// 配信ワーカーを生成する。
+//
+// 再試行が直ちに期限を迎えるよう、待機時間をミリ秒単位としてシステム時計で判定する。
+func newDeliverer(t *testing.T, webhooks repository.Webhook, deliveries repository.Delivery, deadLetters repository.DeadLetter, client *http.Client, maxAttempts int) *Deliverer {
+	t.Helper()
+	return NewDeliverer(webhooks, deliveries, deadLetters, client, clock.NewSystemClock(), zap.NewNop(), 2, maxAttempts, time.Millisecond, time.Millisecond, time.Millisecond, time.Minute)
+}
+
+func TestNewDeliverer(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		workers                int
+		maxAttempts            int
+		initialBackoff         time.Duration
+		maxBackoff             time.Duration
+		expectedWorkers        int
+		expectedMaxAttempts    int
+		expectedInitialBackoff time.Duration
+		expectedMaxBackoff     time.Duration
+	}{
+		"valid arguments": {
+			4, 5, time.Second, time.Minute,
+			4, 5, time.Second, time.Minute,
+		},
+		"zero workers": {
+			0, 5, time.Second, time.Minute,
+			1, 5, time.Second, time.Minute,
+		},
+		"zero attempts": {
+			4, 0, time.Second, time.Minute,
+			4, 1, time.Second, time.Minute,
+		},
+		"max backoff shorter than initial backoff": {
+			4, 5, time.Minute, time.Second,
+			4, 5, time.Minute, time.Minute,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			deliverer := NewDeliverer(nil, nil, nil, http.DefaultClient, helper.ToFixedClock(t, now), zap.NewNop(), tc.workers, tc.maxAttempts, tc.initialBackoff, tc.maxBackoff, time.Second, time.Minute)
+			// then
+			assert.Exactly(t, tc.expectedWorkers, deliverer.workers)
+			assert.Exactly(t, tc.expectedMaxAttempts, deliverer.maxAttempts)
+			assert.Exactly(t, tc.expectedInitialBackoff, deliverer.initialBackoff)
+			assert.Exactly(t, tc.expectedMaxBackoff, deliverer.maxBackoff)
+			assert.Exactly(t, time.Second, deliverer.interval)
+			assert.Exactly(t, time.Minute, deliverer.lease)
+		})
+	}
+}
+
+func TestDeliverer_backoff(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		attempts int
+		expected time.Duration
+	}{
+		"first attempt": {
+			1, time.Second,
+		},
+		"second attempt": {
+			2, 2 * time.Second,
+		},
+		"fourth attempt": {
+			4, 8 * time.Second,
+		},
+		"capped by max backoff": {
+			6, 10 * time.Second,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			deliverer := NewDeliverer(nil, nil, nil, http.DefaultClient, helper.ToFixedClock(t, now), zap.NewNop(), 2, 5, time.Second, 10*time.Second, time.Second, time.Minute)
+			// when
+			actual := deliverer.backoff(tc.attempts)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestDeliverer_Run(t *testing.T) {
+	t.Parallel()
+	t.Run("nil context", func(t *testing.T) {
+		t.Parallel()
+		// given
+		deliverer := newDeliverer(t, nil, nil, nil, http.DefaultClient, 1)
+		// when
+		err := deliverer.Run(nil)
+		// then
+		assert.EqualError(t, err, "argument \"ctx\" is nil")
+	})
+	t.Run("signed payload", func(t *testing.T) {
+		t.Parallel()
+		// given
+		receiver, server := newReceiver(t)
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := NewDeliverer(webhooks, deliveries, deadLetters, server.Client(), helper.ToFixedClock(t, now), zap.NewNop(), 2, 3, time.Millisecond, time.Millisecond, time.Millisecond, time.Minute)
+		stop := start(t, deliverer)
+		defer stop()
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool { return len(receiver.received()) == 1 }, time.Second, time.Millisecond)
+		request := receiver.received()[0]
+		assert.Exactly(t, "application/json", request.header.Get("Content-Type"))
+		assert.Exactly(t, entity.EventBookmarkDeleted, request.header.Get(HeaderEvent))
+		assert.True(t, Verify("secret", request.body, request.header.Get(HeaderSignature)))
+		var payload Payload
+		assert.NoError(t, json.Unmarshal(request.body, &payload))
+		assert.Exactly(t, request.header.Get(HeaderDelivery), payload.DeliveryID)
+		assert.Exactly(t, entity.EventBookmarkDeleted, payload.Event)
+		assert.Exactly(t, "1", payload.BookmarkID)
+		assert.True(t, now.Equal(payload.OccurredAt))
+		assert.Eventually(t, func() bool { return deliveries.pending() == 0 }, time.Second, time.Millisecond)
+		deadLetterList, _ := deadLetters.FindByWebhookID(helper.ToUserID(t, helper.UserID), nil)
+		assert.Empty(t, deadLetterList)
+	})
+	t.Run("retry until success", func(t *testing.T) {
+		t.Parallel()
+		// given
+		receiver, server := newReceiver(t, http.StatusInternalServerError, http.StatusServiceUnavailable)
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := newDeliverer(t, webhooks, deliveries, deadLetters, server.Client(), 3)
+		stop := start(t, deliverer)
+		defer stop()
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool { return len(receiver.received()) == 3 && deliveries.pending() == 0 }, time.Second, time.Millisecond)
+		requests := receiver.received()
+		assert.Exactly(t, requests[0].header.Get(HeaderDelivery), requests[2].header.Get(HeaderDelivery))
+		assert.Exactly(t, requests[0].body, requests[2].body)
+		deadLetterList, _ := deadLetters.FindByWebhookID(helper.ToUserID(t, helper.UserID), nil)
+		assert.Empty(t, deadLetterList)
+	})
+	t.Run("dead letter after max attempts", func(t *testing.T) {
+		t.Parallel()
+		// given
+		receiver, server := newReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusBadGateway)
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := newDeliverer(t, webhooks, deliveries, deadLetters, server.Client(), 3)
+		stop := start(t, deliverer)
+		defer stop()
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool { return deliveries.pending() == 0 }, time.Second, time.Millisecond)
+		requests := receiver.received()
+		deadLetterList, _ := deadLetters.FindByWebhookID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10"))
+		if assert.Len(t, requests, 3) && assert.Len(t, deadLetterList, 1) {
+			deadLetter := deadLetterList[0]
+			id := deadLetter.ID()
+			assert.Exactly(t, requests[2].header.Get(HeaderDelivery), id.Value())
+			assert.Exactly(t, entity.EventBookmarkDeleted, deadLetter.EventName())
+			assert.Exactly(t, *helper.ToID(t, "1"), deadLetter.BookmarkID())
+			assert.Exactly(t, requests[2].body, deadLetter.Payload())
+			assert.Exactly(t, 3, deadLetter.Attempts())
+			assert.Exactly(t, "unexpected status: 502", deadLetter.LastError())
+		}
+	})
+	t.Run("failed at deadLetters.Save", func(t *testing.T) {
+		t.Parallel()
+		// given
+		_, server := newReceiver(t, http.StatusInternalServerError, http.StatusInternalServerError)
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		deliveries := newQueue()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		deadLetters := mock_repository.NewMockDeadLetter(ctrl)
+		saved := make(chan struct{})
+		deadLetters.EXPECT().Save(gomock.Any()).DoAndReturn(func(*entity.DeadLetter) error {
+			close(saved)
+			return errors.New("error")
+		})
+		deliverer := NewDeliverer(webhooks, deliveries, deadLetters, server.Client(), clock.NewSystemClock(), zap.NewNop(), 1, 1, time.Hour, time.Hour, time.Millisecond, time.Hour)
+		stop := start(t, deliverer)
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		<-saved
+		stop()
+		assert.Exactly(t, 1, deliveries.pending())
+	})
+	t.Run("deleted webhook", func(t *testing.T) {
+		t.Parallel()
+		// given
+		receiver, server := newReceiver(t)
+		webhooks := inmemory.NewWebhookRepository()
+		webhook := helper.ToWebhook(t, "10", server.URL, "secret")
+		webhooks.Save(webhook)
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := newDeliverer(t, webhooks, deliveries, deadLetters, server.Client(), 3)
+		deliverer.Deliver(context.Background(), deletedEvent(t))
+		webhooks.Delete(webhook)
+		// when
+		stop := start(t, deliverer)
+		defer stop()
+		// then
+		assert.Eventually(t, func() bool { return deliveries.pending() == 0 }, time.Second, time.Millisecond)
+		assert.Empty(t, receiver.received())
+	})
+	t.Run("no dead letter on shutdown", func(t *testing.T) {
+		t.Parallel()
+		// given
+		var once sync.Once
+		arrived := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			io.ReadAll(req.Body)
+			once.Do(func() { close(arrived) })
+			<-req.Context().Done()
+		}))
+		defer server.Close()
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := newDeliverer(t, webhooks, deliveries, deadLetters, server.Client(), 1)
+		stop := start(t, deliverer)
+		deliverer.Deliver(context.Background(), deletedEvent(t))
+		<-arrived
+		// when
+		stop()
+		// then
+		deadLetterList, _ := deadLetters.FindByWebhookID(helper.ToUserID(t, helper.UserID), helper.ToID(t, "10"))
+		assert.Empty(t, deadLetterList)
+		assert.Exactly(t, 1, deliveries.pending())
+	})
+	t.Run("slow subscriber does not block others", func(t *testing.T) {
+		t.Parallel()
+		// given
+		release := make(chan struct{})
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			io.ReadAll(req.Body)
+			select {
+			case <-release:
+			case <-req.Context().Done():
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer slow.Close()
+		defer close(release)
+		receiver, server := newReceiver(t)
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", slow.URL, "secret"))
+		webhooks.Save(helper.ToWebhook(t, "11", server.URL, "secret"))
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := newDeliverer(t, webhooks, deliveries, deadLetters, http.DefaultClient, 3)
+		stop := start(t, deliverer)
+		defer stop()
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool { return len(receiver.received()) == 1 }, time.Second, time.Millisecond)
+	})
+	t.Run("bounded workers", func(t *testing.T) {
+		t.Parallel()
+		// given
+		var mu sync.Mutex
+		inFlight, maxInFlight, count := 0, 0, 0
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			io.ReadAll(req.Body)
+			mu.Lock()
+			inFlight++
+			count++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			select {
+			case <-release:
+			case <-req.Context().Done():
+			}
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		webhooks.Save(helper.ToWebhook(t, "11", server.URL, "secret"))
+		webhooks.Save(helper.ToWebhook(t, "12", server.URL, "secret"))
+		deliveries := newQueue()
+		deadLetters := inmemory.NewDeadLetterRepository(helper.ToFixedClock(t, now))
+		deliverer := newDeliverer(t, webhooks, deliveries, deadLetters, server.Client(), 3)
+		stop := start(t, deliverer)
+		defer stop()
+		event := deletedEvent(t)
+		// when
+		assert.NoError(t, deliverer.Deliver(context.Background(), event))
+		assert.NoError(t, deliverer.Deliver(context.Background(), event))
+		// then
+		assert.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return inFlight == 2
+		}, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		assert.Exactly(t, 2, maxInFlight)
+		mu.Unlock()
+		close(release)
+		assert.Eventually(t, func() bool { return deliveries.pending() == 0 }, time.Second, time.Millisecond)
+		mu.Lock()
+		assert.Exactly(t, 6, count)
+		assert.Exactly(t, 2, maxInFlight)
+		mu.Unlock()
+	})
+}
+
+func TestDeliverer_Deliver(t *testing.T) {
+	t.Parallel()
+	t.Run("nil context", func(t *testing.T) {
+		t.Parallel()
+		// given
+		deliverer := newDeliverer(t, nil, nil, nil, http.DefaultClient, 1)
+		// when
+		err := deliverer.Deliver(nil, deletedEvent(t))
+		// then
+		assert.EqualError(t, err, "argument \"ctx\" is nil")
+	})
+	t.Run("nil event", func(t *testing.T) {
+		t.Parallel()
+		// given
+		deliverer := newDeliverer(t, nil, nil, nil, http.DefaultClient, 1)
+		// when
+		err := deliverer.Deliver(context.Background(), nil)
+		// then
+		assert.EqualError(t, err, "argument \"event\" is nil")
+	})
+	t.Run("queued without waiting for delivery", func(t *testing.T) {
+		t.Parallel()
+		// given
+		receiver, server := newReceiver(t)
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", server.URL, "secret"))
+		webhooks.Save(helper.ToWebhook(t, "11", server.URL, "secret"))
+		deliveries := newQueue()
+		deliverer := NewDeliverer(webhooks, deliveries, nil, server.Client(), helper.ToFixedClock(t, now), zap.NewNop(), 2, 3, time.Millisecond, time.Millisecond, time.Millisecond, time.Minute)
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, receiver.received())
+		first, _ := deliveries.Claim(now, now.Add(time.Minute))
+		second, _ := deliveries.Claim(now, now.Add(time.Minute))
+		if assert.NotNil(t, first) && assert.NotNil(t, second) {
+			webhookIDs := []entity.ID{first.WebhookID(), second.WebhookID()}
+			assert.ElementsMatch(t, []entity.ID{*helper.ToID(t, "10"), *helper.ToID(t, "11")}, webhookIDs)
+			for _, delivery := range []*entity.Delivery{first, second} {
+				id := delivery.ID()
+				var payload Payload
+				assert.NoError(t, json.Unmarshal(delivery.Payload(), &payload))
+				assert.Exactly(t, id.Value(), payload.DeliveryID)
+				assert.Exactly(t, entity.EventBookmarkDeleted, delivery.EventName())
+				assert.Exactly(t, *helper.ToID(t, "1"), delivery.BookmarkID())
+				assert.Exactly(t, 0, delivery.Attempts())
+			}
+		}
+	})
+	t.Run("event filter", func(t *testing.T) {
+		t.Parallel()
+		// given
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", "https://example.com/hook", "secret", entity.EventBookmarkDeleted))
+		deliveries := newQueue()
+		deliverer := newDeliverer(t, webhooks, deliveries, nil, http.DefaultClient, 3)
+		// when
+		renamedErr := deliverer.Deliver(context.Background(), toEvents(t, func(b *entity.Bookmark) { b.Rename(helper.ToName(t, "Example Domain")) })[0])
+		deletedErr := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, renamedErr)
+		assert.NoError(t, deletedErr)
+		if assert.Exactly(t, 1, deliveries.pending()) {
+			delivery, _ := deliveries.Claim(time.Now().Add(time.Hour), time.Now().Add(time.Hour))
+			assert.Exactly(t, entity.EventBookmarkDeleted, delivery.EventName())
+		}
+	})
+	t.Run("owner filter", func(t *testing.T) {
+		t.Parallel()
+		// given
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", "https://example.com/hook", "secret"))
+		webhooks.Save(helper.ToOwnedWebhook(t, "bob", "20", "https://example.com/other", "secret"))
+		deliveries := newQueue()
+		deliverer := newDeliverer(t, webhooks, deliveries, nil, http.DefaultClient, 3)
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.NoError(t, err)
+		if assert.Exactly(t, 1, deliveries.pending()) {
+			delivery, _ := deliveries.Claim(time.Now().Add(time.Hour), time.Now().Add(time.Hour))
+			assert.Exactly(t, *helper.ToID(t, "10"), delivery.WebhookID())
+		}
+	})
+	t.Run("cancelled context", func(t *testing.T) {
+		t.Parallel()
+		// given
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", "https://example.com/hook", "secret"))
+		deliveries := newQueue()
+		deliverer := newDeliverer(t, webhooks, deliveries, nil, http.DefaultClient, 3)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		// when
+		err := deliverer.Deliver(ctx, deletedEvent(t))
+		// then
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Exactly(t, 0, deliveries.pending())
+	})
+	t.Run("failed at webhooks.FindAll", func(t *testing.T) {
+		t.Parallel()
+		// given
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		webhooks := mock_repository.NewMockWebhook(ctrl)
+		webhooks.EXPECT().FindAll(helper.ToUserID(t, helper.UserID)).Return(nil, errors.New("error"))
+		deliverer := newDeliverer(t, webhooks, inmemory.NewDeliveryRepository(), nil, http.DefaultClient, 3)
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.EqualError(t, err, "failed at webhooks.FindAll: error")
+	})
+	t.Run("failed at deliveries.Save", func(t *testing.T) {
+		t.Parallel()
+		// given
+		webhooks := inmemory.NewWebhookRepository()
+		webhooks.Save(helper.ToWebhook(t, "10", "https://example.com/hook", "secret"))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		deliveries := mock_repository.NewMockDelivery(ctrl)
+		deliveries.EXPECT().Save(gomock.Len(1)).Return(errors.New("error"))
+		deliverer := newDeliverer(t, webhooks, deliveries, nil, http.DefaultClient, 3)
+		// when
+		err := deliverer.Deliver(context.Background(), deletedEvent(t))
+		// then
+		assert.EqualError(t, err, "failed at deliveries.Save: error")
+	})
+}
+
+// 配信待ちの通知の件数を数えるリポジトリ。
+//
+// 保存した通知から削除した通知を除いたIDを記録する。
+type queue struct {
+	repository.Delivery
+	mu  sync.Mutex
+	ids map[entity.ID]struct{}
+}
+
+func newQueue() *queue {
+	return &queue{Delivery: inmemory.NewDeliveryRepository(), ids: map[entity.ID]struct{}{}}
+}
+
+func (q *queue) Save(deliveries []entity.Delivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.Delivery.Save(deliveries); err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		q.ids[delivery.ID()] = struct{}{}
+	}
+	return nil
+}
+
+func (q *queue) Delete(delivery *entity.Delivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.Delivery.Delete(delivery); err != nil {
+		return err
+	}
+	delete(q.ids, delivery.ID())
+	return nil
+}
+
+func (q *queue) pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.ids)
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// 通知に付与するHTTPヘッダ名。
+const (
+	HeaderSignature = "X-Bookmark-Signature" // ペイロードの署名
+	HeaderEvent     = "X-Bookmark-Event"     // イベント名
+	HeaderDelivery  = "X-Bookmark-Delivery"  // 配信ID
+)
+
+// 署名の接頭辞。
+const signaturePrefix = "sha256="
+
+// 通知として送信するペイロード。
+type Payload struct {
+	DeliveryID string                 `json:"deliveryId"` // 配信ID
+	Event      string                 `json:"event"`      // イベント名
+	BookmarkID string                 `json:"bookmarkId"` // イベントが起きたブックマークのID
+	OccurredAt time.Time              `json:"occurredAt"` // イベントを受け付けた日時
+	Data       map[string]interface{} `json:"data"`       // イベントの内容
+}
+
+// ドメインイベントからペイロードを生成する。
+func newPayload(deliveryID string, event entity.Event, occurredAt time.Time) Payload {
+	id := event.BookmarkID()
+	return Payload{
+		DeliveryID: deliveryID,
+		Event:      event.EventName(),
+		BookmarkID: id.Value(),
+		OccurredAt: occurredAt,
+		Data:       eventData(event),
+	}
+}
+
+// ドメインイベントの内容を取得する。
+//
+// 内容を持たないイベントの場合は空のマップを返却する。
+func eventData(event entity.Event) map[string]interface{} {
+	switch e := event.(type) {
+	case entity.BookmarkRegistered:
+		name := e.Name()
+		uri := e.URI()
+		description := e.Description()
+		return map[string]interface{}{"name": name.Value(), "uri": uri.String(), "description": description.Value(), "tags": tagValues(e.Tags())}
+	case entity.BookmarkRenamed:
+		before, after := e.Before(), e.After()
+		return map[string]interface{}{"before": before.Value(), "after": after.Value()}
+	case entity.BookmarkURIRewritten:
+		before, after := e.Before(), e.After()
+		return map[string]interface{}{"before": before.String(), "after": after.String()}
+	case entity.BookmarkTagged:
+		return map[string]interface{}{"tags": tagValues(e.Tags())}
+	case entity.BookmarkUntagged:
+		return map[string]interface{}{"tags": tagValues(e.Tags())}
+	case entity.BookmarkDescribed:
+		before, after := e.Before(), e.After()
+		return map[string]interface{}{"before": before.Value(), "after": after.Value()}
+	case entity.BookmarkMoved:
+		return map[string]interface{}{"before": folderValue(e.Before()), "after": folderValue(e.After())}
+	case entity.BookmarkStatusChanged:
+		return map[string]interface{}{"before": e.Before().Value(), "after": e.After().Value()}
+	}
+	return map[string]interface{}{}
+}
+
+// フォルダのIDを文字列に変換する。
+//
+// 最上位を表すnilの場合は空文字列に変換する。
+func folderValue(folder *entity.ID) string {
+	if folder == nil {
+		return ""
+	}
+	return folder.Value()
+}
+
+// タグ一覧を文字列のスライスに変換する。
+func tagValues(tags []entity.Tag) []string {
+	values := make([]string, len(tags))
+	for i, tag := range tags {
+		values[i] = tag.Value()
+	}
+	return values
+}
+
+// 共有シークレットでペイロードに署名する。
+//
+// HMAC-SHA256の16進表記に "sha256=" を前置した文字列を返却する。
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ペイロードの署名を検証する。
+//
+// 受信側での検証に用いる。
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
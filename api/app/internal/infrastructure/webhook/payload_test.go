@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC) // 現在時刻
+
+func registered(t *testing.T) *entity.Bookmark {
+	t.Helper()
+	bookmark, err := entity.RegisterBookmark(helper.ToID(t, "1"), helper.ToUserID(t, helper.UserID), helper.ToName(t, "Example"), helper.ToURI(t, "https://example.com"), helper.ToDescription(t, ""), helper.ToTags(t, "foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bookmark
+}
+
+func registeredWithDescription(t *testing.T) entity.Event {
+	t.Helper()
+	event, err := entity.NewBookmarkRegistered(helper.ToID(t, "1"), helper.ToUserID(t, helper.UserID), helper.ToName(t, "Example"), helper.ToURI(t, "https://example.com"), helper.ToDescription(t, "memo"), helper.ToTags(t, "foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *event
+}
+
+func toEvents(t *testing.T, change func(*entity.Bookmark)) []entity.Event {
+	t.Helper()
+	bookmark := registered(t)
+	bookmark.PullEvents()
+	change(bookmark)
+	return bookmark.PullEvents()
+}
+
+func TestNewPayload(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		event         entity.Event
+		expectedEvent string
+		expectedData  map[string]interface{}
+	}{
+		"BookmarkRegistered": {
+			registered(t).PullEvents()[0],
+			entity.EventBookmarkRegistered,
+			map[string]interface{}{"name": "Example", "uri": "https://example.com", "description": "", "tags": []string{"foo"}},
+		},
+		"BookmarkRegistered with description": {
+			registeredWithDescription(t),
+			entity.EventBookmarkRegistered,
+			map[string]interface{}{"name": "Example", "uri": "https://example.com", "description": "memo", "tags": []string{"foo"}},
+		},
+		"BookmarkRenamed": {
+			toEvents(t, func(b *entity.Bookmark) { b.Rename(helper.ToName(t, "Example Domain")) })[0],
+			entity.EventBookmarkRenamed,
+			map[string]interface{}{"before": "Example", "after": "Example Domain"},
+		},
+		"BookmarkURIRewritten": {
+			toEvents(t, func(b *entity.Bookmark) { b.RewriteURI(helper.ToURI(t, "https://example.org")) })[0],
+			entity.EventBookmarkURIRewritten,
+			map[string]interface{}{"before": "https://example.com", "after": "https://example.org"},
+		},
+		"BookmarkTagged": {
+			toEvents(t, func(b *entity.Bookmark) { b.AddTags(helper.ToTags(t, "bar")) })[0],
+			entity.EventBookmarkTagged,
+			map[string]interface{}{"tags": []string{"bar"}},
+		},
+		"BookmarkDeleted": {
+			toEvents(t, func(b *entity.Bookmark) { b.Delete() })[0],
+			entity.EventBookmarkDeleted,
+			map[string]interface{}{},
+		},
+		"BookmarkUntagged": {
+			toEvents(t, func(b *entity.Bookmark) { b.RemoveTags(helper.ToTags(t, "foo")) })[0],
+			entity.EventBookmarkUntagged,
+			map[string]interface{}{"tags": []string{"foo"}},
+		},
+		"BookmarkDescribed": {
+			toEvents(t, func(b *entity.Bookmark) { b.Describe(helper.ToDescription(t, "memo")) })[0],
+			entity.EventBookmarkDescribed,
+			map[string]interface{}{"before": "", "after": "memo"},
+		},
+		"BookmarkMoved": {
+			toEvents(t, func(b *entity.Bookmark) { b.MoveTo(helper.ToID(t, "10")) })[0],
+			entity.EventBookmarkMoved,
+			map[string]interface{}{"before": "", "after": "10"},
+		},
+		"BookmarkStatusChanged": {
+			toEvents(t, func(b *entity.Bookmark) { b.MarkRead() })[0],
+			entity.EventBookmarkStatusChanged,
+			map[string]interface{}{"before": "unread", "after": "read"},
+		},
+		"BookmarkStarred": {
+			toEvents(t, func(b *entity.Bookmark) { b.Star() })[0],
+			entity.EventBookmarkStarred,
+			map[string]interface{}{},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			payload := newPayload("100", tc.event, now)
+			// then
+			expected := Payload{DeliveryID: "100", Event: tc.expectedEvent, BookmarkID: "1", OccurredAt: now, Data: tc.expectedData}
+			assert.Exactly(t, expected, payload)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	// when
+	signature := Sign("secret", []byte(`{"event":"BookmarkDeleted"}`))
+	// then
+	assert.Regexp(t, "^sha256=[0-9a-f]{64}$", signature)
+	assert.Exactly(t, signature, Sign("secret", []byte(`{"event":"BookmarkDeleted"}`)))
+	assert.NotEqual(t, signature, Sign("other", []byte(`{"event":"BookmarkDeleted"}`)))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	body := []byte(`{"event":"BookmarkDeleted"}`)
+	cases := map[string]struct {
+		secret    string
+		body      []byte
+		signature string
+		expected  bool
+	}{
+		"valid signature": {
+			"secret", body, Sign("secret", body),
+			true,
+		},
+		"different secret": {
+			"other", body, Sign("secret", body),
+			false,
+		},
+		"tampered body": {
+			"secret", []byte(`{"event":"BookmarkRenamed"}`), Sign("secret", body),
+			false,
+		},
+		"empty signature": {
+			"secret", body, "",
+			false,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actual := Verify(tc.secret, tc.body, tc.signature)
+			// then
+			assert.Exactly(t, tc.expected, actual)
+		})
+	}
+}
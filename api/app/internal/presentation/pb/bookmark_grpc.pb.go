@@ -25,52 +25,2106 @@ const _ = grpc.SupportPackageIsVersion7
 type BookmarkerClient interface {
 	// ブックマークを作成する。
 	//
-	// 作成に成功した場合は OK を返却する。
+	// 作成に成功した場合は OK と作成したブックマークを返却する。
 	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 正規化したURIが一致するブックマークが既に存在する場合は ALREADY_EXISTS を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークを取得する。
+	//
+	// 取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで閲覧権限がない場合は PERMISSION_DENIED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	GetBookmark(ctx context.Context, in *GetBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error)
 	// ブックマークを一覧取得する。
 	//
 	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	//
+	// ブックマークを全て送信した後、最後にページ情報を送信する。
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (Bookmarker_ListBookmarksClient, error)
+	// ブックマークの変更を監視する。
+	//
+	// 作成、更新、削除が発生するたびに変更を送信し、クライアントが切断するまで監視を続ける。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 再開トークンが不正、あるいは再開できる範囲を過ぎている場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	WatchBookmarks(ctx context.Context, in *WatchBookmarksRequest, opts ...grpc.CallOption) (Bookmarker_WatchBookmarksClient, error)
+	// ブックマークを更新する。
+	//
+	// 更新に成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークを削除する。
+	//
+	// ブックマークはゴミ箱に移動し、RestoreBookmark で復元できる。
+	// 削除に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	DeleteBookmark(ctx context.Context, in *DeleteBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ゴミ箱にあるブックマークを一覧取得する。
+	//
+	// ゴミ箱に移動した日時の新しい順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListTrash(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListTrashClient, error)
+	// ブックマークをゴミ箱から復元する。
+	//
+	// 復元に成功した場合は OK と復元したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ゴミ箱にブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 正規化したURIが一致するブックマークが既に存在する場合は ALREADY_EXISTS を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RestoreBookmark(ctx context.Context, in *RestoreBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ゴミ箱にあるブックマークを完全に削除する。
+	//
+	// 基準日時より前にゴミ箱に移動したブックマークを対象とする。
+	// 削除に成功した場合は OK と削除したブックマーク数を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	// ブックマークの改訂履歴を一覧取得する。
+	//
+	// 作成日時の新しい順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListBookmarkRevisions(ctx context.Context, in *ListBookmarkRevisionsRequest, opts ...grpc.CallOption) (Bookmarker_ListBookmarkRevisionsClient, error)
+	// ブックマークを改訂前の内容に戻す。
+	//
+	// ブックマーク名、URI、説明、タグ一覧、所属するフォルダ、状態、お気に入りへの登録を改訂の変更前の内容に戻す。
+	// 戻すことに成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 改訂またはブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RevertBookmark(ctx context.Context, in *RevertBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークを既読にする。
+	//
+	// アーカイブ済みの場合はアーカイブを解除する。
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークを未読に戻す。
+	//
+	// アーカイブ済みの場合はアーカイブを解除する。
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MarkUnread(ctx context.Context, in *MarkUnreadRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークをアーカイブする。
+	//
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークをお気に入りに登録する。
+	//
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	Star(ctx context.Context, in *StarRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークをお気に入りから外す。
+	//
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	Unstar(ctx context.Context, in *UnstarRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークにタグを追加する。
+	//
+	// 追加に成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	AddTags(ctx context.Context, in *AddTagsRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// ブックマークからタグを削除する。
+	//
+	// 削除に成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RemoveTags(ctx context.Context, in *RemoveTagsRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// タグを一覧取得する。
+	//
+	// タグの辞書順にタグとブックマーク数の組を返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListTagsClient, error)
+	// タグ名を変更する。
+	//
+	// 変更前のタグが付与された全てのブックマークを対象とする。
+	// 変更に成功した場合は OK と変更したブックマーク数を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 対象のブックマークが同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// タグを統合する。
+	//
+	// 統合元のタグが付与された全てのブックマークを対象とする。
+	// 統合に成功した場合は OK と統合したブックマーク数を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 対象のブックマークが同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	// 重複するブックマークを一覧取得する。
+	//
+	// 正規形が一致するURIのブックマークを重複とみなす。
+	// 正規形のURIの辞書順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	FindDuplicates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_FindDuplicatesClient, error)
+	// ブックマークを統合する。
+	//
+	// 統合元のブックマークのタグを統合先のブックマークに追加し、統合元のブックマークを削除する。
+	// 統合に成功した場合は OK と統合先のブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// いずれかのブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MergeBookmarks(ctx context.Context, in *MergeBookmarksRequest, opts ...grpc.CallOption) (*Bookmark, error)
+	// 監査ログを一覧取得する。
+	//
+	// 作成日時の新しい順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (Bookmarker_ListAuditEntriesClient, error)
+	// ブックマークまたはコレクションを共有する。
+	//
+	// 同じユーザに同じ対象を共有済みの場合は権限を変更する。
+	// 共有に成功した場合は OK と共有を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ShareBookmark(ctx context.Context, in *ShareBookmarkRequest, opts ...grpc.CallOption) (*Share, error)
+	// 共有を解除する。
+	//
+	// 共有元と共有先のいずれのユーザも解除できる。
+	// 解除に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 共有が存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 共有されたブックマークを一覧取得する。
+	//
+	// ブックマークIDの昇順に返却する。
+	// 同じブックマークが複数の共有に含まれる場合は最も強い権限を返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListSharedWithMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListSharedWithMeClient, error)
+}
+
+type bookmarkerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookmarkerClient(cc grpc.ClientConnInterface) BookmarkerClient {
+	return &bookmarkerClient{cc}
+}
+
+func (c *bookmarkerClient) CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/CreateBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) GetBookmark(ctx context.Context, in *GetBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/GetBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (Bookmarker_ListBookmarksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[0], "/bookmark.Bookmarker/ListBookmarks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerListBookmarksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_ListBookmarksClient interface {
+	Recv() (*ListBookmarksResponse, error)
+	grpc.ClientStream
+}
+
+type bookmarkerListBookmarksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerListBookmarksClient) Recv() (*ListBookmarksResponse, error) {
+	m := new(ListBookmarksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) WatchBookmarks(ctx context.Context, in *WatchBookmarksRequest, opts ...grpc.CallOption) (Bookmarker_WatchBookmarksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[1], "/bookmark.Bookmarker/WatchBookmarks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerWatchBookmarksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_WatchBookmarksClient interface {
+	Recv() (*BookmarkChange, error)
+	grpc.ClientStream
+}
+
+type bookmarkerWatchBookmarksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerWatchBookmarksClient) Recv() (*BookmarkChange, error) {
+	m := new(BookmarkChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/UpdateBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) DeleteBookmark(ctx context.Context, in *DeleteBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/DeleteBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) ListTrash(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListTrashClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[2], "/bookmark.Bookmarker/ListTrash", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerListTrashClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_ListTrashClient interface {
+	Recv() (*Bookmark, error)
+	grpc.ClientStream
+}
+
+type bookmarkerListTrashClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerListTrashClient) Recv() (*Bookmark, error) {
+	m := new(Bookmark)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) RestoreBookmark(ctx context.Context, in *RestoreBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/RestoreBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/PurgeTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) ListBookmarkRevisions(ctx context.Context, in *ListBookmarkRevisionsRequest, opts ...grpc.CallOption) (Bookmarker_ListBookmarkRevisionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[3], "/bookmark.Bookmarker/ListBookmarkRevisions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerListBookmarkRevisionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_ListBookmarkRevisionsClient interface {
+	Recv() (*BookmarkRevision, error)
+	grpc.ClientStream
+}
+
+type bookmarkerListBookmarkRevisionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerListBookmarkRevisionsClient) Recv() (*BookmarkRevision, error) {
+	m := new(BookmarkRevision)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) RevertBookmark(ctx context.Context, in *RevertBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/RevertBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) MarkUnread(ctx context.Context, in *MarkUnreadRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/MarkUnread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/Archive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) Star(ctx context.Context, in *StarRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/Star", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) Unstar(ctx context.Context, in *UnstarRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/Unstar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) AddTags(ctx context.Context, in *AddTagsRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/AddTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) RemoveTags(ctx context.Context, in *RemoveTagsRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/RemoveTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) ListTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListTagsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[4], "/bookmark.Bookmarker/ListTags", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerListTagsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_ListTagsClient interface {
+	Recv() (*TagCount, error)
+	grpc.ClientStream
+}
+
+type bookmarkerListTagsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerListTagsClient) Recv() (*TagCount, error) {
+	m := new(TagCount)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/MergeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) FindDuplicates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_FindDuplicatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[5], "/bookmark.Bookmarker/FindDuplicates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerFindDuplicatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_FindDuplicatesClient interface {
+	Recv() (*Duplicate, error)
+	grpc.ClientStream
+}
+
+type bookmarkerFindDuplicatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerFindDuplicatesClient) Recv() (*Duplicate, error) {
+	m := new(Duplicate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) MergeBookmarks(ctx context.Context, in *MergeBookmarksRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/MergeBookmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (Bookmarker_ListAuditEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[6], "/bookmark.Bookmarker/ListAuditEntries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerListAuditEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_ListAuditEntriesClient interface {
+	Recv() (*AuditEntry, error)
+	grpc.ClientStream
+}
+
+type bookmarkerListAuditEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerListAuditEntriesClient) Recv() (*AuditEntry, error) {
+	m := new(AuditEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookmarkerClient) ShareBookmark(ctx context.Context, in *ShareBookmarkRequest, opts ...grpc.CallOption) (*Share, error) {
+	out := new(Share)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/ShareBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/RevokeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookmarkerClient) ListSharedWithMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListSharedWithMeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[7], "/bookmark.Bookmarker/ListSharedWithMe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookmarkerListSharedWithMeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bookmarker_ListSharedWithMeClient interface {
+	Recv() (*SharedBookmark, error)
+	grpc.ClientStream
+}
+
+type bookmarkerListSharedWithMeClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookmarkerListSharedWithMeClient) Recv() (*SharedBookmark, error) {
+	m := new(SharedBookmark)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BookmarkerServer is the server API for Bookmarker service.
+// All implementations must embed UnimplementedBookmarkerServer
+// for forward compatibility
+type BookmarkerServer interface {
+	// ブックマークを作成する。
+	//
+	// 作成に成功した場合は OK と作成したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 正規化したURIが一致するブックマークが既に存在する場合は ALREADY_EXISTS を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	CreateBookmark(context.Context, *CreateBookmarkRequest) (*Bookmark, error)
+	// ブックマークを取得する。
+	//
+	// 取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで閲覧権限がない場合は PERMISSION_DENIED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	GetBookmark(context.Context, *GetBookmarkRequest) (*Bookmark, error)
+	// ブックマークを一覧取得する。
+	//
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	//
+	// ブックマークを全て送信した後、最後にページ情報を送信する。
+	ListBookmarks(*ListBookmarksRequest, Bookmarker_ListBookmarksServer) error
+	// ブックマークの変更を監視する。
+	//
+	// 作成、更新、削除が発生するたびに変更を送信し、クライアントが切断するまで監視を続ける。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 再開トークンが不正、あるいは再開できる範囲を過ぎている場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	WatchBookmarks(*WatchBookmarksRequest, Bookmarker_WatchBookmarksServer) error
+	// ブックマークを更新する。
+	//
+	// 更新に成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*Bookmark, error)
+	// ブックマークを削除する。
+	//
+	// ブックマークはゴミ箱に移動し、RestoreBookmark で復元できる。
+	// 削除に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	DeleteBookmark(context.Context, *DeleteBookmarkRequest) (*emptypb.Empty, error)
+	// ゴミ箱にあるブックマークを一覧取得する。
+	//
+	// ゴミ箱に移動した日時の新しい順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListTrash(*emptypb.Empty, Bookmarker_ListTrashServer) error
+	// ブックマークをゴミ箱から復元する。
+	//
+	// 復元に成功した場合は OK と復元したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ゴミ箱にブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 正規化したURIが一致するブックマークが既に存在する場合は ALREADY_EXISTS を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RestoreBookmark(context.Context, *RestoreBookmarkRequest) (*Bookmark, error)
+	// ゴミ箱にあるブックマークを完全に削除する。
+	//
+	// 基準日時より前にゴミ箱に移動したブックマークを対象とする。
+	// 削除に成功した場合は OK と削除したブックマーク数を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	// ブックマークの改訂履歴を一覧取得する。
+	//
+	// 作成日時の新しい順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListBookmarkRevisions(*ListBookmarkRevisionsRequest, Bookmarker_ListBookmarkRevisionsServer) error
+	// ブックマークを改訂前の内容に戻す。
+	//
+	// ブックマーク名、URI、説明、タグ一覧、所属するフォルダ、状態、お気に入りへの登録を改訂の変更前の内容に戻す。
+	// 戻すことに成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 改訂またはブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RevertBookmark(context.Context, *RevertBookmarkRequest) (*Bookmark, error)
+	// ブックマークを既読にする。
+	//
+	// アーカイブ済みの場合はアーカイブを解除する。
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MarkRead(context.Context, *MarkReadRequest) (*Bookmark, error)
+	// ブックマークを未読に戻す。
+	//
+	// アーカイブ済みの場合はアーカイブを解除する。
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MarkUnread(context.Context, *MarkUnreadRequest) (*Bookmark, error)
+	// ブックマークをアーカイブする。
+	//
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	Archive(context.Context, *ArchiveRequest) (*Bookmark, error)
+	// ブックマークをお気に入りに登録する。
+	//
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	Star(context.Context, *StarRequest) (*Bookmark, error)
+	// ブックマークをお気に入りから外す。
+	//
+	// 成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	Unstar(context.Context, *UnstarRequest) (*Bookmark, error)
+	// ブックマークにタグを追加する。
+	//
+	// 追加に成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	AddTags(context.Context, *AddTagsRequest) (*Bookmark, error)
+	// ブックマークからタグを削除する。
+	//
+	// 削除に成功した場合は OK と更新したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+	// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RemoveTags(context.Context, *RemoveTagsRequest) (*Bookmark, error)
+	// タグを一覧取得する。
+	//
+	// タグの辞書順にタグとブックマーク数の組を返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListTags(*emptypb.Empty, Bookmarker_ListTagsServer) error
+	// タグ名を変更する。
+	//
+	// 変更前のタグが付与された全てのブックマークを対象とする。
+	// 変更に成功した場合は OK と変更したブックマーク数を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 対象のブックマークが同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// タグを統合する。
+	//
+	// 統合元のタグが付与された全てのブックマークを対象とする。
+	// 統合に成功した場合は OK と統合したブックマーク数を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 対象のブックマークが同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	// 重複するブックマークを一覧取得する。
+	//
+	// 正規形が一致するURIのブックマークを重複とみなす。
+	// 正規形のURIの辞書順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	FindDuplicates(*emptypb.Empty, Bookmarker_FindDuplicatesServer) error
+	// ブックマークを統合する。
+	//
+	// 統合元のブックマークのタグを統合先のブックマークに追加し、統合元のブックマークを削除する。
+	// 統合に成功した場合は OK と統合先のブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// いずれかのブックマークが存在しない場合は NOT_FOUND を返却する。
+	// 同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MergeBookmarks(context.Context, *MergeBookmarksRequest) (*Bookmark, error)
+	// 監査ログを一覧取得する。
+	//
+	// 作成日時の新しい順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListAuditEntries(*ListAuditEntriesRequest, Bookmarker_ListAuditEntriesServer) error
+	// ブックマークまたはコレクションを共有する。
+	//
+	// 同じユーザに同じ対象を共有済みの場合は権限を変更する。
+	// 共有に成功した場合は OK と共有を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ShareBookmark(context.Context, *ShareBookmarkRequest) (*Share, error)
+	// 共有を解除する。
+	//
+	// 共有元と共有先のいずれのユーザも解除できる。
+	// 解除に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 共有が存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	RevokeShare(context.Context, *RevokeShareRequest) (*emptypb.Empty, error)
+	// 共有されたブックマークを一覧取得する。
+	//
+	// ブックマークIDの昇順に返却する。
+	// 同じブックマークが複数の共有に含まれる場合は最も強い権限を返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListSharedWithMe(*emptypb.Empty, Bookmarker_ListSharedWithMeServer) error
+	mustEmbedUnimplementedBookmarkerServer()
+}
+
+// UnimplementedBookmarkerServer must be embedded to have forward compatible implementations.
+type UnimplementedBookmarkerServer struct {
+}
+
+func (UnimplementedBookmarkerServer) CreateBookmark(context.Context, *CreateBookmarkRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) GetBookmark(context.Context, *GetBookmarkRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) ListBookmarks(*ListBookmarksRequest, Bookmarker_ListBookmarksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedBookmarkerServer) WatchBookmarks(*WatchBookmarksRequest, Bookmarker_WatchBookmarksServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBookmarks not implemented")
+}
+func (UnimplementedBookmarkerServer) UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) DeleteBookmark(context.Context, *DeleteBookmarkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) ListTrash(*emptypb.Empty, Bookmarker_ListTrashServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedBookmarkerServer) RestoreBookmark(context.Context, *RestoreBookmarkRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedBookmarkerServer) ListBookmarkRevisions(*ListBookmarkRevisionsRequest, Bookmarker_ListBookmarkRevisionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBookmarkRevisions not implemented")
+}
+func (UnimplementedBookmarkerServer) RevertBookmark(context.Context, *RevertBookmarkRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) MarkRead(context.Context, *MarkReadRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedBookmarkerServer) MarkUnread(context.Context, *MarkUnreadRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkUnread not implemented")
+}
+func (UnimplementedBookmarkerServer) Archive(context.Context, *ArchiveRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Archive not implemented")
+}
+func (UnimplementedBookmarkerServer) Star(context.Context, *StarRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Star not implemented")
+}
+func (UnimplementedBookmarkerServer) Unstar(context.Context, *UnstarRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstar not implemented")
+}
+func (UnimplementedBookmarkerServer) AddTags(context.Context, *AddTagsRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedBookmarkerServer) RemoveTags(context.Context, *RemoveTagsRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedBookmarkerServer) ListTags(*emptypb.Empty, Bookmarker_ListTagsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBookmarkerServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedBookmarkerServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedBookmarkerServer) FindDuplicates(*emptypb.Empty, Bookmarker_FindDuplicatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedBookmarkerServer) MergeBookmarks(context.Context, *MergeBookmarksRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBookmarks not implemented")
+}
+func (UnimplementedBookmarkerServer) ListAuditEntries(*ListAuditEntriesRequest, Bookmarker_ListAuditEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedBookmarkerServer) ShareBookmark(context.Context, *ShareBookmarkRequest) (*Share, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareBookmark not implemented")
+}
+func (UnimplementedBookmarkerServer) RevokeShare(context.Context, *RevokeShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedBookmarkerServer) ListSharedWithMe(*emptypb.Empty, Bookmarker_ListSharedWithMeServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListSharedWithMe not implemented")
+}
+func (UnimplementedBookmarkerServer) mustEmbedUnimplementedBookmarkerServer() {}
+
+// UnsafeBookmarkerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookmarkerServer will
+// result in compilation errors.
+type UnsafeBookmarkerServer interface {
+	mustEmbedUnimplementedBookmarkerServer()
+}
+
+func RegisterBookmarkerServer(s grpc.ServiceRegistrar, srv BookmarkerServer) {
+	s.RegisterService(&Bookmarker_ServiceDesc, srv)
+}
+
+func _Bookmarker_CreateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).CreateBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/CreateBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).CreateBookmark(ctx, req.(*CreateBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_GetBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).GetBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/GetBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).GetBookmark(ctx, req.(*GetBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_ListBookmarks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBookmarksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).ListBookmarks(m, &bookmarkerListBookmarksServer{stream})
+}
+
+type Bookmarker_ListBookmarksServer interface {
+	Send(*ListBookmarksResponse) error
+	grpc.ServerStream
+}
+
+type bookmarkerListBookmarksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerListBookmarksServer) Send(m *ListBookmarksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_WatchBookmarks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBookmarksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).WatchBookmarks(m, &bookmarkerWatchBookmarksServer{stream})
+}
+
+type Bookmarker_WatchBookmarksServer interface {
+	Send(*BookmarkChange) error
+	grpc.ServerStream
+}
+
+type bookmarkerWatchBookmarksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerWatchBookmarksServer) Send(m *BookmarkChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_UpdateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).UpdateBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/UpdateBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).UpdateBookmark(ctx, req.(*UpdateBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_DeleteBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).DeleteBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/DeleteBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).DeleteBookmark(ctx, req.(*DeleteBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_ListTrash_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).ListTrash(m, &bookmarkerListTrashServer{stream})
+}
+
+type Bookmarker_ListTrashServer interface {
+	Send(*Bookmark) error
+	grpc.ServerStream
+}
+
+type bookmarkerListTrashServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerListTrashServer) Send(m *Bookmark) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_RestoreBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).RestoreBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/RestoreBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).RestoreBookmark(ctx, req.(*RestoreBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/PurgeTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_ListBookmarkRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBookmarkRevisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).ListBookmarkRevisions(m, &bookmarkerListBookmarkRevisionsServer{stream})
+}
+
+type Bookmarker_ListBookmarkRevisionsServer interface {
+	Send(*BookmarkRevision) error
+	grpc.ServerStream
+}
+
+type bookmarkerListBookmarkRevisionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerListBookmarkRevisionsServer) Send(m *BookmarkRevision) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_RevertBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).RevertBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/RevertBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).RevertBookmark(ctx, req.(*RevertBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_MarkUnread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkUnreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).MarkUnread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/MarkUnread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).MarkUnread(ctx, req.(*MarkUnreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_Archive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).Archive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/Archive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).Archive(ctx, req.(*ArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_Star_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).Star(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/Star",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).Star(ctx, req.(*StarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_Unstar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnstarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).Unstar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/Unstar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).Unstar(ctx, req.(*UnstarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/AddTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).AddTags(ctx, req.(*AddTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/RemoveTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).RemoveTags(ctx, req.(*RemoveTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).ListTags(m, &bookmarkerListTagsServer{stream})
+}
+
+type Bookmarker_ListTagsServer interface {
+	Send(*TagCount) error
+	grpc.ServerStream
+}
+
+type bookmarkerListTagsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerListTagsServer) Send(m *TagCount) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/RenameTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/MergeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_FindDuplicates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).FindDuplicates(m, &bookmarkerFindDuplicatesServer{stream})
+}
+
+type Bookmarker_FindDuplicatesServer interface {
+	Send(*Duplicate) error
+	grpc.ServerStream
+}
+
+type bookmarkerFindDuplicatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerFindDuplicatesServer) Send(m *Duplicate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_MergeBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).MergeBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/MergeBookmarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).MergeBookmarks(ctx, req.(*MergeBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_ListAuditEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).ListAuditEntries(m, &bookmarkerListAuditEntriesServer{stream})
+}
+
+type Bookmarker_ListAuditEntriesServer interface {
+	Send(*AuditEntry) error
+	grpc.ServerStream
+}
+
+type bookmarkerListAuditEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerListAuditEntriesServer) Send(m *AuditEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bookmarker_ShareBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).ShareBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/ShareBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).ShareBookmark(ctx, req.(*ShareBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookmarkerServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.Bookmarker/RevokeShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookmarkerServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bookmarker_ListSharedWithMe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookmarkerServer).ListSharedWithMe(m, &bookmarkerListSharedWithMeServer{stream})
+}
+
+type Bookmarker_ListSharedWithMeServer interface {
+	Send(*SharedBookmark) error
+	grpc.ServerStream
+}
+
+type bookmarkerListSharedWithMeServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookmarkerListSharedWithMeServer) Send(m *SharedBookmark) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Bookmarker_ServiceDesc is the grpc.ServiceDesc for Bookmarker service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bookmarker_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookmark.Bookmarker",
+	HandlerType: (*BookmarkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBookmark",
+			Handler:    _Bookmarker_CreateBookmark_Handler,
+		},
+		{
+			MethodName: "GetBookmark",
+			Handler:    _Bookmarker_GetBookmark_Handler,
+		},
+		{
+			MethodName: "UpdateBookmark",
+			Handler:    _Bookmarker_UpdateBookmark_Handler,
+		},
+		{
+			MethodName: "DeleteBookmark",
+			Handler:    _Bookmarker_DeleteBookmark_Handler,
+		},
+		{
+			MethodName: "RestoreBookmark",
+			Handler:    _Bookmarker_RestoreBookmark_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _Bookmarker_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "RevertBookmark",
+			Handler:    _Bookmarker_RevertBookmark_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _Bookmarker_MarkRead_Handler,
+		},
+		{
+			MethodName: "MarkUnread",
+			Handler:    _Bookmarker_MarkUnread_Handler,
+		},
+		{
+			MethodName: "Archive",
+			Handler:    _Bookmarker_Archive_Handler,
+		},
+		{
+			MethodName: "Star",
+			Handler:    _Bookmarker_Star_Handler,
+		},
+		{
+			MethodName: "Unstar",
+			Handler:    _Bookmarker_Unstar_Handler,
+		},
+		{
+			MethodName: "AddTags",
+			Handler:    _Bookmarker_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _Bookmarker_RemoveTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _Bookmarker_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _Bookmarker_MergeTags_Handler,
+		},
+		{
+			MethodName: "MergeBookmarks",
+			Handler:    _Bookmarker_MergeBookmarks_Handler,
+		},
+		{
+			MethodName: "ShareBookmark",
+			Handler:    _Bookmarker_ShareBookmark_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _Bookmarker_RevokeShare_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListBookmarks",
+			Handler:       _Bookmarker_ListBookmarks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBookmarks",
+			Handler:       _Bookmarker_WatchBookmarks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTrash",
+			Handler:       _Bookmarker_ListTrash_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBookmarkRevisions",
+			Handler:       _Bookmarker_ListBookmarkRevisions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTags",
+			Handler:       _Bookmarker_ListTags_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindDuplicates",
+			Handler:       _Bookmarker_FindDuplicates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListAuditEntries",
+			Handler:       _Bookmarker_ListAuditEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListSharedWithMe",
+			Handler:       _Bookmarker_ListSharedWithMe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bookmark.proto",
+}
+
+// FolderManagerClient is the client API for FolderManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FolderManagerClient interface {
+	// フォルダを作成する。
+	//
+	// 作成に成功した場合は OK と作成したフォルダを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 親フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// フォルダを取得する。
+	//
+	// 取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	GetFolder(ctx context.Context, in *GetFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// 親フォルダ直下のフォルダを一覧取得する。
+	//
+	// 並び順の昇順、並び順が等しい場合はフォルダIDの昇順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 親フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (FolderManager_ListFoldersClient, error)
+	// フォルダ名を変更する。
+	//
+	// 変更に成功した場合は OK と更新したフォルダを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// フォルダを削除する。
+	//
+	// 削除に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダが存在しない場合は NOT_FOUND を返却する。
+	// 子フォルダまたはブックマーク (ゴミ箱にあるものを含む) を含む場合は FAILED_PRECONDITION を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// フォルダを移動する。
+	//
+	// 移動に成功した場合は OK と移動したフォルダを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダまたは移動先の親フォルダが存在しない場合は NOT_FOUND を返却する。
+	// 移動先が自身の子孫である場合は FAILED_PRECONDITION を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	// ブックマークをフォルダに移動する。
+	//
+	// 移動に成功した場合は OK と移動したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークまたは移動先のフォルダが存在しない場合は NOT_FOUND を返却する。
+	// 同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MoveBookmark(ctx context.Context, in *MoveBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error)
+}
+
+type folderManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFolderManagerClient(cc grpc.ClientConnInterface) FolderManagerClient {
+	return &folderManagerClient{cc}
+}
+
+func (c *folderManagerClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/bookmark.FolderManager/CreateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderManagerClient) GetFolder(ctx context.Context, in *GetFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/bookmark.FolderManager/GetFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderManagerClient) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (FolderManager_ListFoldersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FolderManager_ServiceDesc.Streams[0], "/bookmark.FolderManager/ListFolders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &folderManagerListFoldersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FolderManager_ListFoldersClient interface {
+	Recv() (*Folder, error)
+	grpc.ClientStream
+}
+
+type folderManagerListFoldersClient struct {
+	grpc.ClientStream
+}
+
+func (x *folderManagerListFoldersClient) Recv() (*Folder, error) {
+	m := new(Folder)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *folderManagerClient) UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/bookmark.FolderManager/UpdateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderManagerClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bookmark.FolderManager/DeleteFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderManagerClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, "/bookmark.FolderManager/MoveFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *folderManagerClient) MoveBookmark(ctx context.Context, in *MoveBookmarkRequest, opts ...grpc.CallOption) (*Bookmark, error) {
+	out := new(Bookmark)
+	err := c.cc.Invoke(ctx, "/bookmark.FolderManager/MoveBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FolderManagerServer is the server API for FolderManager service.
+// All implementations must embed UnimplementedFolderManagerServer
+// for forward compatibility
+type FolderManagerServer interface {
+	// フォルダを作成する。
+	//
+	// 作成に成功した場合は OK と作成したフォルダを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 親フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	CreateFolder(context.Context, *CreateFolderRequest) (*Folder, error)
+	// フォルダを取得する。
+	//
+	// 取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	GetFolder(context.Context, *GetFolderRequest) (*Folder, error)
+	// 親フォルダ直下のフォルダを一覧取得する。
+	//
+	// 並び順の昇順、並び順が等しい場合はフォルダIDの昇順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 親フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	ListFolders(*ListFoldersRequest, FolderManager_ListFoldersServer) error
+	// フォルダ名を変更する。
+	//
+	// 変更に成功した場合は OK と更新したフォルダを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダが存在しない場合は NOT_FOUND を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	UpdateFolder(context.Context, *UpdateFolderRequest) (*Folder, error)
+	// フォルダを削除する。
+	//
+	// 削除に成功した場合は OK を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダが存在しない場合は NOT_FOUND を返却する。
+	// 子フォルダまたはブックマーク (ゴミ箱にあるものを含む) を含む場合は FAILED_PRECONDITION を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error)
+	// フォルダを移動する。
+	//
+	// 移動に成功した場合は OK と移動したフォルダを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// フォルダまたは移動先の親フォルダが存在しない場合は NOT_FOUND を返却する。
+	// 移動先が自身の子孫である場合は FAILED_PRECONDITION を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MoveFolder(context.Context, *MoveFolderRequest) (*Folder, error)
+	// ブックマークをフォルダに移動する。
+	//
+	// 移動に成功した場合は OK と移動したブックマークを返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// ブックマークまたは移動先のフォルダが存在しない場合は NOT_FOUND を返却する。
+	// 同時に更新された場合は ABORTED を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	MoveBookmark(context.Context, *MoveBookmarkRequest) (*Bookmark, error)
+	mustEmbedUnimplementedFolderManagerServer()
+}
+
+// UnimplementedFolderManagerServer must be embedded to have forward compatible implementations.
+type UnimplementedFolderManagerServer struct {
+}
+
+func (UnimplementedFolderManagerServer) CreateFolder(context.Context, *CreateFolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedFolderManagerServer) GetFolder(context.Context, *GetFolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolder not implemented")
+}
+func (UnimplementedFolderManagerServer) ListFolders(*ListFoldersRequest, FolderManager_ListFoldersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (UnimplementedFolderManagerServer) UpdateFolder(context.Context, *UpdateFolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFolder not implemented")
+}
+func (UnimplementedFolderManagerServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedFolderManagerServer) MoveFolder(context.Context, *MoveFolderRequest) (*Folder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedFolderManagerServer) MoveBookmark(context.Context, *MoveBookmarkRequest) (*Bookmark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBookmark not implemented")
+}
+func (UnimplementedFolderManagerServer) mustEmbedUnimplementedFolderManagerServer() {}
+
+// UnsafeFolderManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FolderManagerServer will
+// result in compilation errors.
+type UnsafeFolderManagerServer interface {
+	mustEmbedUnimplementedFolderManagerServer()
+}
+
+func RegisterFolderManagerServer(s grpc.ServiceRegistrar, srv FolderManagerServer) {
+	s.RegisterService(&FolderManager_ServiceDesc, srv)
+}
+
+func _FolderManager_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderManagerServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.FolderManager/CreateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderManagerServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderManager_GetFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderManagerServer).GetFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.FolderManager/GetFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderManagerServer).GetFolder(ctx, req.(*GetFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderManager_ListFolders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFoldersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FolderManagerServer).ListFolders(m, &folderManagerListFoldersServer{stream})
+}
+
+type FolderManager_ListFoldersServer interface {
+	Send(*Folder) error
+	grpc.ServerStream
+}
+
+type folderManagerListFoldersServer struct {
+	grpc.ServerStream
+}
+
+func (x *folderManagerListFoldersServer) Send(m *Folder) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FolderManager_UpdateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderManagerServer).UpdateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.FolderManager/UpdateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderManagerServer).UpdateFolder(ctx, req.(*UpdateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderManager_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderManagerServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.FolderManager/DeleteFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderManagerServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderManager_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderManagerServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.FolderManager/MoveFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderManagerServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FolderManager_MoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FolderManagerServer).MoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bookmark.FolderManager/MoveBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FolderManagerServer).MoveBookmark(ctx, req.(*MoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FolderManager_ServiceDesc is the grpc.ServiceDesc for FolderManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FolderManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookmark.FolderManager",
+	HandlerType: (*FolderManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFolder",
+			Handler:    _FolderManager_CreateFolder_Handler,
+		},
+		{
+			MethodName: "GetFolder",
+			Handler:    _FolderManager_GetFolder_Handler,
+		},
+		{
+			MethodName: "UpdateFolder",
+			Handler:    _FolderManager_UpdateFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _FolderManager_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _FolderManager_MoveFolder_Handler,
+		},
+		{
+			MethodName: "MoveBookmark",
+			Handler:    _FolderManager_MoveBookmark_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListFolders",
+			Handler:       _FolderManager_ListFolders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bookmark.proto",
+}
+
+// WebhookManagerClient is the client API for WebhookManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookManagerClient interface {
+	// Webhookを購読する。
+	//
+	// 購読に成功した場合は OK と作成した購読を返却する。
+	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// サーバエラーが発生した場合は INTERNAL を返却する。
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Webhookの購読を一覧取得する。
+	//
+	// WebhookIDの昇順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	ListBookmarks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListBookmarksClient, error)
-	// ブックマークを更新する。
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (WebhookManager_ListWebhooksClient, error)
+	// Webhookの購読を解除する。
 	//
-	// 更新に成功した場合は OK を返却する。
+	// 解除に成功した場合は OK を返却する。
 	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 購読が存在しない場合は NOT_FOUND を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// ブックマークを削除する。
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 配信に失敗した通知を一覧取得する。
 	//
-	// 削除に成功した場合は OK を返却する。
+	// 作成日時の降順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
 	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	DeleteBookmark(ctx context.Context, in *DeleteBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (WebhookManager_ListDeadLettersClient, error)
 }
 
-type bookmarkerClient struct {
+type webhookManagerClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewBookmarkerClient(cc grpc.ClientConnInterface) BookmarkerClient {
-	return &bookmarkerClient{cc}
+func NewWebhookManagerClient(cc grpc.ClientConnInterface) WebhookManagerClient {
+	return &webhookManagerClient{cc}
 }
 
-func (c *bookmarkerClient) CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/CreateBookmark", in, out, opts...)
+func (c *webhookManagerClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/bookmark.WebhookManager/CreateWebhook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bookmarkerClient) ListBookmarks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Bookmarker_ListBookmarksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Bookmarker_ServiceDesc.Streams[0], "/bookmark.Bookmarker/ListBookmarks", opts...)
+func (c *webhookManagerClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (WebhookManager_ListWebhooksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WebhookManager_ServiceDesc.Streams[0], "/bookmark.WebhookManager/ListWebhooks", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &bookmarkerListBookmarksClient{stream}
+	x := &webhookManagerListWebhooksClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -80,199 +2134,229 @@ func (c *bookmarkerClient) ListBookmarks(ctx context.Context, in *emptypb.Empty,
 	return x, nil
 }
 
-type Bookmarker_ListBookmarksClient interface {
-	Recv() (*Bookmark, error)
+type WebhookManager_ListWebhooksClient interface {
+	Recv() (*Webhook, error)
 	grpc.ClientStream
 }
 
-type bookmarkerListBookmarksClient struct {
+type webhookManagerListWebhooksClient struct {
 	grpc.ClientStream
 }
 
-func (x *bookmarkerListBookmarksClient) Recv() (*Bookmark, error) {
-	m := new(Bookmark)
+func (x *webhookManagerListWebhooksClient) Recv() (*Webhook, error) {
+	m := new(Webhook)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *bookmarkerClient) UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *webhookManagerClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/UpdateBookmark", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookmark.WebhookManager/DeleteWebhook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bookmarkerClient) DeleteBookmark(ctx context.Context, in *DeleteBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bookmark.Bookmarker/DeleteBookmark", in, out, opts...)
+func (c *webhookManagerClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (WebhookManager_ListDeadLettersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WebhookManager_ServiceDesc.Streams[1], "/bookmark.WebhookManager/ListDeadLetters", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &webhookManagerListDeadLettersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// BookmarkerServer is the server API for Bookmarker service.
-// All implementations must embed UnimplementedBookmarkerServer
+type WebhookManager_ListDeadLettersClient interface {
+	Recv() (*DeadLetter, error)
+	grpc.ClientStream
+}
+
+type webhookManagerListDeadLettersClient struct {
+	grpc.ClientStream
+}
+
+func (x *webhookManagerListDeadLettersClient) Recv() (*DeadLetter, error) {
+	m := new(DeadLetter)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebhookManagerServer is the server API for WebhookManager service.
+// All implementations must embed UnimplementedWebhookManagerServer
 // for forward compatibility
-type BookmarkerServer interface {
-	// ブックマークを作成する。
+type WebhookManagerServer interface {
+	// Webhookを購読する。
 	//
-	// 作成に成功した場合は OK を返却する。
+	// 購読に成功した場合は OK と作成した購読を返却する。
 	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	CreateBookmark(context.Context, *CreateBookmarkRequest) (*emptypb.Empty, error)
-	// ブックマークを一覧取得する。
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	// Webhookの購読を一覧取得する。
 	//
+	// WebhookIDの昇順に返却する。
 	// 一覧取得に成功した場合は OK を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	ListBookmarks(*emptypb.Empty, Bookmarker_ListBookmarksServer) error
-	// ブックマークを更新する。
+	ListWebhooks(*emptypb.Empty, WebhookManager_ListWebhooksServer) error
+	// Webhookの購読を解除する。
 	//
-	// 更新に成功した場合は OK を返却する。
+	// 解除に成功した場合は OK を返却する。
 	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
+	// 購読が存在しない場合は NOT_FOUND を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*emptypb.Empty, error)
-	// ブックマークを削除する。
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	// 配信に失敗した通知を一覧取得する。
 	//
-	// 削除に成功した場合は OK を返却する。
+	// 作成日時の降順に返却する。
+	// 一覧取得に成功した場合は OK を返却する。
 	// 無効な引数を指定した場合は INVALID_ARGUMENT を返却する。
 	// サーバエラーが発生した場合は INTERNAL を返却する。
-	DeleteBookmark(context.Context, *DeleteBookmarkRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedBookmarkerServer()
+	ListDeadLetters(*ListDeadLettersRequest, WebhookManager_ListDeadLettersServer) error
+	mustEmbedUnimplementedWebhookManagerServer()
 }
 
-// UnimplementedBookmarkerServer must be embedded to have forward compatible implementations.
-type UnimplementedBookmarkerServer struct {
+// UnimplementedWebhookManagerServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookManagerServer struct {
 }
 
-func (UnimplementedBookmarkerServer) CreateBookmark(context.Context, *CreateBookmarkRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmark not implemented")
+func (UnimplementedWebhookManagerServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
 }
-func (UnimplementedBookmarkerServer) ListBookmarks(*emptypb.Empty, Bookmarker_ListBookmarksServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+func (UnimplementedWebhookManagerServer) ListWebhooks(*emptypb.Empty, WebhookManager_ListWebhooksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
 }
-func (UnimplementedBookmarkerServer) UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookmark not implemented")
+func (UnimplementedWebhookManagerServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
 }
-func (UnimplementedBookmarkerServer) DeleteBookmark(context.Context, *DeleteBookmarkRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmark not implemented")
+func (UnimplementedWebhookManagerServer) ListDeadLetters(*ListDeadLettersRequest, WebhookManager_ListDeadLettersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
 }
-func (UnimplementedBookmarkerServer) mustEmbedUnimplementedBookmarkerServer() {}
+func (UnimplementedWebhookManagerServer) mustEmbedUnimplementedWebhookManagerServer() {}
 
-// UnsafeBookmarkerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BookmarkerServer will
+// UnsafeWebhookManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookManagerServer will
 // result in compilation errors.
-type UnsafeBookmarkerServer interface {
-	mustEmbedUnimplementedBookmarkerServer()
+type UnsafeWebhookManagerServer interface {
+	mustEmbedUnimplementedWebhookManagerServer()
 }
 
-func RegisterBookmarkerServer(s grpc.ServiceRegistrar, srv BookmarkerServer) {
-	s.RegisterService(&Bookmarker_ServiceDesc, srv)
+func RegisterWebhookManagerServer(s grpc.ServiceRegistrar, srv WebhookManagerServer) {
+	s.RegisterService(&WebhookManager_ServiceDesc, srv)
 }
 
-func _Bookmarker_CreateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBookmarkRequest)
+func _WebhookManager_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookmarkerServer).CreateBookmark(ctx, in)
+		return srv.(WebhookManagerServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bookmark.Bookmarker/CreateBookmark",
+		FullMethod: "/bookmark.WebhookManager/CreateWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookmarkerServer).CreateBookmark(ctx, req.(*CreateBookmarkRequest))
+		return srv.(WebhookManagerServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bookmarker_ListBookmarks_Handler(srv interface{}, stream grpc.ServerStream) error {
+func _WebhookManager_ListWebhooks_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(emptypb.Empty)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(BookmarkerServer).ListBookmarks(m, &bookmarkerListBookmarksServer{stream})
+	return srv.(WebhookManagerServer).ListWebhooks(m, &webhookManagerListWebhooksServer{stream})
 }
 
-type Bookmarker_ListBookmarksServer interface {
-	Send(*Bookmark) error
+type WebhookManager_ListWebhooksServer interface {
+	Send(*Webhook) error
 	grpc.ServerStream
 }
 
-type bookmarkerListBookmarksServer struct {
+type webhookManagerListWebhooksServer struct {
 	grpc.ServerStream
 }
 
-func (x *bookmarkerListBookmarksServer) Send(m *Bookmark) error {
+func (x *webhookManagerListWebhooksServer) Send(m *Webhook) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Bookmarker_UpdateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBookmarkRequest)
+func _WebhookManager_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookmarkerServer).UpdateBookmark(ctx, in)
+		return srv.(WebhookManagerServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bookmark.Bookmarker/UpdateBookmark",
+		FullMethod: "/bookmark.WebhookManager/DeleteWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookmarkerServer).UpdateBookmark(ctx, req.(*UpdateBookmarkRequest))
+		return srv.(WebhookManagerServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bookmarker_DeleteBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBookmarkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookmarkerServer).DeleteBookmark(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bookmark.Bookmarker/DeleteBookmark",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookmarkerServer).DeleteBookmark(ctx, req.(*DeleteBookmarkRequest))
+func _WebhookManager_ListDeadLetters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDeadLettersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(WebhookManagerServer).ListDeadLetters(m, &webhookManagerListDeadLettersServer{stream})
 }
 
-// Bookmarker_ServiceDesc is the grpc.ServiceDesc for Bookmarker service.
+type WebhookManager_ListDeadLettersServer interface {
+	Send(*DeadLetter) error
+	grpc.ServerStream
+}
+
+type webhookManagerListDeadLettersServer struct {
+	grpc.ServerStream
+}
+
+func (x *webhookManagerListDeadLettersServer) Send(m *DeadLetter) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// WebhookManager_ServiceDesc is the grpc.ServiceDesc for WebhookManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Bookmarker_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "bookmark.Bookmarker",
-	HandlerType: (*BookmarkerServer)(nil),
+var WebhookManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookmark.WebhookManager",
+	HandlerType: (*WebhookManagerServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateBookmark",
-			Handler:    _Bookmarker_CreateBookmark_Handler,
-		},
-		{
-			MethodName: "UpdateBookmark",
-			Handler:    _Bookmarker_UpdateBookmark_Handler,
+			MethodName: "CreateWebhook",
+			Handler:    _WebhookManager_CreateWebhook_Handler,
 		},
 		{
-			MethodName: "DeleteBookmark",
-			Handler:    _Bookmarker_DeleteBookmark_Handler,
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookManager_DeleteWebhook_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "ListBookmarks",
-			Handler:       _Bookmarker_ListBookmarks_Handler,
+			StreamName:    "ListWebhooks",
+			Handler:       _WebhookManager_ListWebhooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDeadLetters",
+			Handler:       _WebhookManager_ListDeadLetters_Handler,
 			ServerStreams: true,
 		},
 	},
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationKey = "authorization" // 認証情報を表すメタデータのキー
+	bearerPrefix     = "Bearer "       // Bearerトークンの接頭辞
+)
+
+// コンテキストにユーザIDを格納するためのキー。
+type userIDContextKey struct{}
+
+// ユーザIDを格納したコンテキストを生成する。
+func withUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, userID)
+}
+
+// コンテキストから認証済みのユーザIDを取得する。
+//
+// ユーザIDが格納されていない場合は UNAUTHENTICATED を返却する。
+func userIDFromContext(ctx context.Context) (string, error) {
+	userID, ok := ctx.Value(userIDContextKey{}).(string)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return userID, nil
+}
+
+// gRPCの呼び出し元を認証する認証器。
+//
+// メタデータの authorization に指定されたBearerトークンからユーザを特定する。
+type Authenticator struct {
+	users map[string]string // トークンとユーザIDの対応
+}
+
+// gRPCの呼び出し元を認証する認証器を生成する。
+func NewAuthenticator(users map[string]string) *Authenticator {
+	copied := make(map[string]string, len(users))
+	for token, userID := range users {
+		copied[token] = userID
+	}
+	return &Authenticator{
+		users: copied,
+	}
+}
+
+// コンテキストのメタデータから呼び出し元を認証する。
+//
+// 認証に成功した場合はユーザIDを格納したコンテキストを返却する。
+// トークンが指定されていない場合、または未知のトークンの場合は UNAUTHENTICATED を返却する。
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(authorizationKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	userID, ok := a.users[strings.TrimPrefix(values[0], bearerPrefix)]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return withUserID(ctx, userID), nil
+}
+
+// 単項RPCの呼び出し元を認証するインターセプタ。
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// ストリーミングRPCの呼び出し元を認証するインターセプタ。
+func (a *Authenticator) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// 認証済みのコンテキストを返却するストリーム。
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context // 認証済みのコンテキスト
+}
+
+// 認証済みのコンテキストを取得する。
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_pb "github.com/kkntzw/bookmark/test/mock/presentation/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		users := map[string]string{"token-a": "alice"}
+		// when
+		authenticator := NewAuthenticator(users)
+		users["token-b"] = "bob"
+		// then
+		expectedUsers := map[string]string{"token-a": "alice"}
+		assert.Exactly(t, expectedUsers, authenticator.users)
+	})
+}
+
+func TestUserIDFromContext(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		ctx            context.Context
+		expectedUserID string
+		expectedErr    error
+	}{
+		"authenticated context": {
+			withUserID(context.TODO(), "alice"),
+			"alice",
+			nil,
+		},
+		"unauthenticated context": {
+			context.TODO(),
+			"",
+			status.Error(codes.Unauthenticated, "unauthenticated"),
+		},
+		"empty user id": {
+			withUserID(context.TODO(), ""),
+			"",
+			status.Error(codes.Unauthenticated, "unauthenticated"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualUserID, actualErr := userIDFromContext(tc.ctx)
+			// then
+			assert.Exactly(t, tc.expectedUserID, actualUserID)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestAuthenticator_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+	authenticator := NewAuthenticator(map[string]string{"token-a": "alice", "token-b": "bob"})
+	cases := map[string]struct {
+		ctx            context.Context
+		expectedUserID string
+		expectedErr    error
+	}{
+		"valid token": {
+			metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Bearer token-b")),
+			"bob",
+			nil,
+		},
+		"no metadata": {
+			context.TODO(),
+			"",
+			status.Error(codes.Unauthenticated, "missing metadata"),
+		},
+		"no token": {
+			metadata.NewIncomingContext(context.TODO(), metadata.Pairs("x-request-id", "1")),
+			"",
+			status.Error(codes.Unauthenticated, "missing bearer token"),
+		},
+		"non-bearer token": {
+			metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Basic token-a")),
+			"",
+			status.Error(codes.Unauthenticated, "missing bearer token"),
+		},
+		"unknown token": {
+			metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Bearer token-c")),
+			"",
+			status.Error(codes.Unauthenticated, "invalid bearer token"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			actualUserID := ""
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				actualUserID, _ = userIDFromContext(ctx)
+				return "response", nil
+			}
+			// when
+			actualResponse, actualErr := authenticator.UnaryInterceptor(tc.ctx, "request", &grpc.UnaryServerInfo{}, handler)
+			// then
+			assert.Exactly(t, tc.expectedUserID, actualUserID)
+			if tc.expectedErr == nil {
+				assert.Exactly(t, "response", actualResponse)
+			} else {
+				assert.Nil(t, actualResponse)
+			}
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestAuthenticator_StreamInterceptor(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	authenticator := NewAuthenticator(map[string]string{"token-a": "alice"})
+	cases := map[string]struct {
+		ctx            context.Context
+		expectedUserID string
+		expectedErr    error
+	}{
+		"valid token": {
+			metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Bearer token-a")),
+			"alice",
+			nil,
+		},
+		"unknown token": {
+			metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Bearer token-c")),
+			"",
+			status.Error(codes.Unauthenticated, "invalid bearer token"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			stream := mock_pb.NewMockBookmarker_ListTrashServer(ctrl)
+			stream.EXPECT().Context().Return(tc.ctx)
+			// given
+			actualUserID := ""
+			handler := func(srv interface{}, ss grpc.ServerStream) error {
+				actualUserID, _ = userIDFromContext(ss.Context())
+				return nil
+			}
+			// when
+			actualErr := authenticator.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+			// then
+			assert.Exactly(t, tc.expectedUserID, actualUserID)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
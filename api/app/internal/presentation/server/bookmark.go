@@ -3,13 +3,17 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
 	"github.com/kkntzw/bookmark/internal/application/usecase"
 	"github.com/kkntzw/bookmark/internal/presentation/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ブックマークに関するgRPCサーバの具象型
@@ -25,104 +29,1064 @@ func NewBookmarkServer(usecase usecase.Bookmark) pb.BookmarkerServer {
 	}
 }
 
+// ブックマークを表すDTOからメッセージを生成する。
+func toBookmarkMessage(bookmark dto.Bookmark) *pb.Bookmark {
+	tags := make([]*pb.Tag, len(bookmark.Tags))
+	for i, tag := range bookmark.Tags {
+		tags[i] = &pb.Tag{TagName: tag}
+	}
+	return &pb.Bookmark{
+		BookmarkId:   bookmark.ID,
+		BookmarkName: bookmark.Name,
+		Uri:          bookmark.URI,
+		Description:  bookmark.Description,
+		FolderId:     bookmark.FolderID,
+		Tags:         tags,
+		Version:      bookmark.Version,
+		CreatedAt:    toTimestamp(bookmark.CreatedAt),
+		UpdatedAt:    toTimestamp(bookmark.UpdatedAt),
+		DeletedAt:    toTimestamp(bookmark.DeletedAt),
+		Status:       toStatusMessage(bookmark.Status),
+		Starred:      bookmark.Starred,
+	}
+}
+
+// DTOの状態から状態を表す列挙型に変換する。
+//
+// 未知の値の場合は BOOKMARK_STATUS_UNSPECIFIED を返却する。
+func toStatusMessage(v string) pb.BookmarkStatus {
+	switch v {
+	case "unread":
+		return pb.BookmarkStatus_BOOKMARK_STATUS_UNREAD
+	case "read":
+		return pb.BookmarkStatus_BOOKMARK_STATUS_READ
+	case "archived":
+		return pb.BookmarkStatus_BOOKMARK_STATUS_ARCHIVED
+	default:
+		return pb.BookmarkStatus_BOOKMARK_STATUS_UNSPECIFIED
+	}
+}
+
+// 日時からタイムスタンプに変換する。
+//
+// ゼロ値の場合はnilを返却する。
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// タイムスタンプから日時に変換する。
+//
+// nilの場合はゼロ値を返却する。
+func toTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
 // ブックマークを作成する。
 //
-// ブックマークの作成に成功した場合は OK を返却する。
+// ブックマークの作成に成功した場合は OK と作成したブックマークを返却する。
 // nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
 // 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが既に存在する場合は ALREADY_EXISTS を返却する。
 // ブックマークの作成に失敗した場合は INTERNAL を返却する。
-func (s *bookmarkServer) CreateBookmark(ctx context.Context, req *pb.CreateBookmarkRequest) (*emptypb.Empty, error) {
+func (s *bookmarkServer) CreateBookmark(ctx context.Context, req *pb.CreateBookmarkRequest) (*pb.Bookmark, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
 	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 	name := req.BookmarkName
 	uri := req.Uri
 	tags := make([]string, len(req.Tags))
 	for i, tag := range req.Tags {
 		tags[i] = tag.TagName
 	}
-	cmd := &command.RegisterBookmark{Name: name, URI: uri, Tags: tags}
-	err := s.usecase.Register(cmd)
-	var icerr *command.InvalidCommandError
-	if errors.As(err, &icerr) {
-		return nil, status.Error(codes.InvalidArgument, "request is invalid")
+	description := req.Description
+	cmd := &command.RegisterBookmark{Name: name, URI: uri, Description: description, Tags: tags, UserID: userID}
+	bookmark, err := s.usecase.Register(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークを取得する。
+//
+// ブックマークの取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または共有されていない他のユーザが所有する場合は NOT_FOUND を返却する。
+// 共有されたブックマークで閲覧権限がない場合は PERMISSION_DENIED を返却する。
+// ブックマークの取得に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) GetBookmark(ctx context.Context, req *pb.GetBookmarkRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
 	}
+	userID, err := userIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "server error")
+		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	id := req.BookmarkId
+	cmd := &command.GetBookmark{ID: id, UserID: userID}
+	bookmark, err := s.usecase.Get(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
 }
 
 // ブックマークを一覧取得する。
 //
 // ブックマークの一覧取得に成功した場合は OK を返却する。
+// ブックマークを全て送信した後、次のページのトークンを含むページ情報を送信する。
 // nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
 // ブックマークの一覧取得に失敗した場合は INTERNAL を返却する。
 // ストリームの送信に失敗した場合は INTERNAL を返却する。
-func (s *bookmarkServer) ListBookmarks(req *emptypb.Empty, stream pb.Bookmarker_ListBookmarksServer) error {
+func (s *bookmarkServer) ListBookmarks(req *pb.ListBookmarksRequest, stream pb.Bookmarker_ListBookmarksServer) error {
 	if req == nil {
 		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
 	}
-	bookmarks, err := s.usecase.List()
+	userID, err := userIDFromContext(stream.Context())
 	if err != nil {
-		return status.Error(codes.Internal, "server error")
+		return err
 	}
-	for _, bookmark := range bookmarks {
-		tags := make([]*pb.Tag, len(bookmark.Tags))
-		for i, tag := range bookmark.Tags {
-			tags[i] = &pb.Tag{TagName: tag}
-		}
-		res := &pb.Bookmark{
-			BookmarkId:   bookmark.ID,
-			BookmarkName: bookmark.Name,
-			Uri:          bookmark.URI,
-			Tags:         tags,
-		}
+	tags := make([]string, len(req.Tags))
+	for i, tag := range req.Tags {
+		tags[i] = tag.TagName
+	}
+	cmd := &command.ListBookmarks{
+		Tags:         tags,
+		MatchAllTags: req.TagMatch == pb.ListBookmarksRequest_TAG_MATCH_ALL,
+		NameContains: req.NameContains,
+		URIContains:  req.UriContains,
+		FolderID:     req.FolderId,
+		Status:       toStatus(req.Status),
+		StarredOnly:  req.StarredOnly,
+		OrderBy:      toOrderBy(req.OrderBy),
+		Descending:   req.Descending,
+		PageSize:     int(req.PageSize),
+		PageToken:    req.PageToken,
+		UserID:       userID,
+	}
+	page, err := s.usecase.List(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, bookmark := range page.Bookmarks {
+		res := &pb.ListBookmarksResponse{Result: &pb.ListBookmarksResponse_Bookmark{Bookmark: toBookmarkMessage(bookmark)}}
 		if err := stream.Send(res); err != nil {
 			return status.Error(codes.Internal, "response failed")
 		}
 	}
+	res := &pb.ListBookmarksResponse{Result: &pb.ListBookmarksResponse_PageInfo{PageInfo: &pb.PageInfo{NextPageToken: page.NextPageToken}}}
+	if err := stream.Send(res); err != nil {
+		return status.Error(codes.Internal, "response failed")
+	}
 	return nil
 }
 
+// ブックマークの変更を監視する。
+//
+// 作成、更新、削除が発生するたびに変更を送信し、クライアントが切断するまで監視を続ける。
+// クライアントが切断した場合はコンテキストのエラーに応じたステータスを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 変更の監視に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) WatchBookmarks(req *pb.WatchBookmarksRequest, stream pb.Bookmarker_WatchBookmarksServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	ctx := stream.Context()
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	cmd := &command.WatchBookmarks{ResumeToken: req.ResumeToken, UserID: userID}
+	sendFailed := false
+	err = s.usecase.Watch(ctx, cmd, func(change dto.BookmarkChange) error {
+		if err := stream.Send(toBookmarkChangeMessage(change)); err != nil {
+			sendFailed = true
+			return err
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+		return nil
+	case sendFailed:
+		return status.Error(codes.Internal, "response failed")
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	return toStatusError(err)
+}
+
+// ブックマークの変更を表すDTOからメッセージを生成する。
+func toBookmarkChangeMessage(change dto.BookmarkChange) *pb.BookmarkChange {
+	var bookmark *pb.Bookmark
+	if change.Bookmark != nil {
+		bookmark = toBookmarkMessage(*change.Bookmark)
+	}
+	return &pb.BookmarkChange{
+		ChangeType:  toChangeTypeMessage(change.Type),
+		BookmarkId:  change.ID,
+		Bookmark:    bookmark,
+		ResumeToken: change.ResumeToken,
+	}
+}
+
+// DTOの変更の種類から変更の種類を表す列挙型に変換する。
+//
+// 未知の値の場合は CHANGE_TYPE_UNSPECIFIED を返却する。
+func toChangeTypeMessage(v string) pb.ChangeType {
+	switch v {
+	case "created":
+		return pb.ChangeType_CHANGE_TYPE_CREATED
+	case "updated":
+		return pb.ChangeType_CHANGE_TYPE_UPDATED
+	case "deleted":
+		return pb.ChangeType_CHANGE_TYPE_DELETED
+	default:
+		return pb.ChangeType_CHANGE_TYPE_UNSPECIFIED
+	}
+}
+
+// 並び替えのキーを表す列挙型からコマンドの並び替えのキーに変換する。
+//
+// 未知の値の場合は列挙型の文字列表現を返却する。
+func toOrderBy(orderBy pb.ListBookmarksRequest_OrderBy) string {
+	switch orderBy {
+	case pb.ListBookmarksRequest_ORDER_BY_ID:
+		return "ID"
+	case pb.ListBookmarksRequest_ORDER_BY_NAME:
+		return "Name"
+	case pb.ListBookmarksRequest_ORDER_BY_URI:
+		return "URI"
+	case pb.ListBookmarksRequest_ORDER_BY_CREATED_AT:
+		return "CreatedAt"
+	case pb.ListBookmarksRequest_ORDER_BY_UPDATED_AT:
+		return "UpdatedAt"
+	default:
+		return orderBy.String()
+	}
+}
+
+// 状態を表す列挙型からコマンドの状態に変換する。
+//
+// BOOKMARK_STATUS_UNSPECIFIED の場合は空文字列を返却する。
+// 未知の値の場合は列挙型の文字列表現を返却する。
+func toStatus(s pb.BookmarkStatus) string {
+	switch s {
+	case pb.BookmarkStatus_BOOKMARK_STATUS_UNSPECIFIED:
+		return ""
+	case pb.BookmarkStatus_BOOKMARK_STATUS_UNREAD:
+		return "unread"
+	case pb.BookmarkStatus_BOOKMARK_STATUS_READ:
+		return "read"
+	case pb.BookmarkStatus_BOOKMARK_STATUS_ARCHIVED:
+		return "archived"
+	default:
+		return s.String()
+	}
+}
+
 // ブックマークを更新する。
 //
-// ブックマークの更新に成功した場合は OK を返却する。
+// ブックマークの更新に成功した場合は OK と更新したブックマークを返却する。
 // nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
 // 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または共有されていない他のユーザが所有する場合は NOT_FOUND を返却する。
+// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+// 版数が期待する版数と異なる場合は ABORTED を返却する。
 // ブックマークの更新に失敗した場合は INTERNAL を返却する。
-func (s *bookmarkServer) UpdateBookmark(ctx context.Context, req *pb.UpdateBookmarkRequest) (*emptypb.Empty, error) {
+func (s *bookmarkServer) UpdateBookmark(ctx context.Context, req *pb.UpdateBookmarkRequest) (*pb.Bookmark, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
 	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 	id := req.BookmarkId
 	name := req.BookmarkName
 	uri := req.Uri
-	cmd := &command.UpdateBookmark{ID: id, Name: name, URI: uri}
-	err := s.usecase.Update(cmd)
-	var icerr *command.InvalidCommandError
-	if errors.As(err, &icerr) {
-		return nil, status.Error(codes.InvalidArgument, "request is invalid")
+	description := req.Description
+	tags := make([]string, len(req.Tags))
+	for i, tag := range req.Tags {
+		tags[i] = tag.GetTagName()
 	}
+	paths := req.UpdateMask.GetPaths()
+	mask := make([]string, len(paths))
+	for i, path := range paths {
+		field, ok := toUpdateField(path)
+		if !ok {
+			return nil, invalidArgumentError(&command.InvalidCommandError{Args: map[string]error{"update_mask": fmt.Errorf("unknown path: %s", path)}})
+		}
+		mask[i] = field
+	}
+	version := req.Version
+	cmd := &command.UpdateBookmark{ID: id, Name: name, URI: uri, Description: description, Tags: tags, UpdateMask: mask, Version: version, UserID: userID}
+	bookmark, err := s.usecase.Update(cmd)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "server error")
+		return nil, toStatusError(err)
 	}
-	return &emptypb.Empty{}, nil
+	return toBookmarkMessage(*bookmark), nil
 }
 
+// 更新マスクのパスからコマンドの更新するフィールドに変換する。
+//
+// 未知のパスの場合はfalseを返却する。
+// コマンドのフィールド名 ("Name" など) をパスに指定した場合も未知のパスとして扱う。
+func toUpdateField(path string) (string, bool) {
+	switch path {
+	case "bookmark_name":
+		return "Name", true
+	case "uri":
+		return "URI", true
+	case "description":
+		return "Description", true
+	case "tags":
+		return "Tags", true
+	default:
+		return "", false
+	}
+}
+
+// ブックマークを削除する。
+//
+// ブックマークはゴミ箱に移動する。
+// ブックマークの削除に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または共有されていない他のユーザが所有する場合は NOT_FOUND を返却する。
+// 共有されたブックマークで編集権限がない場合は PERMISSION_DENIED を返却する。
+// 版数が期待する版数と異なる場合は ABORTED を返却する。
+// ブックマークの削除に失敗した場合は INTERNAL を返却する。
 func (s *bookmarkServer) DeleteBookmark(ctx context.Context, req *pb.DeleteBookmarkRequest) (*emptypb.Empty, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
 	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := req.BookmarkId
+	version := req.Version
+	cmd := &command.DeleteBookmark{ID: id, Version: version, UserID: userID}
+	err = s.usecase.Delete(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ゴミ箱にあるブックマークを一覧取得する。
+//
+// ブックマークの一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// ブックマークの一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) ListTrash(req *emptypb.Empty, stream pb.Bookmarker_ListTrashServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListTrash{UserID: userID}
+	bookmarks, err := s.usecase.ListTrash(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, bookmark := range bookmarks {
+		if err := stream.Send(toBookmarkMessage(bookmark)); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// ブックマークをゴミ箱から復元する。
+//
+// ブックマークの復元に成功した場合は OK と復元したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ゴミ箱にブックマークが存在しない場合は NOT_FOUND を返却する。
+// 同じURIのブックマークが存在する場合は ALREADY_EXISTS を返却する。
+// 版数が期待する版数と異なる場合は ABORTED を返却する。
+// ブックマークの復元に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) RestoreBookmark(ctx context.Context, req *pb.RestoreBookmarkRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := req.BookmarkId
+	version := req.Version
+	cmd := &command.RestoreBookmark{ID: id, Version: version, UserID: userID}
+	bookmark, err := s.usecase.Restore(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ゴミ箱にあるブックマークを完全に削除する。
+//
+// ブックマークの削除に成功した場合は OK と削除したブックマーク数を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークの削除に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) PurgeTrash(ctx context.Context, req *pb.PurgeTrashRequest) (*pb.PurgeTrashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	olderThan := toTime(req.OlderThan)
+	cmd := &command.PurgeTrash{OlderThan: olderThan, UserID: userID}
+	count, err := s.usecase.PurgeTrash(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.PurgeTrashResponse{AffectedBookmarkCount: int64(count)}, nil
+}
+
+// ブックマークの内容を表すDTOからメッセージを生成する。
+func toSnapshotMessage(snapshot dto.Snapshot) *pb.BookmarkSnapshot {
+	tags := make([]*pb.Tag, len(snapshot.Tags))
+	for i, tag := range snapshot.Tags {
+		tags[i] = &pb.Tag{TagName: tag}
+	}
+	return &pb.BookmarkSnapshot{
+		BookmarkName: snapshot.Name,
+		Uri:          snapshot.URI,
+		Description:  snapshot.Description,
+		Tags:         tags,
+	}
+}
+
+// ブックマークの改訂を表すDTOからメッセージを生成する。
+func toRevisionMessage(revision dto.Revision) *pb.BookmarkRevision {
+	return &pb.BookmarkRevision{
+		RevisionId: revision.ID,
+		BookmarkId: revision.BookmarkID,
+		Version:    revision.Version,
+		Actor:      revision.Actor,
+		Before:     toSnapshotMessage(revision.Before),
+		After:      toSnapshotMessage(revision.After),
+		CreatedAt:  toTimestamp(revision.CreatedAt),
+	}
+}
+
+// ブックマークの改訂履歴を一覧取得する。
+//
+// 改訂履歴の一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 改訂履歴の一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) ListBookmarkRevisions(req *pb.ListBookmarkRevisionsRequest, stream pb.Bookmarker_ListBookmarkRevisionsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListBookmarkRevisions{ID: req.BookmarkId, UserID: userID}
+	revisions, err := s.usecase.ListRevisions(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, revision := range revisions {
+		if err := stream.Send(toRevisionMessage(revision)); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// ブックマークを改訂前の内容に戻す。
+//
+// 戻すことに成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 改訂またはブックマークが存在しない場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合は ABORTED を返却する。
+// 戻すことに失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) RevertBookmark(ctx context.Context, req *pb.RevertBookmarkRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.RevertBookmark{RevisionID: req.RevisionId, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.Revert(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークを既読にする。
+//
+// 既読化に成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// 既読化に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.MarkRead{ID: req.BookmarkId, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.MarkRead(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークを未読に戻す。
+//
+// 未読化に成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// 未読化に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) MarkUnread(ctx context.Context, req *pb.MarkUnreadRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.MarkUnread{ID: req.BookmarkId, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.MarkUnread(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークをアーカイブする。
+//
+// アーカイブに成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// アーカイブに失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) Archive(ctx context.Context, req *pb.ArchiveRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.Archive{ID: req.BookmarkId, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.Archive(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークをお気に入りに登録する。
+//
+// お気に入り登録に成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// お気に入り登録に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) Star(ctx context.Context, req *pb.StarRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.Star{ID: req.BookmarkId, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.Star(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークをお気に入りから外す。
+//
+// お気に入り解除に成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// お気に入り解除に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) Unstar(ctx context.Context, req *pb.UnstarRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.Unstar{ID: req.BookmarkId, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.Unstar(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークにタグを追加する。
+//
+// タグの追加に成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// タグの追加に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) AddTags(ctx context.Context, req *pb.AddTagsRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := req.BookmarkId
+	tags := make([]string, len(req.Tags))
+	for i, tag := range req.Tags {
+		tags[i] = tag.TagName
+	}
+	cmd := &command.AddTags{ID: id, Tags: tags, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.AddTags(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// ブックマークからタグを削除する。
+//
+// タグの削除に成功した場合は OK と更新したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 版数が期待する版数と異なる場合、または同時に更新された場合は ABORTED を返却する。
+// タグの削除に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) RemoveTags(ctx context.Context, req *pb.RemoveTagsRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 	id := req.BookmarkId
-	cmd := &command.DeleteBookmark{ID: id}
-	err := s.usecase.Delete(cmd)
-	var icerr *command.InvalidCommandError
-	if errors.As(err, &icerr) {
-		return nil, status.Error(codes.InvalidArgument, "request is invalid")
+	tags := make([]string, len(req.Tags))
+	for i, tag := range req.Tags {
+		tags[i] = tag.TagName
 	}
+	cmd := &command.RemoveTags{ID: id, Tags: tags, Version: req.Version, UserID: userID}
+	bookmark, err := s.usecase.RemoveTags(cmd)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "server error")
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// タグを一覧取得する。
+//
+// タグの一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// タグの一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) ListTags(req *emptypb.Empty, stream pb.Bookmarker_ListTagsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListTags{UserID: userID}
+	tagCounts, err := s.usecase.ListTags(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, tagCount := range tagCounts {
+		res := &pb.TagCount{
+			Tag:           &pb.Tag{TagName: tagCount.Name},
+			BookmarkCount: int64(tagCount.Count),
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// タグ名を変更する。
+//
+// タグ名の変更に成功した場合は OK と変更したブックマーク数を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 対象のブックマークが同時に更新された場合は ABORTED を返却する。
+// タグ名の変更に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from := req.From.GetTagName()
+	to := req.To.GetTagName()
+	cmd := &command.RenameTag{From: from, To: to, UserID: userID}
+	count, err := s.usecase.RenameTag(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.RenameTagResponse{AffectedBookmarkCount: int64(count)}, nil
+}
+
+// タグを統合する。
+//
+// タグの統合に成功した場合は OK と統合したブックマーク数を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 対象のブックマークが同時に更新された場合は ABORTED を返却する。
+// タグの統合に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*pb.MergeTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]string, len(req.Sources))
+	for i, tag := range req.Sources {
+		sources[i] = tag.GetTagName()
+	}
+	target := req.Target.GetTagName()
+	cmd := &command.MergeTags{Sources: sources, Target: target, UserID: userID}
+	count, err := s.usecase.MergeTags(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.MergeTagsResponse{AffectedBookmarkCount: int64(count)}, nil
+}
+
+// 重複するブックマークを一覧取得する。
+//
+// 一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 重複の一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) FindDuplicates(req *emptypb.Empty, stream pb.Bookmarker_FindDuplicatesServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.FindDuplicates{UserID: userID}
+	duplicates, err := s.usecase.FindDuplicates(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, duplicate := range duplicates {
+		bookmarks := make([]*pb.Bookmark, len(duplicate.Bookmarks))
+		for i, bookmark := range duplicate.Bookmarks {
+			bookmarks[i] = toBookmarkMessage(bookmark)
+		}
+		res := &pb.Duplicate{
+			CanonicalUri: duplicate.CanonicalURI,
+			Bookmarks:    bookmarks,
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// ブックマークを統合する。
+//
+// ブックマークの統合に成功した場合は OK と統合先のブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// いずれかのブックマークが存在しない場合は NOT_FOUND を返却する。
+// 保存されている版数が異なる場合は ABORTED を返却する。
+// ブックマークの統合に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) MergeBookmarks(ctx context.Context, req *pb.MergeBookmarksRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.MergeBookmarks{ID: req.BookmarkId, SourceIDs: req.SourceBookmarkIds, UserID: userID}
+	bookmark, err := s.usecase.MergeBookmarks(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
+
+// DTOの操作から監査ログの操作を表す列挙型に変換する。
+//
+// 未知の値の場合は AUDIT_OPERATION_UNSPECIFIED を返却する。
+func toAuditOperationMessage(v string) pb.AuditOperation {
+	switch v {
+	case "register":
+		return pb.AuditOperation_AUDIT_OPERATION_REGISTER
+	case "update":
+		return pb.AuditOperation_AUDIT_OPERATION_UPDATE
+	case "delete":
+		return pb.AuditOperation_AUDIT_OPERATION_DELETE
+	case "restore":
+		return pb.AuditOperation_AUDIT_OPERATION_RESTORE
+	case "purge":
+		return pb.AuditOperation_AUDIT_OPERATION_PURGE
+	default:
+		return pb.AuditOperation_AUDIT_OPERATION_UNSPECIFIED
+	}
+}
+
+// 監査ログの記録を表すDTOからメッセージを生成する。
+func toAuditEntryMessage(entry dto.AuditEntry) *pb.AuditEntry {
+	message := &pb.AuditEntry{
+		AuditEntryId: entry.ID,
+		Actor:        entry.Actor,
+		Operation:    toAuditOperationMessage(entry.Operation),
+		BookmarkId:   entry.BookmarkID,
+		CreatedAt:    toTimestamp(entry.CreatedAt),
+	}
+	if entry.Before != nil {
+		message.Before = toSnapshotMessage(*entry.Before)
+	}
+	if entry.After != nil {
+		message.After = toSnapshotMessage(*entry.After)
+	}
+	return message
+}
+
+// 監査ログを一覧取得する。
+//
+// 監査ログの一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 監査ログの一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) ListAuditEntries(req *pb.ListAuditEntriesRequest, stream pb.Bookmarker_ListAuditEntriesServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListAuditEntries{
+		BookmarkID: req.BookmarkId,
+		Actor:      req.Actor,
+		Since:      toTime(req.Since),
+		Until:      toTime(req.Until),
+		UserID:     userID,
+	}
+	entries, err := s.usecase.ListAuditEntries(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, entry := range entries {
+		if err := stream.Send(toAuditEntryMessage(entry)); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// DTOの権限から共有の権限を表す列挙型に変換する。
+//
+// 未知の値の場合は SHARE_ROLE_UNSPECIFIED を返却する。
+func toShareRoleMessage(v string) pb.ShareRole {
+	switch v {
+	case "viewer":
+		return pb.ShareRole_SHARE_ROLE_VIEWER
+	case "editor":
+		return pb.ShareRole_SHARE_ROLE_EDITOR
+	default:
+		return pb.ShareRole_SHARE_ROLE_UNSPECIFIED
+	}
+}
+
+// 共有の権限を表す列挙型からコマンドの権限に変換する。
+//
+// SHARE_ROLE_UNSPECIFIED の場合は空文字列を返却する。
+func toShareRole(v pb.ShareRole) string {
+	switch v {
+	case pb.ShareRole_SHARE_ROLE_VIEWER:
+		return "viewer"
+	case pb.ShareRole_SHARE_ROLE_EDITOR:
+		return "editor"
+	default:
+		return ""
+	}
+}
+
+// ブックマークの共有を表すDTOからメッセージを生成する。
+func toShareMessage(share dto.Share) *pb.Share {
+	message := &pb.Share{
+		ShareId:    share.ID,
+		Owner:      share.Owner,
+		Grantee:    share.Grantee,
+		BookmarkId: share.BookmarkID,
+		Role:       toShareRoleMessage(share.Role),
+	}
+	if share.Tag != "" {
+		message.Tag = &pb.Tag{TagName: share.Tag}
+	}
+	return message
+}
+
+// ブックマークまたはコレクションを共有する。
+//
+// 共有に成功した場合は OK と共有を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 共有に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) ShareBookmark(ctx context.Context, req *pb.ShareBookmarkRequest) (*pb.Share, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.ShareBookmark{
+		ID:      req.BookmarkId,
+		Tag:     req.Tag.GetTagName(),
+		Grantee: req.Grantee,
+		Role:    toShareRole(req.Role),
+		UserID:  userID,
+	}
+	share, err := s.usecase.Share(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toShareMessage(*share), nil
+}
+
+// 共有を解除する。
+//
+// 解除に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 共有が存在しない場合、または共有元でも共有先でもない場合は NOT_FOUND を返却する。
+// 解除に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) RevokeShare(ctx context.Context, req *pb.RevokeShareRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.RevokeShare{ID: req.ShareId, UserID: userID}
+	if err := s.usecase.RevokeShare(cmd); err != nil {
+		return nil, toStatusError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
+
+// 共有されたブックマークを一覧取得する。
+//
+// 一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *bookmarkServer) ListSharedWithMe(req *emptypb.Empty, stream pb.Bookmarker_ListSharedWithMeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListSharedWithMe{UserID: userID}
+	bookmarks, err := s.usecase.ListSharedWithMe(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, bookmark := range bookmarks {
+		res := &pb.SharedBookmark{
+			Bookmark: toBookmarkMessage(bookmark.Bookmark),
+			Owner:    bookmark.Owner,
+			Role:     toShareRoleMessage(bookmark.Role),
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/kkntzw/bookmark/internal/application/command"
@@ -16,6 +17,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestNewBookmarkServer(t *testing.T) {
@@ -55,18 +58,29 @@ func TestBookmark_CreateBookmark(t *testing.T) {
 	cases := map[string]struct {
 		prepare          func(*mock_usecase.MockBookmark)
 		req              *pb.CreateBookmarkRequest
-		expectedResponse *emptypb.Empty
+		expectedResponse *pb.Bookmark
 		expectedErr      error
 	}{
 		"non-nil request": {
 			func(usecase *mock_usecase.MockBookmark) {
 				usecase.
 					EXPECT().
-					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}}).
-					Return(nil)
+					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}}, nil)
 			},
 			helper.ToCreateBookmarkRequest(t, "Example", "https://example.com", "foo", "bar", "baz"),
-			&emptypb.Empty{},
+			helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"request with description": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Description: "Example Domain\nfor documents", Tags: []string{}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Description: "Example Domain\nfor documents", Tags: []string{}}, nil)
+			},
+			&pb.CreateBookmarkRequest{BookmarkName: "Example", Uri: "https://example.com", Description: "Example Domain\nfor documents"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Description: "Example Domain\nfor documents", Tags: []*pb.Tag{}},
 			nil,
 		},
 		"nil request": {
@@ -79,19 +93,30 @@ func TestBookmark_CreateBookmark(t *testing.T) {
 			func(usecase *mock_usecase.MockBookmark) {
 				usecase.
 					EXPECT().
-					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{""}}).
-					Return(&command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}})
+					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{""}, UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}})
 			},
 			helper.ToCreateBookmarkRequest(t, "Example", "https://example.com", ""),
 			nil,
-			status.Error(codes.InvalidArgument, "request is invalid"),
+			helper.ToInvalidArgumentError(t, map[string]error{"Tags": helper.ToErrTag(t, "")}),
+		},
+		"duplicate bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}, UserID: "alice"}).
+					Return(nil, &command.AlreadyExistsError{Resource: "bookmark"})
+			},
+			helper.ToCreateBookmarkRequest(t, "Example", "https://example.com", "foo", "bar", "baz"),
+			nil,
+			status.Error(codes.AlreadyExists, "bookmark already exists"),
 		},
 		"failed at usecase.Register": {
 			func(usecase *mock_usecase.MockBookmark) {
 				usecase.
 					EXPECT().
-					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}}).
-					Return(errors.New("some error"))
+					Register(&command.RegisterBookmark{Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}, UserID: "alice"}).
+					Return(nil, errors.New("some error"))
 			},
 			helper.ToCreateBookmarkRequest(t, "Example", "https://example.com", "foo", "bar", "baz"),
 			nil,
@@ -106,7 +131,7 @@ func TestBookmark_CreateBookmark(t *testing.T) {
 			tc.prepare(usecase)
 			// given
 			server := NewBookmarkServer(usecase)
-			ctx := context.TODO()
+			ctx := withUserID(context.TODO(), "alice")
 			// when
 			actualResponse, actualErr := server.CreateBookmark(ctx, tc.req)
 			// then
@@ -116,58 +141,269 @@ func TestBookmark_CreateBookmark(t *testing.T) {
 	}
 }
 
+func TestBookmark_GetBookmark(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.GetBookmarkRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Get(&command.GetBookmark{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}}, nil)
+			},
+			helper.ToGetBookmarkRequest(t, "1"),
+			helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "foo", "bar"),
+			nil,
+		},
+		"request for persisted bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Get(&command.GetBookmark{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{
+						ID:        "1",
+						Name:      "Example",
+						URI:       "https://example.com",
+						Tags:      []string{"foo"},
+						Version:   2,
+						CreatedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			helper.ToGetBookmarkRequest(t, "1"),
+			&pb.Bookmark{
+				BookmarkId:   "1",
+				BookmarkName: "Example",
+				Uri:          "https://example.com",
+				Tags:         []*pb.Tag{{TagName: "foo"}},
+				Version:      2,
+				CreatedAt:    timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedAt:    timestamppb.New(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Get(&command.GetBookmark{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			helper.ToGetBookmarkRequest(t, ""),
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Get(&command.GetBookmark{ID: "1", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			helper.ToGetBookmarkRequest(t, "1"),
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"failed at usecase.Get": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Get(&command.GetBookmark{ID: "1", UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			helper.ToGetBookmarkRequest(t, "1"),
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.GetBookmark(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
 func TestBookmark_ListBookmarks(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
 	cases := map[string]struct {
 		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_ListBookmarksServer)
-		req         *emptypb.Empty
+		req         *pb.ListBookmarksRequest
 		expectedErr error
 	}{
-		"non-nil request": {
+		"last page": {
 			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
-				usecase.EXPECT().List().Return(
-					[]dto.Bookmark{
-						{ID: "1", Name: "Example A", URI: "https://foo.example.com", Tags: []string{}},
-						{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{"2-A"}},
-						{ID: "3", Name: "Example C", URI: "https://baz.example.com", Tags: []string{"3-A", "3-B"}},
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListBookmarks{Tags: []string{}, OrderBy: "ID", UserID: "alice"}).Return(
+					&dto.BookmarkPage{
+						Bookmarks: []dto.Bookmark{
+							{ID: "1", Name: "Example A", URI: "https://foo.example.com", Tags: []string{}},
+							{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{"2-A"}},
+							{ID: "3", Name: "Example C", URI: "https://baz.example.com", Tags: []string{"3-A", "3-B"}},
+						},
 					},
 					nil,
 				)
-				stream.EXPECT().Send(helper.ToBookmarkMessage(t, "1", "Example A", "https://foo.example.com")).Return(nil)
-				stream.EXPECT().Send(helper.ToBookmarkMessage(t, "2", "Example B", "https://bar.example.com", "2-A")).Return(nil)
-				stream.EXPECT().Send(helper.ToBookmarkMessage(t, "3", "Example C", "https://baz.example.com", "3-A", "3-B")).Return(nil)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, helper.ToBookmarkMessage(t, "1", "Example A", "https://foo.example.com"))).Return(nil)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, helper.ToBookmarkMessage(t, "2", "Example B", "https://bar.example.com", "2-A"))).Return(nil)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, helper.ToBookmarkMessage(t, "3", "Example C", "https://baz.example.com", "3-A", "3-B"))).Return(nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "")).Return(nil)
+			},
+			&pb.ListBookmarksRequest{},
+			nil,
+		},
+		"request with folder": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					List(&command.ListBookmarks{Tags: []string{}, FolderID: "10", OrderBy: "ID", UserID: "alice"}).
+					Return(&dto.BookmarkPage{Bookmarks: []dto.Bookmark{{ID: "1", Name: "Example", URI: "https://example.com", FolderID: "10", Tags: []string{}}}}, nil)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, &pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", FolderId: "10", Tags: []*pb.Tag{}})).Return(nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "")).Return(nil)
+			},
+			&pb.ListBookmarksRequest{FolderId: "10"},
+			nil,
+		},
+		"request with status and starred": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					List(&command.ListBookmarks{Tags: []string{}, Status: "archived", StarredOnly: true, OrderBy: "ID", UserID: "alice"}).
+					Return(&dto.BookmarkPage{Bookmarks: []dto.Bookmark{{ID: "1", Name: "Example", URI: "https://example.com", Status: "archived", Starred: true, Tags: []string{}}}}, nil)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, &pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Status: pb.BookmarkStatus_BOOKMARK_STATUS_ARCHIVED, Starred: true})).Return(nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "")).Return(nil)
+			},
+			&pb.ListBookmarksRequest{Status: pb.BookmarkStatus_BOOKMARK_STATUS_ARCHIVED, StarredOnly: true},
+			nil,
+		},
+		"order by created at": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListBookmarks{Tags: []string{}, OrderBy: "CreatedAt", UserID: "alice"}).Return(&dto.BookmarkPage{Bookmarks: []dto.Bookmark{}}, nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "")).Return(nil)
+			},
+			&pb.ListBookmarksRequest{OrderBy: pb.ListBookmarksRequest_ORDER_BY_CREATED_AT},
+			nil,
+		},
+		"order by updated at": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListBookmarks{Tags: []string{}, OrderBy: "UpdatedAt", UserID: "alice"}).Return(&dto.BookmarkPage{Bookmarks: []dto.Bookmark{}}, nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "")).Return(nil)
+			},
+			&pb.ListBookmarksRequest{OrderBy: pb.ListBookmarksRequest_ORDER_BY_UPDATED_AT},
+			nil,
+		},
+		"intermediate page": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					List(&command.ListBookmarks{
+						Tags:         []string{"foo", "bar"},
+						MatchAllTags: true,
+						NameContains: "Example",
+						URIContains:  "example.com",
+						OrderBy:      "Name",
+						Descending:   true,
+						PageSize:     1,
+						PageToken:    "MQ",
+						UserID:       "alice",
+					}).
+					Return(
+						&dto.BookmarkPage{
+							Bookmarks:     []dto.Bookmark{{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{"foo", "bar"}}},
+							NextPageToken: "Mg",
+						},
+						nil,
+					)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, helper.ToBookmarkMessage(t, "2", "Example B", "https://bar.example.com", "foo", "bar"))).Return(nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "Mg")).Return(nil)
+			},
+			&pb.ListBookmarksRequest{
+				Tags:         []*pb.Tag{{TagName: "foo"}, {TagName: "bar"}},
+				TagMatch:     pb.ListBookmarksRequest_TAG_MATCH_ALL,
+				NameContains: "Example",
+				UriContains:  "example.com",
+				OrderBy:      pb.ListBookmarksRequest_ORDER_BY_NAME,
+				Descending:   true,
+				PageSize:     1,
+				PageToken:    "MQ",
 			},
-			&emptypb.Empty{},
 			nil,
 		},
+		"failed at stream.Send of page info": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListBookmarks{Tags: []string{}, OrderBy: "ID", UserID: "alice"}).Return(&dto.BookmarkPage{Bookmarks: []dto.Bookmark{}}, nil)
+				stream.EXPECT().Send(helper.ToPageInfoResponse(t, "")).Return(errors.New("some error"))
+			},
+			&pb.ListBookmarksRequest{},
+			status.Error(codes.Internal, "response failed"),
+		},
 		"nil request": {
 			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {},
 			nil,
 			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
 		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					List(&command.ListBookmarks{Tags: []string{}, OrderBy: "ID", PageSize: -1, UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"PageSize": errors.New("out of range: -1")}})
+			},
+			&pb.ListBookmarksRequest{PageSize: -1},
+			helper.ToInvalidArgumentError(t, map[string]error{"PageSize": errors.New("out of range: -1")}),
+		},
 		"failed at usecase.List": {
 			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
-				usecase.EXPECT().List().Return(nil, errors.New("some error"))
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListBookmarks{Tags: []string{}, OrderBy: "ID", UserID: "alice"}).Return(nil, errors.New("some error"))
 			},
-			&emptypb.Empty{},
+			&pb.ListBookmarksRequest{},
 			status.Error(codes.Internal, "server error"),
 		},
 		"failed at stream.Send": {
 			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarksServer) {
-				usecase.EXPECT().List().Return(
-					[]dto.Bookmark{
-						{ID: "1", Name: "Example A", URI: "https://foo.example.com", Tags: []string{}},
-						{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{"2-A"}},
-						{ID: "3", Name: "Example C", URI: "https://baz.example.com", Tags: []string{"3-A", "3-B"}},
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListBookmarks{Tags: []string{}, OrderBy: "ID", UserID: "alice"}).Return(
+					&dto.BookmarkPage{
+						Bookmarks: []dto.Bookmark{
+							{ID: "1", Name: "Example A", URI: "https://foo.example.com", Tags: []string{}},
+							{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{"2-A"}},
+							{ID: "3", Name: "Example C", URI: "https://baz.example.com", Tags: []string{"3-A", "3-B"}},
+						},
 					},
 					nil,
 				)
-				stream.EXPECT().Send(helper.ToBookmarkMessage(t, "1", "Example A", "https://foo.example.com")).Return(nil)
-				stream.EXPECT().Send(helper.ToBookmarkMessage(t, "2", "Example B", "https://bar.example.com", "2-A")).Return(errors.New("some error"))
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, helper.ToBookmarkMessage(t, "1", "Example A", "https://foo.example.com"))).Return(nil)
+				stream.EXPECT().Send(helper.ToListBookmarksResponse(t, helper.ToBookmarkMessage(t, "2", "Example B", "https://bar.example.com", "2-A"))).Return(errors.New("some error"))
 			},
-			&emptypb.Empty{},
+			&pb.ListBookmarksRequest{},
 			status.Error(codes.Internal, "response failed"),
 		},
 	}
@@ -195,16 +431,64 @@ func TestBookmark_UpdateBookmark(t *testing.T) {
 	cases := map[string]struct {
 		prepare          func(*mock_usecase.MockBookmark)
 		req              *pb.UpdateBookmarkRequest
-		expectedResponse *emptypb.Empty
+		expectedResponse *pb.Bookmark
 		expectedErr      error
 	}{
 		"non-nil request": {
 			func(usecase *mock_usecase.MockBookmark) {
-				usecase.EXPECT().Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com"}).Return(nil)
+				usecase.
+					EXPECT().
+					Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Tags: []string{}, UpdateMask: []string{}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Tags: []string{"foo", "bar", "baz"}}, nil)
 			},
 			helper.ToUpdateBookmarkRequest(t, "1", "EXAMPLE", "https://example.com"),
-			&emptypb.Empty{},
+			helper.ToBookmarkMessage(t, "1", "EXAMPLE", "https://example.com", "foo", "bar", "baz"),
+			nil,
+		},
+		"request with update mask": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Update(&command.UpdateBookmark{ID: "1", Tags: []string{"qux"}, UpdateMask: []string{"Name", "Tags"}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "", URI: "https://example.com", Tags: []string{"qux"}}, nil)
+			},
+			&pb.UpdateBookmarkRequest{BookmarkId: "1", Tags: []*pb.Tag{{TagName: "qux"}}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"bookmark_name", "tags"}}},
+			helper.ToBookmarkMessage(t, "1", "", "https://example.com", "qux"),
+			nil,
+		},
+		"request with description in update mask": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Update(&command.UpdateBookmark{ID: "1", Description: "Example Domain", Tags: []string{}, UpdateMask: []string{"Description"}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Description: "Example Domain", Tags: []string{}}, nil)
+			},
+			&pb.UpdateBookmarkRequest{BookmarkId: "1", Description: "Example Domain", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}}},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Description: "Example Domain", Tags: []*pb.Tag{}},
+			nil,
+		},
+		"request with unknown update mask": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			&pb.UpdateBookmarkRequest{BookmarkId: "1", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"foo"}}},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"update_mask": errors.New("unknown path: foo")}),
+		},
+		"request with command field name in update mask": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			&pb.UpdateBookmarkRequest{BookmarkId: "1", BookmarkName: "EXAMPLE", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"bookmark_name", "Name"}}},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"update_mask": errors.New("unknown path: Name")}),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Tags: []string{}, UpdateMask: []string{}, Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.UpdateBookmarkRequest{BookmarkId: "1", BookmarkName: "EXAMPLE", Uri: "https://example.com", Version: 1},
 			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
 		},
 		"nil request": {
 			func(usecase *mock_usecase.MockBookmark) {},
@@ -216,16 +500,27 @@ func TestBookmark_UpdateBookmark(t *testing.T) {
 			func(usecase *mock_usecase.MockBookmark) {
 				usecase.
 					EXPECT().
-					Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: ""}).
-					Return(&command.InvalidCommandError{Args: map[string]error{"URI": helper.ToErrURI(t, "")}})
+					Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "", Tags: []string{}, UpdateMask: []string{}, UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"URI": helper.ToErrURI(t, "")}})
 			},
 			helper.ToUpdateBookmarkRequest(t, "1", "EXAMPLE", ""),
 			nil,
-			status.Error(codes.InvalidArgument, "request is invalid"),
+			helper.ToInvalidArgumentError(t, map[string]error{"URI": helper.ToErrURI(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Tags: []string{}, UpdateMask: []string{}, UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			helper.ToUpdateBookmarkRequest(t, "1", "EXAMPLE", "https://example.com"),
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
 		},
 		"failed at usecase.Update": {
 			func(usecase *mock_usecase.MockBookmark) {
-				usecase.EXPECT().Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com"}).Return(errors.New("some error"))
+				usecase.EXPECT().Update(&command.UpdateBookmark{ID: "1", Name: "EXAMPLE", URI: "https://example.com", Tags: []string{}, UpdateMask: []string{}, UserID: "alice"}).Return(nil, errors.New("some error"))
 			},
 			helper.ToUpdateBookmarkRequest(t, "1", "EXAMPLE", "https://example.com"),
 			nil,
@@ -240,7 +535,7 @@ func TestBookmark_UpdateBookmark(t *testing.T) {
 			tc.prepare(usecase)
 			// given
 			server := NewBookmarkServer(usecase)
-			ctx := context.TODO()
+			ctx := withUserID(context.TODO(), "alice")
 			// when
 			actualResponse, actualErr := server.UpdateBookmark(ctx, tc.req)
 			// then
@@ -262,12 +557,28 @@ func TestBookmark_DeleteBookmark(t *testing.T) {
 	}{
 		"non-nil request": {
 			func(usecase *mock_usecase.MockBookmark) {
-				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1"}).Return(nil)
+				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1", UserID: "alice"}).Return(nil)
 			},
 			helper.ToDeleteBookmarkRequest(t, "1"),
 			&emptypb.Empty{},
 			nil,
 		},
+		"request with version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1", Version: 2, UserID: "alice"}).Return(nil)
+			},
+			&pb.DeleteBookmarkRequest{BookmarkId: "1", Version: 2},
+			&emptypb.Empty{},
+			nil,
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1", Version: 1, UserID: "alice"}).Return(&command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.DeleteBookmarkRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
 		"nil request": {
 			func(usecase *mock_usecase.MockBookmark) {},
 			nil,
@@ -278,16 +589,32 @@ func TestBookmark_DeleteBookmark(t *testing.T) {
 			func(usecase *mock_usecase.MockBookmark) {
 				usecase.
 					EXPECT().
-					Delete(&command.DeleteBookmark{ID: ""}).
+					Delete(&command.DeleteBookmark{ID: "", UserID: "alice"}).
 					Return(&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
 			},
 			helper.ToDeleteBookmarkRequest(t, ""),
 			nil,
-			status.Error(codes.InvalidArgument, "request is invalid"),
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1", UserID: "alice"}).Return(&command.NotFoundError{Resource: "bookmark"})
+			},
+			helper.ToDeleteBookmarkRequest(t, "1"),
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"bookmark shared with viewer role": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1", UserID: "alice"}).Return(&command.PermissionDeniedError{Resource: "bookmark"})
+			},
+			helper.ToDeleteBookmarkRequest(t, "1"),
+			nil,
+			status.Error(codes.PermissionDenied, "bookmark permission denied"),
 		},
 		"failed at usecase.Delete": {
 			func(usecase *mock_usecase.MockBookmark) {
-				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1"}).Return(errors.New("some error"))
+				usecase.EXPECT().Delete(&command.DeleteBookmark{ID: "1", UserID: "alice"}).Return(errors.New("some error"))
 			},
 			helper.ToDeleteBookmarkRequest(t, "1"),
 			nil,
@@ -302,7 +629,7 @@ func TestBookmark_DeleteBookmark(t *testing.T) {
 			tc.prepare(usecase)
 			// given
 			server := NewBookmarkServer(usecase)
-			ctx := context.TODO()
+			ctx := withUserID(context.TODO(), "alice")
 			// when
 			actualResponse, actualErr := server.DeleteBookmark(ctx, tc.req)
 			// then
@@ -311,3 +638,1950 @@ func TestBookmark_DeleteBookmark(t *testing.T) {
 		})
 	}
 }
+
+func TestBookmark_ListTrash(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	deletedAt := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	bookmarks := []dto.Bookmark{
+		{ID: "2", Name: "Example B", URI: "https://bar.example.com", Tags: []string{}, DeletedAt: deletedAt},
+		{ID: "1", Name: "Example A", URI: "https://foo.example.com", Tags: []string{"foo"}, DeletedAt: deletedAt},
+	}
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_ListTrashServer)
+		req         *emptypb.Empty
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTrashServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListTrash(&command.ListTrash{UserID: "alice"}).Return(bookmarks, nil)
+				stream.EXPECT().Send(&pb.Bookmark{
+					BookmarkId:   "2",
+					BookmarkName: "Example B",
+					Uri:          "https://bar.example.com",
+					Tags:         []*pb.Tag{},
+					DeletedAt:    timestamppb.New(deletedAt),
+				}).Return(nil)
+				stream.EXPECT().Send(&pb.Bookmark{
+					BookmarkId:   "1",
+					BookmarkName: "Example A",
+					Uri:          "https://foo.example.com",
+					Tags:         []*pb.Tag{{TagName: "foo"}},
+					DeletedAt:    timestamppb.New(deletedAt),
+				}).Return(nil)
+			},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTrashServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"failed at usecase.ListTrash": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTrashServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListTrash(&command.ListTrash{UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTrashServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListTrash(&command.ListTrash{UserID: "alice"}).Return(bookmarks, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_ListTrashServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.ListTrash(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RestoreBookmark(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.RestoreBookmarkRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Restore(&command.RestoreBookmark{ID: "1", Version: 2, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"foo"}}, nil)
+			},
+			&pb.RestoreBookmarkRequest{BookmarkId: "1", Version: 2},
+			helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "foo"),
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Restore(&command.RestoreBookmark{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.RestoreBookmarkRequest{},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Restore(&command.RestoreBookmark{ID: "1", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.RestoreBookmarkRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"existing bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Restore(&command.RestoreBookmark{ID: "1", UserID: "alice"}).Return(nil, &command.AlreadyExistsError{Resource: "bookmark"})
+			},
+			&pb.RestoreBookmarkRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.AlreadyExists, "bookmark already exists"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Restore(&command.RestoreBookmark{ID: "1", Version: 1, UserID: "alice"}).Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.RestoreBookmarkRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.Restore": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().Restore(&command.RestoreBookmark{ID: "1", UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&pb.RestoreBookmarkRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.RestoreBookmark(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_PurgeTrash(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	olderThan := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.PurgeTrashRequest
+		expectedResponse *pb.PurgeTrashResponse
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().PurgeTrash(&command.PurgeTrash{OlderThan: olderThan, UserID: "alice"}).Return(3, nil)
+			},
+			&pb.PurgeTrashRequest{OlderThan: timestamppb.New(olderThan)},
+			&pb.PurgeTrashResponse{AffectedBookmarkCount: 3},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					PurgeTrash(&command.PurgeTrash{UserID: "alice"}).
+					Return(0, &command.InvalidCommandError{Args: map[string]error{"OlderThan": errors.New("zero time")}})
+			},
+			&pb.PurgeTrashRequest{},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"OlderThan": errors.New("zero time")}),
+		},
+		"failed at usecase.PurgeTrash": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().PurgeTrash(&command.PurgeTrash{OlderThan: olderThan, UserID: "alice"}).Return(0, errors.New("some error"))
+			},
+			&pb.PurgeTrashRequest{OlderThan: timestamppb.New(olderThan)},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.PurgeTrash(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MarkRead(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.MarkReadRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkRead(&command.MarkRead{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "read", Starred: false, Tags: []string{}}, nil)
+			},
+			&pb.MarkReadRequest{BookmarkId: "1"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Status: pb.BookmarkStatus_BOOKMARK_STATUS_READ, Starred: false},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkRead(&command.MarkRead{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.MarkReadRequest{BookmarkId: ""},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkRead(&command.MarkRead{ID: "1", UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.MarkReadRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkRead(&command.MarkRead{ID: "1", UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.MarkReadRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkRead(&command.MarkRead{ID: "1", Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.MarkReadRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.MarkRead": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkRead(&command.MarkRead{ID: "1", UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.MarkReadRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.MarkRead(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MarkUnread(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.MarkUnreadRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkUnread(&command.MarkUnread{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: false, Tags: []string{}}, nil)
+			},
+			&pb.MarkUnreadRequest{BookmarkId: "1"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Status: pb.BookmarkStatus_BOOKMARK_STATUS_UNREAD, Starred: false},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkUnread(&command.MarkUnread{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.MarkUnreadRequest{BookmarkId: ""},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkUnread(&command.MarkUnread{ID: "1", UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.MarkUnreadRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkUnread(&command.MarkUnread{ID: "1", UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.MarkUnreadRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkUnread(&command.MarkUnread{ID: "1", Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.MarkUnreadRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.MarkUnread": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MarkUnread(&command.MarkUnread{ID: "1", UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.MarkUnreadRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.MarkUnread(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Archive(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.ArchiveRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Archive(&command.Archive{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "archived", Starred: false, Tags: []string{}}, nil)
+			},
+			&pb.ArchiveRequest{BookmarkId: "1"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Status: pb.BookmarkStatus_BOOKMARK_STATUS_ARCHIVED, Starred: false},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Archive(&command.Archive{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.ArchiveRequest{BookmarkId: ""},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Archive(&command.Archive{ID: "1", UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.ArchiveRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Archive(&command.Archive{ID: "1", UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.ArchiveRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Archive(&command.Archive{ID: "1", Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.ArchiveRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.Archive": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Archive(&command.Archive{ID: "1", UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.ArchiveRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.Archive(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Star(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.StarRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Star(&command.Star{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: true, Tags: []string{}}, nil)
+			},
+			&pb.StarRequest{BookmarkId: "1"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Status: pb.BookmarkStatus_BOOKMARK_STATUS_UNREAD, Starred: true},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Star(&command.Star{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.StarRequest{BookmarkId: ""},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Star(&command.Star{ID: "1", UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.StarRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Star(&command.Star{ID: "1", UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.StarRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Star(&command.Star{ID: "1", Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.StarRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.Star": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Star(&command.Star{ID: "1", UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.StarRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.Star(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Unstar(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.UnstarRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Unstar(&command.Unstar{ID: "1", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Status: "unread", Starred: false, Tags: []string{}}, nil)
+			},
+			&pb.UnstarRequest{BookmarkId: "1"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Status: pb.BookmarkStatus_BOOKMARK_STATUS_UNREAD, Starred: false},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Unstar(&command.Unstar{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.UnstarRequest{BookmarkId: ""},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Unstar(&command.Unstar{ID: "1", UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.UnstarRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Unstar(&command.Unstar{ID: "1", UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.UnstarRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Unstar(&command.Unstar{ID: "1", Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.UnstarRequest{BookmarkId: "1", Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.Unstar": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Unstar(&command.Unstar{ID: "1", UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.UnstarRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.Unstar(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_AddTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.AddTagsRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					AddTags(&command.AddTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"baz"}}, nil)
+			},
+			helper.ToAddTagsRequest(t, "1", "foo", "bar"),
+			helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "baz"),
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					AddTags(&command.AddTags{ID: "1", Tags: []string{""}, UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}})
+			},
+			helper.ToAddTagsRequest(t, "1", ""),
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"Tags": helper.ToErrTag(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					AddTags(&command.AddTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			helper.ToAddTagsRequest(t, "1", "foo", "bar"),
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					AddTags(&command.AddTags{ID: "1", Tags: []string{"foo", "bar"}, Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.AddTagsRequest{BookmarkId: "1", Tags: []*pb.Tag{{TagName: "foo"}, {TagName: "bar"}}, Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.AddTags": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					AddTags(&command.AddTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			helper.ToAddTagsRequest(t, "1", "foo", "bar"),
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.AddTags(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RemoveTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.RemoveTagsRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RemoveTags(&command.RemoveTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"baz"}}, nil)
+			},
+			helper.ToRemoveTagsRequest(t, "1", "foo", "bar"),
+			helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "baz"),
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RemoveTags(&command.RemoveTags{ID: "1", Tags: []string{""}, UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, "")}})
+			},
+			helper.ToRemoveTagsRequest(t, "1", ""),
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"Tags": helper.ToErrTag(t, "")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RemoveTags(&command.RemoveTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			helper.ToRemoveTagsRequest(t, "1", "foo", "bar"),
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"request with different version": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RemoveTags(&command.RemoveTags{ID: "1", Tags: []string{"foo", "bar"}, Version: 1, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.RemoveTagsRequest{BookmarkId: "1", Tags: []*pb.Tag{{TagName: "foo"}, {TagName: "bar"}}, Version: 1},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.RemoveTags": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RemoveTags(&command.RemoveTags{ID: "1", Tags: []string{"foo", "bar"}, UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			helper.ToRemoveTagsRequest(t, "1", "foo", "bar"),
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.RemoveTags(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_ListTagsServer)
+		req         *emptypb.Empty
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTagsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListTags(&command.ListTags{UserID: "alice"}).Return([]dto.TagCount{{Name: "bar", Count: 2}, {Name: "foo", Count: 3}}, nil)
+				stream.EXPECT().Send(&pb.TagCount{Tag: &pb.Tag{TagName: "bar"}, BookmarkCount: 2}).Return(nil)
+				stream.EXPECT().Send(&pb.TagCount{Tag: &pb.Tag{TagName: "foo"}, BookmarkCount: 3}).Return(nil)
+			},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTagsServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"failed at usecase.ListTags": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTagsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListTags(&command.ListTags{UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListTagsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListTags(&command.ListTags{UserID: "alice"}).Return([]dto.TagCount{{Name: "bar", Count: 2}, {Name: "foo", Count: 3}}, nil)
+				stream.EXPECT().Send(&pb.TagCount{Tag: &pb.Tag{TagName: "bar"}, BookmarkCount: 2}).Return(errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_ListTagsServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.ListTags(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RenameTag(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.RenameTagRequest
+		expectedResponse *pb.RenameTagResponse
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().RenameTag(&command.RenameTag{From: "golang", To: "go", UserID: "alice"}).Return(3, nil)
+			},
+			&pb.RenameTagRequest{From: &pb.Tag{TagName: "golang"}, To: &pb.Tag{TagName: "go"}},
+			&pb.RenameTagResponse{AffectedBookmarkCount: 3},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RenameTag(&command.RenameTag{From: "golang", To: "", UserID: "alice"}).
+					Return(0, &command.InvalidCommandError{Args: map[string]error{"To": helper.ToErrTag(t, "")}})
+			},
+			&pb.RenameTagRequest{From: &pb.Tag{TagName: "golang"}},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"To": helper.ToErrTag(t, "")}),
+		},
+		"failed at usecase.RenameTag": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().RenameTag(&command.RenameTag{From: "golang", To: "go", UserID: "alice"}).Return(0, errors.New("some error"))
+			},
+			&pb.RenameTagRequest{From: &pb.Tag{TagName: "golang"}, To: &pb.Tag{TagName: "go"}},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.RenameTag(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MergeTags(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.MergeTagsRequest
+		expectedResponse *pb.MergeTagsResponse
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().MergeTags(&command.MergeTags{Sources: []string{"golang", "go-lang"}, Target: "go", UserID: "alice"}).Return(3, nil)
+			},
+			&pb.MergeTagsRequest{Sources: []*pb.Tag{{TagName: "golang"}, {TagName: "go-lang"}}, Target: &pb.Tag{TagName: "go"}},
+			&pb.MergeTagsResponse{AffectedBookmarkCount: 3},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MergeTags(&command.MergeTags{Sources: []string{}, Target: "go", UserID: "alice"}).
+					Return(0, &command.InvalidCommandError{Args: map[string]error{"Sources": errors.New("no tags")}})
+			},
+			&pb.MergeTagsRequest{Target: &pb.Tag{TagName: "go"}},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"Sources": errors.New("no tags")}),
+		},
+		"failed at usecase.MergeTags": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().MergeTags(&command.MergeTags{Sources: []string{"golang", "go-lang"}, Target: "go", UserID: "alice"}).Return(0, errors.New("some error"))
+			},
+			&pb.MergeTagsRequest{Sources: []*pb.Tag{{TagName: "golang"}, {TagName: "go-lang"}}, Target: &pb.Tag{TagName: "go"}},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.MergeTags(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_FindDuplicates(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	duplicates := []dto.Duplicate{
+		{
+			CanonicalURI: "https://example.com/",
+			Bookmarks: []dto.Bookmark{
+				{ID: "1", Name: "Example A", URI: "https://example.com", Tags: []string{"foo"}},
+				{ID: "2", Name: "Example B", URI: "https://example.com/#bar", Tags: []string{}},
+			},
+		},
+	}
+	message := &pb.Duplicate{
+		CanonicalUri: "https://example.com/",
+		Bookmarks: []*pb.Bookmark{
+			helper.ToBookmarkMessage(t, "1", "Example A", "https://example.com", "foo"),
+			helper.ToBookmarkMessage(t, "2", "Example B", "https://example.com/#bar"),
+		},
+	}
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_FindDuplicatesServer)
+		req         *emptypb.Empty
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_FindDuplicatesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().FindDuplicates(&command.FindDuplicates{UserID: "alice"}).Return(duplicates, nil)
+				stream.EXPECT().Send(message).Return(nil)
+			},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_FindDuplicatesServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"failed at usecase.FindDuplicates": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_FindDuplicatesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().FindDuplicates(&command.FindDuplicates{UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_FindDuplicatesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().FindDuplicates(&command.FindDuplicates{UserID: "alice"}).Return(duplicates, nil)
+				stream.EXPECT().Send(message).Return(errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_FindDuplicatesServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.FindDuplicates(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_MergeBookmarks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.MergeBookmarksRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MergeBookmarks(&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2", "3"}, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"foo", "bar"}}, nil)
+			},
+			&pb.MergeBookmarksRequest{BookmarkId: "1", SourceBookmarkIds: []string{"2", "3"}},
+			helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "foo", "bar"),
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MergeBookmarks(&command.MergeBookmarks{ID: "1", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"SourceIDs": errors.New("no IDs")}})
+			},
+			&pb.MergeBookmarksRequest{BookmarkId: "1"},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"SourceIDs": errors.New("no IDs")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MergeBookmarks(&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.MergeBookmarksRequest{BookmarkId: "1", SourceBookmarkIds: []string{"2"}},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MergeBookmarks(&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.MergeBookmarksRequest{BookmarkId: "1", SourceBookmarkIds: []string{"2"}},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.MergeBookmarks": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					MergeBookmarks(&command.MergeBookmarks{ID: "1", SourceIDs: []string{"2"}, UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.MergeBookmarksRequest{BookmarkId: "1", SourceBookmarkIds: []string{"2"}},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.MergeBookmarks(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListBookmarkRevisions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	createdAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	revisions := []dto.Revision{
+		{
+			ID:         "2",
+			BookmarkID: "1",
+			Version:    2,
+			Actor:      "alice",
+			Before:     dto.Snapshot{Name: "Example", URI: "https://example.com", Tags: []string{}},
+			After:      dto.Snapshot{Name: "Example", URI: "https://example.com", Description: "desc", Tags: []string{"foo"}},
+			CreatedAt:  createdAt,
+		},
+	}
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_ListBookmarkRevisionsServer)
+		req         *pb.ListBookmarkRevisionsRequest
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarkRevisionsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListRevisions(&command.ListBookmarkRevisions{ID: "1", UserID: "alice"}).Return(revisions, nil)
+				stream.EXPECT().Send(&pb.BookmarkRevision{
+					RevisionId: "2",
+					BookmarkId: "1",
+					Version:    2,
+					Actor:      "alice",
+					Before:     &pb.BookmarkSnapshot{BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}},
+					After:      &pb.BookmarkSnapshot{BookmarkName: "Example", Uri: "https://example.com", Description: "desc", Tags: []*pb.Tag{{TagName: "foo"}}},
+					CreatedAt:  timestamppb.New(createdAt),
+				}).Return(nil)
+			},
+			&pb.ListBookmarkRevisionsRequest{BookmarkId: "1"},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarkRevisionsServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarkRevisionsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					ListRevisions(&command.ListBookmarkRevisions{ID: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.ListBookmarkRevisionsRequest{BookmarkId: ""},
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"failed at usecase.ListRevisions": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarkRevisionsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListRevisions(&command.ListBookmarkRevisions{ID: "1", UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&pb.ListBookmarkRevisionsRequest{BookmarkId: "1"},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListBookmarkRevisionsServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListRevisions(&command.ListBookmarkRevisions{ID: "1", UserID: "alice"}).Return(revisions, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(errors.New("some error"))
+			},
+			&pb.ListBookmarkRevisionsRequest{BookmarkId: "1"},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_ListBookmarkRevisionsServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.ListBookmarkRevisions(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RevertBookmark(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.RevertBookmarkRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Revert(&command.RevertBookmark{RevisionID: "2", Version: 2, UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{}, Version: 3}, nil)
+			},
+			&pb.RevertBookmarkRequest{RevisionId: "2", Version: 2},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}, Version: 3},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Revert(&command.RevertBookmark{RevisionID: "", Version: 2, UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"RevisionID": helper.ToErrID(t, "")}})
+			},
+			&pb.RevertBookmarkRequest{RevisionId: "", Version: 2},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"RevisionID": helper.ToErrID(t, "")}),
+		},
+		"non-existent revision": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Revert(&command.RevertBookmark{RevisionID: "2", Version: 2, UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "revision"})
+			},
+			&pb.RevertBookmarkRequest{RevisionId: "2", Version: 2},
+			nil,
+			status.Error(codes.NotFound, "revision not found"),
+		},
+		"conflicting bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Revert(&command.RevertBookmark{RevisionID: "2", Version: 2, UserID: "alice"}).
+					Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.RevertBookmarkRequest{RevisionId: "2", Version: 2},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"failed at usecase.Revert": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Revert(&command.RevertBookmark{RevisionID: "2", Version: 2, UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.RevertBookmarkRequest{RevisionId: "2", Version: 2},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.RevertBookmark(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_WatchBookmarks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	changes := []dto.BookmarkChange{
+		{Type: "created", ID: "1", Bookmark: &dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{}}, ResumeToken: "1"},
+		{Type: "deleted", ID: "1", Bookmark: nil, ResumeToken: "2"},
+	}
+	deliver := func(ctx context.Context, cmd *command.WatchBookmarks, handler func(dto.BookmarkChange) error) error {
+		for _, change := range changes {
+			if err := handler(change); err != nil {
+				return err
+			}
+		}
+		return context.Canceled
+	}
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_WatchBookmarksServer)
+		req         *pb.WatchBookmarksRequest
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_WatchBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().Watch(ctx, &command.WatchBookmarks{ResumeToken: "0", UserID: "alice"}, gomock.Any()).DoAndReturn(deliver)
+				stream.EXPECT().Send(&pb.BookmarkChange{
+					ChangeType:  pb.ChangeType_CHANGE_TYPE_CREATED,
+					BookmarkId:  "1",
+					Bookmark:    &pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}},
+					ResumeToken: "1",
+				}).Return(nil)
+				stream.EXPECT().Send(&pb.BookmarkChange{
+					ChangeType:  pb.ChangeType_CHANGE_TYPE_DELETED,
+					BookmarkId:  "1",
+					ResumeToken: "2",
+				}).Return(nil)
+			},
+			&pb.WatchBookmarksRequest{ResumeToken: "0"},
+			status.Error(codes.Canceled, context.Canceled.Error()),
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_WatchBookmarksServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_WatchBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					Watch(ctx, &command.WatchBookmarks{ResumeToken: "x", UserID: "alice"}, gomock.Any()).
+					Return(&command.InvalidCommandError{Args: map[string]error{"ResumeToken": errors.New("invalid resume token")}})
+			},
+			&pb.WatchBookmarksRequest{ResumeToken: "x"},
+			helper.ToInvalidArgumentError(t, map[string]error{"ResumeToken": errors.New("invalid resume token")}),
+		},
+		"failed at usecase.Watch": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_WatchBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().Watch(ctx, &command.WatchBookmarks{UserID: "alice"}, gomock.Any()).Return(errors.New("some error"))
+			},
+			&pb.WatchBookmarksRequest{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_WatchBookmarksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().Watch(ctx, &command.WatchBookmarks{UserID: "alice"}, gomock.Any()).DoAndReturn(deliver)
+				stream.EXPECT().Send(gomock.Any()).Return(errors.New("some error"))
+			},
+			&pb.WatchBookmarksRequest{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_WatchBookmarksServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.WatchBookmarks(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListAuditEntries(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	since := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	entries := []dto.AuditEntry{
+		{
+			ID:         "100",
+			Actor:      "alice",
+			Operation:  "update",
+			BookmarkID: "1",
+			Before:     &dto.Snapshot{Name: "Example", URI: "https://example.com", Tags: []string{}},
+			After:      &dto.Snapshot{Name: "Example Domain", URI: "https://example.com", Tags: []string{"foo"}},
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:         "101",
+			Actor:      "bob",
+			Operation:  "delete",
+			BookmarkID: "1",
+			Before:     &dto.Snapshot{Name: "Example Domain", URI: "https://example.com", Tags: []string{"foo"}},
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:         "102",
+			Actor:      "alice",
+			Operation:  "restore",
+			BookmarkID: "1",
+			After:      &dto.Snapshot{Name: "Example Domain", URI: "https://example.com", Tags: []string{"foo"}},
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:         "103",
+			Actor:      "alice",
+			Operation:  "purge",
+			BookmarkID: "1",
+			Before:     &dto.Snapshot{Name: "Example Domain", URI: "https://example.com", Tags: []string{"foo"}},
+			CreatedAt:  createdAt,
+		},
+	}
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_ListAuditEntriesServer)
+		req         *pb.ListAuditEntriesRequest
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListAuditEntriesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					ListAuditEntries(&command.ListAuditEntries{BookmarkID: "1", Actor: "alice", Since: since, Until: until, UserID: "alice"}).
+					Return(entries, nil)
+				stream.EXPECT().Send(&pb.AuditEntry{
+					AuditEntryId: "100",
+					Actor:        "alice",
+					Operation:    pb.AuditOperation_AUDIT_OPERATION_UPDATE,
+					BookmarkId:   "1",
+					Before:       &pb.BookmarkSnapshot{BookmarkName: "Example", Uri: "https://example.com", Tags: []*pb.Tag{}},
+					After:        &pb.BookmarkSnapshot{BookmarkName: "Example Domain", Uri: "https://example.com", Tags: []*pb.Tag{{TagName: "foo"}}},
+					CreatedAt:    timestamppb.New(createdAt),
+				}).Return(nil)
+				stream.EXPECT().Send(&pb.AuditEntry{
+					AuditEntryId: "101",
+					Actor:        "bob",
+					Operation:    pb.AuditOperation_AUDIT_OPERATION_DELETE,
+					BookmarkId:   "1",
+					Before:       &pb.BookmarkSnapshot{BookmarkName: "Example Domain", Uri: "https://example.com", Tags: []*pb.Tag{{TagName: "foo"}}},
+					CreatedAt:    timestamppb.New(createdAt),
+				}).Return(nil)
+				stream.EXPECT().Send(&pb.AuditEntry{
+					AuditEntryId: "102",
+					Actor:        "alice",
+					Operation:    pb.AuditOperation_AUDIT_OPERATION_RESTORE,
+					BookmarkId:   "1",
+					After:        &pb.BookmarkSnapshot{BookmarkName: "Example Domain", Uri: "https://example.com", Tags: []*pb.Tag{{TagName: "foo"}}},
+					CreatedAt:    timestamppb.New(createdAt),
+				}).Return(nil)
+				stream.EXPECT().Send(&pb.AuditEntry{
+					AuditEntryId: "103",
+					Actor:        "alice",
+					Operation:    pb.AuditOperation_AUDIT_OPERATION_PURGE,
+					BookmarkId:   "1",
+					Before:       &pb.BookmarkSnapshot{BookmarkName: "Example Domain", Uri: "https://example.com", Tags: []*pb.Tag{{TagName: "foo"}}},
+					CreatedAt:    timestamppb.New(createdAt),
+				}).Return(nil)
+			},
+			&pb.ListAuditEntriesRequest{BookmarkId: "1", Actor: "alice", Since: timestamppb.New(since), Until: timestamppb.New(until)},
+			nil,
+		},
+		"empty request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListAuditEntriesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListAuditEntries(&command.ListAuditEntries{UserID: "alice"}).Return([]dto.AuditEntry{}, nil)
+			},
+			&pb.ListAuditEntriesRequest{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListAuditEntriesServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListAuditEntriesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					ListAuditEntries(&command.ListAuditEntries{BookmarkID: " ", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"BookmarkID": helper.ToErrID(t, " ")}})
+			},
+			&pb.ListAuditEntriesRequest{BookmarkId: " "},
+			helper.ToInvalidArgumentError(t, map[string]error{"BookmarkID": helper.ToErrID(t, " ")}),
+		},
+		"failed at usecase.ListAuditEntries": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListAuditEntriesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListAuditEntries(&command.ListAuditEntries{UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&pb.ListAuditEntriesRequest{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListAuditEntriesServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListAuditEntries(&command.ListAuditEntries{UserID: "alice"}).Return(entries, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(errors.New("some error"))
+			},
+			&pb.ListAuditEntriesRequest{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_ListAuditEntriesServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.ListAuditEntries(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ShareBookmark(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.ShareBookmarkRequest
+		expectedResponse *pb.Share
+		expectedErr      error
+	}{
+		"bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Share(&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "viewer", UserID: "alice"}).
+					Return(&dto.Share{ID: "10", Owner: "alice", Grantee: "bob", BookmarkID: "1", Role: "viewer"}, nil)
+			},
+			&pb.ShareBookmarkRequest{BookmarkId: "1", Grantee: "bob", Role: pb.ShareRole_SHARE_ROLE_VIEWER},
+			&pb.Share{ShareId: "10", Owner: "alice", Grantee: "bob", BookmarkId: "1", Role: pb.ShareRole_SHARE_ROLE_VIEWER},
+			nil,
+		},
+		"collection": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Share(&command.ShareBookmark{Tag: "foo", Grantee: "bob", Role: "editor", UserID: "alice"}).
+					Return(&dto.Share{ID: "10", Owner: "alice", Grantee: "bob", Tag: "foo", Role: "editor"}, nil)
+			},
+			&pb.ShareBookmarkRequest{Tag: &pb.Tag{TagName: "foo"}, Grantee: "bob", Role: pb.ShareRole_SHARE_ROLE_EDITOR},
+			&pb.Share{ShareId: "10", Owner: "alice", Grantee: "bob", Tag: &pb.Tag{TagName: "foo"}, Role: pb.ShareRole_SHARE_ROLE_EDITOR},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Share(&command.ShareBookmark{ID: "1", Grantee: "bob", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"Role": errors.New("unknown role: ")}})
+			},
+			&pb.ShareBookmarkRequest{BookmarkId: "1", Grantee: "bob"},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"Role": errors.New("unknown role: ")}),
+		},
+		"non-existent bookmark": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Share(&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "viewer", UserID: "alice"}).
+					Return(nil, &command.NotFoundError{Resource: "bookmark"})
+			},
+			&pb.ShareBookmarkRequest{BookmarkId: "1", Grantee: "bob", Role: pb.ShareRole_SHARE_ROLE_VIEWER},
+			nil,
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"failed at usecase.Share": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					Share(&command.ShareBookmark{ID: "1", Grantee: "bob", Role: "viewer", UserID: "alice"}).
+					Return(nil, errors.New("some error"))
+			},
+			&pb.ShareBookmarkRequest{BookmarkId: "1", Grantee: "bob", Role: pb.ShareRole_SHARE_ROLE_VIEWER},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.ShareBookmark(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_RevokeShare(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockBookmark)
+		req              *pb.RevokeShareRequest
+		expectedResponse *emptypb.Empty
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().RevokeShare(&command.RevokeShare{ID: "10", UserID: "alice"}).Return(nil)
+			},
+			&pb.RevokeShareRequest{ShareId: "10"},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.
+					EXPECT().
+					RevokeShare(&command.RevokeShare{UserID: "alice"}).
+					Return(&command.InvalidCommandError{Args: map[string]error{"ID": helper.ToErrID(t, "")}})
+			},
+			&pb.RevokeShareRequest{},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"ID": helper.ToErrID(t, "")}),
+		},
+		"non-existent share": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().RevokeShare(&command.RevokeShare{ID: "10", UserID: "alice"}).Return(&command.NotFoundError{Resource: "share"})
+			},
+			&pb.RevokeShareRequest{ShareId: "10"},
+			nil,
+			status.Error(codes.NotFound, "share not found"),
+		},
+		"failed at usecase.RevokeShare": {
+			func(usecase *mock_usecase.MockBookmark) {
+				usecase.EXPECT().RevokeShare(&command.RevokeShare{ID: "10", UserID: "alice"}).Return(errors.New("some error"))
+			},
+			&pb.RevokeShareRequest{ShareId: "10"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewBookmarkServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.RevokeShare(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_ListSharedWithMe(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	bookmarks := []dto.SharedBookmark{
+		{
+			Bookmark: dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", Tags: []string{"foo"}},
+			Owner:    "bob",
+			Role:     "editor",
+		},
+	}
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockBookmark, *mock_pb.MockBookmarker_ListSharedWithMeServer)
+		req         *emptypb.Empty
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListSharedWithMeServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListSharedWithMe(&command.ListSharedWithMe{UserID: "alice"}).Return(bookmarks, nil)
+				stream.EXPECT().Send(&pb.SharedBookmark{
+					Bookmark: helper.ToBookmarkMessage(t, "1", "Example", "https://example.com", "foo"),
+					Owner:    "bob",
+					Role:     pb.ShareRole_SHARE_ROLE_EDITOR,
+				}).Return(nil)
+			},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListSharedWithMeServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"failed at usecase.ListSharedWithMe": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListSharedWithMeServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListSharedWithMe(&command.ListSharedWithMe{UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockBookmark, stream *mock_pb.MockBookmarker_ListSharedWithMeServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListSharedWithMe(&command.ListSharedWithMe{UserID: "alice"}).Return(bookmarks, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockBookmark(ctrl)
+			stream := mock_pb.NewMockBookmarker_ListSharedWithMeServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewBookmarkServer(usecase)
+			// when
+			actualErr := server.ListSharedWithMe(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBookmark_Unauthenticated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	expectedErr := status.Error(codes.Unauthenticated, "unauthenticated")
+	t.Run("unary", func(t *testing.T) {
+		t.Parallel()
+		usecase := mock_usecase.NewMockBookmark(ctrl)
+		// given
+		server := NewBookmarkServer(usecase)
+		ctx := context.TODO()
+		// when
+		actualResponse, actualErr := server.GetBookmark(ctx, &pb.GetBookmarkRequest{BookmarkId: "1"})
+		// then
+		assert.Nil(t, actualResponse)
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+		usecase := mock_usecase.NewMockBookmark(ctrl)
+		stream := mock_pb.NewMockBookmarker_ListTrashServer(ctrl)
+		stream.EXPECT().Context().Return(context.TODO())
+		// given
+		server := NewBookmarkServer(usecase)
+		// when
+		actualErr := server.ListTrash(&emptypb.Empty{}, stream)
+		// then
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+}
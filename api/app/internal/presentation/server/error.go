@@ -0,0 +1,70 @@
+package server
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ユースケースから返却されたエラーをgRPCのステータスに変換する。
+//
+// InvalidCommandError の場合は INVALID_ARGUMENT を返却する。
+// 不正な引数は BadRequest のフィールド違反として詳細に付与する。
+// NotFoundError の場合は NOT_FOUND を返却する。
+// AlreadyExistsError の場合は ALREADY_EXISTS を返却する。
+// ConflictError の場合は ABORTED を返却する。
+// FailedPreconditionError の場合は FAILED_PRECONDITION を返却する。
+// PermissionDeniedError の場合は PERMISSION_DENIED を返却する。
+// 上記以外の場合は INTERNAL を返却する。
+func toStatusError(err error) error {
+	var icerr *command.InvalidCommandError
+	if errors.As(err, &icerr) {
+		return invalidArgumentError(icerr)
+	}
+	var nferr *command.NotFoundError
+	if errors.As(err, &nferr) {
+		return status.Errorf(codes.NotFound, "%s not found", nferr.Resource)
+	}
+	var aeerr *command.AlreadyExistsError
+	if errors.As(err, &aeerr) {
+		return status.Errorf(codes.AlreadyExists, "%s already exists", aeerr.Resource)
+	}
+	var cerr *command.ConflictError
+	if errors.As(err, &cerr) {
+		return status.Errorf(codes.Aborted, "%s conflicts", cerr.Resource)
+	}
+	var fperr *command.FailedPreconditionError
+	if errors.As(err, &fperr) {
+		return status.Error(codes.FailedPrecondition, fperr.Reason)
+	}
+	var pderr *command.PermissionDeniedError
+	if errors.As(err, &pderr) {
+		return status.Errorf(codes.PermissionDenied, "%s permission denied", pderr.Resource)
+	}
+	return status.Error(codes.Internal, "server error")
+}
+
+// 不正コマンドを表すエラーから INVALID_ARGUMENT のステータスを生成する。
+//
+// 不正な引数をキーの辞書順で BadRequest のフィールド違反に変換する。
+func invalidArgumentError(err *command.InvalidCommandError) error {
+	keys := []string{}
+	for k := range err.Args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(keys))
+	for i, k := range keys {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: k, Description: err.Args[k].Error()}
+	}
+	st := status.New(codes.InvalidArgument, "request is invalid")
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/test/helper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		err         error
+		expectedErr error
+	}{
+		"InvalidCommandError": {
+			&command.InvalidCommandError{Args: map[string]error{"URI": helper.ToErrURI(t, ""), "Name": helper.ToErrName(t, "")}},
+			helper.ToInvalidArgumentError(t, map[string]error{"Name": helper.ToErrName(t, ""), "URI": helper.ToErrURI(t, "")}),
+		},
+		"NotFoundError": {
+			&command.NotFoundError{Resource: "bookmark"},
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"AlreadyExistsError": {
+			&command.AlreadyExistsError{Resource: "bookmark"},
+			status.Error(codes.AlreadyExists, "bookmark already exists"),
+		},
+		"ConflictError": {
+			&command.ConflictError{Resource: "bookmark"},
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+		"FailedPreconditionError": {
+			&command.FailedPreconditionError{Resource: "folder", Reason: "folder has bookmarks"},
+			status.Error(codes.FailedPrecondition, "folder has bookmarks"),
+		},
+		"PermissionDeniedError": {
+			&command.PermissionDeniedError{Resource: "bookmark"},
+			status.Error(codes.PermissionDenied, "bookmark permission denied"),
+		},
+		"wrapped NotFoundError": {
+			fmt.Errorf("some error: %w", &command.NotFoundError{Resource: "bookmark"}),
+			status.Error(codes.NotFound, "bookmark not found"),
+		},
+		"other error": {
+			errors.New("some error"),
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// when
+			actualErr := toStatusError(tc.err)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+	t.Run("field violations", func(t *testing.T) {
+		t.Parallel()
+		// given
+		err := &command.InvalidCommandError{Args: map[string]error{"Tags": helper.ToErrTag(t, ""), "ID": helper.ToErrID(t, "")}}
+		// when
+		st, _ := status.FromError(toStatusError(err))
+		details := st.Details()
+		// then
+		assert.Len(t, details, 1)
+		badRequest, ok := details[0].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		actualFields := []string{}
+		for _, violation := range badRequest.FieldViolations {
+			actualFields = append(actualFields, violation.Field)
+		}
+		expectedFields := []string{"ID", "Tags"}
+		assert.Exactly(t, expectedFields, actualFields)
+	})
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/application/usecase"
+	"github.com/kkntzw/bookmark/internal/presentation/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// フォルダに関するgRPCサーバの具象型
+type folderServer struct {
+	usecase usecase.Folder // ユースケース
+	pb.UnimplementedFolderManagerServer
+}
+
+// フォルダに関するgRPCサーバを生成する。
+func NewFolderServer(usecase usecase.Folder) pb.FolderManagerServer {
+	return &folderServer{
+		usecase: usecase,
+	}
+}
+
+// フォルダを表すDTOからメッセージを生成する。
+func toFolderMessage(folder dto.Folder) *pb.Folder {
+	return &pb.Folder{
+		FolderId:       folder.ID,
+		FolderName:     folder.Name,
+		ParentFolderId: folder.ParentID,
+		Position:       int32(folder.Position),
+	}
+}
+
+// フォルダを作成する。
+//
+// フォルダの作成に成功した場合は OK と作成したフォルダを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 親フォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// フォルダの作成に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) CreateFolder(ctx context.Context, req *pb.CreateFolderRequest) (*pb.Folder, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.CreateFolder{Name: req.FolderName, ParentID: req.ParentFolderId, Position: int(req.Position), UserID: userID}
+	folder, err := s.usecase.Create(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toFolderMessage(*folder), nil
+}
+
+// フォルダを取得する。
+//
+// フォルダの取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// フォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// フォルダの取得に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) GetFolder(ctx context.Context, req *pb.GetFolderRequest) (*pb.Folder, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.GetFolder{ID: req.FolderId, UserID: userID}
+	folder, err := s.usecase.Get(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toFolderMessage(*folder), nil
+}
+
+// 親フォルダ直下のフォルダを一覧取得する。
+//
+// フォルダの一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 親フォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// フォルダの一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) ListFolders(req *pb.ListFoldersRequest, stream pb.FolderManager_ListFoldersServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListFolders{ParentID: req.ParentFolderId, UserID: userID}
+	folders, err := s.usecase.List(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, folder := range folders {
+		res := toFolderMessage(folder)
+		if err := stream.Send(res); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// フォルダ名を変更する。
+//
+// フォルダの更新に成功した場合は OK と更新したフォルダを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// フォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// フォルダの更新に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) UpdateFolder(ctx context.Context, req *pb.UpdateFolderRequest) (*pb.Folder, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.UpdateFolder{ID: req.FolderId, Name: req.FolderName, UserID: userID}
+	folder, err := s.usecase.Update(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toFolderMessage(*folder), nil
+}
+
+// フォルダを削除する。
+//
+// フォルダの削除に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// フォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// フォルダが空でない場合は FAILED_PRECONDITION を返却する。
+// フォルダの削除に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) DeleteFolder(ctx context.Context, req *pb.DeleteFolderRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.DeleteFolder{ID: req.FolderId, UserID: userID}
+	if err := s.usecase.Delete(cmd); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// フォルダを移動する。
+//
+// フォルダの移動に成功した場合は OK と移動したフォルダを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// フォルダまたは移動先の親フォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 移動により循環が生じる場合は FAILED_PRECONDITION を返却する。
+// フォルダの移動に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) MoveFolder(ctx context.Context, req *pb.MoveFolderRequest) (*pb.Folder, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.MoveFolder{ID: req.FolderId, ParentID: req.ParentFolderId, Position: int(req.Position), UserID: userID}
+	folder, err := s.usecase.Move(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toFolderMessage(*folder), nil
+}
+
+// ブックマークをフォルダに移動する。
+//
+// ブックマークの移動に成功した場合は OK と移動したブックマークを返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// ブックマークまたは移動先のフォルダが存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 保存されている版数が異なる場合は ABORTED を返却する。
+// ブックマークの移動に失敗した場合は INTERNAL を返却する。
+func (s *folderServer) MoveBookmark(ctx context.Context, req *pb.MoveBookmarkRequest) (*pb.Bookmark, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.MoveBookmark{ID: req.BookmarkId, FolderID: req.FolderId, UserID: userID}
+	bookmark, err := s.usecase.MoveBookmark(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toBookmarkMessage(*bookmark), nil
+}
@@ -0,0 +1,501 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/presentation/pb"
+	"github.com/kkntzw/bookmark/test/helper"
+	mock_usecase "github.com/kkntzw/bookmark/test/mock/application/usecase"
+	mock_pb "github.com/kkntzw/bookmark/test/mock/presentation/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestNewFolderServer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Run("implementing pb.FolderManagerServer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		usecase := mock_usecase.NewMockFolder(ctrl)
+		// when
+		object := NewFolderServer(usecase)
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*pb.FolderManagerServer)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		usecase := mock_usecase.NewMockFolder(ctrl)
+		abstractServer := NewFolderServer(usecase)
+		// when
+		concreteServer, ok := abstractServer.(*folderServer)
+		actualUsecase := concreteServer.usecase
+		// then
+		assert.True(t, ok)
+		expectedUsecase := usecase
+		assert.Exactly(t, expectedUsecase, actualUsecase)
+	})
+}
+
+func TestFolder_CreateFolder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockFolder)
+		req              *pb.CreateFolderRequest
+		expectedResponse *pb.Folder
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.
+					EXPECT().
+					Create(&command.CreateFolder{Name: "Reading List", ParentID: "2", Position: 3, UserID: "alice"}).
+					Return(&dto.Folder{ID: "1", Name: "Reading List", ParentID: "2", Position: 3}, nil)
+			},
+			&pb.CreateFolderRequest{FolderName: "Reading List", ParentFolderId: "2", Position: 3},
+			&pb.Folder{FolderId: "1", FolderName: "Reading List", ParentFolderId: "2", Position: 3},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.
+					EXPECT().
+					Create(&command.CreateFolder{Name: "", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"Name": helper.ToErrName(t, "")}})
+			},
+			&pb.CreateFolderRequest{FolderName: ""},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"Name": helper.ToErrName(t, "")}),
+		},
+		"non-existent parent": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Create(&command.CreateFolder{Name: "Reading List", ParentID: "2", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "folder"})
+			},
+			&pb.CreateFolderRequest{FolderName: "Reading List", ParentFolderId: "2"},
+			nil,
+			status.Error(codes.NotFound, "folder not found"),
+		},
+		"failed at usecase.Create": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Create(&command.CreateFolder{Name: "Reading List", UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&pb.CreateFolderRequest{FolderName: "Reading List"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewFolderServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.CreateFolder(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_GetFolder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockFolder)
+		req              *pb.GetFolderRequest
+		expectedResponse *pb.Folder
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Get(&command.GetFolder{ID: "1", UserID: "alice"}).Return(&dto.Folder{ID: "1", Name: "Reading List"}, nil)
+			},
+			&pb.GetFolderRequest{FolderId: "1"},
+			&pb.Folder{FolderId: "1", FolderName: "Reading List"},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"non-existent folder": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Get(&command.GetFolder{ID: "1", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "folder"})
+			},
+			&pb.GetFolderRequest{FolderId: "1"},
+			nil,
+			status.Error(codes.NotFound, "folder not found"),
+		},
+		"failed at usecase.Get": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Get(&command.GetFolder{ID: "1", UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&pb.GetFolderRequest{FolderId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewFolderServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.GetFolder(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_ListFolders(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockFolder, *mock_pb.MockFolderManager_ListFoldersServer)
+		req         *pb.ListFoldersRequest
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder, stream *mock_pb.MockFolderManager_ListFoldersServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListFolders{ParentID: "1", UserID: "alice"}).Return([]dto.Folder{{ID: "2", Name: "Go", ParentID: "1"}, {ID: "3", Name: "Rust", ParentID: "1", Position: 1}}, nil)
+				stream.EXPECT().Send(&pb.Folder{FolderId: "2", FolderName: "Go", ParentFolderId: "1"}).Return(nil)
+				stream.EXPECT().Send(&pb.Folder{FolderId: "3", FolderName: "Rust", ParentFolderId: "1", Position: 1}).Return(nil)
+			},
+			&pb.ListFoldersRequest{ParentFolderId: "1"},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder, stream *mock_pb.MockFolderManager_ListFoldersServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"non-existent parent": {
+			func(usecase *mock_usecase.MockFolder, stream *mock_pb.MockFolderManager_ListFoldersServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListFolders{ParentID: "1", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "folder"})
+			},
+			&pb.ListFoldersRequest{ParentFolderId: "1"},
+			status.Error(codes.NotFound, "folder not found"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockFolder, stream *mock_pb.MockFolderManager_ListFoldersServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListFolders{UserID: "alice"}).Return([]dto.Folder{{ID: "1", Name: "Work"}}, nil)
+				stream.EXPECT().Send(&pb.Folder{FolderId: "1", FolderName: "Work"}).Return(errors.New("some error"))
+			},
+			&pb.ListFoldersRequest{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			stream := mock_pb.NewMockFolderManager_ListFoldersServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewFolderServer(usecase)
+			// when
+			actualErr := server.ListFolders(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_UpdateFolder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockFolder)
+		req              *pb.UpdateFolderRequest
+		expectedResponse *pb.Folder
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Update(&command.UpdateFolder{ID: "1", Name: "To Read", UserID: "alice"}).Return(&dto.Folder{ID: "1", Name: "To Read"}, nil)
+			},
+			&pb.UpdateFolderRequest{FolderId: "1", FolderName: "To Read"},
+			&pb.Folder{FolderId: "1", FolderName: "To Read"},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"non-existent folder": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Update(&command.UpdateFolder{ID: "1", Name: "To Read", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "folder"})
+			},
+			&pb.UpdateFolderRequest{FolderId: "1", FolderName: "To Read"},
+			nil,
+			status.Error(codes.NotFound, "folder not found"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewFolderServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.UpdateFolder(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_DeleteFolder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockFolder)
+		req              *pb.DeleteFolderRequest
+		expectedResponse *emptypb.Empty
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Delete(&command.DeleteFolder{ID: "1", UserID: "alice"}).Return(nil)
+			},
+			&pb.DeleteFolderRequest{FolderId: "1"},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"non-empty folder": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Delete(&command.DeleteFolder{ID: "1", UserID: "alice"}).Return(&command.FailedPreconditionError{Resource: "folder", Reason: "folder has bookmarks"})
+			},
+			&pb.DeleteFolderRequest{FolderId: "1"},
+			nil,
+			status.Error(codes.FailedPrecondition, "folder has bookmarks"),
+		},
+		"failed at usecase.Delete": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().Delete(&command.DeleteFolder{ID: "1", UserID: "alice"}).Return(errors.New("some error"))
+			},
+			&pb.DeleteFolderRequest{FolderId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewFolderServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.DeleteFolder(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_MoveFolder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockFolder)
+		req              *pb.MoveFolderRequest
+		expectedResponse *pb.Folder
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.
+					EXPECT().
+					Move(&command.MoveFolder{ID: "1", ParentID: "2", Position: 3, UserID: "alice"}).
+					Return(&dto.Folder{ID: "1", Name: "Reading List", ParentID: "2", Position: 3}, nil)
+			},
+			&pb.MoveFolderRequest{FolderId: "1", ParentFolderId: "2", Position: 3},
+			&pb.Folder{FolderId: "1", FolderName: "Reading List", ParentFolderId: "2", Position: 3},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"circular move": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.
+					EXPECT().
+					Move(&command.MoveFolder{ID: "1", ParentID: "2", UserID: "alice"}).
+					Return(nil, &command.FailedPreconditionError{Resource: "folder", Reason: "move creates a cycle"})
+			},
+			&pb.MoveFolderRequest{FolderId: "1", ParentFolderId: "2"},
+			nil,
+			status.Error(codes.FailedPrecondition, "move creates a cycle"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewFolderServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.MoveFolder(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_MoveBookmark(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockFolder)
+		req              *pb.MoveBookmarkRequest
+		expectedResponse *pb.Bookmark
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.
+					EXPECT().
+					MoveBookmark(&command.MoveBookmark{ID: "1", FolderID: "10", UserID: "alice"}).
+					Return(&dto.Bookmark{ID: "1", Name: "Example", URI: "https://example.com", FolderID: "10", Tags: []string{"foo"}}, nil)
+			},
+			&pb.MoveBookmarkRequest{BookmarkId: "1", FolderId: "10"},
+			&pb.Bookmark{BookmarkId: "1", BookmarkName: "Example", Uri: "https://example.com", FolderId: "10", Tags: []*pb.Tag{{TagName: "foo"}}},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockFolder) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"non-existent folder": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().MoveBookmark(&command.MoveBookmark{ID: "1", FolderID: "10", UserID: "alice"}).Return(nil, &command.NotFoundError{Resource: "folder"})
+			},
+			&pb.MoveBookmarkRequest{BookmarkId: "1", FolderId: "10"},
+			nil,
+			status.Error(codes.NotFound, "folder not found"),
+		},
+		"conflict at usecase.MoveBookmark": {
+			func(usecase *mock_usecase.MockFolder) {
+				usecase.EXPECT().MoveBookmark(&command.MoveBookmark{ID: "1", UserID: "alice"}).Return(nil, &command.ConflictError{Resource: "bookmark"})
+			},
+			&pb.MoveBookmarkRequest{BookmarkId: "1"},
+			nil,
+			status.Error(codes.Aborted, "bookmark conflicts"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockFolder(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewFolderServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.MoveBookmark(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestFolder_Unauthenticated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	expectedErr := status.Error(codes.Unauthenticated, "unauthenticated")
+	t.Run("unary", func(t *testing.T) {
+		t.Parallel()
+		usecase := mock_usecase.NewMockFolder(ctrl)
+		// given
+		server := NewFolderServer(usecase)
+		ctx := context.TODO()
+		// when
+		actualResponse, actualErr := server.GetFolder(ctx, &pb.GetFolderRequest{FolderId: "1"})
+		// then
+		assert.Nil(t, actualResponse)
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+		usecase := mock_usecase.NewMockFolder(ctrl)
+		stream := mock_pb.NewMockFolderManager_ListFoldersServer(ctrl)
+		stream.EXPECT().Context().Return(context.TODO())
+		// given
+		server := NewFolderServer(usecase)
+		// when
+		actualErr := server.ListFolders(&pb.ListFoldersRequest{}, stream)
+		// then
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/application/usecase"
+	"github.com/kkntzw/bookmark/internal/presentation/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Webhookに関するgRPCサーバの具象型
+type webhookServer struct {
+	usecase usecase.Webhook // ユースケース
+	pb.UnimplementedWebhookManagerServer
+}
+
+// Webhookに関するgRPCサーバを生成する。
+func NewWebhookServer(usecase usecase.Webhook) pb.WebhookManagerServer {
+	return &webhookServer{
+		usecase: usecase,
+	}
+}
+
+// Webhookの購読を表すDTOからメッセージを生成する。
+func toWebhookMessage(webhook dto.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		WebhookId: webhook.ID,
+		Uri:       webhook.URI,
+		Events:    webhook.Events,
+	}
+}
+
+// 配信に失敗した通知を表すDTOからメッセージを生成する。
+func toDeadLetterMessage(deadLetter dto.DeadLetter) *pb.DeadLetter {
+	return &pb.DeadLetter{
+		DeliveryId: deadLetter.ID,
+		WebhookId:  deadLetter.WebhookID,
+		Event:      deadLetter.EventName,
+		BookmarkId: deadLetter.BookmarkID,
+		Payload:    deadLetter.Payload,
+		Attempts:   int32(deadLetter.Attempts),
+		LastError:  deadLetter.LastError,
+		CreatedAt:  toTimestamp(deadLetter.CreatedAt),
+	}
+}
+
+// Webhookを購読する。
+//
+// 購読に成功した場合は OK と作成した購読を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 購読に失敗した場合は INTERNAL を返却する。
+func (s *webhookServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.CreateWebhook{URI: req.Uri, Events: req.Events, Secret: req.Secret, UserID: userID}
+	webhook, err := s.usecase.Create(cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toWebhookMessage(*webhook), nil
+}
+
+// Webhookの購読を一覧取得する。
+//
+// 認証したユーザが所有する購読を送信する。
+//
+// 購読の一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 購読の一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *webhookServer) ListWebhooks(req *emptypb.Empty, stream pb.WebhookManager_ListWebhooksServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListWebhooks{UserID: userID}
+	webhooks, err := s.usecase.List(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, webhook := range webhooks {
+		if err := stream.Send(toWebhookMessage(webhook)); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
+
+// Webhookの購読を解除する。
+//
+// 購読の解除に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// 購読が存在しない場合、または他のユーザが所有する場合は NOT_FOUND を返却する。
+// 購読の解除に失敗した場合は INTERNAL を返却する。
+func (s *webhookServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &command.DeleteWebhook{ID: req.WebhookId, UserID: userID}
+	if err := s.usecase.Delete(cmd); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 配信に失敗した通知を一覧取得する。
+//
+// 認証したユーザが所有するWebhookのデッドレターを送信する。
+//
+// デッドレターの一覧取得に成功した場合は OK を返却する。
+// nilを指定した場合は INVALID_ARGUMENT を返却する。
+// 認証されていない場合は UNAUTHENTICATED を返却する。
+// 不正なリクエストを指定した場合は INVALID_ARGUMENT を返却する。
+// デッドレターの一覧取得に失敗した場合は INTERNAL を返却する。
+// ストリームの送信に失敗した場合は INTERNAL を返却する。
+func (s *webhookServer) ListDeadLetters(req *pb.ListDeadLettersRequest, stream pb.WebhookManager_ListDeadLettersServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "argument \"req\" is nil")
+	}
+	userID, err := userIDFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	cmd := &command.ListDeadLetters{WebhookID: req.WebhookId, UserID: userID}
+	deadLetters, err := s.usecase.ListDeadLetters(cmd)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for _, deadLetter := range deadLetters {
+		if err := stream.Send(toDeadLetterMessage(deadLetter)); err != nil {
+			return status.Error(codes.Internal, "response failed")
+		}
+	}
+	return nil
+}
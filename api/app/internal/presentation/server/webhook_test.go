@@ -0,0 +1,334 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/application/command"
+	"github.com/kkntzw/bookmark/internal/application/dto"
+	"github.com/kkntzw/bookmark/internal/presentation/pb"
+	"github.com/kkntzw/bookmark/test/helper"
+	mock_usecase "github.com/kkntzw/bookmark/test/mock/application/usecase"
+	mock_pb "github.com/kkntzw/bookmark/test/mock/presentation/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewWebhookServer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Run("implementing pb.WebhookManagerServer", func(t *testing.T) {
+		t.Parallel()
+		// given
+		usecase := mock_usecase.NewMockWebhook(ctrl)
+		// when
+		object := NewWebhookServer(usecase)
+		// then
+		assert.NotNil(t, object)
+		interfaceObject := (*pb.WebhookManagerServer)(nil)
+		assert.Implements(t, interfaceObject, object)
+	})
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// given
+		usecase := mock_usecase.NewMockWebhook(ctrl)
+		abstractServer := NewWebhookServer(usecase)
+		// when
+		concreteServer, ok := abstractServer.(*webhookServer)
+		actualUsecase := concreteServer.usecase
+		// then
+		assert.True(t, ok)
+		expectedUsecase := usecase
+		assert.Exactly(t, expectedUsecase, actualUsecase)
+	})
+}
+
+func TestWebhook_CreateWebhook(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockWebhook)
+		req              *pb.CreateWebhookRequest
+		expectedResponse *pb.Webhook
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockWebhook) {
+				usecase.
+					EXPECT().
+					Create(&command.CreateWebhook{URI: "https://example.com/hooks", Events: []string{"BookmarkDeleted"}, Secret: "secret", UserID: "alice"}).
+					Return(&dto.Webhook{ID: "1", URI: "https://example.com/hooks", Events: []string{"BookmarkDeleted"}}, nil)
+			},
+			&pb.CreateWebhookRequest{Uri: "https://example.com/hooks", Events: []string{"BookmarkDeleted"}, Secret: "secret"},
+			&pb.Webhook{WebhookId: "1", Uri: "https://example.com/hooks", Events: []string{"BookmarkDeleted"}},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockWebhook) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockWebhook) {
+				usecase.
+					EXPECT().
+					Create(&command.CreateWebhook{URI: "https://example.com/hooks", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"Secret": errors.New("secret is empty")}})
+			},
+			&pb.CreateWebhookRequest{Uri: "https://example.com/hooks"},
+			nil,
+			helper.ToInvalidArgumentError(t, map[string]error{"Secret": errors.New("secret is empty")}),
+		},
+		"failed at usecase.Create": {
+			func(usecase *mock_usecase.MockWebhook) {
+				usecase.EXPECT().Create(&command.CreateWebhook{URI: "https://example.com/hooks", Secret: "secret", UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&pb.CreateWebhookRequest{Uri: "https://example.com/hooks", Secret: "secret"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockWebhook(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewWebhookServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.CreateWebhook(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_ListWebhooks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := withUserID(context.TODO(), "alice")
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockWebhook, *mock_pb.MockWebhookManager_ListWebhooksServer)
+		req         *emptypb.Empty
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListWebhooksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListWebhooks{UserID: "alice"}).Return([]dto.Webhook{
+					{ID: "1", URI: "https://example.com/hooks", Events: []string{}},
+					{ID: "2", URI: "https://example.org/hooks", Events: []string{"BookmarkRenamed"}},
+				}, nil)
+				stream.EXPECT().Send(&pb.Webhook{WebhookId: "1", Uri: "https://example.com/hooks", Events: []string{}}).Return(nil)
+				stream.EXPECT().Send(&pb.Webhook{WebhookId: "2", Uri: "https://example.org/hooks", Events: []string{"BookmarkRenamed"}}).Return(nil)
+			},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListWebhooksServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"failed at usecase.List": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListWebhooksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListWebhooks{UserID: "alice"}).Return(nil, errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "server error"),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListWebhooksServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().List(&command.ListWebhooks{UserID: "alice"}).Return([]dto.Webhook{{ID: "1", URI: "https://example.com/hooks", Events: []string{}}}, nil)
+				stream.EXPECT().Send(&pb.Webhook{WebhookId: "1", Uri: "https://example.com/hooks", Events: []string{}}).Return(errors.New("some error"))
+			},
+			&emptypb.Empty{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockWebhook(ctrl)
+			stream := mock_pb.NewMockWebhookManager_ListWebhooksServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewWebhookServer(usecase)
+			// when
+			actualErr := server.ListWebhooks(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cases := map[string]struct {
+		prepare          func(*mock_usecase.MockWebhook)
+		req              *pb.DeleteWebhookRequest
+		expectedResponse *emptypb.Empty
+		expectedErr      error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockWebhook) {
+				usecase.EXPECT().Delete(&command.DeleteWebhook{ID: "1", UserID: "alice"}).Return(nil)
+			},
+			&pb.DeleteWebhookRequest{WebhookId: "1"},
+			&emptypb.Empty{},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockWebhook) {},
+			nil,
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"non-existent webhook": {
+			func(usecase *mock_usecase.MockWebhook) {
+				usecase.EXPECT().Delete(&command.DeleteWebhook{ID: "1", UserID: "alice"}).Return(&command.NotFoundError{Resource: "webhook"})
+			},
+			&pb.DeleteWebhookRequest{WebhookId: "1"},
+			nil,
+			status.Error(codes.NotFound, "webhook not found"),
+		},
+		"failed at usecase.Delete": {
+			func(usecase *mock_usecase.MockWebhook) {
+				usecase.EXPECT().Delete(&command.DeleteWebhook{ID: "1", UserID: "alice"}).Return(errors.New("some error"))
+			},
+			&pb.DeleteWebhookRequest{WebhookId: "1"},
+			nil,
+			status.Error(codes.Internal, "server error"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockWebhook(ctrl)
+			tc.prepare(usecase)
+			// given
+			server := NewWebhookServer(usecase)
+			ctx := withUserID(context.TODO(), "alice")
+			// when
+			actualResponse, actualErr := server.DeleteWebhook(ctx, tc.req)
+			// then
+			assert.Exactly(t, tc.expectedResponse, actualResponse)
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_ListDeadLetters(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	createdAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	deadLetter := dto.DeadLetter{ID: "100", WebhookID: "10", EventName: "BookmarkDeleted", BookmarkID: "1", Payload: `{}`, Attempts: 5, LastError: "unexpected status: 500", CreatedAt: createdAt}
+	message := &pb.DeadLetter{DeliveryId: "100", WebhookId: "10", Event: "BookmarkDeleted", BookmarkId: "1", Payload: `{}`, Attempts: 5, LastError: "unexpected status: 500", CreatedAt: timestamppb.New(createdAt)}
+	ctx := withUserID(context.TODO(), "alice")
+	cases := map[string]struct {
+		prepare     func(*mock_usecase.MockWebhook, *mock_pb.MockWebhookManager_ListDeadLettersServer)
+		req         *pb.ListDeadLettersRequest
+		expectedErr error
+	}{
+		"non-nil request": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListDeadLettersServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListDeadLetters(&command.ListDeadLetters{WebhookID: "10", UserID: "alice"}).Return([]dto.DeadLetter{deadLetter}, nil)
+				stream.EXPECT().Send(message).Return(nil)
+			},
+			&pb.ListDeadLettersRequest{WebhookId: "10"},
+			nil,
+		},
+		"nil request": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListDeadLettersServer) {},
+			nil,
+			status.Error(codes.InvalidArgument, "argument \"req\" is nil"),
+		},
+		"invalid request": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListDeadLettersServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.
+					EXPECT().
+					ListDeadLetters(&command.ListDeadLetters{WebhookID: "!", UserID: "alice"}).
+					Return(nil, &command.InvalidCommandError{Args: map[string]error{"WebhookID": helper.ToErrID(t, "!")}})
+			},
+			&pb.ListDeadLettersRequest{WebhookId: "!"},
+			helper.ToInvalidArgumentError(t, map[string]error{"WebhookID": helper.ToErrID(t, "!")}),
+		},
+		"failed at stream.Send": {
+			func(usecase *mock_usecase.MockWebhook, stream *mock_pb.MockWebhookManager_ListDeadLettersServer) {
+				stream.EXPECT().Context().Return(ctx)
+				usecase.EXPECT().ListDeadLetters(&command.ListDeadLetters{UserID: "alice"}).Return([]dto.DeadLetter{deadLetter}, nil)
+				stream.EXPECT().Send(message).Return(errors.New("some error"))
+			},
+			&pb.ListDeadLettersRequest{},
+			status.Error(codes.Internal, "response failed"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			usecase := mock_usecase.NewMockWebhook(ctrl)
+			stream := mock_pb.NewMockWebhookManager_ListDeadLettersServer(ctrl)
+			tc.prepare(usecase, stream)
+			// given
+			server := NewWebhookServer(usecase)
+			// when
+			actualErr := server.ListDeadLetters(tc.req, stream)
+			// then
+			assert.Exactly(t, tc.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestWebhook_Unauthenticated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	expectedErr := status.Error(codes.Unauthenticated, "unauthenticated")
+	t.Run("unary", func(t *testing.T) {
+		t.Parallel()
+		usecase := mock_usecase.NewMockWebhook(ctrl)
+		// given
+		server := NewWebhookServer(usecase)
+		ctx := context.TODO()
+		// when
+		actualResponse, actualErr := server.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{WebhookId: "1"})
+		// then
+		assert.Nil(t, actualResponse)
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+		usecase := mock_usecase.NewMockWebhook(ctrl)
+		stream := mock_pb.NewMockWebhookManager_ListWebhooksServer(ctrl)
+		stream.EXPECT().Context().Return(context.TODO())
+		// given
+		server := NewWebhookServer(usecase)
+		// when
+		actualErr := server.ListWebhooks(&emptypb.Empty{}, stream)
+		// then
+		assert.Exactly(t, expectedErr, actualErr)
+	})
+}
@@ -3,6 +3,17 @@
 # モックを作成する。
 
 mockgen -source=./internal/domain/repository/bookmark.go -destination=./test/mock/domain/repository/bookmark.go
+mockgen -source=./internal/domain/repository/folder.go -destination=./test/mock/domain/repository/folder.go
+mockgen -source=./internal/domain/repository/revision.go -destination=./test/mock/domain/repository/revision.go
+mockgen -source=./internal/domain/repository/audit.go -destination=./test/mock/domain/repository/audit.go
+mockgen -source=./internal/domain/repository/watcher.go -destination=./test/mock/domain/repository/watcher.go
+mockgen -source=./internal/domain/repository/webhook.go -destination=./test/mock/domain/repository/webhook.go
+mockgen -source=./internal/domain/repository/delivery.go -destination=./test/mock/domain/repository/delivery.go
+mockgen -source=./internal/domain/repository/dead_letter.go -destination=./test/mock/domain/repository/dead_letter.go
+mockgen -source=./internal/domain/repository/share.go -destination=./test/mock/domain/repository/share.go
 mockgen -source=./internal/domain/service/bookmark.go -destination=./test/mock/domain/service/bookmark.go
+mockgen -source=./internal/domain/service/folder.go -destination=./test/mock/domain/service/folder.go
 mockgen -source=./internal/application/usecase/bookmark.go -destination=./test/mock/application/usecase/bookmark.go
+mockgen -source=./internal/application/usecase/folder.go -destination=./test/mock/application/usecase/folder.go
+mockgen -source=./internal/application/usecase/webhook.go -destination=./test/mock/application/usecase/webhook.go
 mockgen -source=./internal/presentation/pb/bookmark_grpc.pb.go -destination=./test/mock/presentation/pb/bookmark.go
@@ -0,0 +1,21 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkntzw/bookmark/internal/domain/clock"
+)
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+func ToFixedClock(t *testing.T, now time.Time) clock.Clock {
+	t.Helper()
+	return &fixedClock{now}
+}
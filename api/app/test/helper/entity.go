@@ -2,10 +2,14 @@ package helper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kkntzw/bookmark/internal/domain/entity"
 )
 
+// テスト用のブックマークの所有者のユーザID。
+const UserID = "alice"
+
 func ToID(t *testing.T, v string) *entity.ID {
 	t.Helper()
 	id, err := entity.NewID(v)
@@ -15,6 +19,15 @@ func ToID(t *testing.T, v string) *entity.ID {
 	return id
 }
 
+func ToUserID(t *testing.T, v string) *entity.UserID {
+	t.Helper()
+	userID, err := entity.NewUserID(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return userID
+}
+
 func ToName(t *testing.T, v string) *entity.Name {
 	t.Helper()
 	name, err := entity.NewName(v)
@@ -24,6 +37,15 @@ func ToName(t *testing.T, v string) *entity.Name {
 	return name
 }
 
+func ToDescription(t *testing.T, v string) *entity.Description {
+	t.Helper()
+	description, err := entity.NewDescription(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return description
+}
+
 func ToURI(t *testing.T, v string) *entity.URI {
 	t.Helper()
 	uri, err := entity.NewURI(v)
@@ -46,6 +68,15 @@ func ToTags(t *testing.T, vs ...string) []entity.Tag {
 	return tags
 }
 
+func ToStatus(t *testing.T, v string) *entity.Status {
+	t.Helper()
+	status, err := entity.NewStatus(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status
+}
+
 func ToErrID(t *testing.T, v string) error {
 	t.Helper()
 	_, err := entity.NewID(v)
@@ -55,6 +86,15 @@ func ToErrID(t *testing.T, v string) error {
 	return err
 }
 
+func ToErrUserID(t *testing.T, v string) error {
+	t.Helper()
+	_, err := entity.NewUserID(v)
+	if err == nil {
+		t.Fatal()
+	}
+	return err
+}
+
 func ToErrName(t *testing.T, v string) error {
 	t.Helper()
 	_, err := entity.NewName(v)
@@ -64,6 +104,15 @@ func ToErrName(t *testing.T, v string) error {
 	return err
 }
 
+func ToErrDescription(t *testing.T, v string) error {
+	t.Helper()
+	_, err := entity.NewDescription(v)
+	if err == nil {
+		t.Fatal()
+	}
+	return err
+}
+
 func ToErrURI(t *testing.T, v string) error {
 	t.Helper()
 	_, err := entity.NewURI(v)
@@ -92,5 +141,215 @@ func ToBookmark(t *testing.T, iv, nv, uv string, tvs ...string) *entity.Bookmark
 	if err != nil {
 		t.Fatal(err)
 	}
+	bookmark.AssignTo(ToUserID(t, UserID))
+	return bookmark
+}
+
+func ToOwnedBookmark(t *testing.T, ov, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToBookmark(t, iv, nv, uv, tvs...)
+	bookmark.AssignTo(ToUserID(t, ov))
+	return bookmark
+}
+
+func ToRegisteredBookmark(t *testing.T, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	id := ToID(t, iv)
+	name := ToName(t, nv)
+	uri := ToURI(t, uv)
+	tags := ToTags(t, tvs...)
+	bookmark, err := entity.RegisterBookmark(id, ToUserID(t, UserID), name, uri, ToDescription(t, ""), tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bookmark
+}
+
+func ToVersionedBookmark(t *testing.T, version uint64, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToBookmark(t, iv, nv, uv, tvs...)
+	bookmark.SetVersion(version)
+	return bookmark
+}
+
+func ToTimestampedBookmark(t *testing.T, version uint64, createdAt, updatedAt time.Time, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToVersionedBookmark(t, version, iv, nv, uv, tvs...)
+	bookmark.SetTimestamps(createdAt, updatedAt)
+	return bookmark
+}
+
+func ToTrashedBookmark(t *testing.T, version uint64, createdAt, updatedAt, deletedAt time.Time, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToTimestampedBookmark(t, version, createdAt, updatedAt, iv, nv, uv, tvs...)
+	bookmark.SetDeletedAt(deletedAt)
+	return bookmark
+}
+
+func ToDescribedBookmark(t *testing.T, dv, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToBookmark(t, iv, nv, uv, tvs...)
+	bookmark.Describe(ToDescription(t, dv))
+	bookmark.PullEvents()
+	return bookmark
+}
+
+func ToFiledBookmark(t *testing.T, fv, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToBookmark(t, iv, nv, uv, tvs...)
+	bookmark.MoveTo(ToID(t, fv))
+	bookmark.PullEvents()
+	return bookmark
+}
+
+func ToMarkedBookmark(t *testing.T, status entity.Status, starred bool, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToBookmark(t, iv, nv, uv, tvs...)
+	switch status {
+	case entity.StatusRead:
+		bookmark.MarkRead()
+	case entity.StatusArchived:
+		bookmark.Archive()
+	}
+	if starred {
+		bookmark.Star()
+	}
+	bookmark.PullEvents()
+	return bookmark
+}
+
+func ToOwnedMarkedBookmark(t *testing.T, ov string, status entity.Status, starred bool, iv, nv, uv string, tvs ...string) *entity.Bookmark {
+	t.Helper()
+	bookmark := ToMarkedBookmark(t, status, starred, iv, nv, uv, tvs...)
+	bookmark.AssignTo(ToUserID(t, ov))
 	return bookmark
 }
+
+func ToFolder(t *testing.T, iv, nv, pv string, position int) *entity.Folder {
+	t.Helper()
+	return ToOwnedFolder(t, UserID, iv, nv, pv, position)
+}
+
+func ToOwnedFolder(t *testing.T, ov, iv, nv, pv string, position int) *entity.Folder {
+	t.Helper()
+	id := ToID(t, iv)
+	name := ToName(t, nv)
+	var parent *entity.ID
+	if pv != "" {
+		parent = ToID(t, pv)
+	}
+	folder, err := entity.NewFolder(id, ToUserID(t, ov), name, parent, position)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return folder
+}
+
+func ToSnapshot(t *testing.T, nv, uv string, tvs ...string) *entity.Snapshot {
+	t.Helper()
+	snapshot, err := entity.NewSnapshot(ToName(t, nv), ToURI(t, uv), ToDescription(t, ""), ToTags(t, tvs...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return snapshot
+}
+
+func ToStatefulSnapshot(t *testing.T, folder *entity.ID, status entity.Status, starred, trashed bool, nv, uv string, tvs ...string) *entity.Snapshot {
+	t.Helper()
+	snapshot := ToSnapshot(t, nv, uv, tvs...)
+	snapshot.SetState(folder, status, starred, trashed)
+	return snapshot
+}
+
+func ToRevision(t *testing.T, iv, bv string, version uint64, actor string, before, after *entity.Snapshot) *entity.Revision {
+	t.Helper()
+	revision, err := entity.NewRevision(ToID(t, iv), ToID(t, bv), version, actor, before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return revision
+}
+
+func ToTimestampedRevision(t *testing.T, createdAt time.Time, iv, bv string, version uint64, actor string, before, after *entity.Snapshot) *entity.Revision {
+	t.Helper()
+	revision := ToRevision(t, iv, bv, version, actor, before, after)
+	revision.SetCreatedAt(createdAt)
+	return revision
+}
+
+func ToAuditEntry(t *testing.T, iv, actor string, operation entity.AuditOperation, bv, ov string, before, after *entity.Snapshot) *entity.AuditEntry {
+	t.Helper()
+	entry, err := entity.NewAuditEntry(ToID(t, iv), actor, operation, ToID(t, bv), ToUserID(t, ov), before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entry
+}
+
+func ToTimestampedAuditEntry(t *testing.T, createdAt time.Time, iv, actor string, operation entity.AuditOperation, bv, ov string, before, after *entity.Snapshot) *entity.AuditEntry {
+	t.Helper()
+	entry := ToAuditEntry(t, iv, actor, operation, bv, ov, before, after)
+	entry.SetCreatedAt(createdAt)
+	return entry
+}
+
+func ToWebhook(t *testing.T, iv, uv, secret string, events ...string) *entity.Webhook {
+	t.Helper()
+	return ToOwnedWebhook(t, UserID, iv, uv, secret, events...)
+}
+
+func ToOwnedWebhook(t *testing.T, ov, iv, uv, secret string, events ...string) *entity.Webhook {
+	t.Helper()
+	webhook, err := entity.NewWebhook(ToID(t, iv), ToUserID(t, ov), ToURI(t, uv), events, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return webhook
+}
+
+func ToDeadLetter(t *testing.T, iv, wv, eventName, bv string, payload string, attempts int, lastError string) *entity.DeadLetter {
+	t.Helper()
+	deadLetter, err := entity.NewDeadLetter(ToID(t, iv), ToUserID(t, UserID), ToID(t, wv), eventName, ToID(t, bv), []byte(payload), attempts, lastError)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return deadLetter
+}
+
+func ToTimestampedDeadLetter(t *testing.T, createdAt time.Time, iv, wv, eventName, bv string, payload string, attempts int, lastError string) *entity.DeadLetter {
+	t.Helper()
+	deadLetter := ToDeadLetter(t, iv, wv, eventName, bv, payload, attempts, lastError)
+	deadLetter.SetCreatedAt(createdAt)
+	return deadLetter
+}
+
+func ToDelivery(t *testing.T, nextAttemptAt time.Time, iv, wv, eventName, bv string, payload string, attempts int, lastError string) *entity.Delivery {
+	t.Helper()
+	delivery, err := entity.NewDelivery(ToID(t, iv), ToUserID(t, UserID), ToID(t, wv), eventName, ToID(t, bv), []byte(payload), attempts, lastError, nextAttemptAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return delivery
+}
+
+func ToBookmarkShare(t *testing.T, iv, ov, gv, bv string, role entity.ShareRole) *entity.Share {
+	t.Helper()
+	share, err := entity.NewShare(ToID(t, iv), ToUserID(t, ov), ToUserID(t, gv), ToID(t, bv), nil, role)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return share
+}
+
+func ToCollectionShare(t *testing.T, iv, ov, gv, tv string, role entity.ShareRole) *entity.Share {
+	t.Helper()
+	tag, err := entity.NewTag(tv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	share, err := entity.NewShare(ToID(t, iv), ToUserID(t, ov), ToUserID(t, gv), nil, tag, role)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return share
+}
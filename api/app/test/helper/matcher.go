@@ -0,0 +1,72 @@
+package helper
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+type bookmarkMatcher struct {
+	bookmark entity.Bookmark
+	events   []string
+}
+
+func (m *bookmarkMatcher) Matches(x interface{}) bool {
+	actual, ok := x.(*entity.Bookmark)
+	if !ok || actual == nil {
+		return false
+	}
+	return m.matches(*actual)
+}
+
+func (m *bookmarkMatcher) matches(actual entity.Bookmark) bool {
+	events := []string{}
+	for _, event := range actual.PullEvents() {
+		events = append(events, event.EventName())
+	}
+	expected := m.bookmark
+	expected.PullEvents()
+	return reflect.DeepEqual(expected, actual) && reflect.DeepEqual(m.events, events)
+}
+
+func (m *bookmarkMatcher) String() string {
+	return fmt.Sprintf("is equal to %v with events %v", m.bookmark, m.events)
+}
+
+func ToBookmarkMatcher(t *testing.T, bookmark *entity.Bookmark, events ...string) gomock.Matcher {
+	t.Helper()
+	return &bookmarkMatcher{*bookmark, append([]string{}, events...)}
+}
+
+type bookmarksMatcher struct {
+	matchers []*bookmarkMatcher
+}
+
+func (m *bookmarksMatcher) Matches(x interface{}) bool {
+	actual, ok := x.([]entity.Bookmark)
+	if !ok || len(actual) != len(m.matchers) {
+		return false
+	}
+	for i, matcher := range m.matchers {
+		if !matcher.matches(actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *bookmarksMatcher) String() string {
+	return fmt.Sprintf("%v", m.matchers)
+}
+
+func ToBookmarksMatcher(t *testing.T, matchers ...gomock.Matcher) gomock.Matcher {
+	t.Helper()
+	m := &bookmarksMatcher{make([]*bookmarkMatcher, len(matchers))}
+	for i, matcher := range matchers {
+		m.matchers[i] = matcher.(*bookmarkMatcher)
+	}
+	return m
+}
@@ -2,8 +2,10 @@ package helper
 
 import (
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func ToBookmarkDocument(t *testing.T, id, name, uri string, tags ...string) bson.D {
@@ -14,9 +16,166 @@ func ToBookmarkDocument(t *testing.T, id, name, uri string, tags ...string) bson
 	}
 	doc := bson.D{
 		{Key: "_id", Value: id},
+		{Key: "userID", Value: UserID},
 		{Key: "name", Value: name},
 		{Key: "uri", Value: uri},
 		{Key: "tags", Value: tagArray},
 	}
 	return doc
 }
+
+func ToFolderDocument(t *testing.T, id, name, parentID string, position int) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "userID", Value: UserID},
+		{Key: "name", Value: name},
+		{Key: "parentID", Value: parentID},
+		{Key: "position", Value: position},
+	}
+	return doc
+}
+
+func ToSnapshotDocument(t *testing.T, name, uri string, tags ...string) bson.D {
+	t.Helper()
+	tagArray := bson.A{}
+	for _, tag := range tags {
+		tagArray = append(tagArray, tag)
+	}
+	doc := bson.D{
+		{Key: "name", Value: name},
+		{Key: "uri", Value: uri},
+		{Key: "description", Value: ""},
+		{Key: "tags", Value: tagArray},
+	}
+	return doc
+}
+
+func ToRevisionDocument(t *testing.T, createdAt time.Time, id, bookmarkID string, version int64, actor string, before, after bson.D) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "bookmarkID", Value: bookmarkID},
+		{Key: "version", Value: version},
+		{Key: "actor", Value: actor},
+		{Key: "before", Value: before},
+		{Key: "after", Value: after},
+		{Key: "createdAt", Value: primitive.NewDateTimeFromTime(createdAt)},
+	}
+	return doc
+}
+
+func ToChangeEventDocument(t *testing.T, resumeToken, operationType, id string, fullDocument bson.D) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "_data", Value: resumeToken}}},
+		{Key: "operationType", Value: operationType},
+		{Key: "documentKey", Value: bson.D{{Key: "_id", Value: id}}},
+	}
+	if fullDocument != nil {
+		doc = append(doc, bson.E{Key: "fullDocument", Value: fullDocument})
+	}
+	return doc
+}
+
+func ToTombstoneEventDocument(t *testing.T, resumeToken, id, userID string) bson.D {
+	t.Helper()
+	return bson.D{
+		{Key: "_id", Value: bson.D{{Key: "_data", Value: resumeToken}}},
+		{Key: "operationType", Value: "update"},
+		{Key: "documentKey", Value: bson.D{{Key: "_id", Value: id}}},
+		{Key: "updateDescription", Value: bson.D{
+			{Key: "updatedFields", Value: bson.D{{Key: "tombstone", Value: bson.D{{Key: "userID", Value: userID}}}}},
+			{Key: "removedFields", Value: bson.A{}},
+		}},
+	}
+}
+
+func ToWebhookDocument(t *testing.T, id, uri, secret string, events ...string) bson.D {
+	t.Helper()
+	eventArray := bson.A{}
+	for _, event := range events {
+		eventArray = append(eventArray, event)
+	}
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "userID", Value: UserID},
+		{Key: "uri", Value: uri},
+		{Key: "events", Value: eventArray},
+		{Key: "secret", Value: secret},
+	}
+	return doc
+}
+
+func ToDeadLetterDocument(t *testing.T, createdAt time.Time, id, webhookID, eventName, bookmarkID, payload string, attempts int, lastError string) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "userID", Value: UserID},
+		{Key: "webhookID", Value: webhookID},
+		{Key: "eventName", Value: eventName},
+		{Key: "bookmarkID", Value: bookmarkID},
+		{Key: "payload", Value: []byte(payload)},
+		{Key: "attempts", Value: attempts},
+		{Key: "lastError", Value: lastError},
+		{Key: "createdAt", Value: primitive.NewDateTimeFromTime(createdAt)},
+	}
+	return doc
+}
+
+func ToDeliveryDocument(t *testing.T, nextAttemptAt time.Time, id, webhookID, eventName, bookmarkID, payload string, attempts int, lastError string) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "userID", Value: UserID},
+		{Key: "webhookID", Value: webhookID},
+		{Key: "eventName", Value: eventName},
+		{Key: "bookmarkID", Value: bookmarkID},
+		{Key: "payload", Value: []byte(payload)},
+		{Key: "attempts", Value: attempts},
+		{Key: "lastError", Value: lastError},
+		{Key: "nextAttemptAt", Value: primitive.NewDateTimeFromTime(nextAttemptAt)},
+	}
+	return doc
+}
+
+func ToOutboxDocument(t *testing.T, id, eventName, bookmarkID string, fields ...bson.E) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "eventName", Value: eventName},
+		{Key: "bookmarkID", Value: bookmarkID},
+		{Key: "userID", Value: UserID},
+	}
+	doc = append(doc, fields...)
+	doc = append(doc, bson.E{Key: "position", Value: 0}, bson.E{Key: "dispatchedAt", Value: nil})
+	return doc
+}
+
+func ToAuditEntryDocument(t *testing.T, createdAt time.Time, id, actor, operation, bookmarkID, userID string, before, after interface{}) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "actor", Value: actor},
+		{Key: "operation", Value: operation},
+		{Key: "bookmarkID", Value: bookmarkID},
+		{Key: "userID", Value: userID},
+		{Key: "before", Value: before},
+		{Key: "after", Value: after},
+		{Key: "createdAt", Value: primitive.NewDateTimeFromTime(createdAt)},
+	}
+	return doc
+}
+
+func ToShareDocument(t *testing.T, id, owner, grantee, bookmarkID, tag, role string) bson.D {
+	t.Helper()
+	doc := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "owner", Value: owner},
+		{Key: "grantee", Value: grantee},
+		{Key: "bookmarkID", Value: bookmarkID},
+		{Key: "tag", Value: tag},
+		{Key: "role", Value: role},
+	}
+	return doc
+}
@@ -35,6 +35,14 @@ func ToCreateBookmarkRequest(t *testing.T, name, uri string, tagNames ...string)
 	return req
 }
 
+func ToGetBookmarkRequest(t *testing.T, id string) *pb.GetBookmarkRequest {
+	t.Helper()
+	req := &pb.GetBookmarkRequest{
+		BookmarkId: id,
+	}
+	return req
+}
+
 func ToUpdateBookmarkRequest(t *testing.T, id, name, uri string) *pb.UpdateBookmarkRequest {
 	t.Helper()
 	req := &pb.UpdateBookmarkRequest{
@@ -52,3 +60,45 @@ func ToDeleteBookmarkRequest(t *testing.T, id string) *pb.DeleteBookmarkRequest
 	}
 	return req
 }
+
+func ToAddTagsRequest(t *testing.T, id string, tagNames ...string) *pb.AddTagsRequest {
+	t.Helper()
+	tags := make([]*pb.Tag, len(tagNames))
+	for i, tagName := range tagNames {
+		tags[i] = &pb.Tag{TagName: tagName}
+	}
+	req := &pb.AddTagsRequest{
+		BookmarkId: id,
+		Tags:       tags,
+	}
+	return req
+}
+
+func ToRemoveTagsRequest(t *testing.T, id string, tagNames ...string) *pb.RemoveTagsRequest {
+	t.Helper()
+	tags := make([]*pb.Tag, len(tagNames))
+	for i, tagName := range tagNames {
+		tags[i] = &pb.Tag{TagName: tagName}
+	}
+	req := &pb.RemoveTagsRequest{
+		BookmarkId: id,
+		Tags:       tags,
+	}
+	return req
+}
+
+func ToListBookmarksResponse(t *testing.T, bookmark *pb.Bookmark) *pb.ListBookmarksResponse {
+	t.Helper()
+	res := &pb.ListBookmarksResponse{
+		Result: &pb.ListBookmarksResponse_Bookmark{Bookmark: bookmark},
+	}
+	return res
+}
+
+func ToPageInfoResponse(t *testing.T, nextPageToken string) *pb.ListBookmarksResponse {
+	t.Helper()
+	res := &pb.ListBookmarksResponse{
+		Result: &pb.ListBookmarksResponse_PageInfo{PageInfo: &pb.PageInfo{NextPageToken: nextPageToken}},
+	}
+	return res
+}
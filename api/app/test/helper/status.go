@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"sort"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ToInvalidArgumentError(t *testing.T, args map[string]error) error {
+	t.Helper()
+	keys := []string{}
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(keys))
+	for i, k := range keys {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: k, Description: args[k].Error()}
+	}
+	st, err := status.New(codes.InvalidArgument, "request is invalid").WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Err()
+}
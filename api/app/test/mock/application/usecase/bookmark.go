@@ -5,6 +5,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,36 @@ func (m *MockBookmark) EXPECT() *MockBookmarkMockRecorder {
 	return m.recorder
 }
 
+// AddTags mocks base method.
+func (m *MockBookmark) AddTags(arg0 *command.AddTags) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockBookmarkMockRecorder) AddTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockBookmark)(nil).AddTags), arg0)
+}
+
+// Archive mocks base method.
+func (m *MockBookmark) Archive(arg0 *command.Archive) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockBookmarkMockRecorder) Archive(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockBookmark)(nil).Archive), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockBookmark) Delete(arg0 *command.DeleteBookmark) error {
 	m.ctrl.T.Helper()
@@ -49,27 +80,208 @@ func (mr *MockBookmarkMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookmark)(nil).Delete), arg0)
 }
 
+// FindDuplicates mocks base method.
+func (m *MockBookmark) FindDuplicates(arg0 *command.FindDuplicates) ([]dto.Duplicate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicates", arg0)
+	ret0, _ := ret[0].([]dto.Duplicate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockBookmarkMockRecorder) FindDuplicates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockBookmark)(nil).FindDuplicates), arg0)
+}
+
+// Get mocks base method.
+func (m *MockBookmark) Get(arg0 *command.GetBookmark) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBookmarkMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBookmark)(nil).Get), arg0)
+}
+
 // List mocks base method.
-func (m *MockBookmark) List() ([]dto.Bookmark, error) {
+func (m *MockBookmark) List(arg0 *command.ListBookmarks) (*dto.BookmarkPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
-	ret0, _ := ret[0].([]dto.Bookmark)
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].(*dto.BookmarkPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockBookmarkMockRecorder) List() *gomock.Call {
+func (mr *MockBookmarkMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBookmark)(nil).List), arg0)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockBookmark) ListAuditEntries(arg0 *command.ListAuditEntries) ([]dto.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEntries", arg0)
+	ret0, _ := ret[0].([]dto.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockBookmarkMockRecorder) ListAuditEntries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockBookmark)(nil).ListAuditEntries), arg0)
+}
+
+// ListRevisions mocks base method.
+func (m *MockBookmark) ListRevisions(arg0 *command.ListBookmarkRevisions) ([]dto.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", arg0)
+	ret0, _ := ret[0].([]dto.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockBookmarkMockRecorder) ListRevisions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockBookmark)(nil).ListRevisions), arg0)
+}
+
+// ListSharedWithMe mocks base method.
+func (m *MockBookmark) ListSharedWithMe(arg0 *command.ListSharedWithMe) ([]dto.SharedBookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSharedWithMe", arg0)
+	ret0, _ := ret[0].([]dto.SharedBookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedWithMe indicates an expected call of ListSharedWithMe.
+func (mr *MockBookmarkMockRecorder) ListSharedWithMe(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedWithMe", reflect.TypeOf((*MockBookmark)(nil).ListSharedWithMe), arg0)
+}
+
+// ListTags mocks base method.
+func (m *MockBookmark) ListTags(arg0 *command.ListTags) ([]dto.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0)
+	ret0, _ := ret[0].([]dto.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockBookmarkMockRecorder) ListTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockBookmark)(nil).ListTags), arg0)
+}
+
+// ListTrash mocks base method.
+func (m *MockBookmark) ListTrash(arg0 *command.ListTrash) ([]dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0)
+	ret0, _ := ret[0].([]dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockBookmarkMockRecorder) ListTrash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockBookmark)(nil).ListTrash), arg0)
+}
+
+// MarkRead mocks base method.
+func (m *MockBookmark) MarkRead(arg0 *command.MarkRead) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockBookmarkMockRecorder) MarkRead(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockBookmark)(nil).MarkRead), arg0)
+}
+
+// MarkUnread mocks base method.
+func (m *MockBookmark) MarkUnread(arg0 *command.MarkUnread) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUnread", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUnread indicates an expected call of MarkUnread.
+func (mr *MockBookmarkMockRecorder) MarkUnread(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUnread", reflect.TypeOf((*MockBookmark)(nil).MarkUnread), arg0)
+}
+
+// MergeBookmarks mocks base method.
+func (m *MockBookmark) MergeBookmarks(arg0 *command.MergeBookmarks) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeBookmarks", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeBookmarks indicates an expected call of MergeBookmarks.
+func (mr *MockBookmarkMockRecorder) MergeBookmarks(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBookmark)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeBookmarks", reflect.TypeOf((*MockBookmark)(nil).MergeBookmarks), arg0)
+}
+
+// MergeTags mocks base method.
+func (m *MockBookmark) MergeTags(arg0 *command.MergeTags) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBookmarkMockRecorder) MergeTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBookmark)(nil).MergeTags), arg0)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockBookmark) PurgeTrash(arg0 *command.PurgeTrash) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockBookmarkMockRecorder) PurgeTrash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockBookmark)(nil).PurgeTrash), arg0)
 }
 
 // Register mocks base method.
-func (m *MockBookmark) Register(arg0 *command.RegisterBookmark) error {
+func (m *MockBookmark) Register(arg0 *command.RegisterBookmark) (*dto.Bookmark, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Register", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Register indicates an expected call of Register.
@@ -78,16 +290,150 @@ func (mr *MockBookmarkMockRecorder) Register(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockBookmark)(nil).Register), arg0)
 }
 
-// Update mocks base method.
-func (m *MockBookmark) Update(arg0 *command.UpdateBookmark) error {
+// RemoveTags mocks base method.
+func (m *MockBookmark) RemoveTags(arg0 *command.RemoveTags) (*dto.Bookmark, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "RemoveTags", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockBookmarkMockRecorder) RemoveTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockBookmark)(nil).RemoveTags), arg0)
+}
+
+// RenameTag mocks base method.
+func (m *MockBookmark) RenameTag(arg0 *command.RenameTag) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockBookmarkMockRecorder) RenameTag(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockBookmark)(nil).RenameTag), arg0)
+}
+
+// Restore mocks base method.
+func (m *MockBookmark) Restore(arg0 *command.RestoreBookmark) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockBookmarkMockRecorder) Restore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockBookmark)(nil).Restore), arg0)
+}
+
+// Revert mocks base method.
+func (m *MockBookmark) Revert(arg0 *command.RevertBookmark) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revert indicates an expected call of Revert.
+func (mr *MockBookmarkMockRecorder) Revert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockBookmark)(nil).Revert), arg0)
+}
+
+// RevokeShare mocks base method.
+func (m *MockBookmark) RevokeShare(arg0 *command.RevokeShare) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockBookmarkMockRecorder) RevokeShare(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockBookmark)(nil).RevokeShare), arg0)
+}
+
+// Share mocks base method.
+func (m *MockBookmark) Share(arg0 *command.ShareBookmark) (*dto.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", arg0)
+	ret0, _ := ret[0].(*dto.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockBookmarkMockRecorder) Share(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockBookmark)(nil).Share), arg0)
+}
+
+// Star mocks base method.
+func (m *MockBookmark) Star(arg0 *command.Star) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Star", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Star indicates an expected call of Star.
+func (mr *MockBookmarkMockRecorder) Star(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Star", reflect.TypeOf((*MockBookmark)(nil).Star), arg0)
+}
+
+// Unstar mocks base method.
+func (m *MockBookmark) Unstar(arg0 *command.Unstar) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unstar", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unstar indicates an expected call of Unstar.
+func (mr *MockBookmarkMockRecorder) Unstar(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unstar", reflect.TypeOf((*MockBookmark)(nil).Unstar), arg0)
+}
+
+// Update mocks base method.
+func (m *MockBookmark) Update(arg0 *command.UpdateBookmark) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockBookmarkMockRecorder) Update(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookmark)(nil).Update), arg0)
 }
+
+// Watch mocks base method.
+func (m *MockBookmark) Watch(arg0 context.Context, arg1 *command.WatchBookmarks, arg2 func(dto.BookmarkChange) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockBookmarkMockRecorder) Watch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockBookmark)(nil).Watch), arg0, arg1, arg2)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/application/usecase/folder.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	command "github.com/kkntzw/bookmark/internal/application/command"
+	dto "github.com/kkntzw/bookmark/internal/application/dto"
+)
+
+// MockFolder is a mock of Folder interface.
+type MockFolder struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderMockRecorder
+}
+
+// MockFolderMockRecorder is the mock recorder for MockFolder.
+type MockFolderMockRecorder struct {
+	mock *MockFolder
+}
+
+// NewMockFolder creates a new mock instance.
+func NewMockFolder(ctrl *gomock.Controller) *MockFolder {
+	mock := &MockFolder{ctrl: ctrl}
+	mock.recorder = &MockFolderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolder) EXPECT() *MockFolderMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFolder) Create(arg0 *command.CreateFolder) (*dto.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*dto.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFolderMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFolder)(nil).Create), arg0)
+}
+
+// Delete mocks base method.
+func (m *MockFolder) Delete(arg0 *command.DeleteFolder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFolderMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFolder)(nil).Delete), arg0)
+}
+
+// Get mocks base method.
+func (m *MockFolder) Get(arg0 *command.GetFolder) (*dto.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*dto.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFolderMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFolder)(nil).Get), arg0)
+}
+
+// List mocks base method.
+func (m *MockFolder) List(arg0 *command.ListFolders) ([]dto.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].([]dto.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockFolderMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFolder)(nil).List), arg0)
+}
+
+// Move mocks base method.
+func (m *MockFolder) Move(arg0 *command.MoveFolder) (*dto.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", arg0)
+	ret0, _ := ret[0].(*dto.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockFolderMockRecorder) Move(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockFolder)(nil).Move), arg0)
+}
+
+// MoveBookmark mocks base method.
+func (m *MockFolder) MoveBookmark(arg0 *command.MoveBookmark) (*dto.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBookmark", arg0)
+	ret0, _ := ret[0].(*dto.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBookmark indicates an expected call of MoveBookmark.
+func (mr *MockFolderMockRecorder) MoveBookmark(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBookmark", reflect.TypeOf((*MockFolder)(nil).MoveBookmark), arg0)
+}
+
+// Update mocks base method.
+func (m *MockFolder) Update(arg0 *command.UpdateFolder) (*dto.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(*dto.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFolderMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFolder)(nil).Update), arg0)
+}
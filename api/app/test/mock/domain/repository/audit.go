@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/audit.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+	repository "github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// MockAudit is a mock of Audit interface.
+type MockAudit struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditMockRecorder
+}
+
+// MockAuditMockRecorder is the mock recorder for MockAudit.
+type MockAuditMockRecorder struct {
+	mock *MockAudit
+}
+
+// NewMockAudit creates a new mock instance.
+func NewMockAudit(ctrl *gomock.Controller) *MockAudit {
+	mock := &MockAudit{ctrl: ctrl}
+	mock.recorder = &MockAuditMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAudit) EXPECT() *MockAuditMockRecorder {
+	return m.recorder
+}
+
+// FindBySpec mocks base method.
+func (m *MockAudit) FindBySpec(spec *repository.AuditSpec) ([]entity.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySpec", spec)
+	ret0, _ := ret[0].([]entity.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySpec indicates an expected call of FindBySpec.
+func (mr *MockAuditMockRecorder) FindBySpec(spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySpec", reflect.TypeOf((*MockAudit)(nil).FindBySpec), spec)
+}
+
+// NextID mocks base method.
+func (m *MockAudit) NextID() *entity.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextID")
+	ret0, _ := ret[0].(*entity.ID)
+	return ret0
+}
+
+// NextID indicates an expected call of NextID.
+func (mr *MockAuditMockRecorder) NextID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockAudit)(nil).NextID))
+}
+
+// Save mocks base method.
+func (m *MockAudit) Save(entry *entity.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAuditMockRecorder) Save(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAudit)(nil).Save), entry)
+}
@@ -6,9 +6,11 @@ package mock_repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+	repository "github.com/kkntzw/bookmark/internal/domain/repository"
 )
 
 // MockBookmark is a mock of Bookmark interface.
@@ -34,48 +36,182 @@ func (m *MockBookmark) EXPECT() *MockBookmarkMockRecorder {
 	return m.recorder
 }
 
+// CountTags mocks base method.
+func (m *MockBookmark) CountTags(userID *entity.UserID) ([]repository.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTags", userID)
+	ret0, _ := ret[0].([]repository.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTags indicates an expected call of CountTags.
+func (mr *MockBookmarkMockRecorder) CountTags(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTags", reflect.TypeOf((*MockBookmark)(nil).CountTags), userID)
+}
+
 // Delete mocks base method.
-func (m *MockBookmark) Delete(bookmark *entity.Bookmark) error {
+func (m *MockBookmark) Delete(bookmark *entity.Bookmark, actor string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", bookmark)
+	ret := m.ctrl.Call(m, "Delete", bookmark, actor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockBookmarkMockRecorder) Delete(bookmark interface{}) *gomock.Call {
+func (mr *MockBookmarkMockRecorder) Delete(bookmark, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookmark)(nil).Delete), bookmark, actor)
+}
+
+// ExistsInFolder mocks base method.
+func (m *MockBookmark) ExistsInFolder(userID *entity.UserID, folder *entity.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsInFolder", userID, folder)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsInFolder indicates an expected call of ExistsInFolder.
+func (mr *MockBookmarkMockRecorder) ExistsInFolder(userID, folder interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookmark)(nil).Delete), bookmark)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsInFolder", reflect.TypeOf((*MockBookmark)(nil).ExistsInFolder), userID, folder)
 }
 
 // FindAll mocks base method.
-func (m *MockBookmark) FindAll() ([]entity.Bookmark, error) {
+func (m *MockBookmark) FindAll(userID *entity.UserID) ([]entity.Bookmark, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll")
+	ret := m.ctrl.Call(m, "FindAll", userID)
 	ret0, _ := ret[0].([]entity.Bookmark)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockBookmarkMockRecorder) FindAll() *gomock.Call {
+func (mr *MockBookmarkMockRecorder) FindAll(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBookmark)(nil).FindAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBookmark)(nil).FindAll), userID)
+}
+
+// FindByCanonicalURI mocks base method.
+func (m *MockBookmark) FindByCanonicalURI(userID *entity.UserID, uri *entity.URI) (*entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCanonicalURI", userID, uri)
+	ret0, _ := ret[0].(*entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCanonicalURI indicates an expected call of FindByCanonicalURI.
+func (mr *MockBookmarkMockRecorder) FindByCanonicalURI(userID, uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCanonicalURI", reflect.TypeOf((*MockBookmark)(nil).FindByCanonicalURI), userID, uri)
 }
 
 // FindByID mocks base method.
-func (m *MockBookmark) FindByID(id *entity.ID) (*entity.Bookmark, error) {
+func (m *MockBookmark) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", id)
+	ret := m.ctrl.Call(m, "FindByID", userID, id)
 	ret0, _ := ret[0].(*entity.Bookmark)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockBookmarkMockRecorder) FindByID(id interface{}) *gomock.Call {
+func (mr *MockBookmarkMockRecorder) FindByID(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBookmark)(nil).FindByID), userID, id)
+}
+
+// FindBySpec mocks base method.
+func (m *MockBookmark) FindBySpec(userID *entity.UserID, spec *repository.BookmarkSpec) ([]entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySpec", userID, spec)
+	ret0, _ := ret[0].([]entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySpec indicates an expected call of FindBySpec.
+func (mr *MockBookmarkMockRecorder) FindBySpec(userID, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySpec", reflect.TypeOf((*MockBookmark)(nil).FindBySpec), userID, spec)
+}
+
+// FindDuplicates mocks base method.
+func (m *MockBookmark) FindDuplicates(userID *entity.UserID) ([]repository.Duplicate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicates", userID)
+	ret0, _ := ret[0].([]repository.Duplicate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockBookmarkMockRecorder) FindDuplicates(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockBookmark)(nil).FindDuplicates), userID)
+}
+
+// FindTrash mocks base method.
+func (m *MockBookmark) FindTrash(userID *entity.UserID) ([]entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrash", userID)
+	ret0, _ := ret[0].([]entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrash indicates an expected call of FindTrash.
+func (mr *MockBookmarkMockRecorder) FindTrash(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBookmark)(nil).FindByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrash", reflect.TypeOf((*MockBookmark)(nil).FindTrash), userID)
+}
+
+// FindTrashByID mocks base method.
+func (m *MockBookmark) FindTrashByID(userID *entity.UserID, id *entity.ID) (*entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrashByID", userID, id)
+	ret0, _ := ret[0].(*entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrashByID indicates an expected call of FindTrashByID.
+func (mr *MockBookmarkMockRecorder) FindTrashByID(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrashByID", reflect.TypeOf((*MockBookmark)(nil).FindTrashByID), userID, id)
+}
+
+// MergeBookmarks mocks base method.
+func (m *MockBookmark) MergeBookmarks(target *entity.Bookmark, sources []entity.Bookmark, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeBookmarks", target, sources, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeBookmarks indicates an expected call of MergeBookmarks.
+func (mr *MockBookmarkMockRecorder) MergeBookmarks(target, sources, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeBookmarks", reflect.TypeOf((*MockBookmark)(nil).MergeBookmarks), target, sources, actor)
+}
+
+// MergeTags mocks base method.
+func (m *MockBookmark) MergeTags(userID *entity.UserID, sources []entity.Tag, target *entity.Tag, actor string) ([]entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", userID, sources, target, actor)
+	ret0, _ := ret[0].([]entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBookmarkMockRecorder) MergeTags(userID, sources, target, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBookmark)(nil).MergeTags), userID, sources, target, actor)
 }
 
 // NextID mocks base method.
@@ -92,16 +228,59 @@ func (mr *MockBookmarkMockRecorder) NextID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockBookmark)(nil).NextID))
 }
 
+// PurgeTrash mocks base method.
+func (m *MockBookmark) PurgeTrash(userID *entity.UserID, before time.Time, actor string) ([]entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", userID, before, actor)
+	ret0, _ := ret[0].([]entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockBookmarkMockRecorder) PurgeTrash(userID, before, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockBookmark)(nil).PurgeTrash), userID, before, actor)
+}
+
+// Restore mocks base method.
+func (m *MockBookmark) Restore(bookmark *entity.Bookmark, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", bookmark, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockBookmarkMockRecorder) Restore(bookmark, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockBookmark)(nil).Restore), bookmark, actor)
+}
+
 // Save mocks base method.
-func (m *MockBookmark) Save(bookmark *entity.Bookmark) error {
+func (m *MockBookmark) Save(bookmark *entity.Bookmark, actor string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", bookmark)
+	ret := m.ctrl.Call(m, "Save", bookmark, actor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockBookmarkMockRecorder) Save(bookmark interface{}) *gomock.Call {
+func (mr *MockBookmarkMockRecorder) Save(bookmark, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBookmark)(nil).Save), bookmark, actor)
+}
+
+// Trash mocks base method.
+func (m *MockBookmark) Trash(bookmark *entity.Bookmark, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", bookmark, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockBookmarkMockRecorder) Trash(bookmark, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBookmark)(nil).Save), bookmark)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockBookmark)(nil).Trash), bookmark, actor)
 }
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/dead_letter.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// MockDeadLetter is a mock of DeadLetter interface.
+type MockDeadLetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterMockRecorder
+}
+
+// MockDeadLetterMockRecorder is the mock recorder for MockDeadLetter.
+type MockDeadLetterMockRecorder struct {
+	mock *MockDeadLetter
+}
+
+// NewMockDeadLetter creates a new mock instance.
+func NewMockDeadLetter(ctrl *gomock.Controller) *MockDeadLetter {
+	mock := &MockDeadLetter{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetter) EXPECT() *MockDeadLetterMockRecorder {
+	return m.recorder
+}
+
+// FindByWebhookID mocks base method.
+func (m *MockDeadLetter) FindByWebhookID(userID *entity.UserID, webhookID *entity.ID) ([]entity.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByWebhookID", userID, webhookID)
+	ret0, _ := ret[0].([]entity.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByWebhookID indicates an expected call of FindByWebhookID.
+func (mr *MockDeadLetterMockRecorder) FindByWebhookID(userID, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByWebhookID", reflect.TypeOf((*MockDeadLetter)(nil).FindByWebhookID), userID, webhookID)
+}
+
+// Save mocks base method.
+func (m *MockDeadLetter) Save(deadLetter *entity.DeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", deadLetter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDeadLetterMockRecorder) Save(deadLetter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDeadLetter)(nil).Save), deadLetter)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/delivery.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// MockDelivery is a mock of Delivery interface.
+type MockDelivery struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryMockRecorder
+}
+
+// MockDeliveryMockRecorder is the mock recorder for MockDelivery.
+type MockDeliveryMockRecorder struct {
+	mock *MockDelivery
+}
+
+// NewMockDelivery creates a new mock instance.
+func NewMockDelivery(ctrl *gomock.Controller) *MockDelivery {
+	mock := &MockDelivery{ctrl: ctrl}
+	mock.recorder = &MockDeliveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDelivery) EXPECT() *MockDeliveryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockDelivery) Claim(now, until time.Time) (*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", now, until)
+	ret0, _ := ret[0].(*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockDeliveryMockRecorder) Claim(now, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockDelivery)(nil).Claim), now, until)
+}
+
+// Delete mocks base method.
+func (m *MockDelivery) Delete(delivery *entity.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeliveryMockRecorder) Delete(delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDelivery)(nil).Delete), delivery)
+}
+
+// Save mocks base method.
+func (m *MockDelivery) Save(deliveries []entity.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDeliveryMockRecorder) Save(deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDelivery)(nil).Save), deliveries)
+}
+
+// Update mocks base method.
+func (m *MockDelivery) Update(delivery *entity.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDeliveryMockRecorder) Update(delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDelivery)(nil).Update), delivery)
+}
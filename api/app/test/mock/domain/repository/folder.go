@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/folder.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// MockFolder is a mock of Folder interface.
+type MockFolder struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderMockRecorder
+}
+
+// MockFolderMockRecorder is the mock recorder for MockFolder.
+type MockFolderMockRecorder struct {
+	mock *MockFolder
+}
+
+// NewMockFolder creates a new mock instance.
+func NewMockFolder(ctrl *gomock.Controller) *MockFolder {
+	mock := &MockFolder{ctrl: ctrl}
+	mock.recorder = &MockFolderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolder) EXPECT() *MockFolderMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFolder) Delete(folder *entity.Folder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", folder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFolderMockRecorder) Delete(folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFolder)(nil).Delete), folder)
+}
+
+// FindByID mocks base method.
+func (m *MockFolder) FindByID(userID *entity.UserID, id *entity.ID) (*entity.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", userID, id)
+	ret0, _ := ret[0].(*entity.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockFolderMockRecorder) FindByID(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockFolder)(nil).FindByID), userID, id)
+}
+
+// FindByParent mocks base method.
+func (m *MockFolder) FindByParent(userID *entity.UserID, parent *entity.ID) ([]entity.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByParent", userID, parent)
+	ret0, _ := ret[0].([]entity.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByParent indicates an expected call of FindByParent.
+func (mr *MockFolderMockRecorder) FindByParent(userID, parent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByParent", reflect.TypeOf((*MockFolder)(nil).FindByParent), userID, parent)
+}
+
+// NextID mocks base method.
+func (m *MockFolder) NextID() *entity.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextID")
+	ret0, _ := ret[0].(*entity.ID)
+	return ret0
+}
+
+// NextID indicates an expected call of NextID.
+func (mr *MockFolderMockRecorder) NextID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockFolder)(nil).NextID))
+}
+
+// Save mocks base method.
+func (m *MockFolder) Save(folder *entity.Folder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", folder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockFolderMockRecorder) Save(folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockFolder)(nil).Save), folder)
+}
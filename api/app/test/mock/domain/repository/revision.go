@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/revision.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// MockRevision is a mock of Revision interface.
+type MockRevision struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionMockRecorder
+}
+
+// MockRevisionMockRecorder is the mock recorder for MockRevision.
+type MockRevisionMockRecorder struct {
+	mock *MockRevision
+}
+
+// NewMockRevision creates a new mock instance.
+func NewMockRevision(ctrl *gomock.Controller) *MockRevision {
+	mock := &MockRevision{ctrl: ctrl}
+	mock.recorder = &MockRevisionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevision) EXPECT() *MockRevisionMockRecorder {
+	return m.recorder
+}
+
+// FindByBookmarkID mocks base method.
+func (m *MockRevision) FindByBookmarkID(bookmarkID *entity.ID) ([]entity.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBookmarkID", bookmarkID)
+	ret0, _ := ret[0].([]entity.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBookmarkID indicates an expected call of FindByBookmarkID.
+func (mr *MockRevisionMockRecorder) FindByBookmarkID(bookmarkID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBookmarkID", reflect.TypeOf((*MockRevision)(nil).FindByBookmarkID), bookmarkID)
+}
+
+// FindByID mocks base method.
+func (m *MockRevision) FindByID(id *entity.ID) (*entity.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", id)
+	ret0, _ := ret[0].(*entity.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRevisionMockRecorder) FindByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRevision)(nil).FindByID), id)
+}
+
+// NextID mocks base method.
+func (m *MockRevision) NextID() *entity.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextID")
+	ret0, _ := ret[0].(*entity.ID)
+	return ret0
+}
+
+// NextID indicates an expected call of NextID.
+func (mr *MockRevisionMockRecorder) NextID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockRevision)(nil).NextID))
+}
+
+// Save mocks base method.
+func (m *MockRevision) Save(revision *entity.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRevisionMockRecorder) Save(revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRevision)(nil).Save), revision)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/share.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// MockShare is a mock of Share interface.
+type MockShare struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareMockRecorder
+}
+
+// MockShareMockRecorder is the mock recorder for MockShare.
+type MockShareMockRecorder struct {
+	mock *MockShare
+}
+
+// NewMockShare creates a new mock instance.
+func NewMockShare(ctrl *gomock.Controller) *MockShare {
+	mock := &MockShare{ctrl: ctrl}
+	mock.recorder = &MockShareMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShare) EXPECT() *MockShareMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockShare) Delete(share *entity.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockShareMockRecorder) Delete(share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockShare)(nil).Delete), share)
+}
+
+// FindByGrantee mocks base method.
+func (m *MockShare) FindByGrantee(grantee *entity.UserID) ([]entity.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByGrantee", grantee)
+	ret0, _ := ret[0].([]entity.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByGrantee indicates an expected call of FindByGrantee.
+func (mr *MockShareMockRecorder) FindByGrantee(grantee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByGrantee", reflect.TypeOf((*MockShare)(nil).FindByGrantee), grantee)
+}
+
+// FindByID mocks base method.
+func (m *MockShare) FindByID(id *entity.ID) (*entity.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", id)
+	ret0, _ := ret[0].(*entity.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockShareMockRecorder) FindByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockShare)(nil).FindByID), id)
+}
+
+// FindByOwner mocks base method.
+func (m *MockShare) FindByOwner(owner *entity.UserID) ([]entity.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", owner)
+	ret0, _ := ret[0].([]entity.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockShareMockRecorder) FindByOwner(owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockShare)(nil).FindByOwner), owner)
+}
+
+// NextID mocks base method.
+func (m *MockShare) NextID() *entity.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextID")
+	ret0, _ := ret[0].(*entity.ID)
+	return ret0
+}
+
+// NextID indicates an expected call of NextID.
+func (mr *MockShareMockRecorder) NextID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextID", reflect.TypeOf((*MockShare)(nil).NextID))
+}
+
+// Save mocks base method.
+func (m *MockShare) Save(share *entity.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockShareMockRecorder) Save(share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockShare)(nil).Save), share)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/repository/watcher.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+	repository "github.com/kkntzw/bookmark/internal/domain/repository"
+)
+
+// MockBookmarkWatcher is a mock of BookmarkWatcher interface.
+type MockBookmarkWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkWatcherMockRecorder
+}
+
+// MockBookmarkWatcherMockRecorder is the mock recorder for MockBookmarkWatcher.
+type MockBookmarkWatcherMockRecorder struct {
+	mock *MockBookmarkWatcher
+}
+
+// NewMockBookmarkWatcher creates a new mock instance.
+func NewMockBookmarkWatcher(ctrl *gomock.Controller) *MockBookmarkWatcher {
+	mock := &MockBookmarkWatcher{ctrl: ctrl}
+	mock.recorder = &MockBookmarkWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkWatcher) EXPECT() *MockBookmarkWatcherMockRecorder {
+	return m.recorder
+}
+
+// Watch mocks base method.
+func (m *MockBookmarkWatcher) Watch(ctx context.Context, userID *entity.UserID, resumeToken string, handler func(repository.BookmarkChange) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, userID, resumeToken, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockBookmarkWatcherMockRecorder) Watch(ctx, userID, resumeToken, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockBookmarkWatcher)(nil).Watch), ctx, userID, resumeToken, handler)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/service/folder.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/kkntzw/bookmark/internal/domain/entity"
+)
+
+// MockFolder is a mock of Folder interface.
+type MockFolder struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderMockRecorder
+}
+
+// MockFolderMockRecorder is the mock recorder for MockFolder.
+type MockFolderMockRecorder struct {
+	mock *MockFolder
+}
+
+// NewMockFolder creates a new mock instance.
+func NewMockFolder(ctrl *gomock.Controller) *MockFolder {
+	mock := &MockFolder{ctrl: ctrl}
+	mock.recorder = &MockFolderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolder) EXPECT() *MockFolderMockRecorder {
+	return m.recorder
+}
+
+// CreatesCycle mocks base method.
+func (m *MockFolder) CreatesCycle(folder *entity.Folder, parent *entity.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatesCycle", folder, parent)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatesCycle indicates an expected call of CreatesCycle.
+func (mr *MockFolderMockRecorder) CreatesCycle(folder, parent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatesCycle", reflect.TypeOf((*MockFolder)(nil).CreatesCycle), folder, parent)
+}
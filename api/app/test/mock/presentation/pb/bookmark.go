@@ -38,15 +38,55 @@ func (m *MockBookmarkerClient) EXPECT() *MockBookmarkerClientMockRecorder {
 	return m.recorder
 }
 
+// AddTags mocks base method.
+func (m *MockBookmarkerClient) AddTags(ctx context.Context, in *pb.AddTagsRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTags", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockBookmarkerClientMockRecorder) AddTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockBookmarkerClient)(nil).AddTags), varargs...)
+}
+
+// Archive mocks base method.
+func (m *MockBookmarkerClient) Archive(ctx context.Context, in *pb.ArchiveRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Archive", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockBookmarkerClientMockRecorder) Archive(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockBookmarkerClient)(nil).Archive), varargs...)
+}
+
 // CreateBookmark mocks base method.
-func (m *MockBookmarkerClient) CreateBookmark(ctx context.Context, in *pb.CreateBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (m *MockBookmarkerClient) CreateBookmark(ctx context.Context, in *pb.CreateBookmarkRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateBookmark", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*pb.Bookmark)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -71,78 +111,3706 @@ func (m *MockBookmarkerClient) DeleteBookmark(ctx context.Context, in *pb.Delete
 	return ret0, ret1
 }
 
-// DeleteBookmark indicates an expected call of DeleteBookmark.
-func (mr *MockBookmarkerClientMockRecorder) DeleteBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// DeleteBookmark indicates an expected call of DeleteBookmark.
+func (mr *MockBookmarkerClientMockRecorder) DeleteBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).DeleteBookmark), varargs...)
+}
+
+// FindDuplicates mocks base method.
+func (m *MockBookmarkerClient) FindDuplicates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (pb.Bookmarker_FindDuplicatesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindDuplicates", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_FindDuplicatesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockBookmarkerClientMockRecorder) FindDuplicates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockBookmarkerClient)(nil).FindDuplicates), varargs...)
+}
+
+// GetBookmark mocks base method.
+func (m *MockBookmarkerClient) GetBookmark(ctx context.Context, in *pb.GetBookmarkRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBookmark", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmark indicates an expected call of GetBookmark.
+func (mr *MockBookmarkerClientMockRecorder) GetBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).GetBookmark), varargs...)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockBookmarkerClient) ListAuditEntries(ctx context.Context, in *pb.ListAuditEntriesRequest, opts ...grpc.CallOption) (pb.Bookmarker_ListAuditEntriesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditEntries", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_ListAuditEntriesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockBookmarkerClientMockRecorder) ListAuditEntries(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockBookmarkerClient)(nil).ListAuditEntries), varargs...)
+}
+
+// ListBookmarkRevisions mocks base method.
+func (m *MockBookmarkerClient) ListBookmarkRevisions(ctx context.Context, in *pb.ListBookmarkRevisionsRequest, opts ...grpc.CallOption) (pb.Bookmarker_ListBookmarkRevisionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBookmarkRevisions", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_ListBookmarkRevisionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookmarkRevisions indicates an expected call of ListBookmarkRevisions.
+func (mr *MockBookmarkerClientMockRecorder) ListBookmarkRevisions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarkRevisions", reflect.TypeOf((*MockBookmarkerClient)(nil).ListBookmarkRevisions), varargs...)
+}
+
+// ListBookmarks mocks base method.
+func (m *MockBookmarkerClient) ListBookmarks(ctx context.Context, in *pb.ListBookmarksRequest, opts ...grpc.CallOption) (pb.Bookmarker_ListBookmarksClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBookmarks", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_ListBookmarksClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookmarks indicates an expected call of ListBookmarks.
+func (mr *MockBookmarkerClientMockRecorder) ListBookmarks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockBookmarkerClient)(nil).ListBookmarks), varargs...)
+}
+
+// ListSharedWithMe mocks base method.
+func (m *MockBookmarkerClient) ListSharedWithMe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (pb.Bookmarker_ListSharedWithMeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedWithMe", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_ListSharedWithMeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedWithMe indicates an expected call of ListSharedWithMe.
+func (mr *MockBookmarkerClientMockRecorder) ListSharedWithMe(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedWithMe", reflect.TypeOf((*MockBookmarkerClient)(nil).ListSharedWithMe), varargs...)
+}
+
+// ListTags mocks base method.
+func (m *MockBookmarkerClient) ListTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (pb.Bookmarker_ListTagsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTags", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_ListTagsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockBookmarkerClientMockRecorder) ListTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockBookmarkerClient)(nil).ListTags), varargs...)
+}
+
+// ListTrash mocks base method.
+func (m *MockBookmarkerClient) ListTrash(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (pb.Bookmarker_ListTrashClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrash", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_ListTrashClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockBookmarkerClientMockRecorder) ListTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockBookmarkerClient)(nil).ListTrash), varargs...)
+}
+
+// MarkRead mocks base method.
+func (m *MockBookmarkerClient) MarkRead(ctx context.Context, in *pb.MarkReadRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkRead", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockBookmarkerClientMockRecorder) MarkRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockBookmarkerClient)(nil).MarkRead), varargs...)
+}
+
+// MarkUnread mocks base method.
+func (m *MockBookmarkerClient) MarkUnread(ctx context.Context, in *pb.MarkUnreadRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkUnread", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUnread indicates an expected call of MarkUnread.
+func (mr *MockBookmarkerClientMockRecorder) MarkUnread(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUnread", reflect.TypeOf((*MockBookmarkerClient)(nil).MarkUnread), varargs...)
+}
+
+// MergeBookmarks mocks base method.
+func (m *MockBookmarkerClient) MergeBookmarks(ctx context.Context, in *pb.MergeBookmarksRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeBookmarks", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeBookmarks indicates an expected call of MergeBookmarks.
+func (mr *MockBookmarkerClientMockRecorder) MergeBookmarks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeBookmarks", reflect.TypeOf((*MockBookmarkerClient)(nil).MergeBookmarks), varargs...)
+}
+
+// MergeTags mocks base method.
+func (m *MockBookmarkerClient) MergeTags(ctx context.Context, in *pb.MergeTagsRequest, opts ...grpc.CallOption) (*pb.MergeTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeTags", varargs...)
+	ret0, _ := ret[0].(*pb.MergeTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBookmarkerClientMockRecorder) MergeTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBookmarkerClient)(nil).MergeTags), varargs...)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockBookmarkerClient) PurgeTrash(ctx context.Context, in *pb.PurgeTrashRequest, opts ...grpc.CallOption) (*pb.PurgeTrashResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeTrash", varargs...)
+	ret0, _ := ret[0].(*pb.PurgeTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockBookmarkerClientMockRecorder) PurgeTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockBookmarkerClient)(nil).PurgeTrash), varargs...)
+}
+
+// RemoveTags mocks base method.
+func (m *MockBookmarkerClient) RemoveTags(ctx context.Context, in *pb.RemoveTagsRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTags", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockBookmarkerClientMockRecorder) RemoveTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockBookmarkerClient)(nil).RemoveTags), varargs...)
+}
+
+// RenameTag mocks base method.
+func (m *MockBookmarkerClient) RenameTag(ctx context.Context, in *pb.RenameTagRequest, opts ...grpc.CallOption) (*pb.RenameTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameTag", varargs...)
+	ret0, _ := ret[0].(*pb.RenameTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockBookmarkerClientMockRecorder) RenameTag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockBookmarkerClient)(nil).RenameTag), varargs...)
+}
+
+// RestoreBookmark mocks base method.
+func (m *MockBookmarkerClient) RestoreBookmark(ctx context.Context, in *pb.RestoreBookmarkRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreBookmark", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBookmark indicates an expected call of RestoreBookmark.
+func (mr *MockBookmarkerClientMockRecorder) RestoreBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).RestoreBookmark), varargs...)
+}
+
+// RevertBookmark mocks base method.
+func (m *MockBookmarkerClient) RevertBookmark(ctx context.Context, in *pb.RevertBookmarkRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevertBookmark", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertBookmark indicates an expected call of RevertBookmark.
+func (mr *MockBookmarkerClientMockRecorder) RevertBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).RevertBookmark), varargs...)
+}
+
+// RevokeShare mocks base method.
+func (m *MockBookmarkerClient) RevokeShare(ctx context.Context, in *pb.RevokeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeShare", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockBookmarkerClientMockRecorder) RevokeShare(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockBookmarkerClient)(nil).RevokeShare), varargs...)
+}
+
+// ShareBookmark mocks base method.
+func (m *MockBookmarkerClient) ShareBookmark(ctx context.Context, in *pb.ShareBookmarkRequest, opts ...grpc.CallOption) (*pb.Share, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShareBookmark", varargs...)
+	ret0, _ := ret[0].(*pb.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareBookmark indicates an expected call of ShareBookmark.
+func (mr *MockBookmarkerClientMockRecorder) ShareBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).ShareBookmark), varargs...)
+}
+
+// Star mocks base method.
+func (m *MockBookmarkerClient) Star(ctx context.Context, in *pb.StarRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Star", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Star indicates an expected call of Star.
+func (mr *MockBookmarkerClientMockRecorder) Star(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Star", reflect.TypeOf((*MockBookmarkerClient)(nil).Star), varargs...)
+}
+
+// Unstar mocks base method.
+func (m *MockBookmarkerClient) Unstar(ctx context.Context, in *pb.UnstarRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unstar", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unstar indicates an expected call of Unstar.
+func (mr *MockBookmarkerClientMockRecorder) Unstar(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unstar", reflect.TypeOf((*MockBookmarkerClient)(nil).Unstar), varargs...)
+}
+
+// UpdateBookmark mocks base method.
+func (m *MockBookmarkerClient) UpdateBookmark(ctx context.Context, in *pb.UpdateBookmarkRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBookmark", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBookmark indicates an expected call of UpdateBookmark.
+func (mr *MockBookmarkerClientMockRecorder) UpdateBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).UpdateBookmark), varargs...)
+}
+
+// WatchBookmarks mocks base method.
+func (m *MockBookmarkerClient) WatchBookmarks(ctx context.Context, in *pb.WatchBookmarksRequest, opts ...grpc.CallOption) (pb.Bookmarker_WatchBookmarksClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchBookmarks", varargs...)
+	ret0, _ := ret[0].(pb.Bookmarker_WatchBookmarksClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchBookmarks indicates an expected call of WatchBookmarks.
+func (mr *MockBookmarkerClientMockRecorder) WatchBookmarks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchBookmarks", reflect.TypeOf((*MockBookmarkerClient)(nil).WatchBookmarks), varargs...)
+}
+
+// MockBookmarker_ListBookmarksClient is a mock of Bookmarker_ListBookmarksClient interface.
+type MockBookmarker_ListBookmarksClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListBookmarksClientMockRecorder
+}
+
+// MockBookmarker_ListBookmarksClientMockRecorder is the mock recorder for MockBookmarker_ListBookmarksClient.
+type MockBookmarker_ListBookmarksClientMockRecorder struct {
+	mock *MockBookmarker_ListBookmarksClient
+}
+
+// NewMockBookmarker_ListBookmarksClient creates a new mock instance.
+func NewMockBookmarker_ListBookmarksClient(ctrl *gomock.Controller) *MockBookmarker_ListBookmarksClient {
+	mock := &MockBookmarker_ListBookmarksClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListBookmarksClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListBookmarksClient) EXPECT() *MockBookmarker_ListBookmarksClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_ListBookmarksClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListBookmarksClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_ListBookmarksClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_ListBookmarksClient) Recv() (*pb.ListBookmarksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.ListBookmarksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarksClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarksClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_ListBookmarksClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Trailer))
+}
+
+// MockBookmarker_WatchBookmarksClient is a mock of Bookmarker_WatchBookmarksClient interface.
+type MockBookmarker_WatchBookmarksClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_WatchBookmarksClientMockRecorder
+}
+
+// MockBookmarker_WatchBookmarksClientMockRecorder is the mock recorder for MockBookmarker_WatchBookmarksClient.
+type MockBookmarker_WatchBookmarksClientMockRecorder struct {
+	mock *MockBookmarker_WatchBookmarksClient
+}
+
+// NewMockBookmarker_WatchBookmarksClient creates a new mock instance.
+func NewMockBookmarker_WatchBookmarksClient(ctrl *gomock.Controller) *MockBookmarker_WatchBookmarksClient {
+	mock := &MockBookmarker_WatchBookmarksClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_WatchBookmarksClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_WatchBookmarksClient) EXPECT() *MockBookmarker_WatchBookmarksClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_WatchBookmarksClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_WatchBookmarksClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_WatchBookmarksClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_WatchBookmarksClient) Recv() (*pb.BookmarkChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.BookmarkChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_WatchBookmarksClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_WatchBookmarksClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_WatchBookmarksClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_WatchBookmarksClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_WatchBookmarksClient)(nil).Trailer))
+}
+
+// MockBookmarker_ListTrashClient is a mock of Bookmarker_ListTrashClient interface.
+type MockBookmarker_ListTrashClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListTrashClientMockRecorder
+}
+
+// MockBookmarker_ListTrashClientMockRecorder is the mock recorder for MockBookmarker_ListTrashClient.
+type MockBookmarker_ListTrashClientMockRecorder struct {
+	mock *MockBookmarker_ListTrashClient
+}
+
+// NewMockBookmarker_ListTrashClient creates a new mock instance.
+func NewMockBookmarker_ListTrashClient(ctrl *gomock.Controller) *MockBookmarker_ListTrashClient {
+	mock := &MockBookmarker_ListTrashClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListTrashClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListTrashClient) EXPECT() *MockBookmarker_ListTrashClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_ListTrashClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListTrashClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_ListTrashClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_ListTrashClient) Recv() (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListTrashClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListTrashClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_ListTrashClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_ListTrashClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListTrashClient)(nil).Trailer))
+}
+
+// MockBookmarker_ListBookmarkRevisionsClient is a mock of Bookmarker_ListBookmarkRevisionsClient interface.
+type MockBookmarker_ListBookmarkRevisionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListBookmarkRevisionsClientMockRecorder
+}
+
+// MockBookmarker_ListBookmarkRevisionsClientMockRecorder is the mock recorder for MockBookmarker_ListBookmarkRevisionsClient.
+type MockBookmarker_ListBookmarkRevisionsClientMockRecorder struct {
+	mock *MockBookmarker_ListBookmarkRevisionsClient
+}
+
+// NewMockBookmarker_ListBookmarkRevisionsClient creates a new mock instance.
+func NewMockBookmarker_ListBookmarkRevisionsClient(ctrl *gomock.Controller) *MockBookmarker_ListBookmarkRevisionsClient {
+	mock := &MockBookmarker_ListBookmarkRevisionsClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListBookmarkRevisionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListBookmarkRevisionsClient) EXPECT() *MockBookmarker_ListBookmarkRevisionsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsClient) Recv() (*pb.BookmarkRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.BookmarkRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarkRevisionsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarkRevisionsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_ListBookmarkRevisionsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsClient)(nil).Trailer))
+}
+
+// MockBookmarker_ListTagsClient is a mock of Bookmarker_ListTagsClient interface.
+type MockBookmarker_ListTagsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListTagsClientMockRecorder
+}
+
+// MockBookmarker_ListTagsClientMockRecorder is the mock recorder for MockBookmarker_ListTagsClient.
+type MockBookmarker_ListTagsClientMockRecorder struct {
+	mock *MockBookmarker_ListTagsClient
+}
+
+// NewMockBookmarker_ListTagsClient creates a new mock instance.
+func NewMockBookmarker_ListTagsClient(ctrl *gomock.Controller) *MockBookmarker_ListTagsClient {
+	mock := &MockBookmarker_ListTagsClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListTagsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListTagsClient) EXPECT() *MockBookmarker_ListTagsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_ListTagsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListTagsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_ListTagsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_ListTagsClient) Recv() (*pb.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListTagsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListTagsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_ListTagsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_ListTagsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListTagsClient)(nil).Trailer))
+}
+
+// MockBookmarker_FindDuplicatesClient is a mock of Bookmarker_FindDuplicatesClient interface.
+type MockBookmarker_FindDuplicatesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_FindDuplicatesClientMockRecorder
+}
+
+// MockBookmarker_FindDuplicatesClientMockRecorder is the mock recorder for MockBookmarker_FindDuplicatesClient.
+type MockBookmarker_FindDuplicatesClientMockRecorder struct {
+	mock *MockBookmarker_FindDuplicatesClient
+}
+
+// NewMockBookmarker_FindDuplicatesClient creates a new mock instance.
+func NewMockBookmarker_FindDuplicatesClient(ctrl *gomock.Controller) *MockBookmarker_FindDuplicatesClient {
+	mock := &MockBookmarker_FindDuplicatesClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_FindDuplicatesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_FindDuplicatesClient) EXPECT() *MockBookmarker_FindDuplicatesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_FindDuplicatesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_FindDuplicatesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_FindDuplicatesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_FindDuplicatesClient) Recv() (*pb.Duplicate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.Duplicate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_FindDuplicatesClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_FindDuplicatesClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_FindDuplicatesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_FindDuplicatesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_FindDuplicatesClient)(nil).Trailer))
+}
+
+// MockBookmarker_ListAuditEntriesClient is a mock of Bookmarker_ListAuditEntriesClient interface.
+type MockBookmarker_ListAuditEntriesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListAuditEntriesClientMockRecorder
+}
+
+// MockBookmarker_ListAuditEntriesClientMockRecorder is the mock recorder for MockBookmarker_ListAuditEntriesClient.
+type MockBookmarker_ListAuditEntriesClientMockRecorder struct {
+	mock *MockBookmarker_ListAuditEntriesClient
+}
+
+// NewMockBookmarker_ListAuditEntriesClient creates a new mock instance.
+func NewMockBookmarker_ListAuditEntriesClient(ctrl *gomock.Controller) *MockBookmarker_ListAuditEntriesClient {
+	mock := &MockBookmarker_ListAuditEntriesClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListAuditEntriesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListAuditEntriesClient) EXPECT() *MockBookmarker_ListAuditEntriesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_ListAuditEntriesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListAuditEntriesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_ListAuditEntriesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_ListAuditEntriesClient) Recv() (*pb.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListAuditEntriesClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListAuditEntriesClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_ListAuditEntriesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_ListAuditEntriesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListAuditEntriesClient)(nil).Trailer))
+}
+
+// MockBookmarker_ListSharedWithMeClient is a mock of Bookmarker_ListSharedWithMeClient interface.
+type MockBookmarker_ListSharedWithMeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListSharedWithMeClientMockRecorder
+}
+
+// MockBookmarker_ListSharedWithMeClientMockRecorder is the mock recorder for MockBookmarker_ListSharedWithMeClient.
+type MockBookmarker_ListSharedWithMeClientMockRecorder struct {
+	mock *MockBookmarker_ListSharedWithMeClient
+}
+
+// NewMockBookmarker_ListSharedWithMeClient creates a new mock instance.
+func NewMockBookmarker_ListSharedWithMeClient(ctrl *gomock.Controller) *MockBookmarker_ListSharedWithMeClient {
+	mock := &MockBookmarker_ListSharedWithMeClient{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListSharedWithMeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListSharedWithMeClient) EXPECT() *MockBookmarker_ListSharedWithMeClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBookmarker_ListSharedWithMeClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListSharedWithMeClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBookmarker_ListSharedWithMeClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBookmarker_ListSharedWithMeClient) Recv() (*pb.SharedBookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.SharedBookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListSharedWithMeClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListSharedWithMeClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBookmarker_ListSharedWithMeClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBookmarker_ListSharedWithMeClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListSharedWithMeClient)(nil).Trailer))
+}
+
+// MockBookmarkerServer is a mock of BookmarkerServer interface.
+type MockBookmarkerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkerServerMockRecorder
+}
+
+// MockBookmarkerServerMockRecorder is the mock recorder for MockBookmarkerServer.
+type MockBookmarkerServerMockRecorder struct {
+	mock *MockBookmarkerServer
+}
+
+// NewMockBookmarkerServer creates a new mock instance.
+func NewMockBookmarkerServer(ctrl *gomock.Controller) *MockBookmarkerServer {
+	mock := &MockBookmarkerServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarkerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkerServer) EXPECT() *MockBookmarkerServerMockRecorder {
+	return m.recorder
+}
+
+// AddTags mocks base method.
+func (m *MockBookmarkerServer) AddTags(arg0 context.Context, arg1 *pb.AddTagsRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockBookmarkerServerMockRecorder) AddTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockBookmarkerServer)(nil).AddTags), arg0, arg1)
+}
+
+// Archive mocks base method.
+func (m *MockBookmarkerServer) Archive(arg0 context.Context, arg1 *pb.ArchiveRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockBookmarkerServerMockRecorder) Archive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockBookmarkerServer)(nil).Archive), arg0, arg1)
+}
+
+// CreateBookmark mocks base method.
+func (m *MockBookmarkerServer) CreateBookmark(arg0 context.Context, arg1 *pb.CreateBookmarkRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBookmark indicates an expected call of CreateBookmark.
+func (mr *MockBookmarkerServerMockRecorder) CreateBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).CreateBookmark), arg0, arg1)
+}
+
+// DeleteBookmark mocks base method.
+func (m *MockBookmarkerServer) DeleteBookmark(arg0 context.Context, arg1 *pb.DeleteBookmarkRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBookmark indicates an expected call of DeleteBookmark.
+func (mr *MockBookmarkerServerMockRecorder) DeleteBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).DeleteBookmark), arg0, arg1)
+}
+
+// FindDuplicates mocks base method.
+func (m *MockBookmarkerServer) FindDuplicates(arg0 *emptypb.Empty, arg1 pb.Bookmarker_FindDuplicatesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockBookmarkerServerMockRecorder) FindDuplicates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockBookmarkerServer)(nil).FindDuplicates), arg0, arg1)
+}
+
+// GetBookmark mocks base method.
+func (m *MockBookmarkerServer) GetBookmark(arg0 context.Context, arg1 *pb.GetBookmarkRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmark indicates an expected call of GetBookmark.
+func (mr *MockBookmarkerServerMockRecorder) GetBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).GetBookmark), arg0, arg1)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockBookmarkerServer) ListAuditEntries(arg0 *pb.ListAuditEntriesRequest, arg1 pb.Bookmarker_ListAuditEntriesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEntries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockBookmarkerServerMockRecorder) ListAuditEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockBookmarkerServer)(nil).ListAuditEntries), arg0, arg1)
+}
+
+// ListBookmarkRevisions mocks base method.
+func (m *MockBookmarkerServer) ListBookmarkRevisions(arg0 *pb.ListBookmarkRevisionsRequest, arg1 pb.Bookmarker_ListBookmarkRevisionsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarkRevisions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListBookmarkRevisions indicates an expected call of ListBookmarkRevisions.
+func (mr *MockBookmarkerServerMockRecorder) ListBookmarkRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarkRevisions", reflect.TypeOf((*MockBookmarkerServer)(nil).ListBookmarkRevisions), arg0, arg1)
+}
+
+// ListBookmarks mocks base method.
+func (m *MockBookmarkerServer) ListBookmarks(arg0 *pb.ListBookmarksRequest, arg1 pb.Bookmarker_ListBookmarksServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookmarks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListBookmarks indicates an expected call of ListBookmarks.
+func (mr *MockBookmarkerServerMockRecorder) ListBookmarks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockBookmarkerServer)(nil).ListBookmarks), arg0, arg1)
+}
+
+// ListSharedWithMe mocks base method.
+func (m *MockBookmarkerServer) ListSharedWithMe(arg0 *emptypb.Empty, arg1 pb.Bookmarker_ListSharedWithMeServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSharedWithMe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListSharedWithMe indicates an expected call of ListSharedWithMe.
+func (mr *MockBookmarkerServerMockRecorder) ListSharedWithMe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedWithMe", reflect.TypeOf((*MockBookmarkerServer)(nil).ListSharedWithMe), arg0, arg1)
+}
+
+// ListTags mocks base method.
+func (m *MockBookmarkerServer) ListTags(arg0 *emptypb.Empty, arg1 pb.Bookmarker_ListTagsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockBookmarkerServerMockRecorder) ListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockBookmarkerServer)(nil).ListTags), arg0, arg1)
+}
+
+// ListTrash mocks base method.
+func (m *MockBookmarkerServer) ListTrash(arg0 *emptypb.Empty, arg1 pb.Bookmarker_ListTrashServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockBookmarkerServerMockRecorder) ListTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockBookmarkerServer)(nil).ListTrash), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockBookmarkerServer) MarkRead(arg0 context.Context, arg1 *pb.MarkReadRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockBookmarkerServerMockRecorder) MarkRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockBookmarkerServer)(nil).MarkRead), arg0, arg1)
+}
+
+// MarkUnread mocks base method.
+func (m *MockBookmarkerServer) MarkUnread(arg0 context.Context, arg1 *pb.MarkUnreadRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUnread", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUnread indicates an expected call of MarkUnread.
+func (mr *MockBookmarkerServerMockRecorder) MarkUnread(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUnread", reflect.TypeOf((*MockBookmarkerServer)(nil).MarkUnread), arg0, arg1)
+}
+
+// MergeBookmarks mocks base method.
+func (m *MockBookmarkerServer) MergeBookmarks(arg0 context.Context, arg1 *pb.MergeBookmarksRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeBookmarks", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeBookmarks indicates an expected call of MergeBookmarks.
+func (mr *MockBookmarkerServerMockRecorder) MergeBookmarks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeBookmarks", reflect.TypeOf((*MockBookmarkerServer)(nil).MergeBookmarks), arg0, arg1)
+}
+
+// MergeTags mocks base method.
+func (m *MockBookmarkerServer) MergeTags(arg0 context.Context, arg1 *pb.MergeTagsRequest) (*pb.MergeTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", arg0, arg1)
+	ret0, _ := ret[0].(*pb.MergeTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBookmarkerServerMockRecorder) MergeTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBookmarkerServer)(nil).MergeTags), arg0, arg1)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockBookmarkerServer) PurgeTrash(arg0 context.Context, arg1 *pb.PurgeTrashRequest) (*pb.PurgeTrashResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", arg0, arg1)
+	ret0, _ := ret[0].(*pb.PurgeTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockBookmarkerServerMockRecorder) PurgeTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockBookmarkerServer)(nil).PurgeTrash), arg0, arg1)
+}
+
+// RemoveTags mocks base method.
+func (m *MockBookmarkerServer) RemoveTags(arg0 context.Context, arg1 *pb.RemoveTagsRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTags", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockBookmarkerServerMockRecorder) RemoveTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockBookmarkerServer)(nil).RemoveTags), arg0, arg1)
+}
+
+// RenameTag mocks base method.
+func (m *MockBookmarkerServer) RenameTag(arg0 context.Context, arg1 *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0, arg1)
+	ret0, _ := ret[0].(*pb.RenameTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockBookmarkerServerMockRecorder) RenameTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockBookmarkerServer)(nil).RenameTag), arg0, arg1)
+}
+
+// RestoreBookmark mocks base method.
+func (m *MockBookmarkerServer) RestoreBookmark(arg0 context.Context, arg1 *pb.RestoreBookmarkRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBookmark indicates an expected call of RestoreBookmark.
+func (mr *MockBookmarkerServerMockRecorder) RestoreBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).RestoreBookmark), arg0, arg1)
+}
+
+// RevertBookmark mocks base method.
+func (m *MockBookmarkerServer) RevertBookmark(arg0 context.Context, arg1 *pb.RevertBookmarkRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertBookmark indicates an expected call of RevertBookmark.
+func (mr *MockBookmarkerServerMockRecorder) RevertBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).RevertBookmark), arg0, arg1)
+}
+
+// RevokeShare mocks base method.
+func (m *MockBookmarkerServer) RevokeShare(arg0 context.Context, arg1 *pb.RevokeShareRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockBookmarkerServerMockRecorder) RevokeShare(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockBookmarkerServer)(nil).RevokeShare), arg0, arg1)
+}
+
+// ShareBookmark mocks base method.
+func (m *MockBookmarkerServer) ShareBookmark(arg0 context.Context, arg1 *pb.ShareBookmarkRequest) (*pb.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareBookmark indicates an expected call of ShareBookmark.
+func (mr *MockBookmarkerServerMockRecorder) ShareBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).ShareBookmark), arg0, arg1)
+}
+
+// Star mocks base method.
+func (m *MockBookmarkerServer) Star(arg0 context.Context, arg1 *pb.StarRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Star", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Star indicates an expected call of Star.
+func (mr *MockBookmarkerServerMockRecorder) Star(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Star", reflect.TypeOf((*MockBookmarkerServer)(nil).Star), arg0, arg1)
+}
+
+// Unstar mocks base method.
+func (m *MockBookmarkerServer) Unstar(arg0 context.Context, arg1 *pb.UnstarRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unstar", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unstar indicates an expected call of Unstar.
+func (mr *MockBookmarkerServerMockRecorder) Unstar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unstar", reflect.TypeOf((*MockBookmarkerServer)(nil).Unstar), arg0, arg1)
+}
+
+// UpdateBookmark mocks base method.
+func (m *MockBookmarkerServer) UpdateBookmark(arg0 context.Context, arg1 *pb.UpdateBookmarkRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBookmark indicates an expected call of UpdateBookmark.
+func (mr *MockBookmarkerServerMockRecorder) UpdateBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).UpdateBookmark), arg0, arg1)
+}
+
+// WatchBookmarks mocks base method.
+func (m *MockBookmarkerServer) WatchBookmarks(arg0 *pb.WatchBookmarksRequest, arg1 pb.Bookmarker_WatchBookmarksServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchBookmarks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchBookmarks indicates an expected call of WatchBookmarks.
+func (mr *MockBookmarkerServerMockRecorder) WatchBookmarks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchBookmarks", reflect.TypeOf((*MockBookmarkerServer)(nil).WatchBookmarks), arg0, arg1)
+}
+
+// mustEmbedUnimplementedBookmarkerServer mocks base method.
+func (m *MockBookmarkerServer) mustEmbedUnimplementedBookmarkerServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedBookmarkerServer")
+}
+
+// mustEmbedUnimplementedBookmarkerServer indicates an expected call of mustEmbedUnimplementedBookmarkerServer.
+func (mr *MockBookmarkerServerMockRecorder) mustEmbedUnimplementedBookmarkerServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBookmarkerServer", reflect.TypeOf((*MockBookmarkerServer)(nil).mustEmbedUnimplementedBookmarkerServer))
+}
+
+// MockUnsafeBookmarkerServer is a mock of UnsafeBookmarkerServer interface.
+type MockUnsafeBookmarkerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeBookmarkerServerMockRecorder
+}
+
+// MockUnsafeBookmarkerServerMockRecorder is the mock recorder for MockUnsafeBookmarkerServer.
+type MockUnsafeBookmarkerServerMockRecorder struct {
+	mock *MockUnsafeBookmarkerServer
+}
+
+// NewMockUnsafeBookmarkerServer creates a new mock instance.
+func NewMockUnsafeBookmarkerServer(ctrl *gomock.Controller) *MockUnsafeBookmarkerServer {
+	mock := &MockUnsafeBookmarkerServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeBookmarkerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeBookmarkerServer) EXPECT() *MockUnsafeBookmarkerServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedBookmarkerServer mocks base method.
+func (m *MockUnsafeBookmarkerServer) mustEmbedUnimplementedBookmarkerServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedBookmarkerServer")
+}
+
+// mustEmbedUnimplementedBookmarkerServer indicates an expected call of mustEmbedUnimplementedBookmarkerServer.
+func (mr *MockUnsafeBookmarkerServerMockRecorder) mustEmbedUnimplementedBookmarkerServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBookmarkerServer", reflect.TypeOf((*MockUnsafeBookmarkerServer)(nil).mustEmbedUnimplementedBookmarkerServer))
+}
+
+// MockBookmarker_ListBookmarksServer is a mock of Bookmarker_ListBookmarksServer interface.
+type MockBookmarker_ListBookmarksServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListBookmarksServerMockRecorder
+}
+
+// MockBookmarker_ListBookmarksServerMockRecorder is the mock recorder for MockBookmarker_ListBookmarksServer.
+type MockBookmarker_ListBookmarksServerMockRecorder struct {
+	mock *MockBookmarker_ListBookmarksServer
+}
+
+// NewMockBookmarker_ListBookmarksServer creates a new mock instance.
+func NewMockBookmarker_ListBookmarksServer(ctrl *gomock.Controller) *MockBookmarker_ListBookmarksServer {
+	mock := &MockBookmarker_ListBookmarksServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListBookmarksServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListBookmarksServer) EXPECT() *MockBookmarker_ListBookmarksServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListBookmarksServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarksServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_ListBookmarksServer) Send(arg0 *pb.ListBookmarksResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_ListBookmarksServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarksServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_ListBookmarksServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_ListBookmarksServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_WatchBookmarksServer is a mock of Bookmarker_WatchBookmarksServer interface.
+type MockBookmarker_WatchBookmarksServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_WatchBookmarksServerMockRecorder
+}
+
+// MockBookmarker_WatchBookmarksServerMockRecorder is the mock recorder for MockBookmarker_WatchBookmarksServer.
+type MockBookmarker_WatchBookmarksServerMockRecorder struct {
+	mock *MockBookmarker_WatchBookmarksServer
+}
+
+// NewMockBookmarker_WatchBookmarksServer creates a new mock instance.
+func NewMockBookmarker_WatchBookmarksServer(ctrl *gomock.Controller) *MockBookmarker_WatchBookmarksServer {
+	mock := &MockBookmarker_WatchBookmarksServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_WatchBookmarksServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_WatchBookmarksServer) EXPECT() *MockBookmarker_WatchBookmarksServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_WatchBookmarksServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_WatchBookmarksServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_WatchBookmarksServer) Send(arg0 *pb.BookmarkChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_WatchBookmarksServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_WatchBookmarksServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_WatchBookmarksServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_WatchBookmarksServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_WatchBookmarksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_WatchBookmarksServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_ListTrashServer is a mock of Bookmarker_ListTrashServer interface.
+type MockBookmarker_ListTrashServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListTrashServerMockRecorder
+}
+
+// MockBookmarker_ListTrashServerMockRecorder is the mock recorder for MockBookmarker_ListTrashServer.
+type MockBookmarker_ListTrashServerMockRecorder struct {
+	mock *MockBookmarker_ListTrashServer
+}
+
+// NewMockBookmarker_ListTrashServer creates a new mock instance.
+func NewMockBookmarker_ListTrashServer(ctrl *gomock.Controller) *MockBookmarker_ListTrashServer {
+	mock := &MockBookmarker_ListTrashServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListTrashServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListTrashServer) EXPECT() *MockBookmarker_ListTrashServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListTrashServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListTrashServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_ListTrashServer) Send(arg0 *pb.Bookmark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_ListTrashServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListTrashServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_ListTrashServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_ListTrashServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_ListTrashServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListTrashServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_ListBookmarkRevisionsServer is a mock of Bookmarker_ListBookmarkRevisionsServer interface.
+type MockBookmarker_ListBookmarkRevisionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListBookmarkRevisionsServerMockRecorder
+}
+
+// MockBookmarker_ListBookmarkRevisionsServerMockRecorder is the mock recorder for MockBookmarker_ListBookmarkRevisionsServer.
+type MockBookmarker_ListBookmarkRevisionsServerMockRecorder struct {
+	mock *MockBookmarker_ListBookmarkRevisionsServer
+}
+
+// NewMockBookmarker_ListBookmarkRevisionsServer creates a new mock instance.
+func NewMockBookmarker_ListBookmarkRevisionsServer(ctrl *gomock.Controller) *MockBookmarker_ListBookmarkRevisionsServer {
+	mock := &MockBookmarker_ListBookmarkRevisionsServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListBookmarkRevisionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListBookmarkRevisionsServer) EXPECT() *MockBookmarker_ListBookmarkRevisionsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarkRevisionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsServer) Send(arg0 *pb.BookmarkRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListBookmarkRevisionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_ListBookmarkRevisionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_ListBookmarkRevisionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListBookmarkRevisionsServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_ListTagsServer is a mock of Bookmarker_ListTagsServer interface.
+type MockBookmarker_ListTagsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListTagsServerMockRecorder
+}
+
+// MockBookmarker_ListTagsServerMockRecorder is the mock recorder for MockBookmarker_ListTagsServer.
+type MockBookmarker_ListTagsServerMockRecorder struct {
+	mock *MockBookmarker_ListTagsServer
+}
+
+// NewMockBookmarker_ListTagsServer creates a new mock instance.
+func NewMockBookmarker_ListTagsServer(ctrl *gomock.Controller) *MockBookmarker_ListTagsServer {
+	mock := &MockBookmarker_ListTagsServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListTagsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListTagsServer) EXPECT() *MockBookmarker_ListTagsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListTagsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListTagsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_ListTagsServer) Send(arg0 *pb.TagCount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_ListTagsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListTagsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_ListTagsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_ListTagsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_ListTagsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListTagsServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_FindDuplicatesServer is a mock of Bookmarker_FindDuplicatesServer interface.
+type MockBookmarker_FindDuplicatesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_FindDuplicatesServerMockRecorder
+}
+
+// MockBookmarker_FindDuplicatesServerMockRecorder is the mock recorder for MockBookmarker_FindDuplicatesServer.
+type MockBookmarker_FindDuplicatesServerMockRecorder struct {
+	mock *MockBookmarker_FindDuplicatesServer
+}
+
+// NewMockBookmarker_FindDuplicatesServer creates a new mock instance.
+func NewMockBookmarker_FindDuplicatesServer(ctrl *gomock.Controller) *MockBookmarker_FindDuplicatesServer {
+	mock := &MockBookmarker_FindDuplicatesServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_FindDuplicatesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_FindDuplicatesServer) EXPECT() *MockBookmarker_FindDuplicatesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_FindDuplicatesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_FindDuplicatesServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_FindDuplicatesServer) Send(arg0 *pb.Duplicate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_FindDuplicatesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_FindDuplicatesServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_FindDuplicatesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_FindDuplicatesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_FindDuplicatesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_FindDuplicatesServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_ListAuditEntriesServer is a mock of Bookmarker_ListAuditEntriesServer interface.
+type MockBookmarker_ListAuditEntriesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListAuditEntriesServerMockRecorder
+}
+
+// MockBookmarker_ListAuditEntriesServerMockRecorder is the mock recorder for MockBookmarker_ListAuditEntriesServer.
+type MockBookmarker_ListAuditEntriesServerMockRecorder struct {
+	mock *MockBookmarker_ListAuditEntriesServer
+}
+
+// NewMockBookmarker_ListAuditEntriesServer creates a new mock instance.
+func NewMockBookmarker_ListAuditEntriesServer(ctrl *gomock.Controller) *MockBookmarker_ListAuditEntriesServer {
+	mock := &MockBookmarker_ListAuditEntriesServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListAuditEntriesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListAuditEntriesServer) EXPECT() *MockBookmarker_ListAuditEntriesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListAuditEntriesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListAuditEntriesServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_ListAuditEntriesServer) Send(arg0 *pb.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_ListAuditEntriesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListAuditEntriesServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_ListAuditEntriesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_ListAuditEntriesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_ListAuditEntriesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListAuditEntriesServer)(nil).SetTrailer), arg0)
+}
+
+// MockBookmarker_ListSharedWithMeServer is a mock of Bookmarker_ListSharedWithMeServer interface.
+type MockBookmarker_ListSharedWithMeServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarker_ListSharedWithMeServerMockRecorder
+}
+
+// MockBookmarker_ListSharedWithMeServerMockRecorder is the mock recorder for MockBookmarker_ListSharedWithMeServer.
+type MockBookmarker_ListSharedWithMeServerMockRecorder struct {
+	mock *MockBookmarker_ListSharedWithMeServer
+}
+
+// NewMockBookmarker_ListSharedWithMeServer creates a new mock instance.
+func NewMockBookmarker_ListSharedWithMeServer(ctrl *gomock.Controller) *MockBookmarker_ListSharedWithMeServer {
+	mock := &MockBookmarker_ListSharedWithMeServer{ctrl: ctrl}
+	mock.recorder = &MockBookmarker_ListSharedWithMeServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarker_ListSharedWithMeServer) EXPECT() *MockBookmarker_ListSharedWithMeServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBookmarker_ListSharedWithMeServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBookmarker_ListSharedWithMeServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBookmarker_ListSharedWithMeServer) Send(arg0 *pb.SharedBookmark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBookmarker_ListSharedWithMeServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBookmarker_ListSharedWithMeServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBookmarker_ListSharedWithMeServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBookmarker_ListSharedWithMeServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBookmarker_ListSharedWithMeServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListSharedWithMeServer)(nil).SetTrailer), arg0)
+}
+
+// MockFolderManagerClient is a mock of FolderManagerClient interface.
+type MockFolderManagerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderManagerClientMockRecorder
+}
+
+// MockFolderManagerClientMockRecorder is the mock recorder for MockFolderManagerClient.
+type MockFolderManagerClientMockRecorder struct {
+	mock *MockFolderManagerClient
+}
+
+// NewMockFolderManagerClient creates a new mock instance.
+func NewMockFolderManagerClient(ctrl *gomock.Controller) *MockFolderManagerClient {
+	mock := &MockFolderManagerClient{ctrl: ctrl}
+	mock.recorder = &MockFolderManagerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolderManagerClient) EXPECT() *MockFolderManagerClientMockRecorder {
+	return m.recorder
+}
+
+// CreateFolder mocks base method.
+func (m *MockFolderManagerClient) CreateFolder(ctx context.Context, in *pb.CreateFolderRequest, opts ...grpc.CallOption) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFolder", varargs...)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockFolderManagerClientMockRecorder) CreateFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockFolderManagerClient)(nil).CreateFolder), varargs...)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockFolderManagerClient) DeleteFolder(ctx context.Context, in *pb.DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFolder", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockFolderManagerClientMockRecorder) DeleteFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockFolderManagerClient)(nil).DeleteFolder), varargs...)
+}
+
+// GetFolder mocks base method.
+func (m *MockFolderManagerClient) GetFolder(ctx context.Context, in *pb.GetFolderRequest, opts ...grpc.CallOption) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFolder", varargs...)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolder indicates an expected call of GetFolder.
+func (mr *MockFolderManagerClientMockRecorder) GetFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolder", reflect.TypeOf((*MockFolderManagerClient)(nil).GetFolder), varargs...)
+}
+
+// ListFolders mocks base method.
+func (m *MockFolderManagerClient) ListFolders(ctx context.Context, in *pb.ListFoldersRequest, opts ...grpc.CallOption) (pb.FolderManager_ListFoldersClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFolders", varargs...)
+	ret0, _ := ret[0].(pb.FolderManager_ListFoldersClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockFolderManagerClientMockRecorder) ListFolders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockFolderManagerClient)(nil).ListFolders), varargs...)
+}
+
+// MoveBookmark mocks base method.
+func (m *MockFolderManagerClient) MoveBookmark(ctx context.Context, in *pb.MoveBookmarkRequest, opts ...grpc.CallOption) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveBookmark", varargs...)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBookmark indicates an expected call of MoveBookmark.
+func (mr *MockFolderManagerClientMockRecorder) MoveBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBookmark", reflect.TypeOf((*MockFolderManagerClient)(nil).MoveBookmark), varargs...)
+}
+
+// MoveFolder mocks base method.
+func (m *MockFolderManagerClient) MoveFolder(ctx context.Context, in *pb.MoveFolderRequest, opts ...grpc.CallOption) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveFolder", varargs...)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveFolder indicates an expected call of MoveFolder.
+func (mr *MockFolderManagerClientMockRecorder) MoveFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveFolder", reflect.TypeOf((*MockFolderManagerClient)(nil).MoveFolder), varargs...)
+}
+
+// UpdateFolder mocks base method.
+func (m *MockFolderManagerClient) UpdateFolder(ctx context.Context, in *pb.UpdateFolderRequest, opts ...grpc.CallOption) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFolder", varargs...)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockFolderManagerClientMockRecorder) UpdateFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockFolderManagerClient)(nil).UpdateFolder), varargs...)
+}
+
+// MockFolderManager_ListFoldersClient is a mock of FolderManager_ListFoldersClient interface.
+type MockFolderManager_ListFoldersClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderManager_ListFoldersClientMockRecorder
+}
+
+// MockFolderManager_ListFoldersClientMockRecorder is the mock recorder for MockFolderManager_ListFoldersClient.
+type MockFolderManager_ListFoldersClientMockRecorder struct {
+	mock *MockFolderManager_ListFoldersClient
+}
+
+// NewMockFolderManager_ListFoldersClient creates a new mock instance.
+func NewMockFolderManager_ListFoldersClient(ctrl *gomock.Controller) *MockFolderManager_ListFoldersClient {
+	mock := &MockFolderManager_ListFoldersClient{ctrl: ctrl}
+	mock.recorder = &MockFolderManager_ListFoldersClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolderManager_ListFoldersClient) EXPECT() *MockFolderManager_ListFoldersClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockFolderManager_ListFoldersClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockFolderManager_ListFoldersClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockFolderManager_ListFoldersClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockFolderManager_ListFoldersClient) Recv() (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockFolderManager_ListFoldersClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockFolderManager_ListFoldersClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockFolderManager_ListFoldersClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockFolderManager_ListFoldersClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockFolderManager_ListFoldersClient)(nil).Trailer))
+}
+
+// MockFolderManagerServer is a mock of FolderManagerServer interface.
+type MockFolderManagerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderManagerServerMockRecorder
+}
+
+// MockFolderManagerServerMockRecorder is the mock recorder for MockFolderManagerServer.
+type MockFolderManagerServerMockRecorder struct {
+	mock *MockFolderManagerServer
+}
+
+// NewMockFolderManagerServer creates a new mock instance.
+func NewMockFolderManagerServer(ctrl *gomock.Controller) *MockFolderManagerServer {
+	mock := &MockFolderManagerServer{ctrl: ctrl}
+	mock.recorder = &MockFolderManagerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolderManagerServer) EXPECT() *MockFolderManagerServerMockRecorder {
+	return m.recorder
+}
+
+// CreateFolder mocks base method.
+func (m *MockFolderManagerServer) CreateFolder(arg0 context.Context, arg1 *pb.CreateFolderRequest) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockFolderManagerServerMockRecorder) CreateFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockFolderManagerServer)(nil).CreateFolder), arg0, arg1)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockFolderManagerServer) DeleteFolder(arg0 context.Context, arg1 *pb.DeleteFolderRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockFolderManagerServerMockRecorder) DeleteFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockFolderManagerServer)(nil).DeleteFolder), arg0, arg1)
+}
+
+// GetFolder mocks base method.
+func (m *MockFolderManagerServer) GetFolder(arg0 context.Context, arg1 *pb.GetFolderRequest) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolder", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolder indicates an expected call of GetFolder.
+func (mr *MockFolderManagerServerMockRecorder) GetFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolder", reflect.TypeOf((*MockFolderManagerServer)(nil).GetFolder), arg0, arg1)
+}
+
+// ListFolders mocks base method.
+func (m *MockFolderManagerServer) ListFolders(arg0 *pb.ListFoldersRequest, arg1 pb.FolderManager_ListFoldersServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockFolderManagerServerMockRecorder) ListFolders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockFolderManagerServer)(nil).ListFolders), arg0, arg1)
+}
+
+// MoveBookmark mocks base method.
+func (m *MockFolderManagerServer) MoveBookmark(arg0 context.Context, arg1 *pb.MoveBookmarkRequest) (*pb.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBookmark indicates an expected call of MoveBookmark.
+func (mr *MockFolderManagerServerMockRecorder) MoveBookmark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBookmark", reflect.TypeOf((*MockFolderManagerServer)(nil).MoveBookmark), arg0, arg1)
+}
+
+// MoveFolder mocks base method.
+func (m *MockFolderManagerServer) MoveFolder(arg0 context.Context, arg1 *pb.MoveFolderRequest) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveFolder", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveFolder indicates an expected call of MoveFolder.
+func (mr *MockFolderManagerServerMockRecorder) MoveFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveFolder", reflect.TypeOf((*MockFolderManagerServer)(nil).MoveFolder), arg0, arg1)
+}
+
+// UpdateFolder mocks base method.
+func (m *MockFolderManagerServer) UpdateFolder(arg0 context.Context, arg1 *pb.UpdateFolderRequest) (*pb.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFolder", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockFolderManagerServerMockRecorder) UpdateFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockFolderManagerServer)(nil).UpdateFolder), arg0, arg1)
+}
+
+// mustEmbedUnimplementedFolderManagerServer mocks base method.
+func (m *MockFolderManagerServer) mustEmbedUnimplementedFolderManagerServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedFolderManagerServer")
+}
+
+// mustEmbedUnimplementedFolderManagerServer indicates an expected call of mustEmbedUnimplementedFolderManagerServer.
+func (mr *MockFolderManagerServerMockRecorder) mustEmbedUnimplementedFolderManagerServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedFolderManagerServer", reflect.TypeOf((*MockFolderManagerServer)(nil).mustEmbedUnimplementedFolderManagerServer))
+}
+
+// MockUnsafeFolderManagerServer is a mock of UnsafeFolderManagerServer interface.
+type MockUnsafeFolderManagerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeFolderManagerServerMockRecorder
+}
+
+// MockUnsafeFolderManagerServerMockRecorder is the mock recorder for MockUnsafeFolderManagerServer.
+type MockUnsafeFolderManagerServerMockRecorder struct {
+	mock *MockUnsafeFolderManagerServer
+}
+
+// NewMockUnsafeFolderManagerServer creates a new mock instance.
+func NewMockUnsafeFolderManagerServer(ctrl *gomock.Controller) *MockUnsafeFolderManagerServer {
+	mock := &MockUnsafeFolderManagerServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeFolderManagerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeFolderManagerServer) EXPECT() *MockUnsafeFolderManagerServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedFolderManagerServer mocks base method.
+func (m *MockUnsafeFolderManagerServer) mustEmbedUnimplementedFolderManagerServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedFolderManagerServer")
+}
+
+// mustEmbedUnimplementedFolderManagerServer indicates an expected call of mustEmbedUnimplementedFolderManagerServer.
+func (mr *MockUnsafeFolderManagerServerMockRecorder) mustEmbedUnimplementedFolderManagerServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedFolderManagerServer", reflect.TypeOf((*MockUnsafeFolderManagerServer)(nil).mustEmbedUnimplementedFolderManagerServer))
+}
+
+// MockFolderManager_ListFoldersServer is a mock of FolderManager_ListFoldersServer interface.
+type MockFolderManager_ListFoldersServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderManager_ListFoldersServerMockRecorder
+}
+
+// MockFolderManager_ListFoldersServerMockRecorder is the mock recorder for MockFolderManager_ListFoldersServer.
+type MockFolderManager_ListFoldersServerMockRecorder struct {
+	mock *MockFolderManager_ListFoldersServer
+}
+
+// NewMockFolderManager_ListFoldersServer creates a new mock instance.
+func NewMockFolderManager_ListFoldersServer(ctrl *gomock.Controller) *MockFolderManager_ListFoldersServer {
+	mock := &MockFolderManager_ListFoldersServer{ctrl: ctrl}
+	mock.recorder = &MockFolderManager_ListFoldersServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolderManager_ListFoldersServer) EXPECT() *MockFolderManager_ListFoldersServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockFolderManager_ListFoldersServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockFolderManager_ListFoldersServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockFolderManager_ListFoldersServer) Send(arg0 *pb.Folder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockFolderManager_ListFoldersServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockFolderManager_ListFoldersServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockFolderManager_ListFoldersServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockFolderManager_ListFoldersServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockFolderManager_ListFoldersServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockFolderManager_ListFoldersServer)(nil).SetTrailer), arg0)
+}
+
+// MockWebhookManagerClient is a mock of WebhookManagerClient interface.
+type MockWebhookManagerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookManagerClientMockRecorder
+}
+
+// MockWebhookManagerClientMockRecorder is the mock recorder for MockWebhookManagerClient.
+type MockWebhookManagerClientMockRecorder struct {
+	mock *MockWebhookManagerClient
+}
+
+// NewMockWebhookManagerClient creates a new mock instance.
+func NewMockWebhookManagerClient(ctrl *gomock.Controller) *MockWebhookManagerClient {
+	mock := &MockWebhookManagerClient{ctrl: ctrl}
+	mock.recorder = &MockWebhookManagerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookManagerClient) EXPECT() *MockWebhookManagerClientMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookManagerClient) CreateWebhook(ctx context.Context, in *pb.CreateWebhookRequest, opts ...grpc.CallOption) (*pb.Webhook, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWebhook", varargs...)
+	ret0, _ := ret[0].(*pb.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookManagerClientMockRecorder) CreateWebhook(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookManagerClient)(nil).CreateWebhook), varargs...)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookManagerClient) DeleteWebhook(ctx context.Context, in *pb.DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWebhook", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookManagerClientMockRecorder) DeleteWebhook(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookManagerClient)(nil).DeleteWebhook), varargs...)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockWebhookManagerClient) ListDeadLetters(ctx context.Context, in *pb.ListDeadLettersRequest, opts ...grpc.CallOption) (pb.WebhookManager_ListDeadLettersClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeadLetters", varargs...)
+	ret0, _ := ret[0].(pb.WebhookManager_ListDeadLettersClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockWebhookManagerClientMockRecorder) ListDeadLetters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockWebhookManagerClient)(nil).ListDeadLetters), varargs...)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockWebhookManagerClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (pb.WebhookManager_ListWebhooksClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooks", varargs...)
+	ret0, _ := ret[0].(pb.WebhookManager_ListWebhooksClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookManagerClientMockRecorder) ListWebhooks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookManagerClient)(nil).ListWebhooks), varargs...)
+}
+
+// MockWebhookManager_ListWebhooksClient is a mock of WebhookManager_ListWebhooksClient interface.
+type MockWebhookManager_ListWebhooksClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookManager_ListWebhooksClientMockRecorder
+}
+
+// MockWebhookManager_ListWebhooksClientMockRecorder is the mock recorder for MockWebhookManager_ListWebhooksClient.
+type MockWebhookManager_ListWebhooksClientMockRecorder struct {
+	mock *MockWebhookManager_ListWebhooksClient
+}
+
+// NewMockWebhookManager_ListWebhooksClient creates a new mock instance.
+func NewMockWebhookManager_ListWebhooksClient(ctrl *gomock.Controller) *MockWebhookManager_ListWebhooksClient {
+	mock := &MockWebhookManager_ListWebhooksClient{ctrl: ctrl}
+	mock.recorder = &MockWebhookManager_ListWebhooksClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookManager_ListWebhooksClient) EXPECT() *MockWebhookManager_ListWebhooksClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockWebhookManager_ListWebhooksClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockWebhookManager_ListWebhooksClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockWebhookManager_ListWebhooksClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockWebhookManager_ListWebhooksClient) Recv() (*pb.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockWebhookManager_ListWebhooksClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).DeleteBookmark), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).RecvMsg), m)
 }
 
-// ListBookmarks mocks base method.
-func (m *MockBookmarkerClient) ListBookmarks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (pb.Bookmarker_ListBookmarksClient, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListBookmarks", varargs...)
-	ret0, _ := ret[0].(pb.Bookmarker_ListBookmarksClient)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// SendMsg mocks base method.
+func (m_2 *MockWebhookManager_ListWebhooksClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListBookmarks indicates an expected call of ListBookmarks.
-func (mr *MockBookmarkerClientMockRecorder) ListBookmarks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockBookmarkerClient)(nil).ListBookmarks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).SendMsg), m)
 }
 
-// UpdateBookmark mocks base method.
-func (m *MockBookmarkerClient) UpdateBookmark(ctx context.Context, in *pb.UpdateBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// Trailer mocks base method.
+func (m *MockWebhookManager_ListWebhooksClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateBookmark", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// UpdateBookmark indicates an expected call of UpdateBookmark.
-func (mr *MockBookmarkerClientMockRecorder) UpdateBookmark(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer.
+func (mr *MockWebhookManager_ListWebhooksClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmark", reflect.TypeOf((*MockBookmarkerClient)(nil).UpdateBookmark), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockWebhookManager_ListWebhooksClient)(nil).Trailer))
 }
 
-// MockBookmarker_ListBookmarksClient is a mock of Bookmarker_ListBookmarksClient interface.
-type MockBookmarker_ListBookmarksClient struct {
+// MockWebhookManager_ListDeadLettersClient is a mock of WebhookManager_ListDeadLettersClient interface.
+type MockWebhookManager_ListDeadLettersClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockBookmarker_ListBookmarksClientMockRecorder
+	recorder *MockWebhookManager_ListDeadLettersClientMockRecorder
 }
 
-// MockBookmarker_ListBookmarksClientMockRecorder is the mock recorder for MockBookmarker_ListBookmarksClient.
-type MockBookmarker_ListBookmarksClientMockRecorder struct {
-	mock *MockBookmarker_ListBookmarksClient
+// MockWebhookManager_ListDeadLettersClientMockRecorder is the mock recorder for MockWebhookManager_ListDeadLettersClient.
+type MockWebhookManager_ListDeadLettersClientMockRecorder struct {
+	mock *MockWebhookManager_ListDeadLettersClient
 }
 
-// NewMockBookmarker_ListBookmarksClient creates a new mock instance.
-func NewMockBookmarker_ListBookmarksClient(ctrl *gomock.Controller) *MockBookmarker_ListBookmarksClient {
-	mock := &MockBookmarker_ListBookmarksClient{ctrl: ctrl}
-	mock.recorder = &MockBookmarker_ListBookmarksClientMockRecorder{mock}
+// NewMockWebhookManager_ListDeadLettersClient creates a new mock instance.
+func NewMockWebhookManager_ListDeadLettersClient(ctrl *gomock.Controller) *MockWebhookManager_ListDeadLettersClient {
+	mock := &MockWebhookManager_ListDeadLettersClient{ctrl: ctrl}
+	mock.recorder = &MockWebhookManager_ListDeadLettersClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBookmarker_ListBookmarksClient) EXPECT() *MockBookmarker_ListBookmarksClientMockRecorder {
+func (m *MockWebhookManager_ListDeadLettersClient) EXPECT() *MockWebhookManager_ListDeadLettersClientMockRecorder {
 	return m.recorder
 }
 
 // CloseSend mocks base method.
-func (m *MockBookmarker_ListBookmarksClient) CloseSend() error {
+func (m *MockWebhookManager_ListDeadLettersClient) CloseSend() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
@@ -150,13 +3818,13 @@ func (m *MockBookmarker_ListBookmarksClient) CloseSend() error {
 }
 
 // CloseSend indicates an expected call of CloseSend.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) CloseSend() *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).CloseSend))
 }
 
 // Context mocks base method.
-func (m *MockBookmarker_ListBookmarksClient) Context() context.Context {
+func (m *MockWebhookManager_ListDeadLettersClient) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -164,13 +3832,13 @@ func (m *MockBookmarker_ListBookmarksClient) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Context() *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).Context))
 }
 
 // Header mocks base method.
-func (m *MockBookmarker_ListBookmarksClient) Header() (metadata.MD, error) {
+func (m *MockWebhookManager_ListDeadLettersClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(metadata.MD)
@@ -179,28 +3847,28 @@ func (m *MockBookmarker_ListBookmarksClient) Header() (metadata.MD, error) {
 }
 
 // Header indicates an expected call of Header.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Header() *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Header))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).Header))
 }
 
 // Recv mocks base method.
-func (m *MockBookmarker_ListBookmarksClient) Recv() (*pb.Bookmark, error) {
+func (m *MockWebhookManager_ListDeadLettersClient) Recv() (*pb.DeadLetter, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*pb.Bookmark)
+	ret0, _ := ret[0].(*pb.DeadLetter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Recv indicates an expected call of Recv.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Recv() *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).Recv))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockBookmarker_ListBookmarksClient) RecvMsg(m interface{}) error {
+func (m_2 *MockWebhookManager_ListDeadLettersClient) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -208,13 +3876,13 @@ func (m_2 *MockBookmarker_ListBookmarksClient) RecvMsg(m interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).RecvMsg), m)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockBookmarker_ListBookmarksClient) SendMsg(m interface{}) error {
+func (m_2 *MockWebhookManager_ListDeadLettersClient) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -222,13 +3890,13 @@ func (m_2 *MockBookmarker_ListBookmarksClient) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).SendMsg), m)
 }
 
 // Trailer mocks base method.
-func (m *MockBookmarker_ListBookmarksClient) Trailer() metadata.MD {
+func (m *MockWebhookManager_ListDeadLettersClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Trailer")
 	ret0, _ := ret[0].(metadata.MD)
@@ -236,165 +3904,164 @@ func (m *MockBookmarker_ListBookmarksClient) Trailer() metadata.MD {
 }
 
 // Trailer indicates an expected call of Trailer.
-func (mr *MockBookmarker_ListBookmarksClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockWebhookManager_ListDeadLettersClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBookmarker_ListBookmarksClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockWebhookManager_ListDeadLettersClient)(nil).Trailer))
 }
 
-// MockBookmarkerServer is a mock of BookmarkerServer interface.
-type MockBookmarkerServer struct {
+// MockWebhookManagerServer is a mock of WebhookManagerServer interface.
+type MockWebhookManagerServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockBookmarkerServerMockRecorder
+	recorder *MockWebhookManagerServerMockRecorder
 }
 
-// MockBookmarkerServerMockRecorder is the mock recorder for MockBookmarkerServer.
-type MockBookmarkerServerMockRecorder struct {
-	mock *MockBookmarkerServer
+// MockWebhookManagerServerMockRecorder is the mock recorder for MockWebhookManagerServer.
+type MockWebhookManagerServerMockRecorder struct {
+	mock *MockWebhookManagerServer
 }
 
-// NewMockBookmarkerServer creates a new mock instance.
-func NewMockBookmarkerServer(ctrl *gomock.Controller) *MockBookmarkerServer {
-	mock := &MockBookmarkerServer{ctrl: ctrl}
-	mock.recorder = &MockBookmarkerServerMockRecorder{mock}
+// NewMockWebhookManagerServer creates a new mock instance.
+func NewMockWebhookManagerServer(ctrl *gomock.Controller) *MockWebhookManagerServer {
+	mock := &MockWebhookManagerServer{ctrl: ctrl}
+	mock.recorder = &MockWebhookManagerServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBookmarkerServer) EXPECT() *MockBookmarkerServerMockRecorder {
+func (m *MockWebhookManagerServer) EXPECT() *MockWebhookManagerServerMockRecorder {
 	return m.recorder
 }
 
-// CreateBookmark mocks base method.
-func (m *MockBookmarkerServer) CreateBookmark(arg0 context.Context, arg1 *pb.CreateBookmarkRequest) (*emptypb.Empty, error) {
+// CreateWebhook mocks base method.
+func (m *MockWebhookManagerServer) CreateWebhook(arg0 context.Context, arg1 *pb.CreateWebhookRequest) (*pb.Webhook, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBookmark", arg0, arg1)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Webhook)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateBookmark indicates an expected call of CreateBookmark.
-func (mr *MockBookmarkerServerMockRecorder) CreateBookmark(arg0, arg1 interface{}) *gomock.Call {
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookManagerServerMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).CreateBookmark), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookManagerServer)(nil).CreateWebhook), arg0, arg1)
 }
 
-// DeleteBookmark mocks base method.
-func (m *MockBookmarkerServer) DeleteBookmark(arg0 context.Context, arg1 *pb.DeleteBookmarkRequest) (*emptypb.Empty, error) {
+// DeleteWebhook mocks base method.
+func (m *MockWebhookManagerServer) DeleteWebhook(arg0 context.Context, arg1 *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBookmark", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
 	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteBookmark indicates an expected call of DeleteBookmark.
-func (mr *MockBookmarkerServerMockRecorder) DeleteBookmark(arg0, arg1 interface{}) *gomock.Call {
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookManagerServerMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).DeleteBookmark), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookManagerServer)(nil).DeleteWebhook), arg0, arg1)
 }
 
-// ListBookmarks mocks base method.
-func (m *MockBookmarkerServer) ListBookmarks(arg0 *emptypb.Empty, arg1 pb.Bookmarker_ListBookmarksServer) error {
+// ListDeadLetters mocks base method.
+func (m *MockWebhookManagerServer) ListDeadLetters(arg0 *pb.ListDeadLettersRequest, arg1 pb.WebhookManager_ListDeadLettersServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBookmarks", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListDeadLetters", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ListBookmarks indicates an expected call of ListBookmarks.
-func (mr *MockBookmarkerServerMockRecorder) ListBookmarks(arg0, arg1 interface{}) *gomock.Call {
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockWebhookManagerServerMockRecorder) ListDeadLetters(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookmarks", reflect.TypeOf((*MockBookmarkerServer)(nil).ListBookmarks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockWebhookManagerServer)(nil).ListDeadLetters), arg0, arg1)
 }
 
-// UpdateBookmark mocks base method.
-func (m *MockBookmarkerServer) UpdateBookmark(arg0 context.Context, arg1 *pb.UpdateBookmarkRequest) (*emptypb.Empty, error) {
+// ListWebhooks mocks base method.
+func (m *MockWebhookManagerServer) ListWebhooks(arg0 *emptypb.Empty, arg1 pb.WebhookManager_ListWebhooksServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBookmark", arg0, arg1)
-	ret0, _ := ret[0].(*emptypb.Empty)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListWebhooks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// UpdateBookmark indicates an expected call of UpdateBookmark.
-func (mr *MockBookmarkerServerMockRecorder) UpdateBookmark(arg0, arg1 interface{}) *gomock.Call {
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookManagerServerMockRecorder) ListWebhooks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookmark", reflect.TypeOf((*MockBookmarkerServer)(nil).UpdateBookmark), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookManagerServer)(nil).ListWebhooks), arg0, arg1)
 }
 
-// mustEmbedUnimplementedBookmarkerServer mocks base method.
-func (m *MockBookmarkerServer) mustEmbedUnimplementedBookmarkerServer() {
+// mustEmbedUnimplementedWebhookManagerServer mocks base method.
+func (m *MockWebhookManagerServer) mustEmbedUnimplementedWebhookManagerServer() {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedBookmarkerServer")
+	m.ctrl.Call(m, "mustEmbedUnimplementedWebhookManagerServer")
 }
 
-// mustEmbedUnimplementedBookmarkerServer indicates an expected call of mustEmbedUnimplementedBookmarkerServer.
-func (mr *MockBookmarkerServerMockRecorder) mustEmbedUnimplementedBookmarkerServer() *gomock.Call {
+// mustEmbedUnimplementedWebhookManagerServer indicates an expected call of mustEmbedUnimplementedWebhookManagerServer.
+func (mr *MockWebhookManagerServerMockRecorder) mustEmbedUnimplementedWebhookManagerServer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBookmarkerServer", reflect.TypeOf((*MockBookmarkerServer)(nil).mustEmbedUnimplementedBookmarkerServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedWebhookManagerServer", reflect.TypeOf((*MockWebhookManagerServer)(nil).mustEmbedUnimplementedWebhookManagerServer))
 }
 
-// MockUnsafeBookmarkerServer is a mock of UnsafeBookmarkerServer interface.
-type MockUnsafeBookmarkerServer struct {
+// MockUnsafeWebhookManagerServer is a mock of UnsafeWebhookManagerServer interface.
+type MockUnsafeWebhookManagerServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockUnsafeBookmarkerServerMockRecorder
+	recorder *MockUnsafeWebhookManagerServerMockRecorder
 }
 
-// MockUnsafeBookmarkerServerMockRecorder is the mock recorder for MockUnsafeBookmarkerServer.
-type MockUnsafeBookmarkerServerMockRecorder struct {
-	mock *MockUnsafeBookmarkerServer
+// MockUnsafeWebhookManagerServerMockRecorder is the mock recorder for MockUnsafeWebhookManagerServer.
+type MockUnsafeWebhookManagerServerMockRecorder struct {
+	mock *MockUnsafeWebhookManagerServer
 }
 
-// NewMockUnsafeBookmarkerServer creates a new mock instance.
-func NewMockUnsafeBookmarkerServer(ctrl *gomock.Controller) *MockUnsafeBookmarkerServer {
-	mock := &MockUnsafeBookmarkerServer{ctrl: ctrl}
-	mock.recorder = &MockUnsafeBookmarkerServerMockRecorder{mock}
+// NewMockUnsafeWebhookManagerServer creates a new mock instance.
+func NewMockUnsafeWebhookManagerServer(ctrl *gomock.Controller) *MockUnsafeWebhookManagerServer {
+	mock := &MockUnsafeWebhookManagerServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeWebhookManagerServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUnsafeBookmarkerServer) EXPECT() *MockUnsafeBookmarkerServerMockRecorder {
+func (m *MockUnsafeWebhookManagerServer) EXPECT() *MockUnsafeWebhookManagerServerMockRecorder {
 	return m.recorder
 }
 
-// mustEmbedUnimplementedBookmarkerServer mocks base method.
-func (m *MockUnsafeBookmarkerServer) mustEmbedUnimplementedBookmarkerServer() {
+// mustEmbedUnimplementedWebhookManagerServer mocks base method.
+func (m *MockUnsafeWebhookManagerServer) mustEmbedUnimplementedWebhookManagerServer() {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedBookmarkerServer")
+	m.ctrl.Call(m, "mustEmbedUnimplementedWebhookManagerServer")
 }
 
-// mustEmbedUnimplementedBookmarkerServer indicates an expected call of mustEmbedUnimplementedBookmarkerServer.
-func (mr *MockUnsafeBookmarkerServerMockRecorder) mustEmbedUnimplementedBookmarkerServer() *gomock.Call {
+// mustEmbedUnimplementedWebhookManagerServer indicates an expected call of mustEmbedUnimplementedWebhookManagerServer.
+func (mr *MockUnsafeWebhookManagerServerMockRecorder) mustEmbedUnimplementedWebhookManagerServer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBookmarkerServer", reflect.TypeOf((*MockUnsafeBookmarkerServer)(nil).mustEmbedUnimplementedBookmarkerServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedWebhookManagerServer", reflect.TypeOf((*MockUnsafeWebhookManagerServer)(nil).mustEmbedUnimplementedWebhookManagerServer))
 }
 
-// MockBookmarker_ListBookmarksServer is a mock of Bookmarker_ListBookmarksServer interface.
-type MockBookmarker_ListBookmarksServer struct {
+// MockWebhookManager_ListWebhooksServer is a mock of WebhookManager_ListWebhooksServer interface.
+type MockWebhookManager_ListWebhooksServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockBookmarker_ListBookmarksServerMockRecorder
+	recorder *MockWebhookManager_ListWebhooksServerMockRecorder
 }
 
-// MockBookmarker_ListBookmarksServerMockRecorder is the mock recorder for MockBookmarker_ListBookmarksServer.
-type MockBookmarker_ListBookmarksServerMockRecorder struct {
-	mock *MockBookmarker_ListBookmarksServer
+// MockWebhookManager_ListWebhooksServerMockRecorder is the mock recorder for MockWebhookManager_ListWebhooksServer.
+type MockWebhookManager_ListWebhooksServerMockRecorder struct {
+	mock *MockWebhookManager_ListWebhooksServer
 }
 
-// NewMockBookmarker_ListBookmarksServer creates a new mock instance.
-func NewMockBookmarker_ListBookmarksServer(ctrl *gomock.Controller) *MockBookmarker_ListBookmarksServer {
-	mock := &MockBookmarker_ListBookmarksServer{ctrl: ctrl}
-	mock.recorder = &MockBookmarker_ListBookmarksServerMockRecorder{mock}
+// NewMockWebhookManager_ListWebhooksServer creates a new mock instance.
+func NewMockWebhookManager_ListWebhooksServer(ctrl *gomock.Controller) *MockWebhookManager_ListWebhooksServer {
+	mock := &MockWebhookManager_ListWebhooksServer{ctrl: ctrl}
+	mock.recorder = &MockWebhookManager_ListWebhooksServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBookmarker_ListBookmarksServer) EXPECT() *MockBookmarker_ListBookmarksServerMockRecorder {
+func (m *MockWebhookManager_ListWebhooksServer) EXPECT() *MockWebhookManager_ListWebhooksServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method.
-func (m *MockBookmarker_ListBookmarksServer) Context() context.Context {
+func (m *MockWebhookManager_ListWebhooksServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -402,13 +4069,13 @@ func (m *MockBookmarker_ListBookmarksServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) Context() *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).Context))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockBookmarker_ListBookmarksServer) RecvMsg(m interface{}) error {
+func (m_2 *MockWebhookManager_ListWebhooksServer) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -416,13 +4083,13 @@ func (m_2 *MockBookmarker_ListBookmarksServer) RecvMsg(m interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).RecvMsg), m)
 }
 
 // Send mocks base method.
-func (m *MockBookmarker_ListBookmarksServer) Send(arg0 *pb.Bookmark) error {
+func (m *MockWebhookManager_ListWebhooksServer) Send(arg0 *pb.Webhook) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -430,13 +4097,13 @@ func (m *MockBookmarker_ListBookmarksServer) Send(arg0 *pb.Bookmark) error {
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method.
-func (m *MockBookmarker_ListBookmarksServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockWebhookManager_ListWebhooksServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -444,13 +4111,13 @@ func (m *MockBookmarker_ListBookmarksServer) SendHeader(arg0 metadata.MD) error
 }
 
 // SendHeader indicates an expected call of SendHeader.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockBookmarker_ListBookmarksServer) SendMsg(m interface{}) error {
+func (m_2 *MockWebhookManager_ListWebhooksServer) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -458,13 +4125,13 @@ func (m_2 *MockBookmarker_ListBookmarksServer) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).SendMsg), m)
 }
 
 // SetHeader mocks base method.
-func (m *MockBookmarker_ListBookmarksServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockWebhookManager_ListWebhooksServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -472,19 +4139,138 @@ func (m *MockBookmarker_ListBookmarksServer) SetHeader(arg0 metadata.MD) error {
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method.
-func (m *MockBookmarker_ListBookmarksServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockWebhookManager_ListWebhooksServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockBookmarker_ListBookmarksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockWebhookManager_ListWebhooksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBookmarker_ListBookmarksServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockWebhookManager_ListWebhooksServer)(nil).SetTrailer), arg0)
+}
+
+// MockWebhookManager_ListDeadLettersServer is a mock of WebhookManager_ListDeadLettersServer interface.
+type MockWebhookManager_ListDeadLettersServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookManager_ListDeadLettersServerMockRecorder
+}
+
+// MockWebhookManager_ListDeadLettersServerMockRecorder is the mock recorder for MockWebhookManager_ListDeadLettersServer.
+type MockWebhookManager_ListDeadLettersServerMockRecorder struct {
+	mock *MockWebhookManager_ListDeadLettersServer
+}
+
+// NewMockWebhookManager_ListDeadLettersServer creates a new mock instance.
+func NewMockWebhookManager_ListDeadLettersServer(ctrl *gomock.Controller) *MockWebhookManager_ListDeadLettersServer {
+	mock := &MockWebhookManager_ListDeadLettersServer{ctrl: ctrl}
+	mock.recorder = &MockWebhookManager_ListDeadLettersServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookManager_ListDeadLettersServer) EXPECT() *MockWebhookManager_ListDeadLettersServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockWebhookManager_ListDeadLettersServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockWebhookManager_ListDeadLettersServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockWebhookManager_ListDeadLettersServer) Send(arg0 *pb.DeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockWebhookManager_ListDeadLettersServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockWebhookManager_ListDeadLettersServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockWebhookManager_ListDeadLettersServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockWebhookManager_ListDeadLettersServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockWebhookManager_ListDeadLettersServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockWebhookManager_ListDeadLettersServer)(nil).SetTrailer), arg0)
 }